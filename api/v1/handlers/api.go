@@ -6,6 +6,7 @@ import (
 
 	"github.com/tedyst/licenta/api/authorization"
 	"github.com/tedyst/licenta/api/v1/generated"
+	"github.com/tedyst/licenta/breach"
 	"github.com/tedyst/licenta/cache"
 	"github.com/tedyst/licenta/db"
 	"github.com/tedyst/licenta/db/queries"
@@ -24,6 +25,8 @@ type serverHandler struct {
 
 	cache cache.CacheProvider[string]
 
+	breachIndex breach.RangeQuerier
+
 	saltKey string
 }
 
@@ -54,6 +57,10 @@ type HandlerConfig struct {
 
 	Cache cache.CacheProvider[string]
 
+	// BreachIndex answers the breached passwords range queries. It is nil if
+	// no corpus is configured.
+	BreachIndex breach.RangeQuerier
+
 	SaltKey string
 }
 
@@ -67,6 +74,7 @@ func NewServerHandler(config HandlerConfig) *serverHandler {
 		authorization:    config.AuthorizationManager,
 		saltKey:          config.SaltKey,
 		cache:            config.Cache,
+		breachIndex:      config.BreachIndex,
 	}
 }
 
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/tedyst/licenta/api/v1/generated"
+	"github.com/tedyst/licenta/breach"
+)
+
+func (server *serverHandler) GetBreachedPasswordsPrefix(ctx context.Context, request generated.GetBreachedPasswordsPrefixRequestObject) (generated.GetBreachedPasswordsPrefixResponseObject, error) {
+	user, err := server.userAuth.GetUser(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting user: %w", err)
+	}
+	worker, err := server.workerauth.GetWorker(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting worker: %w", err)
+	}
+	if user == nil && worker == nil {
+		return generated.GetBreachedPasswordsPrefix401JSONResponse{
+			Message: "Unauthorized",
+			Success: false,
+		}, nil
+	}
+
+	if server.breachIndex == nil {
+		return generated.GetBreachedPasswordsPrefix404JSONResponse{
+			Message: "No breached passwords corpus is configured",
+			Success: false,
+		}, nil
+	}
+
+	entries, err := server.breachIndex.GetBreachedPasswordRange(ctx, request.Prefix)
+	if errors.Is(err, breach.ErrInvalidPrefix) {
+		return generated.GetBreachedPasswordsPrefix400JSONResponse{
+			Message: "The prefix must be five hex characters",
+			Success: false,
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting breached passwords: %w", err)
+	}
+
+	response := generated.GetBreachedPasswordsPrefix200JSONResponse{
+		Success: true,
+		Entries: make([]generated.BreachedPasswordRangeEntry, len(entries)),
+	}
+	for i, entry := range entries {
+		response.Entries[i] = generated.BreachedPasswordRangeEntry{
+			Suffix: entry.Suffix,
+			Count:  entry.Count,
+		}
+	}
+	return response, nil
+}
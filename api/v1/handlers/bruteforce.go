@@ -186,12 +186,13 @@ func (server *serverHandler) GetProjectsIdBruteforcedPassword(ctx context.Contex
 	return generated.GetProjectsIdBruteforcedPassword200JSONResponse{
 		Success: true,
 		BruteforcedPassword: generated.BruteforcedPassword{
-			Hash:             pass.Hash,
-			Id:               int(pass.ID),
-			LastBruteforceId: int(pass.LastBruteforceID.Int64),
-			Password:         pass.Password.String,
-			ProjectId:        int(pass.ProjectID.Int64),
-			Username:         pass.Username,
+			Hash:                      pass.Hash,
+			Id:                        int(pass.ID),
+			LastBruteforceId:          int(pass.LastBruteforceID.Int64),
+			LastBruteforceFingerprint: pass.LastBruteforceFingerprint,
+			Password:                  pass.Password.String,
+			ProjectId:                 int(pass.ProjectID.Int64),
+			Username:                  pass.Username,
 		},
 	}, nil
 }
@@ -204,6 +205,7 @@ func (server *serverHandler) PatchBruteforcedPasswordsId(ctx context.Context, re
 			Int64: int64(request.Body.LastBruteforceId),
 			Valid: true,
 		},
+		LastBruteforceFingerprint: request.Body.LastBruteforceFingerprint,
 	})
 	if err == pgx.ErrNoRows {
 		return generated.PatchBruteforcedPasswordsId404JSONResponse{
@@ -217,12 +219,13 @@ func (server *serverHandler) PatchBruteforcedPasswordsId(ctx context.Context, re
 	return generated.PatchBruteforcedPasswordsId200JSONResponse{
 		Success: true,
 		BruteforcedPassword: &generated.BruteforcedPassword{
-			Hash:             p.Hash,
-			Id:               int(p.ID),
-			LastBruteforceId: int(p.LastBruteforceID.Int64),
-			Password:         p.Password.String,
-			ProjectId:        int(p.ProjectID.Int64),
-			Username:         p.Username,
+			Hash:                      p.Hash,
+			Id:                        int(p.ID),
+			LastBruteforceId:          int(p.LastBruteforceID.Int64),
+			LastBruteforceFingerprint: p.LastBruteforceFingerprint,
+			Password:                  p.Password.String,
+			ProjectId:                 int(p.ProjectID.Int64),
+			Username:                  p.Username,
 		},
 	}, nil
 }
@@ -262,6 +265,7 @@ func (server *serverHandler) PostProjectsIdBruteforcedPassword(ctx context.Conte
 			Int64: int64(request.Body.LastBruteforceId),
 			Valid: true,
 		},
+		LastBruteforceFingerprint: request.Body.LastBruteforceFingerprint,
 	})
 	if err == pgx.ErrNoRows {
 		return generated.PostProjectsIdBruteforcedPassword404JSONResponse{
@@ -276,12 +280,13 @@ func (server *serverHandler) PostProjectsIdBruteforcedPassword(ctx context.Conte
 	return generated.PostProjectsIdBruteforcedPassword200JSONResponse{
 		Success: true,
 		BruteforcedPassword: &generated.BruteforcedPassword{
-			Hash:             request.Body.Hash,
-			Id:               int(pass.ID),
-			LastBruteforceId: int(pass.LastBruteforceID.Int64),
-			Password:         pass.Password.String,
-			ProjectId:        int(pass.ProjectID.Int64),
-			Username:         pass.Username,
+			Hash:                      request.Body.Hash,
+			Id:                        int(pass.ID),
+			LastBruteforceId:          int(pass.LastBruteforceID.Int64),
+			LastBruteforceFingerprint: pass.LastBruteforceFingerprint,
+			Password:                  pass.Password.String,
+			ProjectId:                 int(pass.ProjectID.Int64),
+			Username:                  pass.Username,
 		},
 	}, nil
 }
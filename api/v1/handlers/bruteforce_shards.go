@@ -0,0 +1,233 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/tedyst/licenta/api/v1/generated"
+	"github.com/tedyst/licenta/db/queries"
+)
+
+func bruteforceShardToAPI(shard *queries.ScanBruteforceShard) generated.BruteforceShard {
+	result := generated.BruteforceShard{
+		Id:       shard.ID,
+		ScanId:   shard.ScanID,
+		ScanType: int(shard.ScanType),
+		Username: shard.Username,
+		Hash:     shard.Hash,
+		StartId:  shard.StartID,
+		NextId:   shard.NextID,
+		Tried:    shard.Tried,
+		Status:   int(shard.Status),
+	}
+	if shard.EndID.Valid {
+		result.EndId = &shard.EndID.Int64
+	}
+	if shard.Password.Valid {
+		result.Password = &shard.Password.String
+	}
+	return result
+}
+
+func bruteforceShardsToAPI(shards []*queries.ScanBruteforceShard) []generated.BruteforceShard {
+	results := make([]generated.BruteforceShard, len(shards))
+	for i, shard := range shards {
+		results[i] = bruteforceShardToAPI(shard)
+	}
+	return results
+}
+
+// isScanOfWorker returns true if the scan with the given ID is bound to the
+// worker that made the request. Only that worker can create and cancel the
+// shards of the scan.
+func (server *serverHandler) isScanOfWorker(ctx context.Context, scanID int64) (bool, error) {
+	w, err := server.workerauth.GetWorker(ctx)
+	if err != nil {
+		return false, fmt.Errorf("cannot get worker: %w", err)
+	}
+	if w == nil {
+		return false, nil
+	}
+
+	scan, err := server.DatabaseProvider.GetScan(ctx, scanID)
+	if err == pgx.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("cannot get scan: %w", err)
+	}
+	return scan.Scan.WorkerID.Valid && scan.Scan.WorkerID.Int64 == int64(w.ID), nil
+}
+
+func (server *serverHandler) GetScanIdBruteforceShards(ctx context.Context, request generated.GetScanIdBruteforceShardsRequestObject) (generated.GetScanIdBruteforceShardsResponseObject, error) {
+	ok, err := server.isScanOfWorker(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return generated.GetScanIdBruteforceShards404JSONResponse{
+			Success: false,
+			Message: "Not found",
+		}, nil
+	}
+
+	shards, err := server.DatabaseProvider.GetScanBruteforceShards(ctx, queries.GetScanBruteforceShardsParams{
+		ScanID:   request.Id,
+		ScanType: int32(request.Params.ScanType),
+		Username: request.Params.Username,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get bruteforce shards: %w", err)
+	}
+
+	return generated.GetScanIdBruteforceShards200JSONResponse{
+		Success: true,
+		Shards:  bruteforceShardsToAPI(shards),
+	}, nil
+}
+
+func (server *serverHandler) PostScanIdBruteforceShards(ctx context.Context, request generated.PostScanIdBruteforceShardsRequestObject) (generated.PostScanIdBruteforceShardsResponseObject, error) {
+	if request.Body.ShardSize < 1 || request.Body.Count < 0 || request.Body.StartId < 0 {
+		return generated.PostScanIdBruteforceShards400JSONResponse{
+			Success: false,
+			Message: "Invalid shard size or range",
+		}, nil
+	}
+
+	ok, err := server.isScanOfWorker(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return generated.PostScanIdBruteforceShards404JSONResponse{
+			Success: false,
+			Message: "Not found",
+		}, nil
+	}
+
+	shards, err := server.DatabaseProvider.CreateScanBruteforceShards(ctx, queries.CreateScanBruteforceShardsParams{
+		ScanID:    request.Id,
+		ScanType:  int32(request.Body.ScanType),
+		Username:  request.Body.Username,
+		Hash:      request.Body.Hash,
+		ShardSize: request.Body.ShardSize,
+		StartID:   request.Body.StartId,
+		Count:     request.Body.Count,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create bruteforce shards: %w", err)
+	}
+
+	return generated.PostScanIdBruteforceShards200JSONResponse{
+		Success: true,
+		Shards:  bruteforceShardsToAPI(shards),
+	}, nil
+}
+
+func (server *serverHandler) DeleteScanIdBruteforceShards(ctx context.Context, request generated.DeleteScanIdBruteforceShardsRequestObject) (generated.DeleteScanIdBruteforceShardsResponseObject, error) {
+	ok, err := server.isScanOfWorker(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return generated.DeleteScanIdBruteforceShards404JSONResponse{
+			Success: false,
+			Message: "Not found",
+		}, nil
+	}
+
+	err = server.DatabaseProvider.CancelScanBruteforceShards(ctx, queries.CancelScanBruteforceShardsParams{
+		ScanID:   request.Id,
+		ScanType: int32(request.Params.ScanType),
+		Username: request.Params.Username,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot cancel bruteforce shards: %w", err)
+	}
+
+	return generated.DeleteScanIdBruteforceShards204JSONResponse{
+		Success: true,
+	}, nil
+}
+
+func (server *serverHandler) PatchBruteforceShardsId(ctx context.Context, request generated.PatchBruteforceShardsIdRequestObject) (generated.PatchBruteforceShardsIdResponseObject, error) {
+	w, err := server.workerauth.GetWorker(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get worker: %w", err)
+	}
+	if w == nil {
+		return generated.PatchBruteforceShardsId401JSONResponse{
+			Success: false,
+			Message: "Unauthorized",
+		}, nil
+	}
+	if request.Body.Tried < 0 || request.Body.NextId < 0 {
+		return generated.PatchBruteforceShardsId400JSONResponse{
+			Success: false,
+			Message: "Invalid progress",
+		}, nil
+	}
+
+	workerID := sql.NullInt64{Int64: int64(w.ID), Valid: true}
+	var shard *queries.ScanBruteforceShard
+	password := request.Body.Password
+	if request.Body.Failed != nil && *request.Body.Failed {
+		shard, err = server.DatabaseProvider.FailScanBruteforceShard(ctx, queries.FailScanBruteforceShardParams{
+			ID:       request.Id,
+			WorkerID: workerID,
+			Tried:    request.Body.Tried,
+			NextID:   request.Body.NextId,
+		})
+	} else if password != nil && *password != "" || request.Body.Finished {
+		shard, err = server.DatabaseProvider.FinishScanBruteforceShard(ctx, queries.FinishScanBruteforceShardParams{
+			ID:       request.Id,
+			WorkerID: workerID,
+			Tried:    request.Body.Tried,
+			NextID:   request.Body.NextId,
+			Password: sql.NullString{String: stringOrEmpty(password), Valid: password != nil && *password != ""},
+		})
+	} else {
+		shard, err = server.DatabaseProvider.UpdateScanBruteforceShardProgress(ctx, queries.UpdateScanBruteforceShardProgressParams{
+			ID:       request.Id,
+			WorkerID: workerID,
+			Tried:    request.Body.Tried,
+			NextID:   request.Body.NextId,
+		})
+	}
+	if err == pgx.ErrNoRows {
+		return generated.PatchBruteforceShardsId404JSONResponse{
+			Success: false,
+			Message: "Not found",
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot update bruteforce shard: %w", err)
+	}
+
+	// The other shards of the user are not needed anymore once one of them
+	// found the password.
+	if shard.Password.Valid {
+		err = server.DatabaseProvider.CancelScanBruteforceShards(ctx, queries.CancelScanBruteforceShardsParams{
+			ScanID:   shard.ScanID,
+			ScanType: shard.ScanType,
+			Username: shard.Username,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("cannot cancel bruteforce shards: %w", err)
+		}
+	}
+
+	return generated.PatchBruteforceShardsId200JSONResponse{
+		Success: true,
+		Shard:   bruteforceShardToAPI(shard),
+	}, nil
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
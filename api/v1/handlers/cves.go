@@ -37,6 +37,9 @@ func (server *serverHandler) GetCvesDbTypeVersion(ctx context.Context, request g
 			Id:           int64(cve.NvdCfe.ID),
 			LastModified: cve.NvdCfe.LastModified.Time.Format(time.RFC3339Nano),
 			PublishedAt:  cve.NvdCfe.Published.Time.Format(time.RFC3339Nano),
+			Score:        cve.NvdCfe.Score,
+			Vector:       cve.NvdCfe.Vector,
+			FixedIn:      cve.FixedIn,
 		})
 	}
 
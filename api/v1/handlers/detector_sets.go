@@ -0,0 +1,271 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/tedyst/licenta/api/authorization"
+	"github.com/tedyst/licenta/api/v1/generated"
+	"github.com/tedyst/licenta/db/queries"
+	"github.com/tedyst/licenta/extractors/file"
+)
+
+func detectorSetToGenerated(set *queries.DetectorSet) generated.DetectorSet {
+	return generated.DetectorSet{
+		Id:             set.ID,
+		OrganizationId: set.OrganizationID,
+		Name:           set.Name,
+		Format:         set.Format,
+		Content:        set.Content,
+		UseDefault:     set.UseDefault,
+		CreatedAt:      set.CreatedAt.Time.Format(time.RFC3339Nano),
+	}
+}
+
+func (server *serverHandler) GetOrganizationsIdDetectorSets(ctx context.Context, request generated.GetOrganizationsIdDetectorSetsRequestObject) (generated.GetOrganizationsIdDetectorSetsResponseObject, error) {
+	user, organization, hasPerm, _, err := server.checkForOrganizationPermission(ctx, request.Id, authorization.Viewer)
+	if err != nil {
+		return nil, fmt.Errorf("error checking permissions: %w", err)
+	}
+	if user == nil || organization == nil {
+		return generated.GetOrganizationsIdDetectorSets401JSONResponse{
+			Message: "Unauthorized",
+			Success: false,
+		}, nil
+	}
+	if !hasPerm {
+		return generated.GetOrganizationsIdDetectorSets404JSONResponse{
+			Message: "Organization not found",
+			Success: false,
+		}, nil
+	}
+
+	sets, err := server.DatabaseProvider.GetDetectorSetsForOrganization(ctx, organization.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting detector sets: %w", err)
+	}
+
+	response := generated.GetOrganizationsIdDetectorSets200JSONResponse{
+		Success:      true,
+		DetectorSets: make([]generated.DetectorSet, len(sets)),
+	}
+	for i, set := range sets {
+		response.DetectorSets[i] = detectorSetToGenerated(set)
+	}
+
+	return response, nil
+}
+
+func (server *serverHandler) PostOrganizationsIdDetectorSets(ctx context.Context, request generated.PostOrganizationsIdDetectorSetsRequestObject) (generated.PostOrganizationsIdDetectorSetsResponseObject, error) {
+	err := valid.Struct(request)
+	if err != nil {
+		return generated.PostOrganizationsIdDetectorSets400JSONResponse{
+			Success: false,
+			Message: "Validation error: " + err.Error(),
+		}, nil
+	}
+
+	user, organization, hasPerm, hasViewPerm, err := server.checkForOrganizationPermission(ctx, request.Id, authorization.Admin)
+	if err != nil {
+		return nil, fmt.Errorf("error checking permissions: %w", err)
+	}
+	if user == nil || organization == nil {
+		return generated.PostOrganizationsIdDetectorSets401JSONResponse{
+			Message: "Unauthorized",
+			Success: false,
+		}, nil
+	}
+	if !hasPerm {
+		if hasViewPerm {
+			return generated.PostOrganizationsIdDetectorSets401JSONResponse{
+				Message: "Forbidden",
+				Success: false,
+			}, nil
+		}
+		return generated.PostOrganizationsIdDetectorSets404JSONResponse{
+			Message: "Organization not found",
+			Success: false,
+		}, nil
+	}
+
+	format := file.DetectorSetFormatYAML
+	if request.Body.Format != nil {
+		format = string(*request.Body.Format)
+	}
+	if _, err := file.ParseDetectorSetFormat(format, []byte(request.Body.Content)); err != nil {
+		return generated.PostOrganizationsIdDetectorSets400JSONResponse{
+			Success: false,
+			Message: "Invalid detector set: " + err.Error(),
+		}, nil
+	}
+
+	useDefault := true
+	if request.Body.UseDefault != nil {
+		useDefault = *request.Body.UseDefault
+	}
+
+	set, err := server.DatabaseProvider.CreateDetectorSet(ctx, queries.CreateDetectorSetParams{
+		OrganizationID: organization.ID,
+		Name:           request.Body.Name,
+		Format:         format,
+		Content:        request.Body.Content,
+		UseDefault:     useDefault,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating detector set: %w", err)
+	}
+
+	return generated.PostOrganizationsIdDetectorSets200JSONResponse{
+		Success:     true,
+		DetectorSet: detectorSetToGenerated(set),
+	}, nil
+}
+
+func (server *serverHandler) DeleteDetectorSetsId(ctx context.Context, request generated.DeleteDetectorSetsIdRequestObject) (generated.DeleteDetectorSetsIdResponseObject, error) {
+	set, err := server.DatabaseProvider.GetDetectorSet(ctx, request.Id)
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("error getting detector set: %w", err)
+	}
+	if err == pgx.ErrNoRows {
+		return generated.DeleteDetectorSetsId404JSONResponse{
+			Message: "Detector set not found",
+			Success: false,
+		}, nil
+	}
+
+	user, organization, hasPerm, hasViewPerm, err := server.checkForOrganizationPermission(ctx, set.OrganizationID, authorization.Admin)
+	if err != nil {
+		return nil, fmt.Errorf("error checking permissions: %w", err)
+	}
+	if user == nil || organization == nil {
+		return generated.DeleteDetectorSetsId401JSONResponse{
+			Message: "Unauthorized",
+			Success: false,
+		}, nil
+	}
+	if !hasPerm {
+		if hasViewPerm {
+			return generated.DeleteDetectorSetsId401JSONResponse{
+				Message: "Forbidden",
+				Success: false,
+			}, nil
+		}
+		return generated.DeleteDetectorSetsId404JSONResponse{
+			Message: "Detector set not found",
+			Success: false,
+		}, nil
+	}
+
+	if err := server.DatabaseProvider.DeleteDetectorSet(ctx, set.ID); err != nil {
+		return nil, fmt.Errorf("error deleting detector set: %w", err)
+	}
+
+	return generated.DeleteDetectorSetsId204JSONResponse{
+		Success: true,
+	}, nil
+}
+
+func (server *serverHandler) GetProjectsIdDetectorSet(ctx context.Context, request generated.GetProjectsIdDetectorSetRequestObject) (generated.GetProjectsIdDetectorSetResponseObject, error) {
+	user, err := server.userAuth.GetUser(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting user: %w", err)
+	}
+	worker, err := server.workerauth.GetWorker(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting worker: %w", err)
+	}
+
+	project, err := server.DatabaseProvider.GetProject(ctx, request.Id)
+	if err != nil {
+		return generated.GetProjectsIdDetectorSet404JSONResponse{
+			Message: "Project not found",
+			Success: false,
+		}, nil
+	}
+
+	var authorized bool
+	if user != nil {
+		authorized, err = server.authorization.UserHasPermissionForProject(ctx, project, user, authorization.Viewer)
+	} else if worker != nil {
+		authorized, err = server.authorization.WorkerHasPermissionForProject(ctx, project, worker, authorization.Worker)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error checking permissions: %w", err)
+	}
+	if !authorized {
+		return generated.GetProjectsIdDetectorSet401JSONResponse{
+			Message: "Not allowed to get the detector set for this project",
+			Success: false,
+		}, nil
+	}
+
+	set, err := server.DatabaseProvider.GetDetectorSetForProject(ctx, project.ID)
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("error getting detector set: %w", err)
+	}
+	if err == pgx.ErrNoRows {
+		return generated.GetProjectsIdDetectorSet404JSONResponse{
+			Message: "Detector set not found",
+			Success: false,
+		}, nil
+	}
+
+	return generated.GetProjectsIdDetectorSet200JSONResponse{
+		Success:     true,
+		DetectorSet: detectorSetToGenerated(set),
+	}, nil
+}
+
+func (server *serverHandler) PutProjectsIdDetectorSet(ctx context.Context, request generated.PutProjectsIdDetectorSetRequestObject) (generated.PutProjectsIdDetectorSetResponseObject, error) {
+	_, project, response, err := checkUserHasProjectPermission[generated.PutProjectsIdDetectorSet401JSONResponse](server, ctx, request.Id, authorization.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if !response.Success {
+		return response, nil
+	}
+
+	set, err := server.DatabaseProvider.GetDetectorSet(ctx, request.Body.DetectorSetId)
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("error getting detector set: %w", err)
+	}
+	if err == pgx.ErrNoRows || set.OrganizationID != project.OrganizationID {
+		return generated.PutProjectsIdDetectorSet404JSONResponse{
+			Message: "Detector set not found",
+			Success: false,
+		}, nil
+	}
+
+	_, err = server.DatabaseProvider.SetProjectDetectorSet(ctx, queries.SetProjectDetectorSetParams{
+		ProjectID:     project.ID,
+		DetectorSetID: set.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error setting detector set: %w", err)
+	}
+
+	return generated.PutProjectsIdDetectorSet200JSONResponse{
+		Success:     true,
+		DetectorSet: detectorSetToGenerated(set),
+	}, nil
+}
+
+func (server *serverHandler) DeleteProjectsIdDetectorSet(ctx context.Context, request generated.DeleteProjectsIdDetectorSetRequestObject) (generated.DeleteProjectsIdDetectorSetResponseObject, error) {
+	_, project, response, err := checkUserHasProjectPermission[generated.DeleteProjectsIdDetectorSet401JSONResponse](server, ctx, request.Id, authorization.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := server.DatabaseProvider.DeleteProjectDetectorSet(ctx, project.ID); err != nil {
+		return nil, fmt.Errorf("error deleting detector set: %w", err)
+	}
+
+	return generated.DeleteProjectsIdDetectorSet204JSONResponse{
+		Success: true,
+	}, nil
+}
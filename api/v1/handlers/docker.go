@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
@@ -71,6 +72,9 @@ func (server *serverHandler) GetDocker(ctx context.Context, request generated.Ge
 		if dockerImage.Password != "" {
 			dockerImagesResponse[i].Password = &dockerImage.Password
 		}
+		if dockerImage.DetectorSetID.Valid {
+			dockerImagesResponse[i].DetectorSetId = &dockerImage.DetectorSetID.Int64
+		}
 	}
 
 	return generated.GetDocker200JSONResponse{
@@ -123,6 +127,11 @@ func (server *serverHandler) GetDockerId(ctx context.Context, request generated.
 		}
 
 		if dbLayer.ID.Valid {
+			var expiresAt *string
+			if dbLayer.ExpiresAt.Valid {
+				t := dbLayer.ExpiresAt.Time.Format(time.RFC3339Nano)
+				expiresAt = &t
+			}
 			layerResults[dbLayer.Lid] = append(layerResults[dbLayer.Lid], generated.DockerLayerResult{
 				CreatedAt:     dbLayer.CreatedAt.Time.Format(time.RFC3339Nano),
 				Filename:      dbLayer.Filename.String,
@@ -130,6 +139,10 @@ func (server *serverHandler) GetDockerId(ctx context.Context, request generated.
 				Layer:         int(dbLayer.Lid),
 				Line:          dbLayer.Line.String,
 				LineNumber:    int(dbLayer.LineNumber.Int32),
+				EndLineNumber: int(dbLayer.EndLineNumber.Int32),
+				Provider:      dbLayer.Provider.String,
+				Confidence:    generated.DockerLayerResultConfidence(dbLayer.Confidence.String),
+				ExpiresAt:     expiresAt,
 				Match:         dbLayer.Match.String,
 				Name:          dbLayer.Name.String,
 				Password:      dbLayer.Password.String,
@@ -148,6 +161,9 @@ func (server *serverHandler) GetDockerId(ctx context.Context, request generated.
 		DockerImage: dockerImage.DockerImage,
 		Id:          int(dockerImage.ID),
 	}
+	if dockerImage.DetectorSetID.Valid {
+		image.DetectorSetId = &dockerImage.DetectorSetID.Int64
+	}
 
 	if dockerImage.Username != "" {
 		image.Username = &dockerImage.Username
@@ -178,7 +194,7 @@ func (server *serverHandler) PatchDockerId(ctx context.Context, request generate
 		return nil, fmt.Errorf("PatchDockerId: error getting docker image: %w", err)
 	}
 
-	_, _, response, err := checkUserHasProjectPermission[generated.PatchDockerId401JSONResponse](server, ctx, dockerImage.ProjectID, authorization.Admin)
+	_, project, response, err := checkUserHasProjectPermission[generated.PatchDockerId401JSONResponse](server, ctx, dockerImage.ProjectID, authorization.Admin)
 	if err != nil {
 		return nil, err
 	}
@@ -186,6 +202,30 @@ func (server *serverHandler) PatchDockerId(ctx context.Context, request generate
 		return response, nil
 	}
 
+	if request.Body.DetectorSetId != nil {
+		detectorSetID := sql.NullInt64{}
+		if *request.Body.DetectorSetId != 0 {
+			set, err := server.DatabaseProvider.GetDetectorSet(ctx, *request.Body.DetectorSetId)
+			if err != nil && err != pgx.ErrNoRows {
+				return nil, fmt.Errorf("PatchDockerId: error getting detector set: %w", err)
+			}
+			if err == pgx.ErrNoRows || set.OrganizationID != project.OrganizationID {
+				return generated.PatchDockerId404JSONResponse{
+					Success: false,
+					Message: "Detector set not found",
+				}, nil
+			}
+			detectorSetID = sql.NullInt64{Int64: set.ID, Valid: true}
+		}
+		err = server.DatabaseProvider.SetDockerImageDetectorSet(ctx, queries.SetDockerImageDetectorSetParams{
+			ID:            dockerImage.ID,
+			DetectorSetID: detectorSetID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("PatchDockerId: error setting detector set: %w", err)
+		}
+	}
+
 	if request.Body.DockerImage != nil {
 		dockerImage.DockerImage = *request.Body.DockerImage
 	}
@@ -240,6 +280,9 @@ func (server *serverHandler) PatchDockerId(ctx context.Context, request generate
 		value := float32(image.LogisticGrowthRate.Float64)
 		responseImage.LogisticGrowthRate = &value
 	}
+	if image.DetectorSetID.Valid {
+		responseImage.DetectorSetId = &image.DetectorSetID.Int64
+	}
 
 	return generated.PatchDockerId200JSONResponse{
 		Success: true,
@@ -126,17 +126,26 @@ func (server *serverHandler) GetGitId(ctx context.Context, request generated.Get
 		}
 
 		if dbCommit.ID.Valid {
+			var expiresAt *string
+			if dbCommit.ExpiresAt.Valid {
+				t := dbCommit.ExpiresAt.Time.Format(time.RFC3339Nano)
+				expiresAt = &t
+			}
 			commitResults[dbCommit.CommitID] = append(commitResults[dbCommit.CommitID], generated.GitResult{
-				Commit:      int(dbCommit.Commit.Int64),
-				Filename:    dbCommit.Filename.String,
-				Id:          int(dbCommit.ID.Int64),
-				Line:        dbCommit.Line.String,
-				LineNumber:  int(dbCommit.LineNumber.Int32),
-				Match:       dbCommit.Match.String,
-				Name:        dbCommit.Name.String,
-				Password:    dbCommit.Password.String,
-				Probability: float32(dbCommit.Probability.Float64),
-				Username:    dbCommit.Username.String,
+				Commit:        int(dbCommit.Commit.Int64),
+				Filename:      dbCommit.Filename.String,
+				Id:            int(dbCommit.ID.Int64),
+				Line:          dbCommit.Line.String,
+				LineNumber:    int(dbCommit.LineNumber.Int32),
+				EndLineNumber: int(dbCommit.EndLineNumber.Int32),
+				Provider:      dbCommit.Provider.String,
+				Confidence:    generated.GitResultConfidence(dbCommit.Confidence.String),
+				ExpiresAt:     expiresAt,
+				Match:         dbCommit.Match.String,
+				Name:          dbCommit.Name.String,
+				Password:      dbCommit.Password.String,
+				Probability:   float32(dbCommit.Probability.Float64),
+				Username:      dbCommit.Username.String,
 			})
 		}
 	}
@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/tedyst/licenta/api/authorization"
+	"github.com/tedyst/licenta/api/v1/generated"
+	"github.com/tedyst/licenta/db/queries"
+)
+
+func ignoredCveToGenerated(cve *queries.ProjectIgnoredCfe) generated.IgnoredCve {
+	return generated.IgnoredCve{
+		Id:        cve.ID,
+		ProjectId: cve.ProjectID,
+		CveId:     cve.CveID,
+		Reason:    cve.Reason,
+		CreatedAt: cve.CreatedAt.Time.Format(time.RFC3339Nano),
+	}
+}
+
+func (server *serverHandler) GetProjectsIdIgnoredCves(ctx context.Context, request generated.GetProjectsIdIgnoredCvesRequestObject) (generated.GetProjectsIdIgnoredCvesResponseObject, error) {
+	user, err := server.userAuth.GetUser(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting user: %w", err)
+	}
+	worker, err := server.workerauth.GetWorker(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting worker: %w", err)
+	}
+
+	project, err := server.DatabaseProvider.GetProject(ctx, request.Id)
+	if err != nil {
+		return generated.GetProjectsIdIgnoredCves404JSONResponse{
+			Message: "Project not found",
+			Success: false,
+		}, nil
+	}
+
+	var authorized bool
+	if user != nil {
+		authorized, err = server.authorization.UserHasPermissionForProject(ctx, project, user, authorization.Viewer)
+	} else if worker != nil {
+		authorized, err = server.authorization.WorkerHasPermissionForProject(ctx, project, worker, authorization.Worker)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error checking permissions: %w", err)
+	}
+	if !authorized {
+		return generated.GetProjectsIdIgnoredCves401JSONResponse{
+			Message: "Not allowed to get ignored CVEs for this project",
+			Success: false,
+		}, nil
+	}
+
+	cves, err := server.DatabaseProvider.GetProjectIgnoredCves(ctx, project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting ignored cves: %w", err)
+	}
+
+	response := generated.GetProjectsIdIgnoredCves200JSONResponse{
+		Success:     true,
+		IgnoredCves: make([]generated.IgnoredCve, len(cves)),
+	}
+	for i, cve := range cves {
+		response.IgnoredCves[i] = ignoredCveToGenerated(cve)
+	}
+
+	return response, nil
+}
+
+func (server *serverHandler) PostProjectsIdIgnoredCves(ctx context.Context, request generated.PostProjectsIdIgnoredCvesRequestObject) (generated.PostProjectsIdIgnoredCvesResponseObject, error) {
+	err := valid.Struct(request)
+	if err != nil {
+		return generated.PostProjectsIdIgnoredCves400JSONResponse{
+			Success: false,
+			Message: "Validation error: " + err.Error(),
+		}, nil
+	}
+
+	_, project, response, err := checkUserHasProjectPermission[generated.PostProjectsIdIgnoredCves401JSONResponse](server, ctx, request.Id, authorization.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if !response.Success {
+		return response, nil
+	}
+
+	reason := ""
+	if request.Body.Reason != nil {
+		reason = *request.Body.Reason
+	}
+
+	cve, err := server.DatabaseProvider.CreateProjectIgnoredCve(ctx, queries.CreateProjectIgnoredCveParams{
+		ProjectID: project.ID,
+		CveID:     request.Body.CveId,
+		Reason:    reason,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating ignored cve: %w", err)
+	}
+
+	return generated.PostProjectsIdIgnoredCves200JSONResponse{
+		Success:    true,
+		IgnoredCve: ignoredCveToGenerated(cve),
+	}, nil
+}
+
+func (server *serverHandler) DeleteIgnoredCvesId(ctx context.Context, request generated.DeleteIgnoredCvesIdRequestObject) (generated.DeleteIgnoredCvesIdResponseObject, error) {
+	cve, err := server.DatabaseProvider.GetProjectIgnoredCve(ctx, request.Id)
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("error getting ignored cve: %w", err)
+	}
+	if err == pgx.ErrNoRows {
+		return generated.DeleteIgnoredCvesId404JSONResponse{
+			Message: "Ignored CVE not found",
+			Success: false,
+		}, nil
+	}
+
+	_, _, response, err := checkUserHasProjectPermission[generated.DeleteIgnoredCvesId401JSONResponse](server, ctx, cve.ProjectID, authorization.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := server.DatabaseProvider.DeleteProjectIgnoredCve(ctx, cve.ID); err != nil {
+		return nil, fmt.Errorf("error deleting ignored cve: %w", err)
+	}
+
+	return generated.DeleteIgnoredCvesId204JSONResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tedyst/licenta/api/authorization"
+	"github.com/tedyst/licenta/api/v1/generated"
+)
+
+func (server *serverHandler) GetProjectsIdPasswordCandidates(ctx context.Context, request generated.GetProjectsIdPasswordCandidatesRequestObject) (generated.GetProjectsIdPasswordCandidatesResponseObject, error) {
+	user, err := server.userAuth.GetUser(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting user: %w", err)
+	}
+	worker, err := server.workerauth.GetWorker(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting worker: %w", err)
+	}
+
+	project, err := server.DatabaseProvider.GetProject(ctx, request.Id)
+	if err != nil {
+		return generated.GetProjectsIdPasswordCandidates404JSONResponse{
+			Message: "Project not found",
+			Success: false,
+		}, nil
+	}
+
+	var authorized bool
+	if user != nil {
+		authorized, err = server.authorization.UserHasPermissionForProject(ctx, project, user, authorization.Viewer)
+	} else if worker != nil {
+		authorized, err = server.authorization.WorkerHasPermissionForProject(ctx, project, worker, authorization.Worker)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error checking permissions: %w", err)
+	}
+	if !authorized {
+		return generated.GetProjectsIdPasswordCandidates401JSONResponse{
+			Message: "Not allowed to get password candidates for this project",
+			Success: false,
+		}, nil
+	}
+
+	candidates, err := server.DatabaseProvider.GetProjectPasswordCandidates(ctx, project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting password candidates: %w", err)
+	}
+
+	response := generated.GetProjectsIdPasswordCandidates200JSONResponse{
+		Success:    true,
+		Candidates: make([]generated.PasswordCandidate, len(candidates)),
+	}
+	for i, candidate := range candidates {
+		response.Candidates[i] = generated.PasswordCandidate{
+			Candidate: candidate.Candidate,
+			Source:    generated.PasswordCandidateSource(candidate.Source),
+			Reference: candidate.Reference,
+			Location:  candidate.Location,
+			Priority:  candidate.Priority,
+		}
+	}
+
+	return response, nil
+}
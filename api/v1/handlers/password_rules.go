@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/tedyst/licenta/api/authorization"
+	"github.com/tedyst/licenta/api/v1/generated"
+	"github.com/tedyst/licenta/bruteforce"
+	"github.com/tedyst/licenta/db/queries"
+)
+
+func passwordRulesToGenerated(rules *queries.ProjectPasswordRule) generated.PasswordRules {
+	return generated.PasswordRules{
+		ProjectId:  rules.ProjectID,
+		UseDefault: rules.UseDefault,
+		Rules:      rules.Rules,
+		UpdatedAt:  rules.UpdatedAt.Time.Format(time.RFC3339Nano),
+	}
+}
+
+func (server *serverHandler) GetProjectsIdPasswordRules(ctx context.Context, request generated.GetProjectsIdPasswordRulesRequestObject) (generated.GetProjectsIdPasswordRulesResponseObject, error) {
+	user, err := server.userAuth.GetUser(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting user: %w", err)
+	}
+	worker, err := server.workerauth.GetWorker(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting worker: %w", err)
+	}
+
+	project, err := server.DatabaseProvider.GetProject(ctx, request.Id)
+	if err != nil {
+		return generated.GetProjectsIdPasswordRules404JSONResponse{
+			Message: "Project not found",
+			Success: false,
+		}, nil
+	}
+
+	var authorized bool
+	if user != nil {
+		authorized, err = server.authorization.UserHasPermissionForProject(ctx, project, user, authorization.Viewer)
+	} else if worker != nil {
+		authorized, err = server.authorization.WorkerHasPermissionForProject(ctx, project, worker, authorization.Worker)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error checking permissions: %w", err)
+	}
+	if !authorized {
+		return generated.GetProjectsIdPasswordRules401JSONResponse{
+			Message: "Not allowed to get password rules for this project",
+			Success: false,
+		}, nil
+	}
+
+	rules, err := server.DatabaseProvider.GetProjectPasswordRules(ctx, project.ID)
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("error getting password rules: %w", err)
+	}
+	if err == pgx.ErrNoRows {
+		return generated.GetProjectsIdPasswordRules404JSONResponse{
+			Message: "Password rules not found",
+			Success: false,
+		}, nil
+	}
+
+	return generated.GetProjectsIdPasswordRules200JSONResponse{
+		Success:       true,
+		PasswordRules: passwordRulesToGenerated(rules),
+	}, nil
+}
+
+func (server *serverHandler) PutProjectsIdPasswordRules(ctx context.Context, request generated.PutProjectsIdPasswordRulesRequestObject) (generated.PutProjectsIdPasswordRulesResponseObject, error) {
+	err := valid.Struct(request)
+	if err != nil {
+		return generated.PutProjectsIdPasswordRules400JSONResponse{
+			Success: false,
+			Message: "Validation error: " + err.Error(),
+		}, nil
+	}
+	if _, err := bruteforce.ParseRules(request.Body.Rules); err != nil {
+		return generated.PutProjectsIdPasswordRules400JSONResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	_, project, response, err := checkUserHasProjectPermission[generated.PutProjectsIdPasswordRules401JSONResponse](server, ctx, request.Id, authorization.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if !response.Success {
+		return response, nil
+	}
+
+	rules, err := server.DatabaseProvider.SetProjectPasswordRules(ctx, queries.SetProjectPasswordRulesParams{
+		ProjectID:  project.ID,
+		UseDefault: request.Body.UseDefault,
+		Rules:      request.Body.Rules,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error setting password rules: %w", err)
+	}
+
+	return generated.PutProjectsIdPasswordRules200JSONResponse{
+		Success:       true,
+		PasswordRules: passwordRulesToGenerated(rules),
+	}, nil
+}
+
+func (server *serverHandler) DeleteProjectsIdPasswordRules(ctx context.Context, request generated.DeleteProjectsIdPasswordRulesRequestObject) (generated.DeleteProjectsIdPasswordRulesResponseObject, error) {
+	_, project, response, err := checkUserHasProjectPermission[generated.DeleteProjectsIdPasswordRules401JSONResponse](server, ctx, request.Id, authorization.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := server.DatabaseProvider.DeleteProjectPasswordRules(ctx, project.ID); err != nil {
+		return nil, fmt.Errorf("error deleting password rules: %w", err)
+	}
+
+	return generated.DeleteProjectsIdPasswordRules204JSONResponse{
+		Success: true,
+	}, nil
+}
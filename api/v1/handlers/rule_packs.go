@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/tedyst/licenta/api/authorization"
+	"github.com/tedyst/licenta/api/v1/generated"
+	"github.com/tedyst/licenta/db/queries"
+	"github.com/tedyst/licenta/scanner/rules"
+)
+
+func rulePackToGenerated(pack *queries.RulePack) generated.RulePack {
+	return generated.RulePack{
+		Id:             pack.ID,
+		OrganizationId: pack.OrganizationID,
+		Name:           pack.Name,
+		Content:        pack.Content,
+		Enabled:        pack.Enabled,
+		CreatedAt:      pack.CreatedAt.Time.Format(time.RFC3339Nano),
+	}
+}
+
+func (server *serverHandler) GetOrganizationsIdRulePacks(ctx context.Context, request generated.GetOrganizationsIdRulePacksRequestObject) (generated.GetOrganizationsIdRulePacksResponseObject, error) {
+	user, organization, hasPerm, _, err := server.checkForOrganizationPermission(ctx, request.Id, authorization.Viewer)
+	if err != nil {
+		return nil, fmt.Errorf("error checking permissions: %w", err)
+	}
+	if user == nil || organization == nil {
+		return generated.GetOrganizationsIdRulePacks401JSONResponse{
+			Message: "Unauthorized",
+			Success: false,
+		}, nil
+	}
+	if !hasPerm {
+		return generated.GetOrganizationsIdRulePacks404JSONResponse{
+			Message: "Organization not found",
+			Success: false,
+		}, nil
+	}
+
+	packs, err := server.DatabaseProvider.GetRulePacksForOrganization(ctx, organization.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting rule packs: %w", err)
+	}
+
+	response := generated.GetOrganizationsIdRulePacks200JSONResponse{
+		Success:   true,
+		RulePacks: make([]generated.RulePack, len(packs)),
+	}
+	for i, pack := range packs {
+		response.RulePacks[i] = rulePackToGenerated(pack)
+	}
+
+	return response, nil
+}
+
+func (server *serverHandler) PostOrganizationsIdRulePacks(ctx context.Context, request generated.PostOrganizationsIdRulePacksRequestObject) (generated.PostOrganizationsIdRulePacksResponseObject, error) {
+	err := valid.Struct(request)
+	if err != nil {
+		return generated.PostOrganizationsIdRulePacks400JSONResponse{
+			Success: false,
+			Message: "Validation error: " + err.Error(),
+		}, nil
+	}
+
+	user, organization, hasPerm, hasViewPerm, err := server.checkForOrganizationPermission(ctx, request.Id, authorization.Admin)
+	if err != nil {
+		return nil, fmt.Errorf("error checking permissions: %w", err)
+	}
+	if user == nil || organization == nil {
+		return generated.PostOrganizationsIdRulePacks401JSONResponse{
+			Message: "Unauthorized",
+			Success: false,
+		}, nil
+	}
+	if !hasPerm {
+		if hasViewPerm {
+			return generated.PostOrganizationsIdRulePacks401JSONResponse{
+				Message: "Forbidden",
+				Success: false,
+			}, nil
+		}
+		return generated.PostOrganizationsIdRulePacks404JSONResponse{
+			Message: "Organization not found",
+			Success: false,
+		}, nil
+	}
+
+	if _, err := rules.ParsePack([]byte(request.Body.Content)); err != nil {
+		return generated.PostOrganizationsIdRulePacks400JSONResponse{
+			Success: false,
+			Message: "Invalid rule pack: " + err.Error(),
+		}, nil
+	}
+
+	enabled := true
+	if request.Body.Enabled != nil {
+		enabled = *request.Body.Enabled
+	}
+
+	pack, err := server.DatabaseProvider.CreateRulePack(ctx, queries.CreateRulePackParams{
+		OrganizationID: organization.ID,
+		Name:           request.Body.Name,
+		Content:        request.Body.Content,
+		Enabled:        enabled,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating rule pack: %w", err)
+	}
+
+	return generated.PostOrganizationsIdRulePacks200JSONResponse{
+		Success:  true,
+		RulePack: rulePackToGenerated(pack),
+	}, nil
+}
+
+func (server *serverHandler) DeleteRulePacksId(ctx context.Context, request generated.DeleteRulePacksIdRequestObject) (generated.DeleteRulePacksIdResponseObject, error) {
+	pack, err := server.DatabaseProvider.GetRulePack(ctx, request.Id)
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("error getting rule pack: %w", err)
+	}
+	if err == pgx.ErrNoRows {
+		return generated.DeleteRulePacksId404JSONResponse{
+			Message: "Rule pack not found",
+			Success: false,
+		}, nil
+	}
+
+	user, organization, hasPerm, hasViewPerm, err := server.checkForOrganizationPermission(ctx, pack.OrganizationID, authorization.Admin)
+	if err != nil {
+		return nil, fmt.Errorf("error checking permissions: %w", err)
+	}
+	if user == nil || organization == nil {
+		return generated.DeleteRulePacksId401JSONResponse{
+			Message: "Unauthorized",
+			Success: false,
+		}, nil
+	}
+	if !hasPerm {
+		if hasViewPerm {
+			return generated.DeleteRulePacksId401JSONResponse{
+				Message: "Forbidden",
+				Success: false,
+			}, nil
+		}
+		return generated.DeleteRulePacksId404JSONResponse{
+			Message: "Rule pack not found",
+			Success: false,
+		}, nil
+	}
+
+	if err := server.DatabaseProvider.DeleteRulePack(ctx, pack.ID); err != nil {
+		return nil, fmt.Errorf("error deleting rule pack: %w", err)
+	}
+
+	return generated.DeleteRulePacksId204JSONResponse{
+		Success: true,
+	}, nil
+}
+
+func (server *serverHandler) GetProjectsIdRulePacks(ctx context.Context, request generated.GetProjectsIdRulePacksRequestObject) (generated.GetProjectsIdRulePacksResponseObject, error) {
+	worker, err := server.workerauth.GetWorker(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting worker: %w", err)
+	}
+	if worker == nil {
+		return generated.GetProjectsIdRulePacks401JSONResponse{
+			Message: "Unauthorized",
+			Success: false,
+		}, nil
+	}
+
+	project, err := server.DatabaseProvider.GetProject(ctx, request.Id)
+	if err != nil {
+		return generated.GetProjectsIdRulePacks404JSONResponse{
+			Message: "Project not found",
+			Success: false,
+		}, nil
+	}
+
+	hasPerm, err := server.authorization.WorkerHasPermissionForProject(ctx, project, worker, authorization.Worker)
+	if err != nil {
+		return nil, fmt.Errorf("error checking permissions: %w", err)
+	}
+	if !hasPerm {
+		return generated.GetProjectsIdRulePacks401JSONResponse{
+			Message: "Not allowed to get rule packs for this project",
+			Success: false,
+		}, nil
+	}
+
+	packs, err := server.DatabaseProvider.GetRulePacksForProject(ctx, project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting rule packs: %w", err)
+	}
+
+	response := generated.GetProjectsIdRulePacks200JSONResponse{
+		Success:   true,
+		RulePacks: make([]generated.RulePack, len(packs)),
+	}
+	for i, pack := range packs {
+		response.RulePacks[i] = rulePackToGenerated(pack)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,259 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/tedyst/licenta/api/authorization"
+	"github.com/tedyst/licenta/api/v1/generated"
+	"github.com/tedyst/licenta/db/queries"
+)
+
+func (server *serverHandler) GetElasticsearchId(ctx context.Context, request generated.GetElasticsearchIdRequestObject) (generated.GetElasticsearchIdResponseObject, error) {
+	database, err := server.DatabaseProvider.GetElasticsearchDatabase(ctx, queries.GetElasticsearchDatabaseParams{
+		ID:      request.Id,
+		SaltKey: server.saltKey,
+	})
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("error getting Elasticsearch database: %w", err)
+	}
+	if err == pgx.ErrNoRows {
+		return generated.GetElasticsearchId404JSONResponse{
+			Success: false,
+			Message: "Database not found",
+		}, nil
+	}
+
+	return generated.GetElasticsearchId200JSONResponse{
+		Success: true,
+		ElasticsearchDatabase: generated.ElasticsearchDatabase{
+			CreatedAt: database.CreatedAt.Time.Format(time.RFC3339Nano),
+			Host:      database.Host,
+			Password:  database.Password,
+			Id:        int(database.ID),
+			Port:      int(database.Port),
+			Username:  database.Username,
+			Version:   database.Version.String,
+			ProjectId: int(database.ProjectID),
+		},
+	}, nil
+}
+
+func (server *serverHandler) PatchElasticsearchId(ctx context.Context, request generated.PatchElasticsearchIdRequestObject) (generated.PatchElasticsearchIdResponseObject, error) {
+	database, err := server.DatabaseProvider.GetElasticsearchDatabase(ctx, queries.GetElasticsearchDatabaseParams{
+		ID:      request.Id,
+		SaltKey: server.saltKey,
+	})
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("error getting Elasticsearch database: %w", err)
+	}
+	if err == pgx.ErrNoRows {
+		return generated.PatchElasticsearchId404JSONResponse{
+			Success: false,
+			Message: "Database not found",
+		}, nil
+	}
+
+	host := database.Host
+	if request.Body.Host != nil {
+		host = *request.Body.Host
+	}
+	username := database.Username
+	if request.Body.Username != nil {
+		username = *request.Body.Username
+	}
+	password := database.Password
+	if request.Body.Password != nil {
+		password = *request.Body.Password
+	}
+	port := database.Port
+	if request.Body.Port != nil {
+		port = int32(*request.Body.Port)
+	}
+	version := database.Version
+	if request.Body.Version != nil {
+		version = sql.NullString{String: *request.Body.Version, Valid: true}
+	}
+
+	err = server.DatabaseProvider.UpdateElasticsearchDatabase(ctx, queries.UpdateElasticsearchDatabaseParams{
+		ID:        int64(request.Id),
+		Host:      host,
+		Username:  username,
+		Password:  password,
+		Port:      port,
+		Version:   version,
+		ProjectID: database.ProjectID,
+		SaltKey:   server.saltKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.PatchElasticsearchId200JSONResponse{
+		Success: true,
+		ElasticsearchDatabase: generated.ElasticsearchDatabase{
+			CreatedAt: database.CreatedAt.Time.Format(time.RFC3339Nano),
+			Host:      host,
+			Password:  password,
+			Id:        int(database.ID),
+			Port:      int(port),
+			Username:  username,
+			ProjectId: int(database.ProjectID),
+			Version:   version.String,
+		},
+	}, nil
+}
+
+func (server *serverHandler) GetElasticsearchScans(ctx context.Context, request generated.GetElasticsearchScansRequestObject) (generated.GetElasticsearchScansResponseObject, error) {
+	worker, err := server.workerauth.GetWorker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ElasticsearchScan, err := server.DatabaseProvider.GetProjectInfoForElasticsearchScanByScanID(ctx, queries.GetProjectInfoForElasticsearchScanByScanIDParams{
+		ScanID:  request.Params.Scan,
+		SaltKey: server.saltKey,
+	})
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("error getting Elasticsearch scan: %w", err)
+	}
+	if err == pgx.ErrNoRows {
+		return generated.GetElasticsearchScans404JSONResponse{
+			Success: false,
+			Message: "Scan not found",
+		}, nil
+	}
+
+	hasPerm, err := server.authorization.WorkerHasPermissionForProject(ctx, &ElasticsearchScan.Project, worker, authorization.Worker)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPerm {
+		return generated.GetElasticsearchScans401JSONResponse{
+			Success: false,
+			Message: "Worker does not have permission for project",
+		}, nil
+	}
+
+	return generated.GetElasticsearchScans200JSONResponse{
+		Success: true,
+		Scans: []generated.ElasticsearchScan{{
+			DatabaseId: int(ElasticsearchScan.ElasticsearchScan.DatabaseID),
+			Id:         int(ElasticsearchScan.ElasticsearchScan.ID),
+		}},
+	}, nil
+}
+
+func (server *serverHandler) GetElasticsearch(ctx context.Context, request generated.GetElasticsearchRequestObject) (generated.GetElasticsearchResponseObject, error) {
+	_, project, response, err := checkUserHasProjectPermission[generated.GetElasticsearch401JSONResponse](server, ctx, int64(request.Params.Project), authorization.Viewer)
+	if err != nil {
+		return nil, err
+	}
+	if response.Success == false {
+		return response, nil
+	}
+
+	databases, err := server.DatabaseProvider.GetElasticsearchDatabasesForProject(ctx, queries.GetElasticsearchDatabasesForProjectParams{
+		ProjectID: project.ID,
+		SaltKey:   server.saltKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ElasticsearchDatabases := make([]generated.ElasticsearchDatabase, len(databases))
+	for i, db := range databases {
+		ElasticsearchDatabases[i] = generated.ElasticsearchDatabase{
+			CreatedAt: db.CreatedAt.Time.Format(time.RFC3339Nano),
+			Host:      db.Host,
+			Id:        int(db.ID),
+			Port:      int(db.Port),
+			Username:  db.Username,
+			Version:   db.Version.String,
+			ProjectId: int(db.ProjectID),
+		}
+	}
+
+	return generated.GetElasticsearch200JSONResponse{
+		Success:                true,
+		ElasticsearchDatabases: ElasticsearchDatabases,
+	}, nil
+}
+
+func (server *serverHandler) PostElasticsearch(ctx context.Context, request generated.PostElasticsearchRequestObject) (generated.PostElasticsearchResponseObject, error) {
+	_, project, response, err := checkUserHasProjectPermission[generated.PostElasticsearch401JSONResponse](server, ctx, int64(request.Body.ProjectId), authorization.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if response.Success == false {
+		return response, nil
+	}
+
+	db, err := server.DatabaseProvider.CreateElasticsearchDatabase(ctx, queries.CreateElasticsearchDatabaseParams{
+		Host:      request.Body.Host,
+		Username:  request.Body.Username,
+		Password:  request.Body.Password,
+		Port:      int32(request.Body.Port),
+		Version:   sql.NullString{Valid: false},
+		ProjectID: project.ID,
+		SaltKey:   server.saltKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.PostElasticsearch201JSONResponse{
+		Success: true,
+		ElasticsearchDatabase: generated.ElasticsearchDatabase{
+			CreatedAt: time.Now().Format(time.RFC3339Nano),
+			Host:      db.Host,
+			Id:        int(db.ID),
+			Port:      int(db.Port),
+			Username:  db.Username,
+			Version:   db.Version.String,
+			ProjectId: int(db.ProjectID),
+		},
+	}, nil
+}
+
+func (server *serverHandler) DeleteElasticsearchId(ctx context.Context, request generated.DeleteElasticsearchIdRequestObject) (generated.DeleteElasticsearchIdResponseObject, error) {
+	database, err := server.DatabaseProvider.GetElasticsearchDatabase(ctx, queries.GetElasticsearchDatabaseParams{
+		ID:      request.Id,
+		SaltKey: server.saltKey,
+	})
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("error getting Elasticsearch database: %w", err)
+	}
+	if err == pgx.ErrNoRows {
+		return generated.DeleteElasticsearchId404JSONResponse{
+			Success: false,
+			Message: "Database not found",
+		}, nil
+	}
+
+	_, project, response, err := checkUserHasProjectPermission[generated.DeleteElasticsearchId401JSONResponse](server, ctx, int64(database.ProjectID), authorization.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if response.Success == false {
+		return response, nil
+	}
+	if project.ID != database.ProjectID {
+		return generated.DeleteElasticsearchId401JSONResponse{
+			Success: false,
+			Message: "Database not found in project",
+		}, nil
+	}
+
+	err = server.DatabaseProvider.DeleteElasticsearchDatabase(ctx, database.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.DeleteElasticsearchId204JSONResponse{
+		Success: true,
+	}, nil
+}
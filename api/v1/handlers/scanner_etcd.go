@@ -0,0 +1,259 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/tedyst/licenta/api/authorization"
+	"github.com/tedyst/licenta/api/v1/generated"
+	"github.com/tedyst/licenta/db/queries"
+)
+
+func (server *serverHandler) GetEtcdId(ctx context.Context, request generated.GetEtcdIdRequestObject) (generated.GetEtcdIdResponseObject, error) {
+	database, err := server.DatabaseProvider.GetEtcdDatabase(ctx, queries.GetEtcdDatabaseParams{
+		ID:      request.Id,
+		SaltKey: server.saltKey,
+	})
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("error getting Etcd database: %w", err)
+	}
+	if err == pgx.ErrNoRows {
+		return generated.GetEtcdId404JSONResponse{
+			Success: false,
+			Message: "Database not found",
+		}, nil
+	}
+
+	return generated.GetEtcdId200JSONResponse{
+		Success: true,
+		EtcdDatabase: generated.EtcdDatabase{
+			CreatedAt: database.CreatedAt.Time.Format(time.RFC3339Nano),
+			Host:      database.Host,
+			Password:  database.Password,
+			Id:        int(database.ID),
+			Port:      int(database.Port),
+			Username:  database.Username,
+			Version:   database.Version.String,
+			ProjectId: int(database.ProjectID),
+		},
+	}, nil
+}
+
+func (server *serverHandler) PatchEtcdId(ctx context.Context, request generated.PatchEtcdIdRequestObject) (generated.PatchEtcdIdResponseObject, error) {
+	database, err := server.DatabaseProvider.GetEtcdDatabase(ctx, queries.GetEtcdDatabaseParams{
+		ID:      request.Id,
+		SaltKey: server.saltKey,
+	})
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("error getting Etcd database: %w", err)
+	}
+	if err == pgx.ErrNoRows {
+		return generated.PatchEtcdId404JSONResponse{
+			Success: false,
+			Message: "Database not found",
+		}, nil
+	}
+
+	host := database.Host
+	if request.Body.Host != nil {
+		host = *request.Body.Host
+	}
+	username := database.Username
+	if request.Body.Username != nil {
+		username = *request.Body.Username
+	}
+	password := database.Password
+	if request.Body.Password != nil {
+		password = *request.Body.Password
+	}
+	port := database.Port
+	if request.Body.Port != nil {
+		port = int32(*request.Body.Port)
+	}
+	version := database.Version
+	if request.Body.Version != nil {
+		version = sql.NullString{String: *request.Body.Version, Valid: true}
+	}
+
+	err = server.DatabaseProvider.UpdateEtcdDatabase(ctx, queries.UpdateEtcdDatabaseParams{
+		ID:        int64(request.Id),
+		Host:      host,
+		Username:  username,
+		Password:  password,
+		Port:      port,
+		Version:   version,
+		ProjectID: database.ProjectID,
+		SaltKey:   server.saltKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.PatchEtcdId200JSONResponse{
+		Success: true,
+		EtcdDatabase: generated.EtcdDatabase{
+			CreatedAt: database.CreatedAt.Time.Format(time.RFC3339Nano),
+			Host:      host,
+			Password:  password,
+			Id:        int(database.ID),
+			Port:      int(port),
+			Username:  username,
+			ProjectId: int(database.ProjectID),
+			Version:   version.String,
+		},
+	}, nil
+}
+
+func (server *serverHandler) GetEtcdScans(ctx context.Context, request generated.GetEtcdScansRequestObject) (generated.GetEtcdScansResponseObject, error) {
+	worker, err := server.workerauth.GetWorker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	EtcdScan, err := server.DatabaseProvider.GetProjectInfoForEtcdScanByScanID(ctx, queries.GetProjectInfoForEtcdScanByScanIDParams{
+		ScanID:  request.Params.Scan,
+		SaltKey: server.saltKey,
+	})
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("error getting Etcd scan: %w", err)
+	}
+	if err == pgx.ErrNoRows {
+		return generated.GetEtcdScans404JSONResponse{
+			Success: false,
+			Message: "Scan not found",
+		}, nil
+	}
+
+	hasPerm, err := server.authorization.WorkerHasPermissionForProject(ctx, &EtcdScan.Project, worker, authorization.Worker)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPerm {
+		return generated.GetEtcdScans401JSONResponse{
+			Success: false,
+			Message: "Worker does not have permission for project",
+		}, nil
+	}
+
+	return generated.GetEtcdScans200JSONResponse{
+		Success: true,
+		Scans: []generated.EtcdScan{{
+			DatabaseId: int(EtcdScan.EtcdScan.DatabaseID),
+			Id:         int(EtcdScan.EtcdScan.ID),
+		}},
+	}, nil
+}
+
+func (server *serverHandler) GetEtcd(ctx context.Context, request generated.GetEtcdRequestObject) (generated.GetEtcdResponseObject, error) {
+	_, project, response, err := checkUserHasProjectPermission[generated.GetEtcd401JSONResponse](server, ctx, int64(request.Params.Project), authorization.Viewer)
+	if err != nil {
+		return nil, err
+	}
+	if response.Success == false {
+		return response, nil
+	}
+
+	databases, err := server.DatabaseProvider.GetEtcdDatabasesForProject(ctx, queries.GetEtcdDatabasesForProjectParams{
+		ProjectID: project.ID,
+		SaltKey:   server.saltKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	EtcdDatabases := make([]generated.EtcdDatabase, len(databases))
+	for i, db := range databases {
+		EtcdDatabases[i] = generated.EtcdDatabase{
+			CreatedAt: db.CreatedAt.Time.Format(time.RFC3339Nano),
+			Host:      db.Host,
+			Id:        int(db.ID),
+			Port:      int(db.Port),
+			Username:  db.Username,
+			Version:   db.Version.String,
+			ProjectId: int(db.ProjectID),
+		}
+	}
+
+	return generated.GetEtcd200JSONResponse{
+		Success:       true,
+		EtcdDatabases: EtcdDatabases,
+	}, nil
+}
+
+func (server *serverHandler) PostEtcd(ctx context.Context, request generated.PostEtcdRequestObject) (generated.PostEtcdResponseObject, error) {
+	_, project, response, err := checkUserHasProjectPermission[generated.PostEtcd401JSONResponse](server, ctx, int64(request.Body.ProjectId), authorization.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if response.Success == false {
+		return response, nil
+	}
+
+	db, err := server.DatabaseProvider.CreateEtcdDatabase(ctx, queries.CreateEtcdDatabaseParams{
+		Host:      request.Body.Host,
+		Username:  request.Body.Username,
+		Password:  request.Body.Password,
+		Port:      int32(request.Body.Port),
+		Version:   sql.NullString{Valid: false},
+		ProjectID: project.ID,
+		SaltKey:   server.saltKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.PostEtcd201JSONResponse{
+		Success: true,
+		EtcdDatabase: generated.EtcdDatabase{
+			CreatedAt: time.Now().Format(time.RFC3339Nano),
+			Host:      db.Host,
+			Id:        int(db.ID),
+			Port:      int(db.Port),
+			Username:  db.Username,
+			Version:   db.Version.String,
+			ProjectId: int(db.ProjectID),
+		},
+	}, nil
+}
+
+func (server *serverHandler) DeleteEtcdId(ctx context.Context, request generated.DeleteEtcdIdRequestObject) (generated.DeleteEtcdIdResponseObject, error) {
+	database, err := server.DatabaseProvider.GetEtcdDatabase(ctx, queries.GetEtcdDatabaseParams{
+		ID:      request.Id,
+		SaltKey: server.saltKey,
+	})
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("error getting Etcd database: %w", err)
+	}
+	if err == pgx.ErrNoRows {
+		return generated.DeleteEtcdId404JSONResponse{
+			Success: false,
+			Message: "Database not found",
+		}, nil
+	}
+
+	_, project, response, err := checkUserHasProjectPermission[generated.DeleteEtcdId401JSONResponse](server, ctx, int64(database.ProjectID), authorization.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if response.Success == false {
+		return response, nil
+	}
+	if project.ID != database.ProjectID {
+		return generated.DeleteEtcdId401JSONResponse{
+			Success: false,
+			Message: "Database not found in project",
+		}, nil
+	}
+
+	err = server.DatabaseProvider.DeleteEtcdDatabase(ctx, database.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.DeleteEtcdId204JSONResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,269 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/tedyst/licenta/api/authorization"
+	"github.com/tedyst/licenta/api/v1/generated"
+	"github.com/tedyst/licenta/db/queries"
+)
+
+func (server *serverHandler) GetMssqlId(ctx context.Context, request generated.GetMssqlIdRequestObject) (generated.GetMssqlIdResponseObject, error) {
+	database, err := server.DatabaseProvider.GetMssqlDatabase(ctx, queries.GetMssqlDatabaseParams{
+		ID:      request.Id,
+		SaltKey: server.saltKey,
+	})
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("error getting mssql database: %w", err)
+	}
+	if err == pgx.ErrNoRows {
+		return generated.GetMssqlId404JSONResponse{
+			Success: false,
+			Message: "Database not found",
+		}, nil
+	}
+
+	return generated.GetMssqlId200JSONResponse{
+		Success: true,
+		MssqlDatabase: generated.MssqlDatabase{
+			CreatedAt:    database.CreatedAt.Time.Format(time.RFC3339Nano),
+			Host:         database.Host,
+			DatabaseName: database.DatabaseName,
+			Password:     database.Password,
+			Id:           int(database.ID),
+			Port:         int(database.Port),
+			Username:     database.Username,
+			Version:      database.Version.String,
+			ProjectId:    int(database.ProjectID),
+		},
+	}, nil
+}
+
+func (server *serverHandler) PatchMssqlId(ctx context.Context, request generated.PatchMssqlIdRequestObject) (generated.PatchMssqlIdResponseObject, error) {
+	database, err := server.DatabaseProvider.GetMssqlDatabase(ctx, queries.GetMssqlDatabaseParams{
+		ID:      request.Id,
+		SaltKey: server.saltKey,
+	})
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("error getting Mssql database: %w", err)
+	}
+	if err == pgx.ErrNoRows {
+		return generated.PatchMssqlId404JSONResponse{
+			Success: false,
+			Message: "Database not found",
+		}, nil
+	}
+
+	host := database.Host
+	if request.Body.Host != nil {
+		host = *request.Body.Host
+	}
+	username := database.Username
+	if request.Body.Username != nil {
+		username = *request.Body.Username
+	}
+	password := database.Password
+	if request.Body.Password != nil {
+		password = *request.Body.Password
+	}
+	databaseName := database.DatabaseName
+	if request.Body.DatabaseName != nil {
+		databaseName = *request.Body.DatabaseName
+	}
+	port := database.Port
+	if request.Body.Port != nil {
+		port = int32(*request.Body.Port)
+	}
+	version := database.Version
+	if request.Body.Version != nil {
+		version = sql.NullString{String: *request.Body.Version, Valid: true}
+	}
+
+	err = server.DatabaseProvider.UpdateMssqlDatabase(ctx, queries.UpdateMssqlDatabaseParams{
+		ID:           int64(request.Id),
+		Host:         host,
+		Username:     username,
+		Password:     password,
+		DatabaseName: databaseName,
+		Port:         port,
+		Version:      version,
+		ProjectID:    database.ProjectID,
+		SaltKey:      server.saltKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.PatchMssqlId200JSONResponse{
+		Success: true,
+		MssqlDatabase: generated.MssqlDatabase{
+			CreatedAt:    database.CreatedAt.Time.Format(time.RFC3339Nano),
+			Host:         host,
+			DatabaseName: databaseName,
+			Password:     password,
+			Id:           int(database.ID),
+			Port:         int(port),
+			Username:     username,
+			ProjectId:    int(database.ProjectID),
+			Version:      version.String,
+		},
+	}, nil
+}
+
+func (server *serverHandler) GetMssqlScans(ctx context.Context, request generated.GetMssqlScansRequestObject) (generated.GetMssqlScansResponseObject, error) {
+	worker, err := server.workerauth.GetWorker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	MssqlScan, err := server.DatabaseProvider.GetProjectInfoForMssqlScanByScanID(ctx, queries.GetProjectInfoForMssqlScanByScanIDParams{
+		ScanID:  request.Params.Scan,
+		SaltKey: server.saltKey,
+	})
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("error getting Mssql scan: %w", err)
+	}
+	if err == pgx.ErrNoRows {
+		return generated.GetMssqlScans404JSONResponse{
+			Success: false,
+			Message: "Scan not found",
+		}, nil
+	}
+
+	hasPerm, err := server.authorization.WorkerHasPermissionForProject(ctx, &MssqlScan.Project, worker, authorization.Worker)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPerm {
+		return generated.GetMssqlScans401JSONResponse{
+			Success: false,
+			Message: "Worker does not have permission for project",
+		}, nil
+	}
+
+	return generated.GetMssqlScans200JSONResponse{
+		Success: true,
+		Scans: []generated.MssqlScan{{
+			DatabaseId: int(MssqlScan.MssqlScan.DatabaseID),
+			Id:         int(MssqlScan.MssqlScan.ID),
+		}},
+	}, nil
+}
+
+func (server *serverHandler) GetMssql(ctx context.Context, request generated.GetMssqlRequestObject) (generated.GetMssqlResponseObject, error) {
+	_, project, response, err := checkUserHasProjectPermission[generated.GetMssql401JSONResponse](server, ctx, int64(request.Params.Project), authorization.Viewer)
+	if err != nil {
+		return nil, err
+	}
+	if response.Success == false {
+		return response, nil
+	}
+
+	databases, err := server.DatabaseProvider.GetMssqlDatabasesForProject(ctx, queries.GetMssqlDatabasesForProjectParams{
+		ProjectID: project.ID,
+		SaltKey:   server.saltKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mssqlDatabases := make([]generated.MssqlDatabase, len(databases))
+	for i, db := range databases {
+		mssqlDatabases[i] = generated.MssqlDatabase{
+			CreatedAt:    db.CreatedAt.Time.Format(time.RFC3339Nano),
+			Host:         db.Host,
+			DatabaseName: db.DatabaseName,
+			Id:           int(db.ID),
+			Port:         int(db.Port),
+			Username:     db.Username,
+			Version:      db.Version.String,
+			ProjectId:    int(db.ProjectID),
+		}
+	}
+
+	return generated.GetMssql200JSONResponse{
+		Success:        true,
+		MssqlDatabases: mssqlDatabases,
+	}, nil
+}
+
+func (server *serverHandler) PostMssql(ctx context.Context, request generated.PostMssqlRequestObject) (generated.PostMssqlResponseObject, error) {
+	_, project, response, err := checkUserHasProjectPermission[generated.PostMssql401JSONResponse](server, ctx, int64(request.Body.ProjectId), authorization.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if response.Success == false {
+		return response, nil
+	}
+
+	db, err := server.DatabaseProvider.CreateMssqlDatabase(ctx, queries.CreateMssqlDatabaseParams{
+		Host:         request.Body.Host,
+		Username:     request.Body.Username,
+		Password:     request.Body.Password,
+		DatabaseName: request.Body.DatabaseName,
+		Port:         int32(request.Body.Port),
+		Version:      sql.NullString{Valid: false},
+		ProjectID:    project.ID,
+		SaltKey:      server.saltKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.PostMssql201JSONResponse{
+		Success: true,
+		MssqlDatabase: generated.MssqlDatabase{
+			CreatedAt:    time.Now().Format(time.RFC3339Nano),
+			Host:         db.Host,
+			DatabaseName: db.DatabaseName,
+			Id:           int(db.ID),
+			Port:         int(db.Port),
+			Username:     db.Username,
+			Version:      db.Version.String,
+			ProjectId:    int(db.ProjectID),
+		},
+	}, nil
+}
+
+func (server *serverHandler) DeleteMssqlId(ctx context.Context, request generated.DeleteMssqlIdRequestObject) (generated.DeleteMssqlIdResponseObject, error) {
+	database, err := server.DatabaseProvider.GetMssqlDatabase(ctx, queries.GetMssqlDatabaseParams{
+		ID:      request.Id,
+		SaltKey: server.saltKey,
+	})
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("error getting Mssql database: %w", err)
+	}
+	if err == pgx.ErrNoRows {
+		return generated.DeleteMssqlId404JSONResponse{
+			Success: false,
+			Message: "Database not found",
+		}, nil
+	}
+
+	_, project, response, err := checkUserHasProjectPermission[generated.DeleteMssqlId401JSONResponse](server, ctx, int64(database.ProjectID), authorization.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if response.Success == false {
+		return response, nil
+	}
+	if project.ID != database.ProjectID {
+		return generated.DeleteMssqlId401JSONResponse{
+			Success: false,
+			Message: "Database not found in project",
+		}, nil
+	}
+
+	err = server.DatabaseProvider.DeleteMssqlDatabase(ctx, database.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.DeleteMssqlId204JSONResponse{
+		Success: true,
+	}, nil
+}
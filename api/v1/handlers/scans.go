@@ -11,8 +11,52 @@ import (
 	"github.com/tedyst/licenta/api/authorization"
 	"github.com/tedyst/licenta/api/v1/generated"
 	"github.com/tedyst/licenta/db/queries"
+	"github.com/tedyst/licenta/scanner"
 )
 
+func cveResultToGenerated(result *queries.ScanCveResult) *generated.CveFinding {
+	return &generated.CveFinding{
+		CveId:       result.CveID,
+		Score:       float32(result.Score),
+		Vector:      result.Vector,
+		Description: result.Description,
+		PublishedAt: result.Published.Time.Format(time.RFC3339Nano),
+		FixedIn:     result.FixedIn,
+	}
+}
+
+// cveResultToFinding presents a vulnerability like the other findings. The
+// CVE ID is used as the rule ID, so a single CVE can be tracked across scans.
+func cveResultToFinding(result *queries.ScanCveResult) *generated.Finding {
+	remediation := "Update to the latest version."
+	if result.FixedIn != "" {
+		remediation = "Update to version " + result.FixedIn + " or later."
+	}
+	return &generated.Finding{
+		RuleId:      result.CveID,
+		Title:       "Vulnerability " + result.CveID,
+		Description: result.Description,
+		Remediation: remediation,
+		References:  []string{"https://nvd.nist.gov/vuln/detail/" + result.CveID},
+		ObjectType:  string(scanner.OBJECT_CVE),
+		ObjectName:  result.CveID,
+		Evidence:    result.Vector,
+	}
+}
+
+func findingToGenerated(finding *queries.ScanResultFinding) *generated.Finding {
+	return &generated.Finding{
+		RuleId:      finding.RuleID,
+		Title:       finding.Title,
+		Description: finding.Description,
+		Remediation: finding.Remediation,
+		References:  finding.ReferenceUrls,
+		ObjectType:  finding.ObjectType,
+		ObjectName:  finding.ObjectName,
+		Evidence:    finding.Evidence,
+	}
+}
+
 func (server *serverHandler) GetScanId(ctx context.Context, request generated.GetScanIdRequestObject) (generated.GetScanIdResponseObject, error) {
 	scan, err := server.DatabaseProvider.GetScan(ctx, request.Id)
 	if err != nil && err != pgx.ErrNoRows {
@@ -30,6 +74,25 @@ func (server *serverHandler) GetScanId(ctx context.Context, request generated.Ge
 		return nil, fmt.Errorf("GetScannerPostgresScanScanid: error getting scan results: %w", err)
 	}
 
+	cveResultsQ, err := server.DatabaseProvider.GetScanCveResults(ctx, scan.Scan.ID)
+	if err != nil {
+		return nil, fmt.Errorf("GetScanId: error getting cve results: %w", err)
+	}
+	cveResults := make(map[int64]*generated.CveFinding, len(cveResultsQ))
+	findings := make(map[int64]*generated.Finding, len(cveResultsQ))
+	for _, cveResult := range cveResultsQ {
+		cveResults[cveResult.ScanResultID] = cveResultToGenerated(cveResult)
+		findings[cveResult.ScanResultID] = cveResultToFinding(cveResult)
+	}
+
+	findingsQ, err := server.DatabaseProvider.GetScanResultFindings(ctx, scan.Scan.ID)
+	if err != nil {
+		return nil, fmt.Errorf("GetScanId: error getting findings: %w", err)
+	}
+	for _, finding := range findingsQ {
+		findings[finding.ScanResultID] = findingToGenerated(finding)
+	}
+
 	scanResults := make([]generated.ScanResult, len(scanResultsQ))
 	for i, scanResult := range scanResultsQ {
 		scanResults[i] = generated.ScanResult{
@@ -38,6 +101,8 @@ func (server *serverHandler) GetScanId(ctx context.Context, request generated.Ge
 			Message:    scanResult.Message,
 			Severity:   int(scanResult.Severity),
 			ScanSource: int(scanResult.ScanSource),
+			Cve:        cveResults[scanResult.ID],
+			Finding:    findings[scanResult.ID],
 		}
 	}
 
@@ -169,6 +234,117 @@ func (server *serverHandler) PostScanIdResult(ctx context.Context, request gener
 	}, nil
 }
 
+func (server *serverHandler) PostScanIdCveResult(ctx context.Context, request generated.PostScanIdCveResultRequestObject) (generated.PostScanIdCveResultResponseObject, error) {
+	if request.Body == nil {
+		return generated.PostScanIdCveResult400JSONResponse{
+			Success: false,
+			Message: "Invalid request",
+		}, nil
+	}
+
+	_, err := server.DatabaseProvider.GetScan(ctx, request.Id)
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("PostScanIdCveResult: error getting scan: %w", err)
+	}
+	if err == pgx.ErrNoRows {
+		return generated.PostScanIdCveResult404JSONResponse{
+			Success: false,
+			Message: "Scan not found",
+		}, nil
+	}
+
+	published, err := time.Parse(time.RFC3339Nano, request.Body.PublishedAt)
+	if err != nil {
+		return generated.PostScanIdCveResult400JSONResponse{
+			Success: false,
+			Message: "Invalid published_at",
+		}, nil
+	}
+
+	result, err := server.DatabaseProvider.CreateScanCveResult(ctx, queries.CreateScanCveResultParams{
+		ScanID:      request.Id,
+		Severity:    int32(request.Body.Severity),
+		Message:     request.Body.Message,
+		ScanSource:  int32(request.Body.ScanSource),
+		CveID:       request.Body.CveId,
+		Score:       float64(request.Body.Score),
+		Vector:      request.Body.Vector,
+		Description: request.Body.Description,
+		Published:   pgtype.Timestamptz{Time: published, Valid: true},
+		FixedIn:     request.Body.FixedIn,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("PostScanIdCveResult: error creating cve result: %w", err)
+	}
+
+	return generated.PostScanIdCveResult200JSONResponse{
+		Success: true,
+		Scan: generated.ScanResult{
+			CreatedAt:  time.Now().Format(time.RFC3339Nano),
+			Id:         int(result.ScanResultID),
+			Message:    request.Body.Message,
+			Severity:   request.Body.Severity,
+			ScanSource: request.Body.ScanSource,
+			Cve:        cveResultToGenerated(result),
+		},
+	}, nil
+}
+
+func (server *serverHandler) PostScanIdFindingResult(ctx context.Context, request generated.PostScanIdFindingResultRequestObject) (generated.PostScanIdFindingResultResponseObject, error) {
+	if request.Body == nil {
+		return generated.PostScanIdFindingResult400JSONResponse{
+			Success: false,
+			Message: "Invalid request",
+		}, nil
+	}
+
+	_, err := server.DatabaseProvider.GetScan(ctx, request.Id)
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("PostScanIdFindingResult: error getting scan: %w", err)
+	}
+	if err == pgx.ErrNoRows {
+		return generated.PostScanIdFindingResult404JSONResponse{
+			Success: false,
+			Message: "Scan not found",
+		}, nil
+	}
+
+	references := request.Body.Finding.References
+	if references == nil {
+		references = []string{}
+	}
+
+	finding, err := server.DatabaseProvider.CreateScanFindingResult(ctx, queries.CreateScanFindingResultParams{
+		ScanID:        request.Id,
+		Severity:      int32(request.Body.Severity),
+		Message:       request.Body.Message,
+		ScanSource:    int32(request.Body.ScanSource),
+		RuleID:        request.Body.Finding.RuleId,
+		Title:         request.Body.Finding.Title,
+		Description:   request.Body.Finding.Description,
+		Remediation:   request.Body.Finding.Remediation,
+		ReferenceUrls: references,
+		ObjectType:    request.Body.Finding.ObjectType,
+		ObjectName:    request.Body.Finding.ObjectName,
+		Evidence:      request.Body.Finding.Evidence,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("PostScanIdFindingResult: error creating finding: %w", err)
+	}
+
+	return generated.PostScanIdFindingResult200JSONResponse{
+		Success: true,
+		Scan: generated.ScanResult{
+			CreatedAt:  time.Now().Format(time.RFC3339Nano),
+			Id:         int(finding.ScanResultID),
+			Message:    request.Body.Message,
+			Severity:   request.Body.Severity,
+			ScanSource: request.Body.ScanSource,
+			Finding:    findingToGenerated(finding),
+		},
+	}, nil
+}
+
 func (server *serverHandler) GetScanGroups(ctx context.Context, request generated.GetScanGroupsRequestObject) (generated.GetScanGroupsResponseObject, error) {
 	_, project, response, err := checkUserHasProjectPermission[generated.GetScanGroups401JSONResponse](server, ctx, int64(request.Params.Project), authorization.Viewer)
 	if err != nil {
@@ -25,6 +25,19 @@ func (server *serverHandler) GetWorkerGetTask(ctx context.Context, request gener
 		}, nil
 	}
 
+	// Shards of a distributed bruteforce are handed out before new scans,
+	// so that the scans that are already running finish first.
+	shard, err := server.DatabaseProvider.ClaimScanBruteforceShard(ctx, queries.ClaimScanBruteforceShardParams{
+		WorkerID:       sql.NullInt64{Int64: int64(w.ID), Valid: true},
+		OrganizationID: w.Organization,
+	})
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("cannot claim bruteforce shard: %w", err)
+	}
+	if shard != nil {
+		return server.getBruteforceShardTask(ctx, shard)
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
@@ -90,6 +103,37 @@ func (server *serverHandler) GetWorkerGetTask(ctx context.Context, request gener
 	}, nil
 }
 
+func (server *serverHandler) getBruteforceShardTask(ctx context.Context, shard *queries.ScanBruteforceShard) (generated.GetWorkerGetTaskResponseObject, error) {
+	scan, err := server.DatabaseProvider.GetScan(ctx, shard.ScanID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get scan: %w", err)
+	}
+
+	scanGroup, err := server.DatabaseProvider.GetScanGroup(ctx, scan.Scan.ScanGroupID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get scan group: %w", err)
+	}
+
+	bruteforceShard := bruteforceShardToAPI(shard)
+	return generated.GetWorkerGetTask200JSONResponse{
+		Success: true,
+		Scan: generated.Scan{
+			Id:              int(scan.Scan.ID),
+			CreatedAt:       scan.Scan.CreatedAt.Time.Format(time.RFC3339Nano),
+			EndedAt:         scan.Scan.EndedAt.Time.Format(time.RFC3339Nano),
+			Error:           scan.Scan.Error.String,
+			Status:          int(scan.Scan.Status),
+			MaximumSeverity: int(scan.MaximumSeverity),
+			ScanGroupId:     int(scan.Scan.ScanGroupID),
+		},
+		ScanGroup: generated.ScanGroup{
+			Id:        int(scanGroup.ID),
+			ProjectId: int(scanGroup.ProjectID),
+		},
+		BruteforceShard: &bruteforceShard,
+	}, nil
+}
+
 func (server *serverHandler) DeleteWorkerId(ctx context.Context, request generated.DeleteWorkerIdRequestObject) (generated.DeleteWorkerIdResponseObject, error) {
 	w, err := server.DatabaseProvider.GetWorker(ctx, request.Id)
 	if err != nil {
@@ -0,0 +1,136 @@
+package breach
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidRange = errors.New("invalid range file")
+
+// ImportRanges adds the hashes of a range file to w. Every line is a hash,
+// or the rest of it after prefix, followed by a colon and its count, like
+// the files of the Pwned Passwords downloader or the range API. With an
+// empty prefix every line has the full hash, like the ordered by hash
+// downloads. Padding entries with a count of 0 are skipped. It returns the
+// number of hashes added.
+func ImportRanges(w *IndexWriter, r io.Reader, prefix string) (int64, error) {
+	lines := bufio.NewScanner(r)
+	var added int64
+	for line := 1; lines.Scan(); line++ {
+		text := strings.TrimSpace(lines.Text())
+		if text == "" {
+			continue
+		}
+		suffix, count, ok := strings.Cut(text, ":")
+		if !ok {
+			return added, fmt.Errorf("%w: line %d has no count", ErrInvalidRange, line)
+		}
+
+		var hash [sha1.Size]byte
+		decoded, err := hex.DecodeString(prefix + suffix)
+		if err != nil || len(decoded) != sha1.Size {
+			return added, fmt.Errorf("%w: line %d does not complete a SHA-1 hash", ErrInvalidRange, line)
+		}
+		copy(hash[:], decoded)
+
+		n, err := strconv.ParseInt(strings.TrimSpace(count), 10, 64)
+		if err != nil {
+			return added, fmt.Errorf("%w: line %d: %w", ErrInvalidRange, line, err)
+		}
+		if n == 0 {
+			continue
+		}
+		if err := w.Add(hash, n); err != nil {
+			return added, fmt.Errorf("line %d: %w", line, err)
+		}
+		added++
+	}
+	if err := lines.Err(); err != nil {
+		return added, fmt.Errorf("could not read range file: %w", err)
+	}
+	return added, nil
+}
+
+// rangePrefix returns the prefix of a range file from its name, like
+// 21BD1 or 21BD1.txt, or an empty string if the file has full hashes.
+func rangePrefix(path string) string {
+	name, _, _ := strings.Cut(filepath.Base(path), ".")
+	if len(name) != PrefixLength {
+		return ""
+	}
+	if _, err := hex.DecodeString(name + "0"); err != nil {
+		return ""
+	}
+	return strings.ToUpper(name)
+}
+
+// importFile imports a single range file, which can be compressed with gzip.
+func importFile(w *IndexWriter, path string) (int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("could not open range file: %w", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	var r io.Reader = reader
+	if magic, _ := reader.Peek(2); bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		decompressed, err := gzip.NewReader(reader)
+		if err != nil {
+			return 0, fmt.Errorf("could not read gzip range file %s: %w", path, err)
+		}
+		defer decompressed.Close()
+		r = decompressed
+	}
+
+	added, err := ImportRanges(w, r, rangePrefix(path))
+	if err != nil {
+		return added, fmt.Errorf("could not import %s: %w", path, err)
+	}
+	return added, nil
+}
+
+// ImportPath imports a range file, or a directory of range files named by
+// their prefix. The files of a directory are imported in the order of their
+// names, so that the hashes are in ascending order.
+func ImportPath(w *IndexWriter, path string) (int64, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return 0, fmt.Errorf("could not stat %s: %w", path, err)
+	}
+	if !stat.IsDir() {
+		return importFile(w, path)
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return 0, fmt.Errorf("could not read directory %s: %w", path, err)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return strings.ToUpper(entries[i].Name()) < strings.ToUpper(entries[j].Name())
+	})
+
+	var added int64
+	for _, entry := range entries {
+		if entry.IsDir() || rangePrefix(entry.Name()) == "" {
+			continue
+		}
+		n, err := importFile(w, filepath.Join(path, entry.Name()))
+		added += n
+		if err != nil {
+			return added, err
+		}
+	}
+	return added, nil
+}
@@ -0,0 +1,269 @@
+// Package breach checks passwords against a corpus of breached passwords,
+// such as the Pwned Passwords dataset, without calling an external service.
+//
+// The corpus is imported from SHA-1 range files into a compact index on
+// disk. Clients that do not have the index query it by the first five hex
+// characters of the hash, so the password itself is never sent.
+package breach
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// The index starts with indexMagic, the number of hashes and a fanout table
+// with the number of hashes whose first two bytes are less than or equal to
+// the position in the table, like the index of a git pack. The hashes follow
+// in ascending order, without the two bytes already given by the fanout, each
+// with its count.
+const (
+	indexMagic   = "LCBRIDX1"
+	fanoutSize   = 1 << 16
+	fanoutBytes  = 2
+	suffixBytes  = sha1.Size - fanoutBytes
+	recordSize   = suffixBytes + 4
+	headerSize   = len(indexMagic) + 8 + fanoutSize*8
+	PrefixLength = 5
+)
+
+var (
+	ErrInvalidIndex  = errors.New("invalid breach index")
+	ErrUnsorted      = errors.New("hashes are not in ascending order")
+	ErrInvalidPrefix = errors.New("invalid hash prefix")
+)
+
+// RangeEntry is a hash that starts with the queried prefix. Suffix is the
+// rest of the hash in uppercase hex, like in the Pwned Passwords range API.
+type RangeEntry struct {
+	Suffix string
+	Count  int64
+}
+
+// Checker returns how many times a password appears in the corpus, or 0 if
+// it does not.
+type Checker interface {
+	Count(ctx context.Context, password string) (int64, error)
+}
+
+// HashPassword returns the uppercase hex SHA-1 hash used by the corpus.
+func HashPassword(password string) string {
+	hash := sha1.Sum([]byte(password))
+	return strings.ToUpper(hex.EncodeToString(hash[:]))
+}
+
+// Index is a breach corpus imported with IndexWriter.
+type Index struct {
+	file   *os.File
+	total  uint64
+	fanout [fanoutSize]uint64
+}
+
+var _ Checker = (*Index)(nil)
+
+// OpenIndex opens an index created by IndexWriter. Only the fanout table is
+// read in memory, the hashes are read from disk for every query.
+func OpenIndex(path string) (*Index, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open breach index: %w", err)
+	}
+
+	index := &Index{file: file}
+	if err := index.readHeader(); err != nil {
+		return nil, errors.Join(err, file.Close())
+	}
+	return index, nil
+}
+
+func (index *Index) readHeader() error {
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(index.file, header); err != nil {
+		return fmt.Errorf("%w: could not read header: %w", ErrInvalidIndex, err)
+	}
+	if string(header[:len(indexMagic)]) != indexMagic {
+		return fmt.Errorf("%w: bad magic", ErrInvalidIndex)
+	}
+	header = header[len(indexMagic):]
+	index.total = binary.BigEndian.Uint64(header)
+	header = header[8:]
+	for i := range index.fanout {
+		index.fanout[i] = binary.BigEndian.Uint64(header[i*8:])
+	}
+	if index.fanout[fanoutSize-1] != index.total {
+		return fmt.Errorf("%w: fanout does not match the number of hashes", ErrInvalidIndex)
+	}
+
+	stat, err := index.file.Stat()
+	if err != nil {
+		return fmt.Errorf("could not stat breach index: %w", err)
+	}
+	if stat.Size() != int64(headerSize)+int64(index.total)*recordSize {
+		return fmt.Errorf("%w: expected %d hashes", ErrInvalidIndex, index.total)
+	}
+	return nil
+}
+
+func (index *Index) Close() error {
+	return index.file.Close()
+}
+
+// Len returns the number of hashes in the index.
+func (index *Index) Len() int64 {
+	return int64(index.total)
+}
+
+// bucket reads the hashes that start with the two bytes of the fanout.
+func (index *Index) bucket(first uint16) ([]byte, error) {
+	var start uint64
+	if first > 0 {
+		start = index.fanout[first-1]
+	}
+	end := index.fanout[first]
+	records := make([]byte, (end-start)*recordSize)
+	if _, err := index.file.ReadAt(records, int64(headerSize)+int64(start)*recordSize); err != nil {
+		return nil, fmt.Errorf("could not read breach index: %w", err)
+	}
+	return records, nil
+}
+
+// Count returns how many times the password appears in the corpus.
+func (index *Index) Count(_ context.Context, password string) (int64, error) {
+	hash := sha1.Sum([]byte(password))
+	records, err := index.bucket(binary.BigEndian.Uint16(hash[:fanoutBytes]))
+	if err != nil {
+		return 0, err
+	}
+	suffix := string(hash[fanoutBytes:])
+	n := len(records) / recordSize
+	i := sort.Search(n, func(i int) bool {
+		return string(records[i*recordSize:i*recordSize+suffixBytes]) >= suffix
+	})
+	if i < n && string(records[i*recordSize:i*recordSize+suffixBytes]) == suffix {
+		return int64(binary.BigEndian.Uint32(records[i*recordSize+suffixBytes:])), nil
+	}
+	return 0, nil
+}
+
+// Range returns the hashes that start with the given five hex characters.
+func (index *Index) Range(_ context.Context, prefix string) ([]RangeEntry, error) {
+	decoded, err := hex.DecodeString(prefix + "0")
+	if len(prefix) != PrefixLength || err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidPrefix, prefix)
+	}
+	records, err := index.bucket(binary.BigEndian.Uint16(decoded))
+	if err != nil {
+		return nil, err
+	}
+
+	// The fifth character is the high nibble of the first byte of the suffix.
+	nibble := decoded[fanoutBytes] >> 4
+	entries := []RangeEntry{}
+	for i := 0; i < len(records); i += recordSize {
+		if records[i]>>4 != nibble {
+			continue
+		}
+		suffix := strings.ToUpper(hex.EncodeToString(records[i : i+suffixBytes]))
+		entries = append(entries, RangeEntry{
+			Suffix: suffix[1:],
+			Count:  int64(binary.BigEndian.Uint32(records[i+suffixBytes:])),
+		})
+	}
+	return entries, nil
+}
+
+// IndexWriter creates an index from hashes added in ascending order. The
+// index is written next to its final path and only replaces it on Close.
+type IndexWriter struct {
+	path   string
+	file   *os.File
+	writer *bufio.Writer
+
+	total   uint64
+	fanout  [fanoutSize]uint64
+	last    [sha1.Size]byte
+	hasLast bool
+}
+
+// CreateIndex starts writing a new index to path.
+func CreateIndex(path string) (*IndexWriter, error) {
+	file, err := os.CreateTemp(filepath.Dir(path), ".breach-index-*")
+	if err != nil {
+		return nil, fmt.Errorf("could not create breach index: %w", err)
+	}
+	if _, err := file.Seek(int64(headerSize), io.SeekStart); err != nil {
+		return nil, errors.Join(fmt.Errorf("could not create breach index: %w", err), file.Close(), os.Remove(file.Name()))
+	}
+	return &IndexWriter{
+		path:   path,
+		file:   file,
+		writer: bufio.NewWriterSize(file, 1024*1024),
+	}, nil
+}
+
+// Len returns the number of hashes added so far.
+func (w *IndexWriter) Len() int64 {
+	return int64(w.total)
+}
+
+// Add appends a hash to the index. Hashes must be added in ascending order,
+// without duplicates. Counts above the maximum of 32 bits are clamped.
+func (w *IndexWriter) Add(hash [sha1.Size]byte, count int64) error {
+	if w.hasLast && string(hash[:]) <= string(w.last[:]) {
+		return fmt.Errorf("%w: %X after %X", ErrUnsorted, hash, w.last)
+	}
+	w.last, w.hasLast = hash, true
+
+	var record [recordSize]byte
+	copy(record[:], hash[fanoutBytes:])
+	binary.BigEndian.PutUint32(record[suffixBytes:], uint32(min(max(count, 0), math.MaxUint32)))
+	if _, err := w.writer.Write(record[:]); err != nil {
+		return fmt.Errorf("could not write breach index: %w", err)
+	}
+
+	w.total++
+	w.fanout[binary.BigEndian.Uint16(hash[:fanoutBytes])]++
+	return nil
+}
+
+// Close writes the header and moves the index to its path.
+func (w *IndexWriter) Close() error {
+	if err := w.writer.Flush(); err != nil {
+		return errors.Join(fmt.Errorf("could not write breach index: %w", err), w.Abort())
+	}
+
+	header := make([]byte, 0, headerSize)
+	header = append(header, indexMagic...)
+	header = binary.BigEndian.AppendUint64(header, w.total)
+	var cumulative uint64
+	for _, count := range w.fanout {
+		cumulative += count
+		header = binary.BigEndian.AppendUint64(header, cumulative)
+	}
+	if _, err := w.file.WriteAt(header, 0); err != nil {
+		return errors.Join(fmt.Errorf("could not write breach index header: %w", err), w.Abort())
+	}
+
+	if err := w.file.Close(); err != nil {
+		return errors.Join(fmt.Errorf("could not close breach index: %w", err), os.Remove(w.file.Name()))
+	}
+	if err := os.Rename(w.file.Name(), w.path); err != nil {
+		return errors.Join(fmt.Errorf("could not move breach index: %w", err), os.Remove(w.file.Name()))
+	}
+	return nil
+}
+
+// Abort removes the partially written index.
+func (w *IndexWriter) Abort() error {
+	return errors.Join(w.file.Close(), os.Remove(w.file.Name()))
+}
@@ -0,0 +1,146 @@
+package breach
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func buildIndex(t *testing.T, sources ...string) *Index {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "breach.idx")
+	w, err := CreateIndex(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, source := range sources {
+		if _, err := ImportPath(w, source); err != nil {
+			t.Fatalf("ImportPath(%s) error = %v", source, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	index, err := OpenIndex(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { index.Close() })
+	return index
+}
+
+func TestIndex_Count(t *testing.T) {
+	index := buildIndex(t, "testdata/ranges")
+	if index.Len() != 5 {
+		t.Errorf("Len() = %d, want 5", index.Len())
+	}
+
+	tests := []struct {
+		password string
+		want     int64
+	}{
+		{"password", 9659365},
+		{"123456", 37359195},
+		{"qwerty", 3946737},
+		{"letmein", 0},
+		{"correct horse battery staple", 0},
+	}
+	for _, tt := range tests {
+		got, err := index.Count(context.Background(), tt.password)
+		if err != nil || got != tt.want {
+			t.Errorf("Count(%q) = %d, %v, want %d", tt.password, got, err, tt.want)
+		}
+	}
+}
+
+func TestIndex_Range(t *testing.T) {
+	index := buildIndex(t, "testdata/ranges")
+
+	tests := []struct {
+		prefix  string
+		want    []RangeEntry
+		wantErr error
+	}{
+		{"5BAA6", []RangeEntry{
+			{"0018A45C4D1DEF81644B54AB7F969B88D65", 1},
+			{"1E4C9B93F3F0682250B6CF8331B7EE68FD8", 9659365},
+			{"FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF", 3},
+		}, nil},
+		{"b1b37", []RangeEntry{{"73A05C0ED0176787A4F1574FF0075F7521E", 3946737}}, nil},
+		{"5BAA7", []RangeEntry{}, nil},
+		{"5BAA", nil, ErrInvalidPrefix},
+		{"5BAAG", nil, ErrInvalidPrefix},
+	}
+	for _, tt := range tests {
+		got, err := index.Range(context.Background(), tt.prefix)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("Range(%s) error = %v, want %v", tt.prefix, err, tt.wantErr)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("Range(%s) = %v, want %v", tt.prefix, got, tt.want)
+		}
+	}
+}
+
+func TestRangeChecker(t *testing.T) {
+	checker := NewRangeChecker(buildIndex(t, "testdata/ordered.txt"))
+	for password, want := range map[string]int64{"dragon": 968625, "hunter2": 33, "password": 0} {
+		got, err := checker.Count(context.Background(), password)
+		if err != nil || got != want {
+			t.Errorf("Count(%q) = %d, %v, want %d", password, got, err, want)
+		}
+	}
+}
+
+func TestImportPath(t *testing.T) {
+	dir := t.TempDir()
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	gz.Write([]byte("D09CA3762AF61E59520943DC26494F8941B:37359195\n"))
+	gz.Close()
+	if err := os.WriteFile(filepath.Join(dir, "7C4A8.txt.gz"), compressed.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	index := buildIndex(t, dir)
+	if got, err := index.Count(context.Background(), "123456"); err != nil || got != 37359195 {
+		t.Errorf("Count(123456) = %d, %v, want 37359195", got, err)
+	}
+
+	// The ordered file starts before the last range file.
+	w, err := CreateIndex(filepath.Join(t.TempDir(), "breach.idx"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Abort()
+	if _, err := ImportPath(w, "testdata/ranges"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ImportPath(w, "testdata/ordered.txt"); !errors.Is(err, ErrUnsorted) {
+		t.Errorf("ImportPath() error = %v, want %v", err, ErrUnsorted)
+	}
+
+	bad := filepath.Join(dir, "bad.txt")
+	if err := os.WriteFile(bad, []byte("5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ImportPath(w, bad); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("ImportPath() error = %v, want %v", err, ErrInvalidRange)
+	}
+}
+
+func TestOpenIndex_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "breach.idx")
+	if err := os.WriteFile(path, []byte("5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8:1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := OpenIndex(path); !errors.Is(err, ErrInvalidIndex) {
+		t.Errorf("OpenIndex() error = %v, want %v", err, ErrInvalidIndex)
+	}
+}
@@ -0,0 +1,60 @@
+package breach
+
+import (
+	"context"
+	"sync"
+)
+
+// RangeQuerier returns the hashes of the corpus that start with a prefix of
+// PrefixLength hex characters. It is implemented by the worker, which asks
+// the server instead of keeping its own copy of the index.
+type RangeQuerier interface {
+	GetBreachedPasswordRange(ctx context.Context, prefix string) ([]RangeEntry, error)
+}
+
+// rangeChecker looks up passwords by the prefix of their hash, so that only
+// the prefix leaves the process. Ranges are cached, since a bruteforce run
+// checks the same passwords for many users.
+type rangeChecker struct {
+	querier RangeQuerier
+
+	lock   sync.Mutex
+	ranges map[string]map[string]int64
+}
+
+var _ Checker = (*rangeChecker)(nil)
+
+func NewRangeChecker(querier RangeQuerier) *rangeChecker {
+	return &rangeChecker{
+		querier: querier,
+		ranges:  map[string]map[string]int64{},
+	}
+}
+
+func (c *rangeChecker) Count(ctx context.Context, password string) (int64, error) {
+	hash := HashPassword(password)
+	prefix, suffix := hash[:PrefixLength], hash[PrefixLength:]
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	counts, ok := c.ranges[prefix]
+	if !ok {
+		entries, err := c.querier.GetBreachedPasswordRange(ctx, prefix)
+		if err != nil {
+			return 0, err
+		}
+		counts = make(map[string]int64, len(entries))
+		for _, entry := range entries {
+			counts[entry.Suffix] = entry.Count
+		}
+		c.ranges[prefix] = counts
+	}
+	return counts[suffix], nil
+}
+
+var _ RangeQuerier = (*Index)(nil)
+
+func (index *Index) GetBreachedPasswordRange(ctx context.Context, prefix string) ([]RangeEntry, error) {
+	return index.Range(ctx, prefix)
+}
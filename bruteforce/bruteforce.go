@@ -8,6 +8,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/tedyst/licenta/breach"
 	"github.com/tedyst/licenta/scanner"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -15,16 +17,73 @@ import (
 )
 
 type bruteforceResult struct {
-	user     string
-	password string
+	user       string
+	password   string
+	privileged bool
+	// source is where the password was found before, if it was reused.
+	source string
+	// breachCount is how many times the password appears in the breach
+	// corpus.
+	breachCount int64
 }
 
-func (*bruteforceResult) Severity() scanner.Severity {
-	return scanner.SEVERITY_HIGH
+// Severity ranks cracked passwords of privileged users and passwords from
+// the breach corpus above the ones of users that can not change the database
+// configuration or other users.
+func (b *bruteforceResult) Severity() scanner.Severity {
+	if b.privileged || b.breachCount > 0 {
+		return scanner.SEVERITY_HIGH
+	}
+	return scanner.SEVERITY_MEDIUM
 }
 
 func (b *bruteforceResult) Detail() string {
-	return "Found password for user " + b.user + " using bruteforce. Discovered password: " + b.password
+	detail := "Found password for user " + b.user + " using bruteforce. Discovered password: " + b.password
+	if b.privileged {
+		detail = "Found password for privileged user " + b.user + " using bruteforce. Discovered password: " + b.password
+	}
+	if b.source != "" {
+		detail += " (password reused from " + b.source + ")"
+	}
+	return detail + b.breachAnnotation()
+}
+
+// breachAnnotation returns the sentence added to the description of a found
+// password that is in the breach corpus.
+func (b *bruteforceResult) breachAnnotation() string {
+	if b.breachCount == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" The password appears %d times in the breach corpus.", b.breachCount)
+}
+
+func (b *bruteforceResult) Finding() scanner.Finding {
+	title := "Weak password for user " + b.user
+	if b.privileged {
+		title = "Weak password for privileged user " + b.user
+	}
+	if b.source != "" {
+		title = "Reused password for user " + b.user
+		if b.privileged {
+			title = "Reused password for privileged user " + b.user
+		}
+		return scanner.Finding{
+			RuleID:         "bruteforce-reused-password",
+			Title:          title,
+			Description:    "The password of the user was reused from " + b.source + "." + b.breachAnnotation(),
+			Remediation:    "Change the password to a long, randomly generated one that is not used anywhere else and remove the leaked secret.",
+			AffectedObject: scanner.AffectedObject{Type: scanner.OBJECT_USER, Name: b.user},
+			Evidence:       b.password,
+		}
+	}
+	return scanner.Finding{
+		RuleID:         "bruteforce-weak-password",
+		Title:          title,
+		Description:    "The password of the user was found using bruteforce." + b.breachAnnotation(),
+		Remediation:    "Change the password to a long, randomly generated one.",
+		AffectedObject: scanner.AffectedObject{Type: scanner.OBJECT_USER, Name: b.user},
+		Evidence:       b.password,
+	}
 }
 
 var _ scanner.ScanResult = (*bruteforceResult)(nil)
@@ -42,6 +101,14 @@ type bruteforcer struct {
 	passwordProvider PasswordProvider
 	scanner          scanner.Scanner
 	updateStatus     func(ctx context.Context) error
+	breach           breach.Checker
+	concurrency      int64
+
+	// The shards of the candidates of a user are run by other workers if
+	// shards is set. See WithShards.
+	shards    ShardQuerier
+	shardSize int64
+	scanID    int64
 
 	status     map[scanner.User]BruteforceUserStatus
 	statusLock sync.Mutex
@@ -51,12 +118,41 @@ type bruteforcer struct {
 	results []scanner.ScanResult
 }
 
-func NewBruteforcer(passwordProvider PasswordProvider, sc scanner.Scanner, statusFunc StatusFunc) *bruteforcer {
+// defaultConcurrency is the number of passwords verified at the same time
+// for a user if WithConcurrency is not used.
+const defaultConcurrency = 10
+
+type Option func(*bruteforcer)
+
+// WithConcurrency sets how many passwords are verified at the same time.
+// Values lower than 1 keep the default.
+func WithConcurrency(concurrency int) Option {
+	return func(br *bruteforcer) {
+		if concurrency > 0 {
+			br.concurrency = int64(concurrency)
+		}
+	}
+}
+
+// WithBreachChecker checks the found passwords against a breach corpus. The
+// plaintext passwords returned by GetRawPassword are reported if they are in
+// the corpus, even if they are not in the wordlist.
+func WithBreachChecker(checker breach.Checker) Option {
+	return func(br *bruteforcer) {
+		br.breach = checker
+	}
+}
+
+func NewBruteforcer(passwordProvider PasswordProvider, sc scanner.Scanner, statusFunc StatusFunc, opts ...Option) *bruteforcer {
 	br := &bruteforcer{
 		passwordProvider: passwordProvider,
 		scanner:          sc,
 		status:           map[scanner.User]BruteforceUserStatus{},
 		statusLock:       sync.Mutex{},
+		concurrency:      defaultConcurrency,
+	}
+	for _, opt := range opts {
+		opt(br)
 	}
 	br.updateStatus = func(ctx context.Context) error {
 		br.statusLock.Lock()
@@ -105,13 +201,16 @@ func (br *bruteforcer) savePasswordHash(_ context.Context, user scanner.User, pa
 		return fmt.Errorf("could not get username: %w", err)
 	}
 	hash, err := user.GetHashedPassword()
+	if errors.Is(err, scanner.ErrPasswordHashUnavailable) {
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("could not get hashed password: %w", err)
 	}
 	if hash == "" {
 		return nil
 	}
-	return br.passwordProvider.SavePasswordHash(username, hash, password, br.status[user].MaximumInternalID)
+	return br.passwordProvider.SavePasswordHash(username, hash, password, br.status[user].MaximumInternalID, providerFingerprint(br.passwordProvider))
 }
 
 func (br *bruteforcer) BruteforcePasswordAllUsers(ctx context.Context) ([]scanner.ScanResult, error) {
@@ -139,9 +238,20 @@ func (br *bruteforcer) BruteforcePasswordAllUsers(ctx context.Context) ([]scanne
 			return nil, fmt.Errorf("could not get username: %w", err)
 		}
 		if pass != "" {
+			privileged, err := user.IsPrivileged()
+			if err != nil {
+				return nil, fmt.Errorf("could not check if user is privileged: %w", err)
+			}
+			breachCount, err := br.breachCount(ctx, pass)
+			if err != nil {
+				return nil, fmt.Errorf("could not check breach corpus: %w", err)
+			}
 			br.results = append(br.results, &bruteforceResult{
-				user:     username,
-				password: pass,
+				user:        username,
+				password:    pass,
+				privileged:  privileged,
+				source:      br.passwordSource(user, pass),
+				breachCount: breachCount,
 			})
 		}
 	}
@@ -149,6 +259,39 @@ func (br *bruteforcer) BruteforcePasswordAllUsers(ctx context.Context) ([]scanne
 	return br.results, nil
 }
 
+// breachCount returns how many times the password appears in the breach
+// corpus. A server without a corpus is treated like an empty one.
+func (br *bruteforcer) breachCount(ctx context.Context, password string) (int64, error) {
+	if br.breach == nil {
+		return 0, nil
+	}
+	count, err := br.breach.Count(ctx, password)
+	if errors.Is(err, pgx.ErrNoRows) {
+		slog.DebugContext(ctx, "Breach corpus is not available")
+		br.breach = nil
+		return 0, nil
+	}
+	return count, err
+}
+
+// passwordSource returns where the password found for user was seen before,
+// or an empty string if the provider does not know.
+func (br *bruteforcer) passwordSource(user scanner.User, password string) string {
+	sourced, ok := br.passwordProvider.(sourcedPasswordProvider)
+	if !ok {
+		return ""
+	}
+	br.statusLock.Lock()
+	internalID := br.status[user].MaximumInternalID
+	br.statusLock.Unlock()
+
+	candidate, source, ok := sourced.Source(internalID)
+	if !ok || candidate != password {
+		return ""
+	}
+	return source
+}
+
 func (br *bruteforcer) markStatusAsSolved(_ context.Context, user scanner.User, password string, internalID int64) error {
 	br.statusLock.Lock()
 	defer br.statusLock.Unlock()
@@ -178,6 +321,21 @@ func (br *bruteforcer) markIncreaseTried(_ context.Context, user scanner.User, i
 	return nil
 }
 
+func (br *bruteforcer) markSkipped(_ context.Context, user scanner.User, skipped int64) error {
+	if skipped == 0 {
+		return nil
+	}
+	br.statusLock.Lock()
+	defer br.statusLock.Unlock()
+	entry, ok := br.status[user]
+	if !ok {
+		return errors.New("user not found")
+	}
+	entry.Tried += skipped
+	br.status[user] = entry
+	return nil
+}
+
 func (br *bruteforcer) markStatusAsUnsolved(_ context.Context, user scanner.User) error {
 	br.statusLock.Lock()
 	defer br.statusLock.Unlock()
@@ -223,6 +381,19 @@ func (br *bruteforcer) tryPlaintextPassword(ctx context.Context, user scanner.Us
 			return pass, nil
 		}
 
+		// The password is known, so it is reported if it was breached even
+		// though it is not in the wordlist.
+		count, err := br.breachCount(ctx, pass)
+		if err != nil {
+			return "", fmt.Errorf("could not check breach corpus: %w", err)
+		}
+		if count > 0 {
+			if err := br.markStatusAsSolved(ctx, user, pass, 0); err != nil {
+				return "", err
+			}
+			return pass, nil
+		}
+
 		return "", nil
 	}
 
@@ -253,6 +424,15 @@ func (br *bruteforcer) bruteforcePasswordsUser(
 		err = br.updateStatus(ctx)
 	}()
 
+	hasPassword, err := u.HasPassword()
+	if err != nil {
+		return "", err
+	}
+	if !hasPassword {
+		slog.DebugContext(ctx, "User has no password, skipping", "user", username)
+		return "", nil
+	}
+
 	pass, err := br.tryPlaintextPassword(ctx, u)
 	if err != nil {
 		return "", err
@@ -262,6 +442,10 @@ func (br *bruteforcer) bruteforcePasswordsUser(
 	}
 
 	hash, err := u.GetHashedPassword()
+	if errors.Is(err, scanner.ErrPasswordHashUnavailable) {
+		slog.DebugContext(ctx, "Password hash not available, skipping", "user", username)
+		return "", nil
+	}
 	if err != nil {
 		return "", err
 	}
@@ -269,7 +453,7 @@ func (br *bruteforcer) bruteforcePasswordsUser(
 	var startBruteforceID int64 = 0
 
 	if hash != "" {
-		alreadySolved, lastID, err := br.passwordProvider.GetPasswordByHash(username, hash)
+		alreadySolved, lastID, err := br.passwordProvider.GetPasswordByHash(username, hash, providerFingerprint(br.passwordProvider))
 		if err != nil {
 			return "", err
 		}
@@ -293,34 +477,58 @@ func (br *bruteforcer) bruteforcePasswordsUser(
 		return "", err
 	}
 
+	if br.shouldShard(u, hash, startBruteforceID) {
+		return br.bruteforceSharded(ctx, u, username, hash, startBruteforceID)
+	}
+
 	err = br.passwordProvider.Start(startBruteforceID)
 	if err != nil {
 		return "", err
 	}
 	defer br.passwordProvider.Close()
 
+	pass, internalID, err := br.tryPasswords(ctx, u, br.passwordProvider)
+	if err != nil {
+		return "", errors.Join(err, br.markStatusAsUnsolved(ctx, u))
+	}
+	if pass != "" {
+		return pass, br.markStatusAsSolved(ctx, u, pass, internalID)
+	}
+	return "", br.markStatusAsUnsolved(ctx, u)
+}
+
+// tryPasswords verifies the candidates of provider, which must already be
+// started, with at most br.concurrency of them at the same time. It returns
+// an empty password if none of them matched.
+func (br *bruteforcer) tryPasswords(ctx context.Context, u scanner.User, provider PasswordProvider) (string, int64, error) {
 	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
 	errorChan := make(chan error, 1)
 	resultChan := make(chan struct {
 		password   string
 		internalID int64
 	}, 1)
 
-	sm := semaphore.NewWeighted(10)
+	sm := semaphore.NewWeighted(br.concurrency)
 	wg := sync.WaitGroup{}
 
-	for br.passwordProvider.Next() {
-		if err := br.passwordProvider.Error(); err != nil {
-			return "", err
+	for provider.Next() {
+		if err := provider.Error(); err != nil {
+			return "", 0, err
 		}
-		internalID, pass, err := br.passwordProvider.Current()
+		internalID, pass, err := provider.Current()
 		if err != nil {
-			return "", err
+			return "", 0, err
+		}
+		if skipping, ok := provider.(skippingPasswordProvider); ok {
+			if err := br.markSkipped(ctx, u, skipping.Skipped()); err != nil {
+				return "", 0, err
+			}
 		}
 
 		err = sm.Acquire(ctx, 1)
 		if err != nil {
-			return "", err
+			return "", 0, err
 		}
 		wg.Add(1)
 		go func() {
@@ -335,10 +543,6 @@ func (br *bruteforcer) bruteforcePasswordsUser(
 			passwordsTried.Add(ctx, 1)
 
 			if ok {
-				err = br.markStatusAsSolved(ctx, u, pass, internalID)
-				if err != nil {
-					errorChan <- err
-				}
 				resultChan <- struct {
 					password   string
 					internalID int64
@@ -356,30 +560,27 @@ func (br *bruteforcer) bruteforcePasswordsUser(
 		case <-ticker.C:
 			err = br.updateStatus(ctx)
 			if err != nil {
-				return "", err
+				return "", 0, err
 			}
 		case err := <-errorChan:
-			return "", err
+			return "", 0, err
 		case pass := <-resultChan:
-			return pass.password, nil
+			return pass.password, pass.internalID, nil
 		default:
 		}
 	}
-	if err := br.passwordProvider.Error(); err != nil {
-		return "", err
+	if err := provider.Error(); err != nil {
+		return "", 0, err
 	}
 
 	wg.Wait()
 
 	select {
 	case err := <-errorChan:
-		err2 := br.markStatusAsUnsolved(ctx, u)
-		return "", errors.Join(err, err2)
+		return "", 0, err
 	case pass := <-resultChan:
-		err := br.markStatusAsSolved(ctx, u, pass.password, pass.internalID)
-		return pass.password, err
+		return pass.password, pass.internalID, nil
 	default:
-		err2 := br.markStatusAsUnsolved(ctx, u)
-		return "", errors.Join(err, err2)
+		return "", 0, nil
 	}
 }
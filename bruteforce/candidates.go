@@ -0,0 +1,280 @@
+package bruteforce
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tedyst/licenta/db/queries"
+)
+
+// PasswordCandidatesQuerier is implemented by databases that can list the
+// secrets and names already known for a project. It is optional, like
+// PasswordRulesQuerier.
+type PasswordCandidatesQuerier interface {
+	GetProjectPasswordCandidates(ctx context.Context, projectID int64) ([]*queries.GetProjectPasswordCandidatesRow, error)
+}
+
+// sourcedPasswordProvider is implemented by providers that know where a
+// candidate comes from, so that a found password can be attributed to it.
+type sourcedPasswordProvider interface {
+	// Source returns the candidate with the given internal ID and a
+	// description of where it was found.
+	Source(internalID int64) (candidate string, source string, ok bool)
+}
+
+type passwordCandidate struct {
+	password string
+	source   string
+}
+
+// describeCandidate returns where a candidate was found, for example
+// "git commit 1a2b3c (config/database.yml)".
+func describeCandidate(row *queries.GetProjectPasswordCandidatesRow) string {
+	switch row.Source {
+	case "git":
+		return fmt.Sprintf("git commit %s (%s)", row.Reference, row.Location)
+	case "docker":
+		return fmt.Sprintf("docker layer %s (%s)", row.Reference, row.Location)
+	case "git-username":
+		return fmt.Sprintf("a username in git commit %s (%s)", row.Reference, row.Location)
+	case "docker-username":
+		return fmt.Sprintf("a username in docker layer %s (%s)", row.Reference, row.Location)
+	case "database":
+		return fmt.Sprintf("the name of a %s database on %s", row.Location, row.Reference)
+	case "project":
+		return "the project name"
+	case "organization":
+		return "the organization name"
+	default:
+		return row.Source + " " + row.Reference
+	}
+}
+
+// candidatePasswordProvider tries a fixed list of candidates, for example
+// the passwords that were already found in the repositories and images of a
+// project. It does not store bruteforced hashes, so it is meant to be
+// chained before a provider that does.
+type candidatePasswordProvider struct {
+	candidates []passwordCandidate
+	index      int
+}
+
+var _ PasswordProvider = (*candidatePasswordProvider)(nil)
+var _ sourcedPasswordProvider = (*candidatePasswordProvider)(nil)
+
+func newCandidatePasswordProvider(candidates []passwordCandidate) *candidatePasswordProvider {
+	seen := map[string]struct{}{}
+	unique := []passwordCandidate{}
+	for _, candidate := range candidates {
+		if _, ok := seen[candidate.password]; ok || candidate.password == "" {
+			continue
+		}
+		seen[candidate.password] = struct{}{}
+		unique = append(unique, candidate)
+	}
+	return &candidatePasswordProvider{
+		candidates: unique,
+	}
+}
+
+// NewProjectPasswordProvider returns the passwords, usernames and names
+// already known for a project, most likely first. A candidate that was found
+// in several places is attributed to the first one.
+func NewProjectPasswordProvider(ctx context.Context, database PasswordCandidatesQuerier, projectID int64) (*candidatePasswordProvider, error) {
+	rows, err := database.GetProjectPasswordCandidates(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get project password candidates: %w", err)
+	}
+	candidates := make([]passwordCandidate, len(rows))
+	for i, row := range rows {
+		candidates[i] = passwordCandidate{
+			password: row.Candidate,
+			source:   describeCandidate(row),
+		}
+	}
+	return newCandidatePasswordProvider(candidates), nil
+}
+
+func (p *candidatePasswordProvider) GetCount() (int64, error) {
+	return int64(len(p.candidates)), nil
+}
+
+func (p *candidatePasswordProvider) GetSpecificPassword(password string) (int64, bool, error) {
+	for i, candidate := range p.candidates {
+		if candidate.password == password {
+			return int64(i), true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+func (p *candidatePasswordProvider) Next() bool {
+	if p.index >= len(p.candidates) {
+		return false
+	}
+	p.index++
+	return true
+}
+
+func (p *candidatePasswordProvider) Error() error {
+	return nil
+}
+
+func (p *candidatePasswordProvider) Current() (int64, string, error) {
+	return int64(p.index - 1), p.candidates[p.index-1].password, nil
+}
+
+func (p *candidatePasswordProvider) Start(index int64) error {
+	p.index = int(max(0, min(index, int64(len(p.candidates)))))
+	return nil
+}
+
+func (p *candidatePasswordProvider) Close() {
+
+}
+
+func (p *candidatePasswordProvider) SavePasswordHash(username, hash, password string, maxInternalID int64, fingerprint string) error {
+	return nil
+}
+
+func (p *candidatePasswordProvider) GetPasswordByHash(username, hash, fingerprint string) (string, int64, error) {
+	return "", 0, nil
+}
+
+func (p *candidatePasswordProvider) Source(internalID int64) (string, string, bool) {
+	if internalID < 0 || internalID >= int64(len(p.candidates)) {
+		return "", "", false
+	}
+	candidate := p.candidates[internalID]
+	return candidate.password, candidate.source, true
+}
+
+// chainedPasswordProvider tries every candidate of first and then the ones
+// of second. The candidates of first get negative internal IDs, -1 for the
+// first one, so that the IDs of second and the resume position stored for
+// them do not change when the number of candidates of first changes.
+// Because of that, first is always tried from the beginning.
+type chainedPasswordProvider struct {
+	first  PasswordProvider
+	second PasswordProvider
+
+	inFirst bool
+}
+
+var _ PasswordProvider = (*chainedPasswordProvider)(nil)
+var _ skippingPasswordProvider = (*chainedPasswordProvider)(nil)
+var _ sourcedPasswordProvider = (*chainedPasswordProvider)(nil)
+var _ fingerprintedPasswordProvider = (*chainedPasswordProvider)(nil)
+
+func NewChainedPasswordProvider(first PasswordProvider, second PasswordProvider) *chainedPasswordProvider {
+	return &chainedPasswordProvider{
+		first:   first,
+		second:  second,
+		inFirst: true,
+	}
+}
+
+func chainedID(firstID int64) int64 {
+	return -1 - firstID
+}
+
+func (p *chainedPasswordProvider) GetCount() (int64, error) {
+	first, err := p.first.GetCount()
+	if err != nil {
+		return 0, err
+	}
+	second, err := p.second.GetCount()
+	if err != nil {
+		return 0, err
+	}
+	return first + second, nil
+}
+
+func (p *chainedPasswordProvider) GetSpecificPassword(password string) (int64, bool, error) {
+	id, ok, err := p.first.GetSpecificPassword(password)
+	if err != nil {
+		return 0, false, err
+	}
+	if ok {
+		return chainedID(id), true, nil
+	}
+	return p.second.GetSpecificPassword(password)
+}
+
+func (p *chainedPasswordProvider) Next() bool {
+	if p.inFirst {
+		if p.first.Next() {
+			return true
+		}
+		if p.first.Error() != nil {
+			return false
+		}
+		p.inFirst = false
+	}
+	return p.second.Next()
+}
+
+func (p *chainedPasswordProvider) Error() error {
+	if p.inFirst {
+		return p.first.Error()
+	}
+	return p.second.Error()
+}
+
+func (p *chainedPasswordProvider) Current() (int64, string, error) {
+	if !p.inFirst {
+		return p.second.Current()
+	}
+	id, password, err := p.first.Current()
+	return chainedID(id), password, err
+}
+
+// Start resumes second from index. The candidates of first are tried again.
+func (p *chainedPasswordProvider) Start(index int64) error {
+	p.inFirst = true
+	if err := p.first.Start(0); err != nil {
+		return err
+	}
+	return p.second.Start(max(index, 0))
+}
+
+func (p *chainedPasswordProvider) Skipped() int64 {
+	var skipped int64
+	for _, provider := range []PasswordProvider{p.first, p.second} {
+		if skipping, ok := provider.(skippingPasswordProvider); ok {
+			skipped += skipping.Skipped()
+		}
+	}
+	return skipped
+}
+
+func (p *chainedPasswordProvider) Source(internalID int64) (string, string, bool) {
+	provider := p.second
+	if internalID < 0 {
+		provider, internalID = p.first, chainedID(internalID)
+	}
+	sourced, ok := provider.(sourcedPasswordProvider)
+	if !ok {
+		return "", "", false
+	}
+	return sourced.Source(internalID)
+}
+
+func (p *chainedPasswordProvider) Close() {
+	p.first.Close()
+	p.second.Close()
+}
+
+// Fingerprint is the one of second, since the stored position is only used
+// for second.
+func (p *chainedPasswordProvider) Fingerprint() string {
+	return providerFingerprint(p.second)
+}
+
+func (p *chainedPasswordProvider) SavePasswordHash(username, hash, password string, maxInternalID int64, fingerprint string) error {
+	return p.second.SavePasswordHash(username, hash, password, maxInternalID, fingerprint)
+}
+
+func (p *chainedPasswordProvider) GetPasswordByHash(username, hash, fingerprint string) (string, int64, error) {
+	return p.second.GetPasswordByHash(username, hash, fingerprint)
+}
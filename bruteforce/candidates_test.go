@@ -0,0 +1,102 @@
+package bruteforce
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/tedyst/licenta/db/queries"
+)
+
+type fakeCandidatesQuerier []*queries.GetProjectPasswordCandidatesRow
+
+func (q fakeCandidatesQuerier) GetProjectPasswordCandidates(ctx context.Context, projectID int64) ([]*queries.GetProjectPasswordCandidatesRow, error) {
+	return q, nil
+}
+
+func newTestProjectProvider(t *testing.T) *candidatePasswordProvider {
+	t.Helper()
+	p, err := NewProjectPasswordProvider(context.Background(), fakeCandidatesQuerier{
+		{Candidate: "s3cret", Source: "git", Reference: "1a2b3c", Location: "config.yml", Priority: 1},
+		{Candidate: "s3cret", Source: "docker", Reference: "sha256:abc", Location: "/app/.env", Priority: 1},
+		{Candidate: "hunter2", Source: "docker", Reference: "sha256:abc", Location: "/app/.env", Priority: 1},
+		{Candidate: "acme", Source: "project", Reference: "acme", Priority: 4},
+	}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestChainedPasswordProvider(t *testing.T) {
+	p := NewChainedPasswordProvider(newTestProjectProvider(t), NewPasswordListIterator([]string{"password", "hunter2"}))
+
+	count, err := p.GetCount()
+	if err != nil || count != 5 {
+		t.Fatalf("GetCount() = %d, %v, want 5", count, err)
+	}
+
+	project := []candidate{{-1, "s3cret"}, {-2, "hunter2"}, {-3, "acme"}}
+	tests := []struct {
+		start int64
+		want  []candidate
+	}{
+		{0, append(slices.Clone(project), candidate{0, "password"}, candidate{1, "hunter2"})},
+		{1, append(slices.Clone(project), candidate{1, "hunter2"})},
+	}
+	for _, tt := range tests {
+		if err := p.Start(tt.start); err != nil {
+			t.Fatal(err)
+		}
+		var got []candidate
+		for p.Next() {
+			id, password, err := p.Current()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, candidate{id, password})
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("candidates after Start(%d) = %v, want %v", tt.start, got, tt.want)
+		}
+	}
+
+	id, ok, err := p.GetSpecificPassword("hunter2")
+	if err != nil || !ok || id != -2 {
+		t.Errorf("GetSpecificPassword(hunter2) = %d, %v, %v, want -2", id, ok, err)
+	}
+	id, ok, err = p.GetSpecificPassword("password")
+	if err != nil || !ok || id != 0 {
+		t.Errorf("GetSpecificPassword(password) = %d, %v, %v, want 0", id, ok, err)
+	}
+}
+
+func TestChainedPasswordProvider_Source(t *testing.T) {
+	rules, err := ParseRules(":\nc $1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := NewChainedPasswordProvider(
+		NewMutationPasswordProvider(newTestProjectProvider(t), rules),
+		NewPasswordListIterator([]string{"password"}),
+	)
+
+	tests := []struct {
+		id        int64
+		candidate string
+		source    string
+		ok        bool
+	}{
+		{-1, "s3cret", "git commit 1a2b3c (config.yml)", true},
+		{-4, "Hunter21", `docker layer sha256:abc (/app/.env), changed with rule "c $1"`, true},
+		{-5, "acme", "the project name", true},
+		{0, "", "", false},
+		{-100, "", "", false},
+	}
+	for _, tt := range tests {
+		candidate, source, ok := p.Source(tt.id)
+		if candidate != tt.candidate || source != tt.source || ok != tt.ok {
+			t.Errorf("Source(%d) = %q, %q, %v, want %q, %q, %v", tt.id, candidate, source, ok, tt.candidate, tt.source, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package bruteforce
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/viper"
+	"github.com/tedyst/licenta/breach"
+)
+
+// NewConfiguredBruteforceProvider returns the bruteforce provider selected by
+// the bruteforce-provider setting: "database" tries the passwords imported
+// into the database and "file" streams the files listed in wordlists.
+//
+// If breach-index is set, the found passwords are checked against that
+// breach corpus. Otherwise they are checked through queries, if it
+// implements breach.RangeQuerier.
+//
+// bruteforce-concurrency sets how many passwords are verified at the same
+// time. If bruteforce-shard-size is set and queries implements ShardQuerier,
+// users with more candidates than that are split into shards that are run by
+// all of the workers.
+func NewConfiguredBruteforceProvider(queries DatabasePasswordProviderInterface) (BruteforceProvider, error) {
+	var provider *databaseBruteforceProvider
+	switch name := viper.GetString("bruteforce-provider"); name {
+	case "", "database":
+		provider = NewDatabaseBruteforceProvider(queries)
+	case "file":
+		wordlists := viper.GetStringSlice("wordlists")
+		if len(wordlists) == 0 {
+			return nil, fmt.Errorf("bruteforce provider %q needs at least one wordlist", name)
+		}
+		provider = NewFileBruteforceProvider(queries, wordlists)
+	default:
+		return nil, fmt.Errorf("unknown bruteforce provider %q", name)
+	}
+
+	provider.concurrency = viper.GetInt("bruteforce-concurrency")
+	provider.shardSize = viper.GetInt64("bruteforce-shard-size")
+
+	index, err := OpenConfiguredBreachIndex()
+	if err != nil {
+		return nil, err
+	}
+	if index != nil {
+		provider.breach = index
+	}
+	return provider, nil
+}
+
+// OpenConfiguredBreachIndex opens the breach corpus set by breach-index. It
+// returns nil if none is configured.
+func OpenConfiguredBreachIndex() (*breach.Index, error) {
+	path := viper.GetString("breach-index")
+	if path == "" {
+		return nil, nil
+	}
+	return breach.OpenIndex(path)
+}
+
+// NewConfiguredPasswordProvider returns the passwords used to bruteforce the
+// users of a single project, for commands that run without the task runner.
+// With the file bruteforce provider it works without a database, in which
+// case the found passwords are only kept in memory. It returns nil if there is
+// nothing to bruteforce with.
+func NewConfiguredPasswordProvider(ctx context.Context, database DatabasePasswordProviderInterface, projectID int64) (PasswordProvider, error) {
+	var databaseProvider PasswordHashStore
+	var provider PasswordProvider
+	if database != nil {
+		p, err := NewDatabasePasswordProvider(ctx, database, projectID)
+		if err != nil {
+			return nil, err
+		}
+		databaseProvider, provider = p, p
+	}
+
+	if viper.GetString("bruteforce-provider") != "file" {
+		return provider, nil
+	}
+	fileProvider, err := NewFilePasswordProvider(viper.GetStringSlice("wordlists"), databaseProvider)
+	if err != nil {
+		return nil, err
+	}
+	return fileProvider, nil
+}
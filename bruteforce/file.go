@@ -0,0 +1,287 @@
+package bruteforce
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+)
+
+// PasswordHashStore keeps the passwords found for hashes, so that they are
+// not bruteforced again. Every PasswordProvider is one.
+//
+// The resume position of a hash is stored with the fingerprint of the
+// provider that generated it, and GetPasswordByHash only returns it for the
+// same fingerprint.
+type PasswordHashStore interface {
+	SavePasswordHash(username, hash, password string, maxInternalID int64, fingerprint string) error
+	GetPasswordByHash(username, hash, fingerprint string) (string, int64, error)
+}
+
+type memoryHashEntry struct {
+	password      string
+	maxInternalID int64
+	fingerprint   string
+}
+
+type memoryHashStore map[[2]string]memoryHashEntry
+
+func (m memoryHashStore) SavePasswordHash(username, hash, password string, maxInternalID int64, fingerprint string) error {
+	m[[2]string{username, hash}] = memoryHashEntry{password, maxInternalID, fingerprint}
+	return nil
+}
+
+func (m memoryHashStore) GetPasswordByHash(username, hash, fingerprint string) (string, int64, error) {
+	entry := m[[2]string{username, hash}]
+	if entry.fingerprint != fingerprint {
+		return entry.password, 0, nil
+	}
+	return entry.password, entry.maxInternalID, nil
+}
+
+type openWordlist struct {
+	file    *os.File
+	decoder io.ReadCloser
+	reader  *bufio.Reader
+}
+
+func openWordlistFile(path string) (*openWordlist, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open wordlist: %w", err)
+	}
+	decoder, err := DecodeWordlist(file)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("could not decode wordlist %s: %w", path, err), file.Close())
+	}
+	return &openWordlist{
+		file:    file,
+		decoder: decoder,
+		reader:  bufio.NewReader(decoder),
+	}, nil
+}
+
+func (w *openWordlist) Close() error {
+	return errors.Join(w.decoder.Close(), w.file.Close())
+}
+
+// countWordlistLines returns the number of lines of a wordlist, including
+// the empty ones, so that they can be used as stable IDs.
+func countWordlistLines(path string) (int64, error) {
+	wordlist, err := openWordlistFile(path)
+	if err != nil {
+		return 0, err
+	}
+	defer wordlist.Close()
+
+	var count int64
+	for {
+		_, err := readWordlistLine(wordlist.reader)
+		if err == io.EOF {
+			return count, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("could not read wordlist %s: %w", path, err)
+		}
+		count++
+	}
+}
+
+// filePasswordProvider streams passwords from wordlists on disk, one per
+// line, without importing them into the database. The wordlists are tried in
+// order and can be compressed with gzip, bzip2 or zstd.
+//
+// The internal ID of a password is its line number, counted from 0 across
+// all of the wordlists, so resuming works as long as the wordlists do not
+// change. Changing the paths or the line counts changes the fingerprint.
+// Empty lines keep their ID, but are skipped.
+type filePasswordProvider struct {
+	paths  []string
+	counts []int64
+	total  int64
+	hashes PasswordHashStore
+
+	fileIndex int
+	wordlist  *openWordlist
+	nextID    int64
+
+	current   string
+	currentID int64
+	skipped   int64
+	error     error
+}
+
+var _ PasswordProvider = (*filePasswordProvider)(nil)
+var _ skippingPasswordProvider = (*filePasswordProvider)(nil)
+var _ fingerprintedPasswordProvider = (*filePasswordProvider)(nil)
+
+// NewFilePasswordProvider counts the lines of every wordlist in paths and
+// returns a provider that streams them. Found passwords are saved in hashes,
+// or kept in memory if it is nil.
+func NewFilePasswordProvider(paths []string, hashes PasswordHashStore) (*filePasswordProvider, error) {
+	if len(paths) == 0 {
+		return nil, errors.New("no wordlists configured")
+	}
+	if hashes == nil {
+		hashes = memoryHashStore{}
+	}
+
+	p := &filePasswordProvider{
+		paths:  paths,
+		counts: make([]int64, len(paths)),
+		hashes: hashes,
+	}
+	for i, path := range paths {
+		count, err := countWordlistLines(path)
+		if err != nil {
+			return nil, err
+		}
+		p.counts[i] = count
+		p.total += count
+	}
+	return p, nil
+}
+
+func (p *filePasswordProvider) closeWordlist() error {
+	if p.wordlist == nil {
+		return nil
+	}
+	err := p.wordlist.Close()
+	p.wordlist = nil
+	return err
+}
+
+func (p *filePasswordProvider) GetCount() (int64, error) {
+	return p.total, nil
+}
+
+// GetSpecificPassword reads the wordlists from the start, so it is slow for
+// large lists.
+func (p *filePasswordProvider) GetSpecificPassword(password string) (int64, bool, error) {
+	var id int64
+	for _, path := range p.paths {
+		wordlist, err := openWordlistFile(path)
+		if err != nil {
+			return 0, false, err
+		}
+		for {
+			line, err := readWordlistLine(wordlist.reader)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return 0, false, errors.Join(fmt.Errorf("could not read wordlist %s: %w", path, err), wordlist.Close())
+			}
+			if line == password && line != "" {
+				return id, true, wordlist.Close()
+			}
+			id++
+		}
+		if err := wordlist.Close(); err != nil {
+			return 0, false, err
+		}
+	}
+	return 0, false, nil
+}
+
+func (p *filePasswordProvider) Next() bool {
+	for p.error == nil {
+		if p.wordlist == nil {
+			if p.fileIndex >= len(p.paths) {
+				return false
+			}
+			p.wordlist, p.error = openWordlistFile(p.paths[p.fileIndex])
+			continue
+		}
+
+		line, err := readWordlistLine(p.wordlist.reader)
+		if err == io.EOF {
+			p.error = p.closeWordlist()
+			p.fileIndex++
+			continue
+		}
+		if err != nil {
+			p.error = fmt.Errorf("could not read wordlist %s: %w", p.paths[p.fileIndex], err)
+			return false
+		}
+
+		id := p.nextID
+		p.nextID++
+		if line == "" {
+			p.skipped++
+			continue
+		}
+		p.current, p.currentID = line, id
+		return true
+	}
+	return false
+}
+
+func (p *filePasswordProvider) Error() error {
+	return p.error
+}
+
+func (p *filePasswordProvider) Current() (int64, string, error) {
+	return p.currentID, p.current, nil
+}
+
+func (p *filePasswordProvider) Skipped() int64 {
+	skipped := p.skipped
+	p.skipped = 0
+	return skipped
+}
+
+// Start opens the wordlist that contains the line with the given ID and
+// skips the lines before it.
+func (p *filePasswordProvider) Start(index int64) error {
+	p.error = nil
+	p.skipped = 0
+	if err := p.closeWordlist(); err != nil {
+		return err
+	}
+
+	index = max(index, 0)
+	p.fileIndex, p.nextID = 0, 0
+	for p.fileIndex < len(p.paths) && p.nextID+p.counts[p.fileIndex] <= index {
+		p.nextID += p.counts[p.fileIndex]
+		p.fileIndex++
+	}
+	if p.fileIndex >= len(p.paths) {
+		return nil
+	}
+
+	wordlist, err := openWordlistFile(p.paths[p.fileIndex])
+	if err != nil {
+		return err
+	}
+	p.wordlist = wordlist
+	for ; p.nextID < index; p.nextID++ {
+		if _, err := readWordlistLine(wordlist.reader); err != nil {
+			return fmt.Errorf("could not skip to line %d of wordlist %s: %w", index, p.paths[p.fileIndex], err)
+		}
+	}
+	return nil
+}
+
+func (p *filePasswordProvider) Close() {
+	_ = p.closeWordlist()
+}
+
+func (p *filePasswordProvider) Fingerprint() string {
+	parts := slices.Clone(p.paths)
+	for _, count := range p.counts {
+		parts = append(parts, strconv.FormatInt(count, 10))
+	}
+	return "wordlists:" + hashFingerprint(parts...)
+}
+
+func (p *filePasswordProvider) SavePasswordHash(username, hash, password string, maxInternalID int64, fingerprint string) error {
+	return p.hashes.SavePasswordHash(username, hash, password, maxInternalID, fingerprint)
+}
+
+func (p *filePasswordProvider) GetPasswordByHash(username, hash, fingerprint string) (string, int64, error) {
+	return p.hashes.GetPasswordByHash(username, hash, fingerprint)
+}
@@ -0,0 +1,201 @@
+package bruteforce
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// bzip2Wordlist is "dragon\nmonkey\n" compressed with bzip2.
+var bzip2Wordlist = []byte{
+	0x42, 0x5a, 0x68, 0x39, 0x31, 0x41, 0x59, 0x26, 0x53, 0x59, 0xe8, 0xa6, 0x7a, 0x21, 0x00, 0x00, 0x01,
+	0xc1, 0x80, 0x00, 0x10, 0x26, 0x8b, 0x90, 0x20, 0x20, 0x00, 0x22, 0x00, 0x0f, 0x50, 0x80, 0x69, 0xa6,
+	0x83, 0x48, 0xda, 0x98, 0x13, 0x8e, 0x3c, 0x5d, 0xc9, 0x14, 0xe1, 0x42, 0x43, 0xa2, 0x99, 0xe8, 0x84,
+}
+
+func gzipBytes(t *testing.T, data string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write([]byte(data)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func zstdBytes(t *testing.T, data string) []byte {
+	t.Helper()
+	w, err := zstd.NewWriter(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	return w.EncodeAll([]byte(data), nil)
+}
+
+func TestDecodeWordlist(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"plain", []byte("password\n123456\n"), "password\n123456\n"},
+		{"utf-8", []byte("pässword\n"), "pässword\n"},
+		{"utf-8 bom", []byte("\xef\xbb\xbfpässword\n"), "pässword\n"},
+		{"iso8859-1", []byte("p\xe4ssword\n"), "pässword\n"},
+		{"utf-16le bom", []byte("\xff\xfep\x00\xe4\x00s\x00\n\x00"), "päs\n"},
+		{"utf-16le", []byte("p\x00\xe4\x00s\x00\n\x00"), "päs\n"},
+		{"gzip", gzipBytes(t, "p\xe4ssword\n"), "pässword\n"},
+		{"zstd", zstdBytes(t, "letmein\n"), "letmein\n"},
+		{"bzip2", bzip2Wordlist, "dragon\nmonkey\n"},
+		{"starts with BZh", []byte("BZh9lover\n"), "BZh9lover\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := DecodeWordlist(bytes.NewReader(tt.data))
+			if err != nil {
+				t.Fatalf("DecodeWordlist() error = %v", err)
+			}
+			defer r.Close()
+			got, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("DecodeWordlist() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func writeWordlists(t *testing.T, files ...[]byte) []string {
+	t.Helper()
+	dir := t.TempDir()
+	paths := make([]string, len(files))
+	for i, data := range files {
+		paths[i] = filepath.Join(dir, strings.Repeat("w", i+1))
+		if err := os.WriteFile(paths[i], data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return paths
+}
+
+func TestFilePasswordProvider(t *testing.T) {
+	paths := writeWordlists(t,
+		[]byte("123456\r\npassword\n\nqwerty"),
+		gzipBytes(t, "letmein\n123456\n"),
+		bzip2Wordlist,
+	)
+	p, err := NewFilePasswordProvider(paths, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := p.GetCount()
+	if err != nil || count != 8 {
+		t.Fatalf("GetCount() = %d, %v, want 8", count, err)
+	}
+
+	all := []candidate{{0, "123456"}, {1, "password"}, {3, "qwerty"}, {4, "letmein"}, {5, "123456"}, {6, "dragon"}, {7, "monkey"}}
+	tests := []struct {
+		start   int64
+		want    []candidate
+		skipped int64
+	}{
+		{0, all, 1},
+		{2, all[2:], 1},
+		{4, all[3:], 0},
+		{7, all[6:], 0},
+		{8, nil, 0},
+	}
+	for _, tt := range tests {
+		if err := p.Start(tt.start); err != nil {
+			t.Fatalf("Start(%d) error = %v", tt.start, err)
+		}
+		var got []candidate
+		var skipped int64
+		for p.Next() {
+			id, password, err := p.Current()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, candidate{id, password})
+			skipped += p.Skipped()
+		}
+		if err := p.Error(); err != nil {
+			t.Fatalf("Error() = %v", err)
+		}
+		skipped += p.Skipped()
+		if !slices.Equal(got, tt.want) || skipped != tt.skipped {
+			t.Errorf("after Start(%d) got %v and skipped %d, want %v and %d", tt.start, got, skipped, tt.want, tt.skipped)
+		}
+		p.Close()
+	}
+
+	id, ok, err := p.GetSpecificPassword("monkey")
+	if err != nil || !ok || id != 7 {
+		t.Errorf("GetSpecificPassword(monkey) = %d, %v, %v, want 7", id, ok, err)
+	}
+	if _, ok, err := p.GetSpecificPassword("hunter2"); ok || err != nil {
+		t.Errorf("GetSpecificPassword(hunter2) = %v, %v, want false", ok, err)
+	}
+
+	if err := p.SavePasswordHash("admin", "hash", "dragon", 6, p.Fingerprint()); err != nil {
+		t.Fatal(err)
+	}
+	password, lastID, err := p.GetPasswordByHash("admin", "hash", p.Fingerprint())
+	if err != nil || password != "dragon" || lastID != 6 {
+		t.Errorf("GetPasswordByHash() = %q, %d, %v, want dragon, 6", password, lastID, err)
+	}
+
+	// The IDs of a mutation provider are not line numbers, so the position
+	// saved for the wordlists is not used for it.
+	mutated := NewMutationPasswordProvider(p, DefaultRules())
+	password, lastID, err = mutated.GetPasswordByHash("admin", "hash", providerFingerprint(mutated))
+	if err != nil || password != "dragon" || lastID != 0 {
+		t.Errorf("GetPasswordByHash() with rules = %q, %d, %v, want dragon, 0", password, lastID, err)
+	}
+}
+
+func TestFilePasswordProvider_Fingerprint(t *testing.T) {
+	paths := writeWordlists(t, []byte("123456\npassword\n"), []byte("letmein\n"))
+	fingerprint := func(paths []string) string {
+		t.Helper()
+		p, err := NewFilePasswordProvider(paths, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return p.Fingerprint()
+	}
+
+	before := fingerprint(paths)
+	if fingerprint(paths) != before {
+		t.Error("Fingerprint() changed for the same wordlists")
+	}
+	if fingerprint(paths[:1]) == before {
+		t.Error("Fingerprint() did not change when a wordlist was removed")
+	}
+	if err := os.WriteFile(paths[0], []byte("123456\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if fingerprint(paths) == before {
+		t.Error("Fingerprint() did not change when a wordlist was shortened")
+	}
+}
+
+func TestNewFilePasswordProvider_MissingFile(t *testing.T) {
+	if _, err := NewFilePasswordProvider([]string{filepath.Join(t.TempDir(), "missing.txt")}, nil); err == nil {
+		t.Error("NewFilePasswordProvider() error = nil, want an error for a missing wordlist")
+	}
+}
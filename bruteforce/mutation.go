@@ -0,0 +1,195 @@
+package bruteforce
+
+import (
+	_ "embed"
+	"fmt"
+)
+
+//go:embed rules/default.rule
+var defaultRules string
+
+// DefaultRules returns the builtin rule set, which tries common
+// capitalization, digit and year suffixes and leetspeak substitutions.
+func DefaultRules() *RuleSet {
+	rs, err := ParseRules(defaultRules)
+	if err != nil {
+		panic(err)
+	}
+	return rs
+}
+
+// skippingPasswordProvider is implemented by providers that can skip
+// candidates, so that the bruteforcer can still count them as tried.
+type skippingPasswordProvider interface {
+	// Skipped returns the number of candidates skipped since the last call.
+	Skipped() int64
+}
+
+// mutationPasswordProvider applies every rule of a RuleSet to every word of
+// another provider. Candidates are generated lazily, one word at a time.
+//
+// The internal ID of a candidate is wordID*Len()+ruleIndex, so resuming from
+// an ID continues with the same word and rule. IDs are only meaningful for
+// the rule set that generated them, which is part of the fingerprint.
+type mutationPasswordProvider struct {
+	base  PasswordProvider
+	rules *RuleSet
+
+	word      string
+	wordID    int64
+	ruleIndex int
+	haveWord  bool
+	seen      map[string]struct{}
+
+	startPending bool
+	startWordID  int64
+	startRule    int
+
+	current   string
+	currentID int64
+	skipped   int64
+	error     error
+}
+
+var _ PasswordProvider = (*mutationPasswordProvider)(nil)
+var _ skippingPasswordProvider = (*mutationPasswordProvider)(nil)
+var _ sourcedPasswordProvider = (*mutationPasswordProvider)(nil)
+var _ fingerprintedPasswordProvider = (*mutationPasswordProvider)(nil)
+
+// NewMutationPasswordProvider wraps base with rules, which must contain at
+// least one rule.
+func NewMutationPasswordProvider(base PasswordProvider, rules *RuleSet) *mutationPasswordProvider {
+	return &mutationPasswordProvider{
+		base:  base,
+		rules: rules,
+		seen:  map[string]struct{}{},
+	}
+}
+
+func (p *mutationPasswordProvider) stride() int64 {
+	return int64(p.rules.Len())
+}
+
+func (p *mutationPasswordProvider) GetCount() (int64, error) {
+	count, err := p.base.GetCount()
+	if err != nil {
+		return 0, err
+	}
+	return count * p.stride(), nil
+}
+
+// GetSpecificPassword only finds passwords that are in the wrapped provider
+// unchanged, since the rules can not be reversed.
+func (p *mutationPasswordProvider) GetSpecificPassword(password string) (int64, bool, error) {
+	id, ok, err := p.base.GetSpecificPassword(password)
+	if err != nil || !ok {
+		return id, ok, err
+	}
+	return id * p.stride(), true, nil
+}
+
+func (p *mutationPasswordProvider) nextWord() bool {
+	if !p.base.Next() {
+		return false
+	}
+	id, word, err := p.base.Current()
+	if err != nil {
+		p.error = err
+		return false
+	}
+	p.word, p.wordID, p.ruleIndex, p.haveWord = word, id, 0, true
+	if p.startPending && id == p.startWordID {
+		// The rules before startRule were tried before resuming.
+		p.ruleIndex = p.startRule
+	}
+	p.startPending = false
+	clear(p.seen)
+	return true
+}
+
+func (p *mutationPasswordProvider) Next() bool {
+	for {
+		if !p.haveWord || p.ruleIndex >= p.rules.Len() {
+			if !p.nextWord() {
+				return false
+			}
+		}
+
+		index := p.ruleIndex
+		p.ruleIndex++
+		candidate := p.rules.Apply(index, p.word)
+		if _, ok := p.seen[candidate]; ok || candidate == "" {
+			p.skipped++
+			continue
+		}
+		p.seen[candidate] = struct{}{}
+
+		p.current = candidate
+		p.currentID = p.wordID*p.stride() + int64(index)
+		return true
+	}
+}
+
+func (p *mutationPasswordProvider) Skipped() int64 {
+	skipped := p.skipped
+	p.skipped = 0
+	return skipped
+}
+
+// Source attributes a candidate to the word it was derived from, if the
+// wrapped provider knows where that word comes from.
+func (p *mutationPasswordProvider) Source(internalID int64) (string, string, bool) {
+	sourced, ok := p.base.(sourcedPasswordProvider)
+	if !ok || internalID < 0 {
+		return "", "", false
+	}
+	word, source, ok := sourced.Source(internalID / p.stride())
+	if !ok {
+		return "", "", false
+	}
+	index := int(internalID % p.stride())
+	if p.rules.String(index) != ":" {
+		source = fmt.Sprintf("%s, changed with rule %q", source, p.rules.String(index))
+	}
+	return p.rules.Apply(index, word), source, true
+}
+
+func (p *mutationPasswordProvider) Error() error {
+	if p.error != nil {
+		return p.error
+	}
+	return p.base.Error()
+}
+
+func (p *mutationPasswordProvider) Current() (int64, string, error) {
+	return p.currentID, p.current, nil
+}
+
+func (p *mutationPasswordProvider) Start(index int64) error {
+	p.haveWord = false
+	p.skipped = 0
+	p.startWordID = index / p.stride()
+	p.startRule = int(index % p.stride())
+	p.startPending = index != 0
+	return p.base.Start(p.startWordID)
+}
+
+func (p *mutationPasswordProvider) Close() {
+	p.base.Close()
+}
+
+func (p *mutationPasswordProvider) Fingerprint() string {
+	rules := make([]string, p.rules.Len())
+	for i := range rules {
+		rules[i] = p.rules.String(i)
+	}
+	return providerFingerprint(p.base) + "+rules:" + hashFingerprint(rules...)
+}
+
+func (p *mutationPasswordProvider) SavePasswordHash(username, hash, password string, maxInternalID int64, fingerprint string) error {
+	return p.base.SavePasswordHash(username, hash, password, maxInternalID, fingerprint)
+}
+
+func (p *mutationPasswordProvider) GetPasswordByHash(username, hash, fingerprint string) (string, int64, error) {
+	return p.base.GetPasswordByHash(username, hash, fingerprint)
+}
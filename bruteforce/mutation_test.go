@@ -0,0 +1,88 @@
+package bruteforce
+
+import (
+	"slices"
+	"testing"
+)
+
+type candidate struct {
+	id       int64
+	password string
+}
+
+func collectCandidates(t *testing.T, p *mutationPasswordProvider) ([]candidate, int64) {
+	t.Helper()
+	var candidates []candidate
+	var skipped int64
+	for p.Next() {
+		id, password, err := p.Current()
+		if err != nil {
+			t.Fatal(err)
+		}
+		candidates = append(candidates, candidate{id, password})
+		skipped += p.Skipped()
+	}
+	if err := p.Error(); err != nil {
+		t.Fatal(err)
+	}
+	return candidates, skipped + p.Skipped()
+}
+
+func TestMutationPasswordProvider(t *testing.T) {
+	rules, err := ParseRules(":\nc\n$1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := NewMutationPasswordProvider(NewPasswordListIterator([]string{"abc", "Abc", "123"}), rules)
+
+	count, err := p.GetCount()
+	if err != nil || count != 9 {
+		t.Fatalf("GetCount() = %d, %v, want 9", count, err)
+	}
+
+	if err := p.Start(0); err != nil {
+		t.Fatal(err)
+	}
+	got, skipped := collectCandidates(t, p)
+	want := []candidate{
+		{0, "abc"}, {1, "Abc"}, {2, "abc1"},
+		{3, "Abc"}, {5, "Abc1"},
+		{6, "123"}, {8, "1231"},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("candidates = %v, want %v", got, want)
+	}
+	if int64(len(got))+skipped != count {
+		t.Errorf("tried %d and skipped %d candidates, want %d in total", len(got), skipped, count)
+	}
+}
+
+func TestMutationPasswordProvider_Start(t *testing.T) {
+	rules, err := ParseRules(":\nc\n$1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := NewMutationPasswordProvider(NewPasswordListIterator([]string{"abc", "def"}), rules)
+
+	if err := p.Start(4); err != nil {
+		t.Fatal(err)
+	}
+	got, _ := collectCandidates(t, p)
+	want := []candidate{{4, "Def"}, {5, "def1"}}
+	if !slices.Equal(got, want) {
+		t.Errorf("candidates after Start(4) = %v, want %v", got, want)
+	}
+}
+
+func TestMutationPasswordProvider_GetSpecificPassword(t *testing.T) {
+	rules, err := ParseRules(":\nc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := NewMutationPasswordProvider(NewPasswordListIterator([]string{"abc", "def"}), rules)
+
+	id, ok, err := p.GetSpecificPassword("def")
+	if err != nil || !ok || id != 2 {
+		t.Errorf("GetSpecificPassword(def) = %d, %v, %v, want 2", id, ok, err)
+	}
+}
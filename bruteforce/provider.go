@@ -2,21 +2,39 @@ package bruteforce
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/tedyst/licenta/breach"
+	"github.com/tedyst/licenta/db/queries"
 	"github.com/tedyst/licenta/scanner"
 )
 
 type BruteforceProvider interface {
-	NewBruteforcer(ctx context.Context, sc scanner.Scanner, statusFunc StatusFunc, projectID int64) (Bruteforcer, error)
+	NewBruteforcer(ctx context.Context, sc scanner.Scanner, statusFunc StatusFunc, projectID int64, opts ...Option) (Bruteforcer, error)
 }
 
 type Bruteforcer interface {
 	BruteforcePasswordAllUsers(ctx context.Context) ([]scanner.ScanResult, error)
 }
 
+// PasswordRulesQuerier is implemented by databases that store a mutation
+// rule set per project. It is optional, so that the bruteforce provider
+// keeps working with querier implementations that do not support it.
+type PasswordRulesQuerier interface {
+	GetProjectPasswordRules(ctx context.Context, projectID int64) (*queries.ProjectPasswordRule, error)
+}
+
 type databaseBruteforceProvider struct {
-	queries DatabasePasswordProviderInterface
+	queries   DatabasePasswordProviderInterface
+	wordlists []string
+	breach    breach.Checker
+
+	concurrency int
+	// shardSize is the number of candidates of a shard. Users with fewer
+	// candidates are not split into shards, and 0 disables sharding.
+	shardSize int64
 }
 
 var _ BruteforceProvider = (*databaseBruteforceProvider)(nil)
@@ -27,12 +45,113 @@ func NewDatabaseBruteforceProvider(queries DatabasePasswordProviderInterface) *d
 	}
 }
 
-func (d *databaseBruteforceProvider) NewBruteforcer(ctx context.Context, sc scanner.Scanner, statusFunc StatusFunc, projectID int64) (Bruteforcer, error) {
-	passProvider, err := NewDatabasePasswordProvider(ctx, d.queries, projectID)
+// NewFileBruteforceProvider streams the passwords from wordlists on disk
+// instead of default_bruteforce_passwords. The found passwords, the project
+// rules and the project candidates still come from queries.
+func NewFileBruteforceProvider(queries DatabasePasswordProviderInterface, wordlists []string) *databaseBruteforceProvider {
+	return &databaseBruteforceProvider{
+		queries:   queries,
+		wordlists: wordlists,
+	}
+}
+
+// ProjectRules builds the rule set configured for a project. It returns nil
+// if the project has no rules, in which case the passwords are tried as is.
+func ProjectRules(config *queries.ProjectPasswordRule) (*RuleSet, error) {
+	custom, err := ParseRules(config.Rules)
+	if err != nil {
+		return nil, err
+	}
+	if config.UseDefault {
+		return DefaultRules().Append(custom), nil
+	}
+	if custom.Len() == 0 {
+		return nil, nil
+	}
+	return custom, nil
+}
+
+func (d *databaseBruteforceProvider) projectRules(ctx context.Context, projectID int64) (*RuleSet, error) {
+	querier, ok := d.queries.(PasswordRulesQuerier)
+	if !ok {
+		return nil, nil
+	}
+	config, err := querier.GetProjectPasswordRules(ctx, projectID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not get password rules: %w", err)
+	}
+	rules, err := ProjectRules(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse password rules: %w", err)
+	}
+	return rules, nil
+}
+
+// passwordProvider returns the candidates tried for the users of a project:
+// the secrets already found for the project, followed by the wordlist, both
+// mutated with the rules of the project.
+func (d *databaseBruteforceProvider) passwordProvider(ctx context.Context, projectID int64) (PasswordProvider, error) {
+	rules, err := d.projectRules(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var passProvider PasswordProvider
+	passProvider, err = NewDatabasePasswordProvider(ctx, d.queries, projectID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create password provider: %w", err)
 	}
+	if len(d.wordlists) > 0 {
+		passProvider, err = NewFilePasswordProvider(d.wordlists, passProvider)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create password provider: %w", err)
+		}
+	}
+	if rules != nil {
+		passProvider = NewMutationPasswordProvider(passProvider, rules)
+	}
+
+	// The secrets already found for the project are the most likely
+	// passwords, so they are tried before the wordlist.
+	if querier, ok := d.queries.(PasswordCandidatesQuerier); ok {
+		var projectProvider PasswordProvider
+		projectProvider, err = NewProjectPasswordProvider(ctx, querier, projectID)
+		if err != nil {
+			return nil, err
+		}
+		if rules != nil {
+			projectProvider = NewMutationPasswordProvider(projectProvider, rules)
+		}
+		passProvider = NewChainedPasswordProvider(projectProvider, passProvider)
+	}
+	return passProvider, nil
+}
+
+// options returns the options shared by the bruteforcers of the provider
+// and the shards it runs.
+func (d *databaseBruteforceProvider) options() []Option {
+	return []Option{WithConcurrency(d.concurrency)}
+}
+
+func (d *databaseBruteforceProvider) NewBruteforcer(ctx context.Context, sc scanner.Scanner, statusFunc StatusFunc, projectID int64, opts ...Option) (Bruteforcer, error) {
+	passProvider, err := d.passwordProvider(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
 	defer passProvider.Close()
 
-	return NewBruteforcer(passProvider, sc, statusFunc), nil
+	options := d.options()
+	if d.breach != nil {
+		options = append(options, WithBreachChecker(d.breach))
+	} else if querier, ok := d.queries.(breach.RangeQuerier); ok {
+		options = append(options, WithBreachChecker(breach.NewRangeChecker(querier)))
+	}
+	if querier, ok := d.queries.(ShardQuerier); ok && d.shardSize > 0 {
+		options = append(options, WithShards(querier, d.shardSize))
+	}
+
+	return NewBruteforcer(passProvider, sc, statusFunc, append(options, opts...)...), nil
 }
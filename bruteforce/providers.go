@@ -2,7 +2,9 @@ package bruteforce
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/jackc/pgx/v5"
@@ -29,8 +31,37 @@ type PasswordProvider interface {
 	Start(index int64) error
 	Close()
 
-	SavePasswordHash(username, hash, password string, maxInternalID int64) error
-	GetPasswordByHash(username, hash string) (string, int64, error)
+	SavePasswordHash(username, hash, password string, maxInternalID int64, fingerprint string) error
+	GetPasswordByHash(username, hash, fingerprint string) (string, int64, error)
+}
+
+// fingerprintedPasswordProvider is implemented by providers whose internal
+// IDs depend on how they are configured, such as the wordlists of a file
+// provider or the rules of a mutation provider.
+type fingerprintedPasswordProvider interface {
+	// Fingerprint changes whenever the internal IDs of the candidates do.
+	Fingerprint() string
+}
+
+// providerFingerprint returns the fingerprint stored with the resume position
+// of a hash. A position stored with another fingerprint is not used, since
+// its internal ID points to another candidate.
+func providerFingerprint(provider PasswordProvider) string {
+	if fingerprinted, ok := provider.(fingerprintedPasswordProvider); ok {
+		return fingerprinted.Fingerprint()
+	}
+	return ""
+}
+
+// hashFingerprint returns a short hash of parts, for the fingerprints of
+// configurations that are too long to store as is.
+func hashFingerprint(parts ...string) string {
+	h := sha256.New()
+	for _, part := range parts {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
 }
 
 type databasePasswordProvider struct {
@@ -138,7 +169,13 @@ func (d *databasePasswordProvider) Close() {
 
 }
 
-func (d *databasePasswordProvider) SavePasswordHash(username, hash, password string, maxInternalID int64) error {
+// Fingerprint is the same for every project, since the internal IDs are the
+// IDs of default_bruteforce_passwords.
+func (d *databasePasswordProvider) Fingerprint() string {
+	return "database"
+}
+
+func (d *databasePasswordProvider) SavePasswordHash(username, hash, password string, maxInternalID int64, fingerprint string) error {
 	oldPW, err := d.database.GetBruteforcedPasswords(d.context, queries.GetBruteforcedPasswordsParams{
 		Username: username,
 		Hash:     hash,
@@ -157,7 +194,8 @@ func (d *databasePasswordProvider) SavePasswordHash(username, hash, password str
 				Int64: maxInternalID,
 				Valid: maxInternalID != 0,
 			},
-			Password: sql.NullString{String: password, Valid: password != ""},
+			Password:                  sql.NullString{String: password, Valid: password != ""},
+			LastBruteforceFingerprint: fingerprint,
 		})
 		return err
 	}
@@ -169,6 +207,7 @@ func (d *databasePasswordProvider) SavePasswordHash(username, hash, password str
 			Int64: maxInternalID,
 			Valid: maxInternalID != 0,
 		},
+		LastBruteforceFingerprint: fingerprint,
 		ProjectID: sql.NullInt64{
 			Int64: d.projectID,
 			Valid: d.projectID != 0,
@@ -177,7 +216,10 @@ func (d *databasePasswordProvider) SavePasswordHash(username, hash, password str
 	return err
 }
 
-func (d *databasePasswordProvider) GetPasswordByHash(username, hash string) (string, int64, error) {
+// GetPasswordByHash returns the password found for a hash and the position
+// to resume its bruteforce from, which is 0 if it was stored with another
+// fingerprint.
+func (d *databasePasswordProvider) GetPasswordByHash(username, hash, fingerprint string) (string, int64, error) {
 	p, err := d.database.GetBruteforcedPasswords(d.context, queries.GetBruteforcedPasswordsParams{
 		Username: username,
 		Hash:     hash,
@@ -189,7 +231,13 @@ func (d *databasePasswordProvider) GetPasswordByHash(username, hash string) (str
 	if err == pgx.ErrNoRows {
 		return "", 0, nil
 	}
-	return p.Password.String, p.LastBruteforceID.Int64, err
+	if err != nil {
+		return "", 0, err
+	}
+	if p.LastBruteforceFingerprint != fingerprint {
+		return p.Password.String, 0, nil
+	}
+	return p.Password.String, p.LastBruteforceID.Int64, nil
 }
 
 func NewDatabasePasswordProvider(ctx context.Context, database DatabasePasswordProviderInterface, projectID int64) (*databasePasswordProvider, error) {
@@ -270,7 +318,7 @@ func (p *passwordListProvider) Close() {
 
 }
 
-func (p *passwordListProvider) SavePasswordHash(username, hash, password string, maxInternalID int64) error {
+func (p *passwordListProvider) SavePasswordHash(username, hash, password string, maxInternalID int64, fingerprint string) error {
 	p.passwordHashes = append(p.passwordHashes, passwordHashes{
 		hash:     hash,
 		username: username,
@@ -279,7 +327,7 @@ func (p *passwordListProvider) SavePasswordHash(username, hash, password string,
 	return nil
 }
 
-func (p *passwordListProvider) GetPasswordByHash(username, hash string) (string, int64, error) {
+func (p *passwordListProvider) GetPasswordByHash(username, hash, fingerprint string) (string, int64, error) {
 	for _, h := range p.passwordHashes {
 		if h.hash == hash && h.username == username {
 			return h.password, 0, nil
@@ -0,0 +1,339 @@
+package bruteforce
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// MaxRuleWordLength limits the length of a mutated candidate, so that rules
+// like duplicate can not grow a word without bounds.
+const MaxRuleWordLength = 256
+
+var ErrInvalidRule = errors.New("invalid rule")
+
+type ruleFunc func([]rune) []rune
+
+// RuleSet is a list of hashcat style rules. Every rule is applied to every
+// word of the wrapped provider, in order. The supported functions are:
+//
+//	:        do nothing
+//	l u      lowercase, uppercase
+//	c C      capitalize, inverse capitalize
+//	t TN     toggle the case of all characters, of the character at N
+//	r d f    reverse, duplicate, append the reversed word
+//	{ }      rotate left, rotate right
+//	pN       append the word N times
+//	$X ^X    append, prepend the character X
+//	[ ]      delete the first, the last character
+//	DN 'N    delete the character at N, truncate at N
+//	iNX oNX  insert, overwrite the character at N with X
+//	sXY @X   replace every X with Y, remove every X
+//	zN ZN    duplicate the first, the last character N times
+//
+// Positions are 0-9 followed by A-Z for 10-35. Spaces between functions are
+// ignored and lines starting with # are comments.
+type RuleSet struct {
+	rules   [][]ruleFunc
+	sources []string
+}
+
+func rulePosition(c byte) (int, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0'), true
+	case c >= 'A' && c <= 'Z':
+		return int(c-'A') + 10, true
+	default:
+		return 0, false
+	}
+}
+
+func mapRunes(fn func(rune) rune) ruleFunc {
+	return func(word []rune) []rune {
+		for i, r := range word {
+			word[i] = fn(r)
+		}
+		return word
+	}
+}
+
+func toggleCase(r rune) rune {
+	if unicode.IsUpper(r) {
+		return unicode.ToLower(r)
+	}
+	return unicode.ToUpper(r)
+}
+
+func reversed(word []rune) []rune {
+	result := make([]rune, len(word))
+	for i, r := range word {
+		result[len(word)-1-i] = r
+	}
+	return result
+}
+
+// parseRule parses a single rule line into its functions.
+func parseRule(line string) ([]ruleFunc, error) {
+	// Arguments are single bytes, so multi byte characters can only be used
+	// as the argument of $, ^, i, o, s and @.
+	input := []rune(line)
+	funcs := []ruleFunc{}
+	for i := 0; i < len(input); i++ {
+		op := input[i]
+		arg := func(n int) ([]rune, error) {
+			if i+n >= len(input) {
+				return nil, fmt.Errorf("function %c needs %d arguments", op, n)
+			}
+			args := input[i+1 : i+1+n]
+			i += n
+			return args, nil
+		}
+		position := func(r rune) (int, error) {
+			if r > 0x7f {
+				return 0, fmt.Errorf("invalid position %c for function %c", r, op)
+			}
+			n, ok := rulePosition(byte(r))
+			if !ok {
+				return 0, fmt.Errorf("invalid position %c for function %c", r, op)
+			}
+			return n, nil
+		}
+
+		switch op {
+		case ' ', '\t':
+		case ':':
+			funcs = append(funcs, func(word []rune) []rune { return word })
+		case 'l':
+			funcs = append(funcs, mapRunes(unicode.ToLower))
+		case 'u':
+			funcs = append(funcs, mapRunes(unicode.ToUpper))
+		case 'c', 'C':
+			first, rest := unicode.ToUpper, unicode.ToLower
+			if op == 'C' {
+				first, rest = unicode.ToLower, unicode.ToUpper
+			}
+			funcs = append(funcs, func(word []rune) []rune {
+				for i, r := range word {
+					if i == 0 {
+						word[i] = first(r)
+					} else {
+						word[i] = rest(r)
+					}
+				}
+				return word
+			})
+		case 't':
+			funcs = append(funcs, mapRunes(toggleCase))
+		case 'r':
+			funcs = append(funcs, reversed)
+		case 'd':
+			funcs = append(funcs, func(word []rune) []rune { return append(word, word...) })
+		case 'f':
+			funcs = append(funcs, func(word []rune) []rune { return append(word, reversed(word)...) })
+		case '{':
+			funcs = append(funcs, func(word []rune) []rune {
+				if len(word) < 2 {
+					return word
+				}
+				return append(word[1:], word[0])
+			})
+		case '}':
+			funcs = append(funcs, func(word []rune) []rune {
+				if len(word) < 2 {
+					return word
+				}
+				return append([]rune{word[len(word)-1]}, word[:len(word)-1]...)
+			})
+		case '[':
+			funcs = append(funcs, func(word []rune) []rune {
+				if len(word) == 0 {
+					return word
+				}
+				return word[1:]
+			})
+		case ']':
+			funcs = append(funcs, func(word []rune) []rune {
+				if len(word) == 0 {
+					return word
+				}
+				return word[:len(word)-1]
+			})
+		case '$', '^', '@':
+			args, err := arg(1)
+			if err != nil {
+				return nil, err
+			}
+			c := args[0]
+			switch op {
+			case '$':
+				funcs = append(funcs, func(word []rune) []rune { return append(word, c) })
+			case '^':
+				funcs = append(funcs, func(word []rune) []rune { return append([]rune{c}, word...) })
+			case '@':
+				funcs = append(funcs, func(word []rune) []rune {
+					result := word[:0]
+					for _, r := range word {
+						if r != c {
+							result = append(result, r)
+						}
+					}
+					return result
+				})
+			}
+		case 's':
+			args, err := arg(2)
+			if err != nil {
+				return nil, err
+			}
+			from, to := args[0], args[1]
+			funcs = append(funcs, mapRunes(func(r rune) rune {
+				if r == from {
+					return to
+				}
+				return r
+			}))
+		case 'T', 'D', '\'', 'p', 'z', 'Z':
+			args, err := arg(1)
+			if err != nil {
+				return nil, err
+			}
+			n, err := position(args[0])
+			if err != nil {
+				return nil, err
+			}
+			switch op {
+			case 'T':
+				funcs = append(funcs, func(word []rune) []rune {
+					if n < len(word) {
+						word[n] = toggleCase(word[n])
+					}
+					return word
+				})
+			case 'D':
+				funcs = append(funcs, func(word []rune) []rune {
+					if n < len(word) {
+						return append(word[:n], word[n+1:]...)
+					}
+					return word
+				})
+			case '\'':
+				funcs = append(funcs, func(word []rune) []rune {
+					if n < len(word) {
+						return word[:n]
+					}
+					return word
+				})
+			case 'p':
+				funcs = append(funcs, func(word []rune) []rune {
+					original := append([]rune{}, word...)
+					for j := 0; j < n; j++ {
+						word = append(word, original...)
+					}
+					return word
+				})
+			case 'z', 'Z':
+				funcs = append(funcs, func(word []rune) []rune {
+					if len(word) == 0 {
+						return word
+					}
+					if op == 'z' {
+						prefix := make([]rune, n)
+						for j := range prefix {
+							prefix[j] = word[0]
+						}
+						return append(prefix, word...)
+					}
+					last := word[len(word)-1]
+					for j := 0; j < n; j++ {
+						word = append(word, last)
+					}
+					return word
+				})
+			}
+		case 'i', 'o':
+			args, err := arg(2)
+			if err != nil {
+				return nil, err
+			}
+			n, err := position(args[0])
+			if err != nil {
+				return nil, err
+			}
+			c := args[1]
+			if op == 'i' {
+				funcs = append(funcs, func(word []rune) []rune {
+					if n > len(word) {
+						return word
+					}
+					return append(word[:n], append([]rune{c}, word[n:]...)...)
+				})
+			} else {
+				funcs = append(funcs, func(word []rune) []rune {
+					if n < len(word) {
+						word[n] = c
+					}
+					return word
+				})
+			}
+		default:
+			return nil, fmt.Errorf("unknown function %c", op)
+		}
+	}
+	if len(funcs) == 0 {
+		return nil, errors.New("empty rule")
+	}
+	return funcs, nil
+}
+
+// ParseRules parses one rule per line. Text without any rule results in an
+// empty rule set.
+func ParseRules(text string) (*RuleSet, error) {
+	rs := &RuleSet{}
+	for n, line := range strings.Split(text, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		rule, err := parseRule(line)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidRule, n+1, err)
+		}
+		rs.rules = append(rs.rules, rule)
+		rs.sources = append(rs.sources, line)
+	}
+	return rs, nil
+}
+
+// Len returns the number of rules.
+func (rs *RuleSet) Len() int {
+	return len(rs.rules)
+}
+
+// Append returns a new rule set with the rules of other after the rules of rs.
+func (rs *RuleSet) Append(other *RuleSet) *RuleSet {
+	return &RuleSet{
+		rules:   append(slices.Clip(rs.rules), other.rules...),
+		sources: append(slices.Clip(rs.sources), other.sources...),
+	}
+}
+
+// String returns the rule at index i as it was written.
+func (rs *RuleSet) String(i int) string {
+	return rs.sources[i]
+}
+
+// Apply returns the word mutated by the rule at index i. The result is empty
+// if the rule made the word longer than MaxRuleWordLength.
+func (rs *RuleSet) Apply(i int, word string) string {
+	result := []rune(word)
+	for _, fn := range rs.rules[i] {
+		result = fn(result)
+		if len(result) > MaxRuleWordLength {
+			return ""
+		}
+	}
+	return string(result)
+}
@@ -0,0 +1,105 @@
+package bruteforce
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRuleSet_Apply(t *testing.T) {
+	tests := []struct {
+		rule string
+		word string
+		want string
+	}{
+		{":", "password", "password"},
+		{"l", "PassWord", "password"},
+		{"u", "password", "PASSWORD"},
+		{"c", "pASSWORD", "Password"},
+		{"C", "Password", "pASSWORD"},
+		{"t", "PassWord", "pASSwORD"},
+		{"T0", "password", "Password"},
+		{"r", "abc", "cba"},
+		{"d", "abc", "abcabc"},
+		{"f", "abc", "abccba"},
+		{"{", "abc", "bca"},
+		{"}", "abc", "cab"},
+		{"p2", "ab", "ababab"},
+		{"$1 $2 $3", "password", "password123"},
+		{"^1", "password", "1password"},
+		{"c $2 $0 $2 $4", "summer", "Summer2024"},
+		{"[", "password", "assword"},
+		{"]", "password", "passwor"},
+		{"D3", "password", "pasword"},
+		{"'4", "password", "pass"},
+		{"i4!", "password", "pass!word"},
+		{"o0P", "password", "Password"},
+		{"sa@ so0", "password", "p@ssw0rd"},
+		{"@s", "password", "paword"},
+		{"z2", "abc", "aaabc"},
+		{"Z2", "abc", "abccc"},
+		{"D9", "password", "password"},
+		{"T9", "abc", "abc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			rs, err := ParseRules(tt.rule)
+			if err != nil {
+				t.Fatalf("ParseRules(%q) error = %v", tt.rule, err)
+			}
+			if got := rs.Apply(0, tt.word); got != tt.want {
+				t.Errorf("Apply(%q, %q) = %q, want %q", tt.rule, tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRules(t *testing.T) {
+	rs, err := ParseRules("# comment\n:\n\nc\r\n$1\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rs.Len() != 3 || rs.String(1) != "c" {
+		t.Errorf("ParseRules() = %d rules, want 3", rs.Len())
+	}
+
+	empty, err := ParseRules("# nothing here\n")
+	if err != nil || empty.Len() != 0 {
+		t.Errorf("ParseRules(comment) = %v, %v, want an empty rule set", empty, err)
+	}
+
+	for _, rule := range []string{"$", "T", "s1", "X", "i1", "'!"} {
+		if _, err := ParseRules(rule); !errors.Is(err, ErrInvalidRule) {
+			t.Errorf("ParseRules(%q) error = %v, want ErrInvalidRule", rule, err)
+		}
+	}
+}
+
+func TestRuleSet_ApplyLimitsLength(t *testing.T) {
+	rs, err := ParseRules("d d d d d d d d d d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := rs.Apply(0, "password"); got != "" {
+		t.Errorf("Apply() returned %d characters, want the candidate to be dropped", len(got))
+	}
+}
+
+func TestDefaultRules(t *testing.T) {
+	rs := DefaultRules()
+	if rs.Len() == 0 {
+		t.Fatal("DefaultRules() is empty")
+	}
+	found := map[string]bool{}
+	for i := 0; i < rs.Len(); i++ {
+		found[rs.Apply(i, "summer")] = true
+	}
+	for _, want := range []string{"summer", "Summer", "summer1", "Summer2024", "5umm3r"} {
+		if !found[want] {
+			t.Errorf("DefaultRules() does not generate %q", want)
+		}
+	}
+	if strings.Contains(rs.String(0), "#") {
+		t.Errorf("DefaultRules() kept a comment: %q", rs.String(0))
+	}
+}
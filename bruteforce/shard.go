@@ -0,0 +1,405 @@
+package bruteforce
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/tedyst/licenta/db/queries"
+	"github.com/tedyst/licenta/models"
+	"github.com/tedyst/licenta/scanner"
+	"github.com/tedyst/licenta/scanner/etcd"
+	"github.com/tedyst/licenta/scanner/mysql"
+	"github.com/tedyst/licenta/scanner/postgres"
+	"github.com/tedyst/licenta/scanner/redis"
+)
+
+// ShardQuerier stores the shards of a distributed bruteforce. The server
+// splits the candidates of a user into ranges of internal IDs, which are
+// handed out to the workers by /worker/get-task and run with RunShard.
+type ShardQuerier interface {
+	CreateScanBruteforceShards(ctx context.Context, arg queries.CreateScanBruteforceShardsParams) ([]*queries.ScanBruteforceShard, error)
+	GetScanBruteforceShards(ctx context.Context, arg queries.GetScanBruteforceShardsParams) ([]*queries.ScanBruteforceShard, error)
+	CancelScanBruteforceShards(ctx context.Context, arg queries.CancelScanBruteforceShardsParams) error
+}
+
+// ShardRunner is implemented by bruteforce providers that can run a shard
+// created by the bruteforcer of another worker.
+type ShardRunner interface {
+	RunShard(ctx context.Context, user scanner.User, projectID int64, shard *queries.ScanBruteforceShard, progress ShardProgressFunc) (BruteforceUserStatus, error)
+}
+
+// ShardProgressFunc is called every second while a shard is running, with
+// the number of candidates tried so far and the highest internal ID among
+// them. Returning an error stops the shard, for example because another
+// worker already found the password.
+type ShardProgressFunc = func(BruteforceUserStatus) error
+
+// shardPollInterval is how often the bruteforcer checks the shards it
+// created.
+var shardPollInterval = time.Second
+
+// shardClaimTimeout is how long the bruteforcer waits for another worker to
+// claim one of the shards it created, before running all of them itself.
+var shardClaimTimeout = time.Minute
+
+// shardUsers creates the user of a shard from its hash, for every scanner
+// whose hashes can be verified without a connection to the database. The
+// users of the other scanners are never split into shards.
+var shardUsers = map[int32]func(username string, hash string) (scanner.User, error){
+	postgres.GetScannerID(): postgres.UserFromHash,
+	mysql.GetScannerID():    mysql.UserFromHash,
+	redis.GetScannerID():    redis.UserFromHash,
+	etcd.GetScannerID():     etcd.UserFromHash,
+}
+
+// ShardUser creates the user that shard bruteforces from its username and
+// password hash.
+func ShardUser(shard *queries.ScanBruteforceShard) (scanner.User, error) {
+	userFromHash, ok := shardUsers[shard.ScanType]
+	if !ok {
+		return nil, fmt.Errorf("cannot run bruteforce shards of scan type %d", shard.ScanType)
+	}
+	return userFromHash(shard.Username, shard.Hash)
+}
+
+// WithShards splits the candidates of a user into shards of size internal
+// IDs, if there are more than size of them and the user has a password
+// hash. The shards are stored with q, so that they can be run by every worker
+// of the organization. It only has an effect together with WithScan.
+func WithShards(q ShardQuerier, size int64) Option {
+	return func(br *bruteforcer) {
+		br.shards = q
+		br.shardSize = size
+	}
+}
+
+// WithScan sets the scan that the shards created with WithShards belong to.
+func WithScan(scanID int64) Option {
+	return func(br *bruteforcer) {
+		br.scanID = scanID
+	}
+}
+
+func (br *bruteforcer) shouldShard(u scanner.User, hash string, start int64) bool {
+	if br.shards == nil || br.shardSize <= 0 || br.scanID == 0 || hash == "" || br.scanner == nil {
+		return false
+	}
+	if _, ok := shardUsers[br.scanner.GetScannerID()]; !ok {
+		return false
+	}
+	br.statusLock.Lock()
+	total := br.status[u].Total
+	br.statusLock.Unlock()
+	return total-max(start, 0) > br.shardSize
+}
+
+// bruteforceSharded tries the candidates with negative internal IDs, which
+// are the ones found in the project, and splits the others into shards. It
+// then waits for the workers to run the shards and merges their progress into
+// the status of u, until one of them finds the password or all of them
+// finish. The shards that fail are run by the bruteforcer itself, and so are
+// all of them if no worker claims one within shardClaimTimeout.
+func (br *bruteforcer) bruteforceSharded(ctx context.Context, u scanner.User, username string, hash string, start int64) (string, error) {
+	if err := br.passwordProvider.Start(start); err != nil {
+		return "", err
+	}
+	head := newRangePasswordProvider(br.passwordProvider, math.MinInt64, sql.NullInt64{Int64: 0, Valid: true})
+	pass, internalID, err := br.tryPasswords(ctx, u, head)
+	br.passwordProvider.Close()
+	if err != nil {
+		return "", errors.Join(err, br.markStatusAsUnsolved(ctx, u))
+	}
+	if pass != "" {
+		return pass, br.markStatusAsSolved(ctx, u, pass, internalID)
+	}
+
+	br.statusLock.Lock()
+	status := br.status[u]
+	br.statusLock.Unlock()
+
+	start = max(start, 0)
+	key := queries.GetScanBruteforceShardsParams{
+		ScanID:   br.scanID,
+		ScanType: br.scanner.GetScannerID(),
+		Username: username,
+	}
+	shards, err := br.shards.CreateScanBruteforceShards(ctx, queries.CreateScanBruteforceShardsParams{
+		ScanID:    key.ScanID,
+		ScanType:  key.ScanType,
+		Username:  key.Username,
+		Hash:      hash,
+		ShardSize: br.shardSize,
+		StartID:   start,
+		Count:     status.Total - start,
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not create bruteforce shards: %w", err)
+	}
+	slog.InfoContext(ctx, "Split bruteforce into shards", "user", username, "shards", len(shards), "shard_size", br.shardSize)
+
+	// The shards that are still running are stopped once the password is
+	// found or the scan fails.
+	defer func() {
+		if err := br.shards.CancelScanBruteforceShards(context.WithoutCancel(ctx), queries.CancelScanBruteforceShardsParams(key)); err != nil {
+			slog.WarnContext(ctx, "Could not cancel bruteforce shards", "user", username, "error", err)
+		}
+	}()
+
+	// local holds the shards that were run by this bruteforcer, which
+	// replace the ones returned by the server.
+	local := map[int64]*queries.ScanBruteforceShard{}
+	created := time.Now()
+	ticker := time.NewTicker(shardPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return "", errors.Join(ctx.Err(), br.markStatusAsUnsolved(ctx, u))
+		case <-ticker.C:
+		}
+
+		shards, err := br.shards.GetScanBruteforceShards(ctx, key)
+		if err != nil {
+			return "", fmt.Errorf("could not get bruteforce shards: %w", err)
+		}
+
+		unclaimed := time.Since(created) >= shardClaimTimeout && allShardsPending(shards)
+		if unclaimed {
+			slog.InfoContext(ctx, "No worker claimed the bruteforce shards, running them locally", "user", username, "shards", len(shards))
+			if err := br.shards.CancelScanBruteforceShards(ctx, queries.CancelScanBruteforceShardsParams(key)); err != nil {
+				return "", fmt.Errorf("could not cancel bruteforce shards: %w", err)
+			}
+		}
+		for i, shard := range shards {
+			if result, ok := local[shard.ID]; ok {
+				shards[i] = result
+				continue
+			}
+			if !unclaimed && shard.Status != models.BRUTEFORCE_SHARD_FAILED {
+				continue
+			}
+			if shard.Status == models.BRUTEFORCE_SHARD_FAILED {
+				slog.WarnContext(ctx, "Bruteforce shard failed, running it locally", "user", username, "shard", shard.ID)
+			}
+			result, err := br.runShardLocally(ctx, u, shard)
+			if err != nil {
+				return "", errors.Join(err, br.markStatusAsUnsolved(ctx, u))
+			}
+			local[shard.ID] = result
+			shards[i] = result
+			if result.Password.Valid {
+				break
+			}
+		}
+
+		progress := mergeShards(shards)
+		if progress.password != "" {
+			return progress.password, br.markStatusAsSolved(ctx, u, progress.password, progress.internalID)
+		}
+		if progress.done {
+			return "", br.markStatusAsUnsolved(ctx, u)
+		}
+
+		br.statusLock.Lock()
+		entry := br.status[u]
+		entry.Tried = min(status.Tried+progress.tried, entry.Total)
+		entry.MaximumInternalID = progress.internalID
+		br.status[u] = entry
+		br.statusLock.Unlock()
+		if err := br.updateStatus(ctx); err != nil {
+			return "", err
+		}
+	}
+}
+
+// runShardLocally tries the candidates of shard that were not tried yet,
+// for the shards that no other worker could run. It returns shard with the
+// progress of the run, as if a worker had finished it.
+func (br *bruteforcer) runShardLocally(ctx context.Context, u scanner.User, shard *queries.ScanBruteforceShard) (*queries.ScanBruteforceShard, error) {
+	if err := br.passwordProvider.Start(max(shard.NextID-1, 0)); err != nil {
+		return nil, err
+	}
+	defer br.passwordProvider.Close()
+
+	br.statusLock.Lock()
+	before := br.status[u].Tried
+	br.statusLock.Unlock()
+
+	provider := newRangePasswordProvider(br.passwordProvider, shard.NextID, shard.EndID)
+	pass, internalID, err := br.tryPasswords(ctx, u, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	br.statusLock.Lock()
+	status := br.status[u]
+	br.statusLock.Unlock()
+
+	result := *shard
+	result.Status = models.BRUTEFORCE_SHARD_FINISHED
+	result.Tried += status.Tried - before
+	if shard.EndID.Valid {
+		result.NextID = shard.EndID.Int64
+	} else {
+		result.NextID = max(shard.NextID, status.MaximumInternalID+1)
+	}
+	if pass != "" {
+		result.Password = sql.NullString{String: pass, Valid: true}
+		result.NextID = internalID
+	}
+	return &result, nil
+}
+
+// allShardsPending returns true if none of the shards was claimed by a worker
+// yet.
+func allShardsPending(shards []*queries.ScanBruteforceShard) bool {
+	for _, shard := range shards {
+		if shard.Status != models.BRUTEFORCE_SHARD_PENDING {
+			return false
+		}
+	}
+	return true
+}
+
+type shardProgress struct {
+	tried int64
+	// internalID is the position the bruteforce can be resumed from, or the
+	// ID of the password if one was found.
+	internalID int64
+	password   string
+	done       bool
+}
+
+// mergeShards sums the progress of the shards of a user, which must be
+// ordered by their start.
+func mergeShards(shards []*queries.ScanBruteforceShard) shardProgress {
+	progress := shardProgress{done: true}
+	resumed := false
+	for _, shard := range shards {
+		progress.tried += shard.Tried
+		if shard.Password.Valid {
+			progress.password = shard.Password.String
+			progress.internalID = shard.NextID
+		}
+
+		finished := shard.Status == models.BRUTEFORCE_SHARD_FINISHED || shard.Status == models.BRUTEFORCE_SHARD_CANCELLED
+		if !finished {
+			progress.done = false
+		}
+		if resumed || progress.password != "" {
+			continue
+		}
+		progress.internalID = shard.NextID
+		if !finished {
+			resumed = true
+		} else if shard.EndID.Valid {
+			progress.internalID = shard.EndID.Int64
+		}
+	}
+	return progress
+}
+
+// rangePasswordProvider returns the candidates of another provider whose
+// internal IDs are in [start, end), or greater than start if end is not
+// valid. The IDs of the wrapped provider must be ascending, which they are
+// for all of the providers of this package.
+type rangePasswordProvider struct {
+	PasswordProvider
+	start int64
+	end   sql.NullInt64
+
+	done  bool
+	error error
+}
+
+var _ skippingPasswordProvider = (*rangePasswordProvider)(nil)
+
+func newRangePasswordProvider(provider PasswordProvider, start int64, end sql.NullInt64) *rangePasswordProvider {
+	return &rangePasswordProvider{
+		PasswordProvider: provider,
+		start:            start,
+		end:              end,
+	}
+}
+
+func (p *rangePasswordProvider) Next() bool {
+	if p.done {
+		return false
+	}
+	for p.PasswordProvider.Next() {
+		id, _, err := p.PasswordProvider.Current()
+		if err != nil {
+			p.error = err
+			return false
+		}
+		if id < p.start {
+			continue
+		}
+		if p.end.Valid && id >= p.end.Int64 {
+			p.done = true
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+func (p *rangePasswordProvider) Error() error {
+	if p.error != nil {
+		return p.error
+	}
+	return p.PasswordProvider.Error()
+}
+
+func (p *rangePasswordProvider) Skipped() int64 {
+	if skipping, ok := p.PasswordProvider.(skippingPasswordProvider); ok {
+		return skipping.Skipped()
+	}
+	return 0
+}
+
+var _ ShardRunner = (*databaseBruteforceProvider)(nil)
+
+// RunShard tries the candidates of a shard for user. The candidates come
+// from the same providers as the ones of NewBruteforcer, so the workers must
+// be configured with the same wordlists as the one that created the shard.
+// It returns the progress of the shard, with the password if it was found.
+func (d *databaseBruteforceProvider) RunShard(ctx context.Context, user scanner.User, projectID int64, shard *queries.ScanBruteforceShard, progress ShardProgressFunc) (BruteforceUserStatus, error) {
+	passProvider, err := d.passwordProvider(ctx, projectID)
+	if err != nil {
+		return BruteforceUserStatus{}, err
+	}
+	defer passProvider.Close()
+
+	if err := passProvider.Start(max(shard.NextID-1, 0)); err != nil {
+		return BruteforceUserStatus{}, err
+	}
+	provider := newRangePasswordProvider(passProvider, shard.NextID, shard.EndID)
+
+	br := NewBruteforcer(provider, nil, func(status map[scanner.User]BruteforceUserStatus) error {
+		return progress(status[user])
+	}, d.options()...)
+	br.status[user] = BruteforceUserStatus{
+		Tried:             shard.Tried,
+		MaximumInternalID: shard.NextID - 1,
+	}
+
+	pass, internalID, err := br.tryPasswords(ctx, user, provider)
+
+	br.statusLock.Lock()
+	defer br.statusLock.Unlock()
+	status := br.status[user]
+	if err != nil {
+		return status, err
+	}
+	if pass != "" {
+		status.FoundPassword = pass
+		status.MaximumInternalID = internalID
+	}
+	return status, nil
+}
@@ -0,0 +1,241 @@
+package bruteforce
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/tedyst/licenta/db/queries"
+	"github.com/tedyst/licenta/models"
+	"github.com/tedyst/licenta/scanner"
+	"github.com/tedyst/licenta/scanner/redis"
+)
+
+func TestMergeShards(t *testing.T) {
+	end := func(id int64) sql.NullInt64 { return sql.NullInt64{Int64: id, Valid: true} }
+	tests := []struct {
+		name   string
+		shards []*queries.ScanBruteforceShard
+		want   shardProgress
+	}{
+		{
+			name: "running",
+			shards: []*queries.ScanBruteforceShard{
+				{StartID: 0, EndID: end(10), NextID: 10, Tried: 10, Status: models.BRUTEFORCE_SHARD_FINISHED},
+				{StartID: 10, EndID: end(20), NextID: 14, Tried: 4, Status: models.BRUTEFORCE_SHARD_RUNNING},
+				{StartID: 20, NextID: 25, Tried: 5, Status: models.BRUTEFORCE_SHARD_RUNNING},
+			},
+			want: shardProgress{tried: 19, internalID: 14},
+		},
+		{
+			name: "finished",
+			shards: []*queries.ScanBruteforceShard{
+				{StartID: 0, EndID: end(10), NextID: 10, Tried: 10, Status: models.BRUTEFORCE_SHARD_FINISHED},
+				{StartID: 10, NextID: 15, Tried: 5, Status: models.BRUTEFORCE_SHARD_FINISHED},
+			},
+			want: shardProgress{tried: 15, internalID: 15, done: true},
+		},
+		{
+			name: "found",
+			shards: []*queries.ScanBruteforceShard{
+				{StartID: 0, EndID: end(10), NextID: 3, Tried: 3, Status: models.BRUTEFORCE_SHARD_CANCELLED},
+				{StartID: 10, NextID: 12, Tried: 2, Status: models.BRUTEFORCE_SHARD_FINISHED, Password: sql.NullString{String: "secret", Valid: true}},
+			},
+			want: shardProgress{tried: 5, internalID: 12, password: "secret", done: true},
+		},
+		{
+			name: "pending",
+			shards: []*queries.ScanBruteforceShard{
+				{StartID: 0, EndID: end(10), NextID: 0, Status: models.BRUTEFORCE_SHARD_PENDING},
+				{StartID: 10, NextID: 10, Status: models.BRUTEFORCE_SHARD_PENDING},
+			},
+			want: shardProgress{},
+		},
+		{
+			name: "failed",
+			shards: []*queries.ScanBruteforceShard{
+				{StartID: 0, EndID: end(10), NextID: 10, Tried: 10, Status: models.BRUTEFORCE_SHARD_FINISHED},
+				{StartID: 10, NextID: 13, Tried: 3, Status: models.BRUTEFORCE_SHARD_FAILED},
+			},
+			want: shardProgress{tried: 13, internalID: 13},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergeShards(tt.shards); got != tt.want {
+				t.Errorf("mergeShards() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRangePasswordProvider(t *testing.T) {
+	tests := []struct {
+		name  string
+		start int64
+		end   sql.NullInt64
+		want  []candidate
+	}{
+		{
+			name:  "bounded",
+			start: 1,
+			end:   sql.NullInt64{Int64: 3, Valid: true},
+			want:  []candidate{{1, "b"}, {2, "c"}},
+		},
+		{
+			name:  "unbounded",
+			start: 3,
+			want:  []candidate{{3, "d"}, {4, "e"}},
+		},
+		{
+			name:  "empty",
+			start: 2,
+			end:   sql.NullInt64{Int64: 2, Valid: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newRangePasswordProvider(NewPasswordListIterator([]string{"a", "b", "c", "d", "e"}), tt.start, tt.end)
+			var got []candidate
+			for p.Next() {
+				id, password, err := p.Current()
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, candidate{id, password})
+			}
+			if err := p.Error(); err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("candidates = %v, want %v", got, tt.want)
+			}
+			if p.Next() {
+				t.Error("Next() = true after the end of the range")
+			}
+		})
+	}
+}
+
+type fakeShardQuerier struct {
+	shards    []*queries.ScanBruteforceShard
+	cancelled bool
+}
+
+func (q *fakeShardQuerier) CreateScanBruteforceShards(ctx context.Context, arg queries.CreateScanBruteforceShardsParams) ([]*queries.ScanBruteforceShard, error) {
+	return q.shards, nil
+}
+
+func (q *fakeShardQuerier) GetScanBruteforceShards(ctx context.Context, arg queries.GetScanBruteforceShardsParams) ([]*queries.ScanBruteforceShard, error) {
+	shards := make([]*queries.ScanBruteforceShard, len(q.shards))
+	for i, shard := range q.shards {
+		copied := *shard
+		shards[i] = &copied
+	}
+	return shards, nil
+}
+
+func (q *fakeShardQuerier) CancelScanBruteforceShards(ctx context.Context, arg queries.CancelScanBruteforceShardsParams) error {
+	q.cancelled = true
+	return nil
+}
+
+type fakeShardScanner struct {
+	scanner.Scanner
+}
+
+func (fakeShardScanner) GetScannerID() int32 {
+	return redis.GetScannerID()
+}
+
+func TestBruteforceSharded(t *testing.T) {
+	end := func(id int64) sql.NullInt64 { return sql.NullInt64{Int64: id, Valid: true} }
+	tests := []struct {
+		name         string
+		claimTimeout time.Duration
+		shards       []*queries.ScanBruteforceShard
+	}{
+		{
+			name:         "not claimed",
+			claimTimeout: 0,
+			shards: []*queries.ScanBruteforceShard{
+				{ID: 1, StartID: 0, EndID: end(3), NextID: 0, Status: models.BRUTEFORCE_SHARD_PENDING},
+				{ID: 2, StartID: 3, NextID: 3, Status: models.BRUTEFORCE_SHARD_PENDING},
+			},
+		},
+		{
+			name:         "failed",
+			claimTimeout: time.Hour,
+			shards: []*queries.ScanBruteforceShard{
+				{ID: 1, StartID: 0, EndID: end(3), NextID: 3, Tried: 3, Status: models.BRUTEFORCE_SHARD_FINISHED},
+				{ID: 2, StartID: 3, NextID: 3, Status: models.BRUTEFORCE_SHARD_FAILED},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldPoll, oldTimeout := shardPollInterval, shardClaimTimeout
+			shardPollInterval, shardClaimTimeout = time.Millisecond, tt.claimTimeout
+			defer func() { shardPollInterval, shardClaimTimeout = oldPoll, oldTimeout }()
+
+			hash := sha256.Sum256([]byte("d"))
+			user, err := redis.UserFromHash("app", hex.EncodeToString(hash[:]))
+			if err != nil {
+				t.Fatal(err)
+			}
+			q := &fakeShardQuerier{shards: tt.shards}
+			br := NewBruteforcer(NewPasswordListIterator([]string{"a", "b", "c", "d", "e"}), fakeShardScanner{}, func(map[scanner.User]BruteforceUserStatus) error {
+				return nil
+			}, WithShards(q, 3), WithScan(1))
+			br.status[user] = BruteforceUserStatus{Total: 5}
+
+			got, err := br.bruteforceSharded(context.Background(), user, "app", hex.EncodeToString(hash[:]), 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != "d" {
+				t.Errorf("bruteforceSharded() = %q, want %q", got, "d")
+			}
+			if !q.cancelled {
+				t.Error("shards were not cancelled")
+			}
+		})
+	}
+}
+
+type fakeUnshardableScanner struct {
+	scanner.Scanner
+}
+
+func (fakeUnshardableScanner) GetScannerID() int32 {
+	return models.SCAN_MONGODB
+}
+
+func TestBruteforcer_shouldShard(t *testing.T) {
+	tests := []struct {
+		name    string
+		scanner scanner.Scanner
+		want    bool
+	}{
+		{name: "hashes verified offline", scanner: fakeShardScanner{}, want: true},
+		{name: "hashes need a connection", scanner: fakeUnshardableScanner{}, want: false},
+		{name: "no scanner", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := redis.UserFromHash("app", hex.EncodeToString(make([]byte, sha256.Size)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			br := NewBruteforcer(NewPasswordListIterator(nil), tt.scanner, nil, WithShards(&fakeShardQuerier{}, 3), WithScan(1))
+			br.status[user] = BruteforceUserStatus{Total: 10}
+			if got := br.shouldShard(user, "hash", 0); got != tt.want {
+				t.Errorf("shouldShard() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
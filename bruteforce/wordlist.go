@@ -0,0 +1,157 @@
+package bruteforce
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/klauspost/compress/zstd"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+// charsetSampleSize is how much of a wordlist is read to detect its charset.
+const charsetSampleSize = 64 * 1024
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	// bzip2 streams start with BZh, the block size and the magic number of
+	// the first block, so that a wordlist starting with BZh is not mistaken
+	// for one.
+	bzip2Magic      = []byte("BZh")
+	bzip2BlockMagic = []byte{0x31, 0x41, 0x59, 0x26, 0x53, 0x59}
+)
+
+func isBzip2(magic []byte) bool {
+	return len(magic) >= 10 && bytes.HasPrefix(magic, bzip2Magic) &&
+		magic[3] >= '1' && magic[3] <= '9' && bytes.Equal(magic[4:10], bzip2BlockMagic)
+}
+
+type wordlistReader struct {
+	io.Reader
+	closers []func() error
+}
+
+func (r *wordlistReader) Close() error {
+	var err error
+	for _, closer := range r.closers {
+		err = errors.Join(err, closer())
+	}
+	return err
+}
+
+// decompress detects gzip, bzip2 and zstd streams by their magic bytes and
+// returns r unchanged if it is not compressed.
+func decompress(r *bufio.Reader) (io.Reader, func() error, error) {
+	magic, err := r.Peek(10)
+	if err != nil && err != io.EOF {
+		return nil, nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		reader, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not read gzip wordlist: %w", err)
+		}
+		return reader, reader.Close, nil
+	case isBzip2(magic):
+		return bzip2.NewReader(r), nil, nil
+	case bytes.HasPrefix(magic, zstdMagic):
+		reader, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not read zstd wordlist: %w", err)
+		}
+		return reader, func() error { reader.Close(); return nil }, nil
+	default:
+		return r, nil, nil
+	}
+}
+
+// detectCharset guesses the encoding of a wordlist from a sample of its
+// start. Byte order marks are handled by the returned decoder, text that is
+// valid UTF-8 is kept and NUL bytes in every other position are taken as
+// UTF-16 without a BOM. Everything else is decoded as ISO8859-1, which is
+// the encoding of most leaked password lists.
+func detectCharset(sample []byte, eof bool) encoding.Encoding {
+	if !eof {
+		// Do not count a rune cut at the end of the sample as invalid.
+		for i := 1; i < utf8.UTFMax && i <= len(sample); i++ {
+			if utf8.RuneStart(sample[len(sample)-i]) {
+				if !utf8.FullRune(sample[len(sample)-i:]) {
+					sample = sample[:len(sample)-i]
+				}
+				break
+			}
+		}
+	}
+
+	var evenNUL, oddNUL int
+	for i, b := range sample {
+		if b != 0 {
+			continue
+		}
+		if i%2 == 0 {
+			evenNUL++
+		} else {
+			oddNUL++
+		}
+	}
+	switch {
+	case oddNUL > len(sample)/4 && evenNUL == 0:
+		return unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)
+	case evenNUL > len(sample)/4 && oddNUL == 0:
+		return unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM)
+	case utf8.Valid(sample):
+		return unicode.UTF8
+	default:
+		return charmap.ISO8859_1
+	}
+}
+
+// DecodeWordlist decompresses r if needed and decodes it to UTF-8. Closing
+// the result releases the decompressor, but does not close r.
+func DecodeWordlist(r io.Reader) (io.ReadCloser, error) {
+	result := &wordlistReader{}
+
+	raw, closer, err := decompress(bufio.NewReader(r))
+	if err != nil {
+		return nil, err
+	}
+	if closer != nil {
+		result.closers = append(result.closers, closer)
+	}
+
+	buffered := bufio.NewReaderSize(raw, charsetSampleSize)
+	sample, err := buffered.Peek(charsetSampleSize)
+	if err != nil && err != io.EOF {
+		return nil, errors.Join(fmt.Errorf("could not read wordlist: %w", err), result.Close())
+	}
+	charset := detectCharset(sample, err == io.EOF)
+
+	result.Reader = transform.NewReader(buffered, unicode.BOMOverride(charset.NewDecoder()))
+	return result, nil
+}
+
+// readWordlistLine returns the next line without its line ending. It returns
+// io.EOF only after the last line.
+func readWordlistLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if line == "" {
+		return "", err
+	}
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	line = strings.TrimSuffix(line, "\n")
+	return strings.TrimSuffix(line, "\r"), nil
+}
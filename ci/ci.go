@@ -42,13 +42,20 @@ func waitForScan(ctx context.Context, client generated.ClientWithResponsesInterf
 				}
 
 				for _, result := range response.JSON200.Results {
+					attrs := []any{"scan", scan.Id, "severity", result.Severity, "title", result.Message, "source", result.ScanSource}
+					if result.Finding != nil {
+						attrs = append(attrs, "rule", result.Finding.RuleId)
+					}
+					if result.Cve != nil {
+						attrs = append(attrs, "cve", result.Cve.CveId, "score", result.Cve.Score, "vector", result.Cve.Vector, "fixed_in", result.Cve.FixedIn)
+					}
 					switch result.Severity {
 					case int(scanner.SEVERITY_WARNING):
-						slog.InfoContext(ctx, "Found problem", "scan", scan.Id, "severity", result.Severity, "title", result.Message, "source", result.ScanSource)
+						slog.InfoContext(ctx, "Found problem", attrs...)
 					case int(scanner.SEVERITY_MEDIUM):
-						slog.WarnContext(ctx, "Found problem", "scan", scan.Id, "severity", result.Severity, "title", result.Message, "source", result.ScanSource)
+						slog.WarnContext(ctx, "Found problem", attrs...)
 					case int(scanner.SEVERITY_HIGH):
-						slog.ErrorContext(ctx, "Found problem", "scan", scan.Id, "severity", result.Severity, "title", result.Message, "source", result.ScanSource)
+						slog.ErrorContext(ctx, "Found problem", attrs...)
 					}
 				}
 
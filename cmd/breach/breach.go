@@ -0,0 +1,15 @@
+package breach
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewBreachCmd() *cobra.Command {
+	return breachCmd
+}
+
+var breachCmd = &cobra.Command{
+	Use:   "breach",
+	Short: "Breached passwords corpus management",
+	Long:  `This command allows you to import a corpus of breached passwords, like Pwned Passwords, and query it without calling an external service.`,
+}
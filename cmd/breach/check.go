@@ -0,0 +1,85 @@
+package breach
+
+import (
+	"bufio"
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tedyst/licenta/breach"
+)
+
+func openIndex() (*breach.Index, error) {
+	path := viper.GetString("breach-index")
+	if path == "" {
+		return nil, errors.New("breach-index is not set")
+	}
+	return breach.OpenIndex(path)
+}
+
+var checkCmd = &cobra.Command{
+	Use:   "check [password...]",
+	Short: "Check passwords against the breach index",
+	Long:  `This command prints how many times every password appears in the breach index. Without arguments, the passwords are read from the standard input, one per line.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		index, err := openIndex()
+		if err != nil {
+			return err
+		}
+		defer index.Close()
+
+		check := func(password string) error {
+			count, err := index.Count(cmd.Context(), password)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("%s\t%d\n", password, count)
+			return nil
+		}
+
+		if len(args) > 0 {
+			for _, password := range args {
+				if err := check(password); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		lines := bufio.NewScanner(cmd.InOrStdin())
+		for lines.Scan() {
+			if err := check(lines.Text()); err != nil {
+				return err
+			}
+		}
+		return lines.Err()
+	},
+}
+
+var rangeCmd = &cobra.Command{
+	Use:   "range [prefix]",
+	Short: "Print the hashes of the breach index that start with a prefix",
+	Long:  `This command prints the hashes that start with the given five hex characters, in the same format as the Pwned Passwords range API.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		index, err := openIndex()
+		if err != nil {
+			return err
+		}
+		defer index.Close()
+
+		entries, err := index.Range(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			cmd.Printf("%s:%d\n", entry.Suffix, entry.Count)
+		}
+		return nil
+	},
+}
+
+func init() {
+	breachCmd.AddCommand(checkCmd)
+	breachCmd.AddCommand(rangeCmd)
+}
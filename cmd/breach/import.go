@@ -0,0 +1,42 @@
+package breach
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/spf13/cobra"
+	"github.com/tedyst/licenta/breach"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import [index] [source...]",
+	Short: "Import SHA-1 range files into a breach index",
+	Long: `This command creates a breach index from SHA-1 range files, like the ones saved by the Pwned Passwords downloader. A source can be a directory of range files named by their five character prefix, a single range file or a file with full hashes ordered by hash. The files can be compressed with gzip.
+
+The hashes must be in ascending order across all of the sources. The index replaces the file at the given path only after the import succeeds.`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		writer, err := breach.CreateIndex(args[0])
+		if err != nil {
+			return err
+		}
+
+		for _, source := range args[1:] {
+			added, err := breach.ImportPath(writer, source)
+			if err != nil {
+				return errors.Join(err, writer.Abort())
+			}
+			slog.InfoContext(cmd.Context(), "Imported range files", "source", source, "hashes", added)
+		}
+
+		if err := writer.Close(); err != nil {
+			return err
+		}
+		slog.InfoContext(cmd.Context(), "Created breach index", "path", args[0], "hashes", writer.Len())
+		return nil
+	},
+}
+
+func init() {
+	breachCmd.AddCommand(importCmd)
+}
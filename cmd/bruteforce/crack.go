@@ -0,0 +1,242 @@
+package bruteforce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tedyst/licenta/breach"
+	"github.com/tedyst/licenta/bruteforce"
+	"github.com/tedyst/licenta/db"
+	"github.com/tedyst/licenta/nvd"
+	"github.com/tedyst/licenta/scanner"
+	"github.com/tedyst/licenta/scanner/mongodb"
+	"github.com/tedyst/licenta/scanner/mysql"
+	"github.com/tedyst/licenta/scanner/postgres"
+	"github.com/tedyst/licenta/scanner/redis"
+)
+
+var dumpParsers = map[string]struct {
+	parse     func(io.Reader) ([]scanner.User, error)
+	scannerID int32
+}{
+	"postgres": {postgres.ParseUserDump, postgres.GetScannerID()},
+	"mysql":    {mysql.ParseUserDump, mysql.GetScannerID()},
+	"mongodb":  {mongodb.ParseUserDump, mongodb.GetScannerID()},
+	"redis":    {redis.ParseUserDump, redis.GetScannerID()},
+}
+
+// dumpScanner returns the users parsed from a dump, so that they can be
+// bruteforced without a connection to the database they came from.
+type dumpScanner struct {
+	name      string
+	scannerID int32
+	users     []scanner.User
+}
+
+var _ scanner.Scanner = (*dumpScanner)(nil)
+
+func (sc *dumpScanner) GetScannerName() string {
+	return sc.name + " dump"
+}
+
+func (sc *dumpScanner) GetScannerID() int32 {
+	return sc.scannerID
+}
+
+func (sc *dumpScanner) GetNvdProductType() nvd.Product {
+	return nvd.PRODUCT_UNKNOWN
+}
+
+func (sc *dumpScanner) ShouldNotBePublic() bool {
+	return false
+}
+
+func (sc *dumpScanner) Ping(context.Context) error {
+	return scanner.ErrPingNotSupported
+}
+
+func (sc *dumpScanner) CheckPermissions(context.Context) error {
+	return scanner.ErrCheckPermissionsNotSupported
+}
+
+func (sc *dumpScanner) ScanConfig(context.Context) ([]scanner.ScanResult, error) {
+	return nil, scanner.ErrScanConfigNotSupported
+}
+
+func (sc *dumpScanner) GetUsers(context.Context) ([]scanner.User, error) {
+	return sc.users, nil
+}
+
+func (sc *dumpScanner) GetVersion(context.Context) (string, error) {
+	return "", scanner.ErrVersionNotSupported
+}
+
+type crackedAccount struct {
+	Username   string `json:"username"`
+	Password   string `json:"password"`
+	Privileged bool   `json:"privileged"`
+	Hash       string `json:"hash"`
+	// BreachCount is how many times the password appears in the breach
+	// corpus, if one is configured.
+	BreachCount int64 `json:"breach_count,omitempty"`
+}
+
+func readUserDump(kind string, path string) (*dumpScanner, error) {
+	parser, ok := dumpParsers[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown dump type %q", kind)
+	}
+
+	file := os.Stdin
+	if path != "-" {
+		var err error
+		file, err = os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not open dump: %w", err)
+		}
+		defer file.Close()
+	}
+
+	users, err := parser.parse(file)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %s dump: %w", kind, err)
+	}
+	return &dumpScanner{name: kind, scannerID: parser.scannerID, users: users}, nil
+}
+
+func crackedAccounts(ctx context.Context, users []scanner.User, status map[scanner.User]bruteforce.BruteforceUserStatus, checker breach.Checker) ([]crackedAccount, error) {
+	accounts := []crackedAccount{}
+	for _, user := range users {
+		password := status[user].FoundPassword
+		if password == "" {
+			continue
+		}
+		username, err := user.GetUsername()
+		if err != nil {
+			return nil, fmt.Errorf("could not get username: %w", err)
+		}
+		privileged, err := user.IsPrivileged()
+		if err != nil {
+			return nil, fmt.Errorf("could not check if user is privileged: %w", err)
+		}
+		hash, err := user.GetHashedPassword()
+		if err != nil {
+			return nil, fmt.Errorf("could not get hashed password: %w", err)
+		}
+		var breachCount int64
+		if checker != nil {
+			breachCount, err = checker.Count(ctx, password)
+			if err != nil {
+				return nil, fmt.Errorf("could not check breach corpus: %w", err)
+			}
+		}
+		accounts = append(accounts, crackedAccount{
+			Username:    username,
+			Password:    password,
+			Privileged:  privileged,
+			Hash:        hash,
+			BreachCount: breachCount,
+		})
+	}
+	return accounts, nil
+}
+
+func writeCrackedAccounts(w io.Writer, accounts []crackedAccount) error {
+	table := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(table, "USERNAME\tPASSWORD\tPRIVILEGED\tBREACHED")
+	for _, account := range accounts {
+		fmt.Fprintf(table, "%s\t%s\t%t\t%d\n", account.Username, account.Password, account.Privileged, account.BreachCount)
+	}
+	return table.Flush()
+}
+
+func writeCrackedAccountsJSON(path string, accounts []crackedAccount) (err error) {
+	w := os.Stdout
+	if path != "-" {
+		w, err = os.Create(path)
+		if err != nil {
+			return fmt.Errorf("could not create %s: %w", path, err)
+		}
+		defer func() {
+			err = errors.Join(err, w.Close())
+		}()
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(accounts)
+}
+
+var crackCmd = &cobra.Command{
+	Use:   "crack [postgres|mysql|mongodb|redis] [file]",
+	Short: "Crack the password hashes from a user dump",
+	Long: `This command bruteforces the password hashes exported from a database, without connecting to it. It accepts pg_dumpall --roles-only output or a CSV export of pg_authid, a mysql --batch or CSV export of mysql.user, a mongoexport of admin.system.users and a Redis ACL file. Use - as the file to read the dump from the standard input.
+
+The passwords are taken from the configured bruteforce provider. If a database is configured, the passwords found before are reused and the new ones are saved.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		sc, err := readUserDump(args[0], args[1])
+		if err != nil {
+			return err
+		}
+
+		var database bruteforce.DatabasePasswordProviderInterface
+		if viper.GetString("database") != "" {
+			database = db.InitDatabase(viper.GetString("database"))
+		}
+		passProvider, err := bruteforce.NewConfiguredPasswordProvider(cmd.Context(), database, viper.GetInt64("project"))
+		if err != nil {
+			return err
+		}
+		if passProvider == nil {
+			return errors.New("no passwords to bruteforce with, configure a database or the file bruteforce provider")
+		}
+		defer passProvider.Close()
+
+		opts := []bruteforce.Option{bruteforce.WithConcurrency(viper.GetInt("bruteforce-concurrency"))}
+		var checker breach.Checker
+		index, err := bruteforce.OpenConfiguredBreachIndex()
+		if err != nil {
+			return err
+		}
+		if index != nil {
+			defer index.Close()
+			checker = index
+			opts = append(opts, bruteforce.WithBreachChecker(index))
+		}
+
+		var status map[scanner.User]bruteforce.BruteforceUserStatus
+		bruteforcer := bruteforce.NewBruteforcer(passProvider, sc, func(s map[scanner.User]bruteforce.BruteforceUserStatus) error {
+			status = s
+			return nil
+		}, opts...)
+		if _, err := bruteforcer.BruteforcePasswordAllUsers(cmd.Context()); err != nil {
+			return err
+		}
+
+		accounts, err := crackedAccounts(cmd.Context(), sc.users, status, checker)
+		if err != nil {
+			return err
+		}
+		if path := viper.GetString("json"); path != "" {
+			return writeCrackedAccountsJSON(path, accounts)
+		}
+		return writeCrackedAccounts(cmd.OutOrStdout(), accounts)
+	},
+}
+
+func init() {
+	// The dump is cracked offline, so unlike the other commands the database
+	// is optional.
+	crackCmd.Flags().String("database", "", "Database connection string, used to reuse and save the found passwords")
+	crackCmd.Flags().Int64("project", -1, "Project whose passwords are also tried")
+	crackCmd.Flags().String("json", "", "Write the cracked accounts as JSON to this file, or - for the standard output")
+
+	bruteforceCmd.AddCommand(crackCmd)
+}
@@ -8,13 +8,12 @@ import (
 	"github.com/spf13/viper"
 	"github.com/tedyst/licenta/bruteforce"
 	"github.com/tedyst/licenta/db"
-	"golang.org/x/text/encoding/charmap"
 )
 
 var downloadCmd = &cobra.Command{
 	Use:   "download [file]",
 	Short: "Download a file and import it into the database",
-	Long:  `Reads a file from the internet and imports it into the database. The file must be in ISO8859-1 encoding, otherwise the import will fail. The file must be a text file, with one password per line. Duplicate passwords will be ignored.`,
+	Long:  `Reads a file from the internet and imports it into the database. The file must be a text file with one password per line, optionally compressed with gzip, bzip2 or zstd. The charset is detected from the start of the file and falls back to ISO8859-1. Duplicate passwords will be ignored.`,
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var err error
@@ -35,7 +34,13 @@ var downloadCmd = &cobra.Command{
 			err = errors.Join(reader.Body.Close())
 		}()
 
-		return bruteforce.ImportFromReader(cmd.Context(), charmap.ISO8859_1.NewDecoder().Reader(reader.Body), database)
+		wordlist, err := bruteforce.DecodeWordlist(reader.Body)
+		if err != nil {
+			return err
+		}
+		defer wordlist.Close()
+
+		return bruteforce.ImportFromReader(cmd.Context(), wordlist, database)
 	},
 }
 
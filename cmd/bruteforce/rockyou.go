@@ -8,7 +8,6 @@ import (
 	"github.com/spf13/viper"
 	"github.com/tedyst/licenta/bruteforce"
 	"github.com/tedyst/licenta/db"
-	"golang.org/x/text/encoding/charmap"
 )
 
 const baseRockyouURL = "https://github.com/brannondorsey/naive-hashcat/releases/download/data/rockyou.txt"
@@ -37,7 +36,13 @@ var rockyouCmd = &cobra.Command{
 			err = errors.Join(reader.Body.Close())
 		}()
 
-		return bruteforce.ImportFromReader(cmd.Context(), charmap.ISO8859_1.NewDecoder().Reader(reader.Body), database)
+		wordlist, err := bruteforce.DecodeWordlist(reader.Body)
+		if err != nil {
+			return err
+		}
+		defer wordlist.Close()
+
+		return bruteforce.ImportFromReader(cmd.Context(), wordlist, database)
 	},
 }
 
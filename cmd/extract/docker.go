@@ -11,7 +11,6 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/tedyst/licenta/extractors/docker"
-	"github.com/tedyst/licenta/extractors/file"
 )
 
 var extractDockerCmd = &cobra.Command{
@@ -27,7 +26,7 @@ var extractDockerCmd = &cobra.Command{
 			return nil
 		}
 		ctx := context.Background()
-		fileScanner, err := file.NewScanner()
+		fileScanner, err := newFileScanner()
 		if err != nil {
 			fmt.Printf("%+v\n", err)
 		}
@@ -5,6 +5,8 @@ package extract
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tedyst/licenta/extractors/file"
 )
 
 var extractCmd = &cobra.Command{
@@ -16,3 +18,22 @@ var extractCmd = &cobra.Command{
 func NewExtractCmd() *cobra.Command {
 	return extractCmd
 }
+
+// newFileScanner creates the file scanner with the detector sets given by
+// --detector-sets, on top of the builtin detectors unless
+// --no-default-detectors is set.
+func newFileScanner() (*file.FileScanner, error) {
+	sets := []*file.DetectorSet{}
+	for _, path := range viper.GetStringSlice("detector-sets") {
+		set, err := file.LoadDetectorSet(path)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, set)
+	}
+	return file.NewScanner(file.WithDetectorSets(!viper.GetBool("no-default-detectors"), sets...))
+}
+
+func init() {
+	extractCmd.PersistentFlags().Bool("no-default-detectors", false, "Only use the detectors from --detector-sets")
+}
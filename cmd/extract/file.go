@@ -6,7 +6,6 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
-	"github.com/tedyst/licenta/extractors/file"
 )
 
 var extractFileCmd = &cobra.Command{
@@ -19,7 +18,7 @@ var extractFileCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		fileScanner, err := file.NewScanner()
+		fileScanner, err := newFileScanner()
 		if err != nil {
 			return err
 		}
@@ -6,7 +6,6 @@ import (
 
 	gitgo "github.com/go-git/go-git/v5"
 	"github.com/spf13/cobra"
-	"github.com/tedyst/licenta/extractors/file"
 	"github.com/tedyst/licenta/extractors/git"
 )
 
@@ -23,7 +22,7 @@ var extractGitCmd = &cobra.Command{
 		var err error
 		var scanner *git.GitScan
 
-		fileScanner, err := file.NewScanner()
+		fileScanner, err := newFileScanner()
 
 		if strings.HasPrefix(args[0], "https://") || strings.HasPrefix(args[0], "http://") || strings.HasPrefix(args[0], "git://") || strings.HasPrefix(args[0], "ssh://") {
 			slog.InfoContext(cmd.Context(), "Opening remote git repo", "url", args[0])
@@ -32,7 +32,10 @@ var natsCmd = &cobra.Command{
 			return err
 		}
 
-		bruteforceProvider := bruteforce.NewDatabaseBruteforceProvider(db)
+		bruteforceProvider, err := bruteforce.NewConfiguredBruteforceProvider(db)
+		if err != nil {
+			return err
+		}
 
 		emailSender := email.NewSendGridEmailSender(
 			viper.GetString("email-sendgrid"),
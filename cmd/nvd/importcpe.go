@@ -61,14 +61,14 @@ var importCpeCmd = &cobra.Command{
 			err = errors.Join(err, database.EndTransaction(cmd.Context(), err != nil))
 		}()
 
-		dbCpes, err := database.GetNvdCPEsByDBType(cmd.Context(), int32(nvd.POSTGRESQL))
+		dbCpes, err := database.GetNvdCPEsByDBType(cmd.Context(), int32(product))
 		if err != nil {
 			return err
 		}
 
 		for _, result := range result.Products {
 			fmt.Println("Trying to import", result.Cpe.CpeName, "...")
-			version, err := nvd.ExtractCpeVersionProduct(nvd.POSTGRESQL, result.Cpe.Titles)
+			version, err := nvd.ExtractCpeVersionProduct(product, result.Cpe)
 			if err != nil {
 				continue
 			}
@@ -91,7 +91,7 @@ var importCpeCmd = &cobra.Command{
 			if !found {
 				cpe, err = database.CreateNvdCPE(cmd.Context(), queries.CreateNvdCPEParams{
 					Cpe:          result.Cpe.CpeName,
-					DatabaseType: int32(nvd.POSTGRESQL),
+					DatabaseType: int32(product),
 					LastModified: pgtype.Timestamptz{Time: t, Valid: true},
 					Version:      version,
 				})
@@ -13,6 +13,7 @@ import (
 	"github.com/tedyst/licenta/db"
 	"github.com/tedyst/licenta/db/queries"
 	"github.com/tedyst/licenta/nvd"
+	"github.com/tedyst/licenta/tasks/local"
 )
 
 var importCveCmd = &cobra.Command{
@@ -25,6 +26,20 @@ var importCveCmd = &cobra.Command{
 		switch viper.GetString("product") {
 		case "postgresql":
 			product = nvd.POSTGRESQL
+		case "mysql":
+			product = nvd.MYSQL
+		case "redis":
+			product = nvd.REDIS
+		case "mongodb":
+			product = nvd.MONGODB
+		case "elasticsearch":
+			product = nvd.ELASTICSEARCH
+		case "opensearch":
+			product = nvd.OPENSEARCH
+		case "mssql":
+			product = nvd.MSSQL
+		case "etcd":
+			product = nvd.ETCD
 		default:
 			return errors.New("invalid product")
 		}
@@ -92,12 +107,17 @@ var importCveCmd = &cobra.Command{
 					Published:    pgtype.Timestamptz{Time: publishedDate, Valid: true},
 					LastModified: pgtype.Timestamptz{Time: lastModified, Valid: true},
 					Score:        score,
+					Vector:       result.Cve.Vector(),
 				})
 				if err != nil {
 					return fmt.Errorf("failed to create cve: %w", err)
 				}
 			}
 
+			if err := local.ImportVersionRanges(cmd.Context(), database, product, cve.ID, result.Cve); err != nil {
+				return err
+			}
+
 			_, err := database.GetCveCpeByCveAndCpe(cmd.Context(), queries.GetCveCpeByCveAndCpeParams{
 				CveID: cve.ID,
 				CpeID: cpe.ID,
@@ -122,7 +142,7 @@ var importCveCmd = &cobra.Command{
 
 func init() {
 	importCveCmd.Flags().String("file", "", "Load from file instead from API")
-	importCveCmd.Flags().String("product", "", "Product to import for: postgresql/mysql/redis")
+	importCveCmd.Flags().String("product", "", "Product to import for: postgresql/mysql/redis/mongodb/elasticsearch/opensearch/mssql/etcd")
 	importCveCmd.Flags().String("version", "", "Version to import for: 9.6.0/5.7.0/3.2.0")
 
 	if err := importCveCmd.MarkFlagRequired("product"); err != nil {
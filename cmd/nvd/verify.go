@@ -18,11 +18,8 @@ var verifyCmd = &cobra.Command{
 	Long:  `This command allows you to check a specific version of a product against the known vulnerabilities from the database.`,
 	Args:  cobra.ExactArgs(2),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		var product nvd.Product
-		switch args[0] {
-		case "postgres":
-			product = nvd.POSTGRESQL
-		default:
+		product := nvd.GetNvdProductType(args[0])
+		if product == nvd.PRODUCT_UNKNOWN {
 			return errors.New("product does not exist")
 		}
 
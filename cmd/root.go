@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"github.com/tedyst/licenta/cmd/breach"
 	"github.com/tedyst/licenta/cmd/bruteforce"
 	"github.com/tedyst/licenta/cmd/extract"
 	"github.com/tedyst/licenta/cmd/migrate"
@@ -116,12 +117,20 @@ func init() {
 	rootCmd.PersistentFlags().Bool("telemetry", false, "Enable telemetry")
 	rootCmd.PersistentFlags().String("telemetry-collector-endpoint", "", "Telemetry collector endpoint")
 	rootCmd.PersistentFlags().String("ssl-extra-ca", "", "Add extra CA file to the SSL certificate store")
+	rootCmd.PersistentFlags().StringSlice("rule-packs", []string{}, "Extra rule pack files or directories to load for configuration checks")
+	rootCmd.PersistentFlags().StringSlice("detector-sets", []string{}, "Secret detector sets (YAML or gitleaks TOML) used by the git, docker and file extractors")
+	rootCmd.PersistentFlags().String("bruteforce-provider", "database", "Where the bruteforce passwords come from: database or file")
+	rootCmd.PersistentFlags().StringSlice("wordlists", []string{}, "Wordlist files used by the file bruteforce provider, in priority order")
+	rootCmd.PersistentFlags().Int("bruteforce-concurrency", 10, "Number of passwords verified at the same time for a user")
+	rootCmd.PersistentFlags().Int64("bruteforce-shard-size", 0, "Split users with more bruteforce candidates than this into shards that are run by all of the workers of the organization, 0 to disable. Only set it on workers, the shards are handed out by /worker/get-task")
+	rootCmd.PersistentFlags().String("breach-index", "", "Breach corpus index created by breach import, used to check the found passwords")
 
 	rootCmd.AddCommand(user.NewUserCmd())
 	rootCmd.AddCommand(extract.NewExtractCmd())
 	rootCmd.AddCommand(scan.NewScanCmd())
 	rootCmd.AddCommand(nvd.NewNvdCmd())
 	rootCmd.AddCommand(bruteforce.NewBruteforceCmd())
+	rootCmd.AddCommand(breach.NewBreachCmd())
 	rootCmd.AddCommand(tasks.GetTasksCmd())
 	rootCmd.AddCommand(migrate.NewMigrateCmd())
 }
@@ -0,0 +1,122 @@
+package scan
+
+import (
+	"context"
+
+	"log/slog"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tedyst/licenta/bruteforce"
+	"github.com/tedyst/licenta/scanner"
+	"github.com/tedyst/licenta/scanner/etcd"
+)
+
+var scanEtcdCmd = &cobra.Command{
+	Use:   "etcd [url]",
+	Short: "Scan an etcd cluster member",
+	Long:  `This command allows you to scan an etcd cluster member manually, using its client URL such as https://127.0.0.1:2379. It does not update the database, so the results will not be visible in the web interface. The database connection string is not required, but is recommended for the bruteforce module. If a bruteforce is successful, the database will store that result in order to avoid repeating the bruteforce.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		sc, err := etcd.NewScanner(ctx, args[0], viper.GetString("username"), viper.GetString("password"),
+			etcd.WithSnapshotPasswordHashes(viper.GetBool("snapshot-hashes")))
+		if err != nil {
+			return err
+		}
+
+		err = sc.Ping(ctx)
+		if err != nil {
+			return err
+		}
+		slog.InfoContext(cmd.Context(), "Connection established")
+
+		v, err := sc.GetVersion(ctx)
+		if err != nil {
+			return err
+		}
+		slog.InfoContext(cmd.Context(), "Got version", "version", v)
+
+		err = sc.CheckPermissions(ctx)
+		if err != nil {
+			return err
+		}
+		slog.InfoContext(cmd.Context(), "Permissions checked")
+
+		results, err := sc.ScanConfig(ctx)
+		if err != nil {
+			return err
+		}
+		slog.InfoContext(cmd.Context(), "Config scanned")
+
+		for _, result := range results {
+			switch result.Severity() {
+			case scanner.SEVERITY_HIGH:
+				slog.ErrorContext(cmd.Context(), "Config scan result", "detail", result.Detail(), "severity", result.Severity())
+			case scanner.SEVERITY_MEDIUM:
+				slog.WarnContext(cmd.Context(), "Config scan result", "detail", result.Detail(), "severity", result.Severity())
+			case scanner.SEVERITY_WARNING:
+				slog.InfoContext(cmd.Context(), "Config scan result", "detail", result.Detail(), "severity", result.Severity())
+			default:
+			}
+		}
+
+		users, err := sc.GetUsers(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, user := range users {
+			username, err := user.GetUsername()
+			if err != nil {
+				return err
+			}
+			slog.DebugContext(cmd.Context(), "Found user", "user", username)
+		}
+
+		slog.Info("Users scanned")
+
+		passProvider, err := newPasswordProvider(ctx, 1)
+		if err != nil {
+			return err
+		}
+		if passProvider != nil {
+			defer passProvider.Close()
+
+			opts, err := bruteforceOptions()
+			if err != nil {
+				return err
+			}
+
+			bruteforcer := bruteforce.NewBruteforcer(passProvider, sc, func(m map[scanner.User]bruteforce.BruteforceUserStatus) error {
+				for user, entry := range m {
+					username, err := user.GetUsername()
+					if err != nil {
+						return err
+					}
+					slog.InfoContext(cmd.Context(), "Received update from function", "user", username, "password", entry.FoundPassword, "tried", entry.Tried, "total", entry.Total)
+				}
+				return nil
+			}, opts...)
+
+			result, err := bruteforcer.BruteforcePasswordAllUsers(ctx)
+			if err != nil {
+				return err
+			}
+			for _, result := range result {
+				slog.InfoContext(cmd.Context(), "Bruteforced passwords", "detail", result.Detail(), "severity", result.Severity())
+			}
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	scanEtcdCmd.Flags().String("database", "", "Database connection string")
+	scanEtcdCmd.Flags().String("username", "", "etcd username")
+	scanEtcdCmd.Flags().String("password", "", "etcd password")
+	scanEtcdCmd.Flags().Bool("snapshot-hashes", false, "Read the password hashes of the users from a snapshot of the member so that they can be bruteforced. The snapshot has every key, including the Kubernetes secrets, and is downloaded into memory")
+	scanCmd.AddCommand(scanEtcdCmd)
+}
@@ -6,9 +6,7 @@ import (
 	"log/slog"
 
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 	"github.com/tedyst/licenta/bruteforce"
-	"github.com/tedyst/licenta/db"
 	"github.com/tedyst/licenta/scanner"
 	"github.com/tedyst/licenta/scanner/mongodb"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -29,7 +27,12 @@ var scanMongodbCmd = &cobra.Command{
 			panic(err)
 		}
 
-		sc, err := mongodb.NewScanner(context.Background(), client)
+		engine, err := loadRuleEngine()
+		if err != nil {
+			return err
+		}
+
+		sc, err := mongodb.NewScanner(context.Background(), client, mongodb.WithRuleEngine(engine))
 		if err != nil {
 			return err
 		}
@@ -83,13 +86,17 @@ var scanMongodbCmd = &cobra.Command{
 
 		slog.Info("Users scanned")
 
-		if viper.GetString("database") != "" {
-			database := db.InitDatabase(viper.GetString("database"))
-			passProvider, err := bruteforce.NewDatabasePasswordProvider(ctx, database, 1)
+		passProvider, err := newPasswordProvider(ctx, 1)
+		if err != nil {
+			return err
+		}
+		if passProvider != nil {
+			defer passProvider.Close()
+
+			opts, err := bruteforceOptions()
 			if err != nil {
 				return err
 			}
-			defer passProvider.Close()
 
 			bruteforcer := bruteforce.NewBruteforcer(passProvider, sc, func(m map[scanner.User]bruteforce.BruteforceUserStatus) error {
 				for user, entry := range m {
@@ -100,7 +107,7 @@ var scanMongodbCmd = &cobra.Command{
 					slog.InfoContext(cmd.Context(), "Received update from function", "user", username, "password", entry.FoundPassword, "tried", entry.Tried, "total", entry.Total)
 				}
 				return nil
-			})
+			}, opts...)
 
 			result, err := bruteforcer.BruteforcePasswordAllUsers(ctx)
 			if err != nil {
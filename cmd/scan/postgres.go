@@ -7,9 +7,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 	"github.com/tedyst/licenta/bruteforce"
-	"github.com/tedyst/licenta/db"
 	"github.com/tedyst/licenta/scanner"
 	"github.com/tedyst/licenta/scanner/postgres"
 )
@@ -27,7 +25,12 @@ var scanPostgresCmd = &cobra.Command{
 			return err
 		}
 
-		sc, err := postgres.NewScanner(context.Background(), conn)
+		engine, err := loadRuleEngine()
+		if err != nil {
+			return err
+		}
+
+		sc, err := postgres.NewScanner(context.Background(), conn, postgres.WithRuleEngine(engine))
 		if err != nil {
 			return err
 		}
@@ -73,13 +76,17 @@ var scanPostgresCmd = &cobra.Command{
 
 		slog.Info("Users scanned")
 
-		if viper.GetString("database") != "" {
-			database := db.InitDatabase(viper.GetString("database"))
-			passProvider, err := bruteforce.NewDatabasePasswordProvider(ctx, database, -1)
+		passProvider, err := newPasswordProvider(ctx, -1)
+		if err != nil {
+			return err
+		}
+		if passProvider != nil {
+			defer passProvider.Close()
+
+			opts, err := bruteforceOptions()
 			if err != nil {
 				return err
 			}
-			defer passProvider.Close()
 
 			bruteforcer := bruteforce.NewBruteforcer(passProvider, sc, func(m map[scanner.User]bruteforce.BruteforceUserStatus) error {
 				for user, entry := range m {
@@ -90,7 +97,7 @@ var scanPostgresCmd = &cobra.Command{
 					slog.InfoContext(cmd.Context(), "Received update from function", "user", username, "password", entry.FoundPassword, "tried", entry.Tried, "total", entry.Total)
 				}
 				return nil
-			})
+			}, opts...)
 
 			result, err := bruteforcer.BruteforcePasswordAllUsers(ctx)
 			if err != nil {
@@ -7,9 +7,7 @@ import (
 
 	r "github.com/redis/go-redis/v9"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 	"github.com/tedyst/licenta/bruteforce"
-	"github.com/tedyst/licenta/db"
 	"github.com/tedyst/licenta/scanner"
 	"github.com/tedyst/licenta/scanner/redis"
 )
@@ -29,7 +27,12 @@ var scanRedisCmd = &cobra.Command{
 			DB:       0,
 		})
 
-		sc, err := redis.NewScanner(context.Background(), rdb)
+		engine, err := loadRuleEngine()
+		if err != nil {
+			return err
+		}
+
+		sc, err := redis.NewScanner(context.Background(), rdb, redis.WithRuleEngine(engine))
 		if err != nil {
 			return err
 		}
@@ -81,13 +84,17 @@ var scanRedisCmd = &cobra.Command{
 
 		slog.Info("Users scanned")
 
-		if viper.GetString("database") != "" {
-			database := db.InitDatabase(viper.GetString("database"))
-			passProvider, err := bruteforce.NewDatabasePasswordProvider(ctx, database, 1)
+		passProvider, err := newPasswordProvider(ctx, 1)
+		if err != nil {
+			return err
+		}
+		if passProvider != nil {
+			defer passProvider.Close()
+
+			opts, err := bruteforceOptions()
 			if err != nil {
 				return err
 			}
-			defer passProvider.Close()
 
 			bruteforcer := bruteforce.NewBruteforcer(passProvider, sc, func(m map[scanner.User]bruteforce.BruteforceUserStatus) error {
 				for user, entry := range m {
@@ -98,7 +105,7 @@ var scanRedisCmd = &cobra.Command{
 					slog.InfoContext(cmd.Context(), "Received update from function", "user", username, "password", entry.FoundPassword, "tried", entry.Tried, "total", entry.Total)
 				}
 				return nil
-			})
+			}, opts...)
 
 			result, err := bruteforcer.BruteforcePasswordAllUsers(ctx)
 			if err != nil {
@@ -1,7 +1,13 @@
 package scan
 
 import (
+	"context"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tedyst/licenta/bruteforce"
+	"github.com/tedyst/licenta/db"
+	"github.com/tedyst/licenta/scanner/rules"
 )
 
 var scanCmd = &cobra.Command{
@@ -13,3 +19,35 @@ var scanCmd = &cobra.Command{
 func NewScanCmd() *cobra.Command {
 	return scanCmd
 }
+
+func loadRuleEngine() (*rules.Engine, error) {
+	engine := rules.Default()
+	for _, path := range viper.GetStringSlice("rule-packs") {
+		if err := engine.LoadPath(path); err != nil {
+			return nil, err
+		}
+	}
+	return engine, nil
+}
+
+// newPasswordProvider returns the passwords used to bruteforce the users of
+// the scanned database, using the database only if one is configured.
+func newPasswordProvider(ctx context.Context, projectID int64) (bruteforce.PasswordProvider, error) {
+	var database bruteforce.DatabasePasswordProviderInterface
+	if viper.GetString("database") != "" {
+		database = db.InitDatabase(viper.GetString("database"))
+	}
+	return bruteforce.NewConfiguredPasswordProvider(ctx, database, projectID)
+}
+
+// bruteforceOptions sets the concurrency from bruteforce-concurrency and
+// checks the found passwords against the breach corpus set by breach-index,
+// if there is one.
+func bruteforceOptions() ([]bruteforce.Option, error) {
+	opts := []bruteforce.Option{bruteforce.WithConcurrency(viper.GetInt("bruteforce-concurrency"))}
+	index, err := bruteforce.OpenConfiguredBreachIndex()
+	if err != nil || index == nil {
+		return opts, err
+	}
+	return append(opts, bruteforce.WithBreachChecker(index)), nil
+}
@@ -39,7 +39,10 @@ var schedulerCmd = &cobra.Command{
 		}
 
 		localExchange := localExchange.NewLocalExchange()
-		bruteforceProvider := bruteforce.NewDatabaseBruteforceProvider(db)
+		bruteforceProvider, err := bruteforce.NewConfiguredBruteforceProvider(db)
+		if err != nil {
+			return err
+		}
 
 		emailSender := email.NewSendGridEmailSender(
 			viper.GetString("email-sendgrid"),
@@ -14,6 +14,7 @@ import (
 	"github.com/tedyst/licenta/api/authorization"
 	v1 "github.com/tedyst/licenta/api/v1"
 	"github.com/tedyst/licenta/api/v1/handlers"
+	"github.com/tedyst/licenta/breach"
 	"github.com/tedyst/licenta/bruteforce"
 	"github.com/tedyst/licenta/cache"
 	database "github.com/tedyst/licenta/db"
@@ -47,7 +48,10 @@ var serveLocalCmd = &cobra.Command{
 		}
 
 		localExchange := localExchange.NewLocalExchange()
-		brutefroceProvider := bruteforce.NewDatabaseBruteforceProvider(db)
+		brutefroceProvider, err := bruteforce.NewConfiguredBruteforceProvider(db)
+		if err != nil {
+			return err
+		}
 
 		taskRunner := local.NewLocalRunner(viper.GetBool("debug"), email.NewConsoleEmailSender(
 			"no-reply@localhost",
@@ -82,6 +86,14 @@ var serveLocalCmd = &cobra.Command{
 			return err
 		}
 
+		var breachIndex breach.RangeQuerier
+		if index, err := bruteforce.OpenConfiguredBreachIndex(); err != nil {
+			return err
+		} else if index != nil {
+			defer index.Close()
+			breachIndex = index
+		}
+
 		app, err := api.Initialize(api.ApiConfig{
 			Origin: viper.GetString("baseurl"),
 			ApiV1Config: v1.ApiV1Config{
@@ -95,6 +107,7 @@ var serveLocalCmd = &cobra.Command{
 					WorkerAuth:           workerAuth,
 					UserAuth:             userAuth,
 					Cache:                serverCache,
+					BreachIndex:          breachIndex,
 				},
 			},
 		})
@@ -19,6 +19,8 @@ import (
 	"github.com/tedyst/licenta/api/authorization"
 	v1 "github.com/tedyst/licenta/api/v1"
 	"github.com/tedyst/licenta/api/v1/handlers"
+	"github.com/tedyst/licenta/breach"
+	"github.com/tedyst/licenta/bruteforce"
 	"github.com/tedyst/licenta/cache"
 	database "github.com/tedyst/licenta/db"
 	"github.com/tedyst/licenta/db/queries"
@@ -105,6 +107,14 @@ var serveCmd = &cobra.Command{
 			return err
 		}
 
+		var breachIndex breach.RangeQuerier
+		if index, err := bruteforce.OpenConfiguredBreachIndex(); err != nil {
+			return err
+		} else if index != nil {
+			defer index.Close()
+			breachIndex = index
+		}
+
 		app, err := api.Initialize(api.ApiConfig{
 			Origin: viper.GetString("baseurl"),
 			ApiV1Config: v1.ApiV1Config{
@@ -118,6 +128,7 @@ var serveCmd = &cobra.Command{
 					WorkerAuth:           workerAuth,
 					UserAuth:             userAuth,
 					Cache:                serverCache,
+					BreachIndex:          breachIndex,
 				},
 			},
 		})
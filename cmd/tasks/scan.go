@@ -20,7 +20,10 @@ var taskScanCmd = &cobra.Command{
 		database := db.InitDatabase(viper.GetString("database"))
 
 		localExchange := localExchange.NewLocalExchange()
-		bruteforceProvider := bruteforce.NewDatabaseBruteforceProvider(database)
+		bruteforceProvider, err := bruteforce.NewConfiguredBruteforceProvider(database)
+		if err != nil {
+			return err
+		}
 
 		taskRunner := local.NewLocalRunner(true, nil, database, localExchange, bruteforceProvider, viper.GetString("db-encryption-salt"))
 
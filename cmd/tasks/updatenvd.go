@@ -26,7 +26,10 @@ var updateNVDTask = &cobra.Command{
 		}
 
 		localExchange := localExchange.NewLocalExchange()
-		bruteforceProvider := bruteforce.NewDatabaseBruteforceProvider(transaction)
+		bruteforceProvider, err := bruteforce.NewConfiguredBruteforceProvider(transaction)
+		if err != nil {
+			return err
+		}
 
 		taskRunner := local.NewLocalRunner(true, nil, transaction, localExchange, bruteforceProvider, viper.GetString("db-encryption-salt"))
 
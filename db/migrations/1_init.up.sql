@@ -96,6 +96,10 @@ CREATE TABLE git_results(
     name text NOT NULL,
     line text NOT NULL,
     line_number integer NOT NULL,
+    end_line_number integer NOT NULL,
+    provider text NOT NULL,
+    confidence text NOT NULL,
+    expires_at timestamptz,
     match text NOT NULL,
     probability float NOT NULL,
     username text,
@@ -104,6 +108,23 @@ CREATE TABLE git_results(
     created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
 );
 
+CREATE TABLE detector_sets(
+    id bigserial PRIMARY KEY,
+    organization_id bigint NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name text NOT NULL,
+    format text NOT NULL DEFAULT 'yaml',
+    content text NOT NULL,
+    use_default boolean NOT NULL DEFAULT TRUE,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (organization_id, name)
+);
+
+CREATE TABLE project_detector_sets(
+    project_id bigint PRIMARY KEY REFERENCES projects(id) ON DELETE CASCADE,
+    detector_set_id bigint NOT NULL REFERENCES detector_sets(id) ON DELETE CASCADE,
+    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
 CREATE TABLE docker_images(
     id bigserial PRIMARY KEY,
     project_id bigint NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
@@ -115,6 +136,7 @@ CREATE TABLE docker_images(
     probability_increase_multiplier float,
     entropy_threshold float,
     logistic_growth_rate float,
+    detector_set_id bigint REFERENCES detector_sets(id) ON DELETE SET NULL,
     created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
 );
 
@@ -131,6 +153,10 @@ CREATE TABLE docker_results(
     name text NOT NULL,
     line text NOT NULL,
     line_number integer NOT NULL,
+    end_line_number integer NOT NULL,
+    provider text NOT NULL,
+    confidence text NOT NULL,
+    expires_at timestamptz,
     previous_lines text NOT NULL,
     match text NOT NULL,
     probability float NOT NULL,
@@ -158,6 +184,7 @@ CREATE TABLE nvd_cves(
     published timestamp with time zone NOT NULL,
     last_modified timestamp with time zone NOT NULL,
     score float NOT NULL,
+    vector text NOT NULL DEFAULT '',
     created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
 );
 
@@ -171,6 +198,62 @@ CREATE INDEX nvd_cve_cpes_cve_id_idx ON nvd_cve_cpes(cve_id);
 
 CREATE INDEX nvd_cve_cpes_cpe_id_idx ON nvd_cve_cpes(cpe_id);
 
+CREATE TABLE nvd_cve_ranges(
+    id bigserial PRIMARY KEY,
+    cve_id bigint REFERENCES nvd_cves(id) ON DELETE CASCADE NOT NULL,
+    database_type int NOT NULL,
+    criteria text NOT NULL,
+    version_exact text,
+    version_start_including text,
+    version_start_excluding text,
+    version_end_including text,
+    version_end_excluding text
+);
+
+CREATE INDEX nvd_cve_ranges_cve_id_idx ON nvd_cve_ranges(cve_id);
+
+CREATE INDEX nvd_cve_ranges_database_type_idx ON nvd_cve_ranges(database_type);
+
+-- nvd_version_key turns a version like 8.0.36-0ubuntu into {8,0,36,0} so that
+-- versions can be compared numerically. Versions are padded to four parts so
+-- that 7.2 and 7.2.0 are equal.
+CREATE OR REPLACE FUNCTION nvd_version_key(version text)
+    RETURNS numeric[]
+    AS $$
+    SELECT
+        CASE WHEN parts IS NULL THEN
+            NULL
+        ELSE
+            parts || array_fill(0::numeric, ARRAY[greatest(0, 4 - cardinality(parts))])
+        END
+    FROM (
+        SELECT
+            string_to_array(substring(version FROM '^[0-9]+(?:\.[0-9]+)*'), '.')::numeric[] AS parts) AS v
+$$
+LANGUAGE sql
+IMMUTABLE;
+
+-- nvd_range_contains reports whether the version is inside a range stored in
+-- nvd_cve_ranges.
+CREATE OR REPLACE FUNCTION nvd_range_contains(r nvd_cve_ranges, version text)
+    RETURNS boolean
+    AS $$
+    SELECT
+        nvd_version_key(version) IS NOT NULL
+        AND (r.version_exact IS NULL
+            OR nvd_version_key(r.version_exact) = nvd_version_key(version))
+        AND (r.version_start_including IS NULL
+            OR nvd_version_key(version) >= nvd_version_key(r.version_start_including))
+        AND (r.version_start_excluding IS NULL
+            OR nvd_version_key(version) > nvd_version_key(r.version_start_excluding))
+        AND (r.version_end_including IS NULL
+            OR nvd_version_key(version) <= nvd_version_key(r.version_end_including))
+        AND (r.version_end_excluding IS NULL
+            OR nvd_version_key(version) < nvd_version_key(r.version_end_excluding))
+$$
+LANGUAGE sql
+IMMUTABLE;
+
 CREATE TABLE default_bruteforce_passwords(
     id bigserial PRIMARY KEY,
     password text NOT NULL UNIQUE
@@ -211,6 +294,40 @@ CREATE TABLE redis_databases(
     created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
 );
 
+CREATE TABLE elasticsearch_databases(
+    id bigserial PRIMARY KEY,
+    project_id bigint NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    host text NOT NULL,
+    port integer NOT NULL,
+    username text NOT NULL,
+    password text NOT NULL,
+    version text,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE TABLE etcd_databases(
+    id bigserial PRIMARY KEY,
+    project_id bigint NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    host text NOT NULL,
+    port integer NOT NULL,
+    username text NOT NULL,
+    password text NOT NULL,
+    version text,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE TABLE mssql_databases(
+    id bigserial PRIMARY KEY,
+    project_id bigint NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    host text NOT NULL,
+    port integer NOT NULL,
+    database_name text NOT NULL,
+    username text NOT NULL,
+    password text NOT NULL,
+    version text,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
 CREATE TABLE scan_groups(
     id bigserial PRIMARY KEY,
     project_id bigint NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
@@ -267,6 +384,24 @@ CREATE TABLE redis_scans(
     database_id bigint NOT NULL REFERENCES redis_databases(id) ON DELETE CASCADE
 );
 
+CREATE TABLE elasticsearch_scans(
+    id bigserial PRIMARY KEY,
+    scan_id bigint NOT NULL REFERENCES scans(id) ON DELETE CASCADE,
+    database_id bigint NOT NULL REFERENCES elasticsearch_databases(id) ON DELETE CASCADE
+);
+
+CREATE TABLE etcd_scans(
+    id bigserial PRIMARY KEY,
+    scan_id bigint NOT NULL REFERENCES scans(id) ON DELETE CASCADE,
+    database_id bigint NOT NULL REFERENCES etcd_databases(id) ON DELETE CASCADE
+);
+
+CREATE TABLE mssql_scans(
+    id bigserial PRIMARY KEY,
+    scan_id bigint NOT NULL REFERENCES scans(id) ON DELETE CASCADE,
+    database_id bigint NOT NULL REFERENCES mssql_databases(id) ON DELETE CASCADE
+);
+
 CREATE TABLE scan_results(
     id bigserial PRIMARY KEY,
     scan_id bigint NOT NULL REFERENCES scans(id) ON DELETE CASCADE,
@@ -289,12 +424,34 @@ CREATE TABLE scan_bruteforce_results(
     created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
 );
 
+CREATE TABLE scan_bruteforce_shards(
+    id bigserial PRIMARY KEY,
+    scan_id bigint NOT NULL REFERENCES scans(id) ON DELETE CASCADE,
+    scan_type integer NOT NULL,
+    username text NOT NULL,
+    hash text NOT NULL,
+    start_id bigint NOT NULL,
+    end_id bigint,
+    next_id bigint NOT NULL,
+    tried bigint NOT NULL DEFAULT 0,
+    password text,
+    status integer NOT NULL DEFAULT 0,
+    worker_id bigint REFERENCES workers(id) ON DELETE SET NULL,
+    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX scan_bruteforce_shards_scan_id_idx ON scan_bruteforce_shards(scan_id, scan_type, username);
+
+CREATE INDEX scan_bruteforce_shards_status_idx ON scan_bruteforce_shards(status);
+
 CREATE TABLE bruteforced_passwords(
     id bigserial PRIMARY KEY,
     hash text NOT NULL,
     username text NOT NULL,
     password text,
     last_bruteforce_id bigint,
+    last_bruteforce_fingerprint text NOT NULL DEFAULT '',
     project_id bigint REFERENCES projects(id) ON DELETE CASCADE,
     UNIQUE (hash, username, project_id)
 );
@@ -342,6 +499,58 @@ CREATE TABLE mysql_scans(
     database_id bigint NOT NULL REFERENCES mysql_databases(id) ON DELETE CASCADE
 );
 
+CREATE TABLE rule_packs(
+    id bigserial PRIMARY KEY,
+    organization_id bigint NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name text NOT NULL,
+    content text NOT NULL,
+    enabled boolean NOT NULL DEFAULT TRUE,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (organization_id, name)
+);
+
+CREATE TABLE scan_cve_results(
+    id bigserial PRIMARY KEY,
+    scan_result_id bigint NOT NULL UNIQUE REFERENCES scan_results(id) ON DELETE CASCADE,
+    cve_id text NOT NULL,
+    score float NOT NULL,
+    vector text NOT NULL,
+    description text NOT NULL,
+    published timestamp with time zone NOT NULL,
+    fixed_in text NOT NULL DEFAULT ''
+);
+
+CREATE TABLE scan_result_findings(
+    id bigserial PRIMARY KEY,
+    scan_result_id bigint NOT NULL UNIQUE REFERENCES scan_results(id) ON DELETE CASCADE,
+    rule_id text NOT NULL,
+    title text NOT NULL,
+    description text NOT NULL,
+    remediation text NOT NULL DEFAULT '',
+    reference_urls text[] NOT NULL DEFAULT '{}',
+    object_type text NOT NULL DEFAULT '',
+    object_name text NOT NULL DEFAULT '',
+    evidence text NOT NULL DEFAULT ''
+);
+
+CREATE INDEX scan_result_findings_rule_id_idx ON scan_result_findings(rule_id);
+
+CREATE TABLE project_ignored_cves(
+    id bigserial PRIMARY KEY,
+    project_id bigint NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    cve_id text NOT NULL,
+    reason text NOT NULL DEFAULT '',
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (project_id, cve_id)
+);
+
+CREATE TABLE project_password_rules(
+    project_id bigint PRIMARY KEY REFERENCES projects(id) ON DELETE CASCADE,
+    use_default boolean NOT NULL DEFAULT FALSE,
+    rules text NOT NULL DEFAULT '',
+    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
 CREATE OR REPLACE FUNCTION encrypt_data(project_id bigint, salt_key text, data text)
     RETURNS text
     AS $$
@@ -159,6 +159,83 @@ func (c *MockTransactionQuerierBindScanToWorkerCall) DoAndReturn(f func(context.
 	return c
 }
 
+// CancelScanBruteforceShards mocks base method.
+func (m *MockTransactionQuerier) CancelScanBruteforceShards(ctx context.Context, arg queries.CancelScanBruteforceShardsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScanBruteforceShards", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelScanBruteforceShards indicates an expected call of CancelScanBruteforceShards.
+func (mr *MockTransactionQuerierMockRecorder) CancelScanBruteforceShards(ctx, arg any) *MockTransactionQuerierCancelScanBruteforceShardsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScanBruteforceShards", reflect.TypeOf((*MockTransactionQuerier)(nil).CancelScanBruteforceShards), ctx, arg)
+	return &MockTransactionQuerierCancelScanBruteforceShardsCall{Call: call}
+}
+
+// MockTransactionQuerierCancelScanBruteforceShardsCall wrap *gomock.Call
+type MockTransactionQuerierCancelScanBruteforceShardsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierCancelScanBruteforceShardsCall) Return(arg0 error) *MockTransactionQuerierCancelScanBruteforceShardsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierCancelScanBruteforceShardsCall) Do(f func(context.Context, queries.CancelScanBruteforceShardsParams) error) *MockTransactionQuerierCancelScanBruteforceShardsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierCancelScanBruteforceShardsCall) DoAndReturn(f func(context.Context, queries.CancelScanBruteforceShardsParams) error) *MockTransactionQuerierCancelScanBruteforceShardsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ClaimScanBruteforceShard mocks base method.
+func (m *MockTransactionQuerier) ClaimScanBruteforceShard(ctx context.Context, arg queries.ClaimScanBruteforceShardParams) (*queries.ScanBruteforceShard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimScanBruteforceShard", ctx, arg)
+	ret0, _ := ret[0].(*queries.ScanBruteforceShard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimScanBruteforceShard indicates an expected call of ClaimScanBruteforceShard.
+func (mr *MockTransactionQuerierMockRecorder) ClaimScanBruteforceShard(ctx, arg any) *MockTransactionQuerierClaimScanBruteforceShardCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimScanBruteforceShard", reflect.TypeOf((*MockTransactionQuerier)(nil).ClaimScanBruteforceShard), ctx, arg)
+	return &MockTransactionQuerierClaimScanBruteforceShardCall{Call: call}
+}
+
+// MockTransactionQuerierClaimScanBruteforceShardCall wrap *gomock.Call
+type MockTransactionQuerierClaimScanBruteforceShardCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierClaimScanBruteforceShardCall) Return(arg0 *queries.ScanBruteforceShard, arg1 error) *MockTransactionQuerierClaimScanBruteforceShardCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierClaimScanBruteforceShardCall) Do(f func(context.Context, queries.ClaimScanBruteforceShardParams) (*queries.ScanBruteforceShard, error)) *MockTransactionQuerierClaimScanBruteforceShardCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierClaimScanBruteforceShardCall) DoAndReturn(f func(context.Context, queries.ClaimScanBruteforceShardParams) (*queries.ScanBruteforceShard, error)) *MockTransactionQuerierClaimScanBruteforceShardCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CountUsers mocks base method.
 func (m *MockTransactionQuerier) CountUsers(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -237,6 +314,45 @@ func (c *MockTransactionQuerierCreateBruteforcedPasswordCall) DoAndReturn(f func
 	return c
 }
 
+// CreateDetectorSet mocks base method.
+func (m *MockTransactionQuerier) CreateDetectorSet(ctx context.Context, arg queries.CreateDetectorSetParams) (*queries.DetectorSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDetectorSet", ctx, arg)
+	ret0, _ := ret[0].(*queries.DetectorSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDetectorSet indicates an expected call of CreateDetectorSet.
+func (mr *MockTransactionQuerierMockRecorder) CreateDetectorSet(ctx, arg any) *MockTransactionQuerierCreateDetectorSetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDetectorSet", reflect.TypeOf((*MockTransactionQuerier)(nil).CreateDetectorSet), ctx, arg)
+	return &MockTransactionQuerierCreateDetectorSetCall{Call: call}
+}
+
+// MockTransactionQuerierCreateDetectorSetCall wrap *gomock.Call
+type MockTransactionQuerierCreateDetectorSetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierCreateDetectorSetCall) Return(arg0 *queries.DetectorSet, arg1 error) *MockTransactionQuerierCreateDetectorSetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierCreateDetectorSetCall) Do(f func(context.Context, queries.CreateDetectorSetParams) (*queries.DetectorSet, error)) *MockTransactionQuerierCreateDetectorSetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierCreateDetectorSetCall) DoAndReturn(f func(context.Context, queries.CreateDetectorSetParams) (*queries.DetectorSet, error)) *MockTransactionQuerierCreateDetectorSetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateDockerImage mocks base method.
 func (m *MockTransactionQuerier) CreateDockerImage(ctx context.Context, arg queries.CreateDockerImageParams) (*queries.DockerImage, error) {
 	m.ctrl.T.Helper()
@@ -393,6 +509,162 @@ func (c *MockTransactionQuerierCreateDockerScannedLayerForProjectCall) DoAndRetu
 	return c
 }
 
+// CreateElasticsearchDatabase mocks base method.
+func (m *MockTransactionQuerier) CreateElasticsearchDatabase(ctx context.Context, arg queries.CreateElasticsearchDatabaseParams) (*queries.ElasticsearchDatabase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateElasticsearchDatabase", ctx, arg)
+	ret0, _ := ret[0].(*queries.ElasticsearchDatabase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateElasticsearchDatabase indicates an expected call of CreateElasticsearchDatabase.
+func (mr *MockTransactionQuerierMockRecorder) CreateElasticsearchDatabase(ctx, arg any) *MockTransactionQuerierCreateElasticsearchDatabaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateElasticsearchDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).CreateElasticsearchDatabase), ctx, arg)
+	return &MockTransactionQuerierCreateElasticsearchDatabaseCall{Call: call}
+}
+
+// MockTransactionQuerierCreateElasticsearchDatabaseCall wrap *gomock.Call
+type MockTransactionQuerierCreateElasticsearchDatabaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierCreateElasticsearchDatabaseCall) Return(arg0 *queries.ElasticsearchDatabase, arg1 error) *MockTransactionQuerierCreateElasticsearchDatabaseCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierCreateElasticsearchDatabaseCall) Do(f func(context.Context, queries.CreateElasticsearchDatabaseParams) (*queries.ElasticsearchDatabase, error)) *MockTransactionQuerierCreateElasticsearchDatabaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierCreateElasticsearchDatabaseCall) DoAndReturn(f func(context.Context, queries.CreateElasticsearchDatabaseParams) (*queries.ElasticsearchDatabase, error)) *MockTransactionQuerierCreateElasticsearchDatabaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateElasticsearchScan mocks base method.
+func (m *MockTransactionQuerier) CreateElasticsearchScan(ctx context.Context, arg queries.CreateElasticsearchScanParams) (*queries.ElasticsearchScan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateElasticsearchScan", ctx, arg)
+	ret0, _ := ret[0].(*queries.ElasticsearchScan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateElasticsearchScan indicates an expected call of CreateElasticsearchScan.
+func (mr *MockTransactionQuerierMockRecorder) CreateElasticsearchScan(ctx, arg any) *MockTransactionQuerierCreateElasticsearchScanCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateElasticsearchScan", reflect.TypeOf((*MockTransactionQuerier)(nil).CreateElasticsearchScan), ctx, arg)
+	return &MockTransactionQuerierCreateElasticsearchScanCall{Call: call}
+}
+
+// MockTransactionQuerierCreateElasticsearchScanCall wrap *gomock.Call
+type MockTransactionQuerierCreateElasticsearchScanCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierCreateElasticsearchScanCall) Return(arg0 *queries.ElasticsearchScan, arg1 error) *MockTransactionQuerierCreateElasticsearchScanCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierCreateElasticsearchScanCall) Do(f func(context.Context, queries.CreateElasticsearchScanParams) (*queries.ElasticsearchScan, error)) *MockTransactionQuerierCreateElasticsearchScanCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierCreateElasticsearchScanCall) DoAndReturn(f func(context.Context, queries.CreateElasticsearchScanParams) (*queries.ElasticsearchScan, error)) *MockTransactionQuerierCreateElasticsearchScanCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateEtcdDatabase mocks base method.
+func (m *MockTransactionQuerier) CreateEtcdDatabase(ctx context.Context, arg queries.CreateEtcdDatabaseParams) (*queries.EtcdDatabase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEtcdDatabase", ctx, arg)
+	ret0, _ := ret[0].(*queries.EtcdDatabase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEtcdDatabase indicates an expected call of CreateEtcdDatabase.
+func (mr *MockTransactionQuerierMockRecorder) CreateEtcdDatabase(ctx, arg any) *MockTransactionQuerierCreateEtcdDatabaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEtcdDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).CreateEtcdDatabase), ctx, arg)
+	return &MockTransactionQuerierCreateEtcdDatabaseCall{Call: call}
+}
+
+// MockTransactionQuerierCreateEtcdDatabaseCall wrap *gomock.Call
+type MockTransactionQuerierCreateEtcdDatabaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierCreateEtcdDatabaseCall) Return(arg0 *queries.EtcdDatabase, arg1 error) *MockTransactionQuerierCreateEtcdDatabaseCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierCreateEtcdDatabaseCall) Do(f func(context.Context, queries.CreateEtcdDatabaseParams) (*queries.EtcdDatabase, error)) *MockTransactionQuerierCreateEtcdDatabaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierCreateEtcdDatabaseCall) DoAndReturn(f func(context.Context, queries.CreateEtcdDatabaseParams) (*queries.EtcdDatabase, error)) *MockTransactionQuerierCreateEtcdDatabaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateEtcdScan mocks base method.
+func (m *MockTransactionQuerier) CreateEtcdScan(ctx context.Context, arg queries.CreateEtcdScanParams) (*queries.EtcdScan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEtcdScan", ctx, arg)
+	ret0, _ := ret[0].(*queries.EtcdScan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEtcdScan indicates an expected call of CreateEtcdScan.
+func (mr *MockTransactionQuerierMockRecorder) CreateEtcdScan(ctx, arg any) *MockTransactionQuerierCreateEtcdScanCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEtcdScan", reflect.TypeOf((*MockTransactionQuerier)(nil).CreateEtcdScan), ctx, arg)
+	return &MockTransactionQuerierCreateEtcdScanCall{Call: call}
+}
+
+// MockTransactionQuerierCreateEtcdScanCall wrap *gomock.Call
+type MockTransactionQuerierCreateEtcdScanCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierCreateEtcdScanCall) Return(arg0 *queries.EtcdScan, arg1 error) *MockTransactionQuerierCreateEtcdScanCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierCreateEtcdScanCall) Do(f func(context.Context, queries.CreateEtcdScanParams) (*queries.EtcdScan, error)) *MockTransactionQuerierCreateEtcdScanCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierCreateEtcdScanCall) DoAndReturn(f func(context.Context, queries.CreateEtcdScanParams) (*queries.EtcdScan, error)) *MockTransactionQuerierCreateEtcdScanCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateGitCommitForProject mocks base method.
 func (m *MockTransactionQuerier) CreateGitCommitForProject(ctx context.Context, arg queries.CreateGitCommitForProjectParams) (*queries.GitCommit, error) {
 	m.ctrl.T.Helper()
@@ -627,6 +899,84 @@ func (c *MockTransactionQuerierCreateMongoScanCall) DoAndReturn(f func(context.C
 	return c
 }
 
+// CreateMssqlDatabase mocks base method.
+func (m *MockTransactionQuerier) CreateMssqlDatabase(ctx context.Context, arg queries.CreateMssqlDatabaseParams) (*queries.MssqlDatabase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMssqlDatabase", ctx, arg)
+	ret0, _ := ret[0].(*queries.MssqlDatabase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMssqlDatabase indicates an expected call of CreateMssqlDatabase.
+func (mr *MockTransactionQuerierMockRecorder) CreateMssqlDatabase(ctx, arg any) *MockTransactionQuerierCreateMssqlDatabaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMssqlDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).CreateMssqlDatabase), ctx, arg)
+	return &MockTransactionQuerierCreateMssqlDatabaseCall{Call: call}
+}
+
+// MockTransactionQuerierCreateMssqlDatabaseCall wrap *gomock.Call
+type MockTransactionQuerierCreateMssqlDatabaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierCreateMssqlDatabaseCall) Return(arg0 *queries.MssqlDatabase, arg1 error) *MockTransactionQuerierCreateMssqlDatabaseCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierCreateMssqlDatabaseCall) Do(f func(context.Context, queries.CreateMssqlDatabaseParams) (*queries.MssqlDatabase, error)) *MockTransactionQuerierCreateMssqlDatabaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierCreateMssqlDatabaseCall) DoAndReturn(f func(context.Context, queries.CreateMssqlDatabaseParams) (*queries.MssqlDatabase, error)) *MockTransactionQuerierCreateMssqlDatabaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateMssqlScan mocks base method.
+func (m *MockTransactionQuerier) CreateMssqlScan(ctx context.Context, arg queries.CreateMssqlScanParams) (*queries.MssqlScan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMssqlScan", ctx, arg)
+	ret0, _ := ret[0].(*queries.MssqlScan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMssqlScan indicates an expected call of CreateMssqlScan.
+func (mr *MockTransactionQuerierMockRecorder) CreateMssqlScan(ctx, arg any) *MockTransactionQuerierCreateMssqlScanCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMssqlScan", reflect.TypeOf((*MockTransactionQuerier)(nil).CreateMssqlScan), ctx, arg)
+	return &MockTransactionQuerierCreateMssqlScanCall{Call: call}
+}
+
+// MockTransactionQuerierCreateMssqlScanCall wrap *gomock.Call
+type MockTransactionQuerierCreateMssqlScanCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierCreateMssqlScanCall) Return(arg0 *queries.MssqlScan, arg1 error) *MockTransactionQuerierCreateMssqlScanCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierCreateMssqlScanCall) Do(f func(context.Context, queries.CreateMssqlScanParams) (*queries.MssqlScan, error)) *MockTransactionQuerierCreateMssqlScanCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierCreateMssqlScanCall) DoAndReturn(f func(context.Context, queries.CreateMssqlScanParams) (*queries.MssqlScan, error)) *MockTransactionQuerierCreateMssqlScanCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateMysqlDatabase mocks base method.
 func (m *MockTransactionQuerier) CreateMysqlDatabase(ctx context.Context, arg queries.CreateMysqlDatabaseParams) (*queries.MysqlDatabase, error) {
 	m.ctrl.T.Helper()
@@ -822,55 +1172,94 @@ func (c *MockTransactionQuerierCreateNvdCveCPECall) DoAndReturn(f func(context.C
 	return c
 }
 
-// CreateOrganization mocks base method.
-func (m *MockTransactionQuerier) CreateOrganization(ctx context.Context, name string) (*queries.Organization, error) {
+// CreateNvdCveRange mocks base method.
+func (m *MockTransactionQuerier) CreateNvdCveRange(ctx context.Context, arg queries.CreateNvdCveRangeParams) (*queries.NvdCveRange, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrganization", ctx, name)
-	ret0, _ := ret[0].(*queries.Organization)
+	ret := m.ctrl.Call(m, "CreateNvdCveRange", ctx, arg)
+	ret0, _ := ret[0].(*queries.NvdCveRange)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateOrganization indicates an expected call of CreateOrganization.
-func (mr *MockTransactionQuerierMockRecorder) CreateOrganization(ctx, name any) *MockTransactionQuerierCreateOrganizationCall {
+// CreateNvdCveRange indicates an expected call of CreateNvdCveRange.
+func (mr *MockTransactionQuerierMockRecorder) CreateNvdCveRange(ctx, arg any) *MockTransactionQuerierCreateNvdCveRangeCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockTransactionQuerier)(nil).CreateOrganization), ctx, name)
-	return &MockTransactionQuerierCreateOrganizationCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNvdCveRange", reflect.TypeOf((*MockTransactionQuerier)(nil).CreateNvdCveRange), ctx, arg)
+	return &MockTransactionQuerierCreateNvdCveRangeCall{Call: call}
 }
 
-// MockTransactionQuerierCreateOrganizationCall wrap *gomock.Call
-type MockTransactionQuerierCreateOrganizationCall struct {
+// MockTransactionQuerierCreateNvdCveRangeCall wrap *gomock.Call
+type MockTransactionQuerierCreateNvdCveRangeCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierCreateOrganizationCall) Return(arg0 *queries.Organization, arg1 error) *MockTransactionQuerierCreateOrganizationCall {
+func (c *MockTransactionQuerierCreateNvdCveRangeCall) Return(arg0 *queries.NvdCveRange, arg1 error) *MockTransactionQuerierCreateNvdCveRangeCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierCreateOrganizationCall) Do(f func(context.Context, string) (*queries.Organization, error)) *MockTransactionQuerierCreateOrganizationCall {
+func (c *MockTransactionQuerierCreateNvdCveRangeCall) Do(f func(context.Context, queries.CreateNvdCveRangeParams) (*queries.NvdCveRange, error)) *MockTransactionQuerierCreateNvdCveRangeCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierCreateOrganizationCall) DoAndReturn(f func(context.Context, string) (*queries.Organization, error)) *MockTransactionQuerierCreateOrganizationCall {
+func (c *MockTransactionQuerierCreateNvdCveRangeCall) DoAndReturn(f func(context.Context, queries.CreateNvdCveRangeParams) (*queries.NvdCveRange, error)) *MockTransactionQuerierCreateNvdCveRangeCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// CreatePostgresDatabase mocks base method.
-func (m *MockTransactionQuerier) CreatePostgresDatabase(ctx context.Context, arg queries.CreatePostgresDatabaseParams) (*queries.PostgresDatabase, error) {
+// CreateOrganization mocks base method.
+func (m *MockTransactionQuerier) CreateOrganization(ctx context.Context, name string) (*queries.Organization, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePostgresDatabase", ctx, arg)
-	ret0, _ := ret[0].(*queries.PostgresDatabase)
+	ret := m.ctrl.Call(m, "CreateOrganization", ctx, name)
+	ret0, _ := ret[0].(*queries.Organization)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreatePostgresDatabase indicates an expected call of CreatePostgresDatabase.
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *MockTransactionQuerierMockRecorder) CreateOrganization(ctx, name any) *MockTransactionQuerierCreateOrganizationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockTransactionQuerier)(nil).CreateOrganization), ctx, name)
+	return &MockTransactionQuerierCreateOrganizationCall{Call: call}
+}
+
+// MockTransactionQuerierCreateOrganizationCall wrap *gomock.Call
+type MockTransactionQuerierCreateOrganizationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierCreateOrganizationCall) Return(arg0 *queries.Organization, arg1 error) *MockTransactionQuerierCreateOrganizationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierCreateOrganizationCall) Do(f func(context.Context, string) (*queries.Organization, error)) *MockTransactionQuerierCreateOrganizationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierCreateOrganizationCall) DoAndReturn(f func(context.Context, string) (*queries.Organization, error)) *MockTransactionQuerierCreateOrganizationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreatePostgresDatabase mocks base method.
+func (m *MockTransactionQuerier) CreatePostgresDatabase(ctx context.Context, arg queries.CreatePostgresDatabaseParams) (*queries.PostgresDatabase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePostgresDatabase", ctx, arg)
+	ret0, _ := ret[0].(*queries.PostgresDatabase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePostgresDatabase indicates an expected call of CreatePostgresDatabase.
 func (mr *MockTransactionQuerierMockRecorder) CreatePostgresDatabase(ctx, arg any) *MockTransactionQuerierCreatePostgresDatabaseCall {
 	mr.mock.ctrl.T.Helper()
 	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePostgresDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).CreatePostgresDatabase), ctx, arg)
@@ -978,6 +1367,45 @@ func (c *MockTransactionQuerierCreateProjectCall) DoAndReturn(f func(context.Con
 	return c
 }
 
+// CreateProjectIgnoredCve mocks base method.
+func (m *MockTransactionQuerier) CreateProjectIgnoredCve(ctx context.Context, arg queries.CreateProjectIgnoredCveParams) (*queries.ProjectIgnoredCfe, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectIgnoredCve", ctx, arg)
+	ret0, _ := ret[0].(*queries.ProjectIgnoredCfe)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectIgnoredCve indicates an expected call of CreateProjectIgnoredCve.
+func (mr *MockTransactionQuerierMockRecorder) CreateProjectIgnoredCve(ctx, arg any) *MockTransactionQuerierCreateProjectIgnoredCveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectIgnoredCve", reflect.TypeOf((*MockTransactionQuerier)(nil).CreateProjectIgnoredCve), ctx, arg)
+	return &MockTransactionQuerierCreateProjectIgnoredCveCall{Call: call}
+}
+
+// MockTransactionQuerierCreateProjectIgnoredCveCall wrap *gomock.Call
+type MockTransactionQuerierCreateProjectIgnoredCveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierCreateProjectIgnoredCveCall) Return(arg0 *queries.ProjectIgnoredCfe, arg1 error) *MockTransactionQuerierCreateProjectIgnoredCveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierCreateProjectIgnoredCveCall) Do(f func(context.Context, queries.CreateProjectIgnoredCveParams) (*queries.ProjectIgnoredCfe, error)) *MockTransactionQuerierCreateProjectIgnoredCveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierCreateProjectIgnoredCveCall) DoAndReturn(f func(context.Context, queries.CreateProjectIgnoredCveParams) (*queries.ProjectIgnoredCfe, error)) *MockTransactionQuerierCreateProjectIgnoredCveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateRedisDatabase mocks base method.
 func (m *MockTransactionQuerier) CreateRedisDatabase(ctx context.Context, arg queries.CreateRedisDatabaseParams) (*queries.RedisDatabase, error) {
 	m.ctrl.T.Helper()
@@ -1134,6 +1562,45 @@ func (c *MockTransactionQuerierCreateResetPasswordTokenCall) DoAndReturn(f func(
 	return c
 }
 
+// CreateRulePack mocks base method.
+func (m *MockTransactionQuerier) CreateRulePack(ctx context.Context, arg queries.CreateRulePackParams) (*queries.RulePack, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRulePack", ctx, arg)
+	ret0, _ := ret[0].(*queries.RulePack)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRulePack indicates an expected call of CreateRulePack.
+func (mr *MockTransactionQuerierMockRecorder) CreateRulePack(ctx, arg any) *MockTransactionQuerierCreateRulePackCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRulePack", reflect.TypeOf((*MockTransactionQuerier)(nil).CreateRulePack), ctx, arg)
+	return &MockTransactionQuerierCreateRulePackCall{Call: call}
+}
+
+// MockTransactionQuerierCreateRulePackCall wrap *gomock.Call
+type MockTransactionQuerierCreateRulePackCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierCreateRulePackCall) Return(arg0 *queries.RulePack, arg1 error) *MockTransactionQuerierCreateRulePackCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierCreateRulePackCall) Do(f func(context.Context, queries.CreateRulePackParams) (*queries.RulePack, error)) *MockTransactionQuerierCreateRulePackCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierCreateRulePackCall) DoAndReturn(f func(context.Context, queries.CreateRulePackParams) (*queries.RulePack, error)) *MockTransactionQuerierCreateRulePackCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateScan mocks base method.
 func (m *MockTransactionQuerier) CreateScan(ctx context.Context, arg queries.CreateScanParams) (*queries.Scan, error) {
 	m.ctrl.T.Helper()
@@ -1212,6 +1679,123 @@ func (c *MockTransactionQuerierCreateScanBruteforceResultCall) DoAndReturn(f fun
 	return c
 }
 
+// CreateScanBruteforceShards mocks base method.
+func (m *MockTransactionQuerier) CreateScanBruteforceShards(ctx context.Context, arg queries.CreateScanBruteforceShardsParams) ([]*queries.ScanBruteforceShard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScanBruteforceShards", ctx, arg)
+	ret0, _ := ret[0].([]*queries.ScanBruteforceShard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScanBruteforceShards indicates an expected call of CreateScanBruteforceShards.
+func (mr *MockTransactionQuerierMockRecorder) CreateScanBruteforceShards(ctx, arg any) *MockTransactionQuerierCreateScanBruteforceShardsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScanBruteforceShards", reflect.TypeOf((*MockTransactionQuerier)(nil).CreateScanBruteforceShards), ctx, arg)
+	return &MockTransactionQuerierCreateScanBruteforceShardsCall{Call: call}
+}
+
+// MockTransactionQuerierCreateScanBruteforceShardsCall wrap *gomock.Call
+type MockTransactionQuerierCreateScanBruteforceShardsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierCreateScanBruteforceShardsCall) Return(arg0 []*queries.ScanBruteforceShard, arg1 error) *MockTransactionQuerierCreateScanBruteforceShardsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierCreateScanBruteforceShardsCall) Do(f func(context.Context, queries.CreateScanBruteforceShardsParams) ([]*queries.ScanBruteforceShard, error)) *MockTransactionQuerierCreateScanBruteforceShardsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierCreateScanBruteforceShardsCall) DoAndReturn(f func(context.Context, queries.CreateScanBruteforceShardsParams) ([]*queries.ScanBruteforceShard, error)) *MockTransactionQuerierCreateScanBruteforceShardsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateScanCveResult mocks base method.
+func (m *MockTransactionQuerier) CreateScanCveResult(ctx context.Context, arg queries.CreateScanCveResultParams) (*queries.ScanCveResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScanCveResult", ctx, arg)
+	ret0, _ := ret[0].(*queries.ScanCveResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScanCveResult indicates an expected call of CreateScanCveResult.
+func (mr *MockTransactionQuerierMockRecorder) CreateScanCveResult(ctx, arg any) *MockTransactionQuerierCreateScanCveResultCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScanCveResult", reflect.TypeOf((*MockTransactionQuerier)(nil).CreateScanCveResult), ctx, arg)
+	return &MockTransactionQuerierCreateScanCveResultCall{Call: call}
+}
+
+// MockTransactionQuerierCreateScanCveResultCall wrap *gomock.Call
+type MockTransactionQuerierCreateScanCveResultCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierCreateScanCveResultCall) Return(arg0 *queries.ScanCveResult, arg1 error) *MockTransactionQuerierCreateScanCveResultCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierCreateScanCveResultCall) Do(f func(context.Context, queries.CreateScanCveResultParams) (*queries.ScanCveResult, error)) *MockTransactionQuerierCreateScanCveResultCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierCreateScanCveResultCall) DoAndReturn(f func(context.Context, queries.CreateScanCveResultParams) (*queries.ScanCveResult, error)) *MockTransactionQuerierCreateScanCveResultCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateScanFindingResult mocks base method.
+func (m *MockTransactionQuerier) CreateScanFindingResult(ctx context.Context, arg queries.CreateScanFindingResultParams) (*queries.ScanResultFinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScanFindingResult", ctx, arg)
+	ret0, _ := ret[0].(*queries.ScanResultFinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScanFindingResult indicates an expected call of CreateScanFindingResult.
+func (mr *MockTransactionQuerierMockRecorder) CreateScanFindingResult(ctx, arg any) *MockTransactionQuerierCreateScanFindingResultCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScanFindingResult", reflect.TypeOf((*MockTransactionQuerier)(nil).CreateScanFindingResult), ctx, arg)
+	return &MockTransactionQuerierCreateScanFindingResultCall{Call: call}
+}
+
+// MockTransactionQuerierCreateScanFindingResultCall wrap *gomock.Call
+type MockTransactionQuerierCreateScanFindingResultCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierCreateScanFindingResultCall) Return(arg0 *queries.ScanResultFinding, arg1 error) *MockTransactionQuerierCreateScanFindingResultCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierCreateScanFindingResultCall) Do(f func(context.Context, queries.CreateScanFindingResultParams) (*queries.ScanResultFinding, error)) *MockTransactionQuerierCreateScanFindingResultCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierCreateScanFindingResultCall) DoAndReturn(f func(context.Context, queries.CreateScanFindingResultParams) (*queries.ScanResultFinding, error)) *MockTransactionQuerierCreateScanFindingResultCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateScanGroup mocks base method.
 func (m *MockTransactionQuerier) CreateScanGroup(ctx context.Context, arg queries.CreateScanGroupParams) (*queries.ScanGroup, error) {
 	m.ctrl.T.Helper()
@@ -1446,653 +2030,1073 @@ func (c *MockTransactionQuerierCreateWorkerCall) DoAndReturn(f func(context.Cont
 	return c
 }
 
-// DeleteDockerImage mocks base method.
-func (m *MockTransactionQuerier) DeleteDockerImage(ctx context.Context, id int64) error {
+// DeleteDetectorSet mocks base method.
+func (m *MockTransactionQuerier) DeleteDetectorSet(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteDockerImage", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteDetectorSet", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteDockerImage indicates an expected call of DeleteDockerImage.
-func (mr *MockTransactionQuerierMockRecorder) DeleteDockerImage(ctx, id any) *MockTransactionQuerierDeleteDockerImageCall {
+// DeleteDetectorSet indicates an expected call of DeleteDetectorSet.
+func (mr *MockTransactionQuerierMockRecorder) DeleteDetectorSet(ctx, id any) *MockTransactionQuerierDeleteDetectorSetCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDockerImage", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteDockerImage), ctx, id)
-	return &MockTransactionQuerierDeleteDockerImageCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDetectorSet", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteDetectorSet), ctx, id)
+	return &MockTransactionQuerierDeleteDetectorSetCall{Call: call}
 }
 
-// MockTransactionQuerierDeleteDockerImageCall wrap *gomock.Call
-type MockTransactionQuerierDeleteDockerImageCall struct {
+// MockTransactionQuerierDeleteDetectorSetCall wrap *gomock.Call
+type MockTransactionQuerierDeleteDetectorSetCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierDeleteDockerImageCall) Return(arg0 error) *MockTransactionQuerierDeleteDockerImageCall {
+func (c *MockTransactionQuerierDeleteDetectorSetCall) Return(arg0 error) *MockTransactionQuerierDeleteDetectorSetCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierDeleteDockerImageCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteDockerImageCall {
+func (c *MockTransactionQuerierDeleteDetectorSetCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteDetectorSetCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierDeleteDockerImageCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteDockerImageCall {
+func (c *MockTransactionQuerierDeleteDetectorSetCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteDetectorSetCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteGitRepository mocks base method.
-func (m *MockTransactionQuerier) DeleteGitRepository(ctx context.Context, id int64) error {
+// DeleteDockerImage mocks base method.
+func (m *MockTransactionQuerier) DeleteDockerImage(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteGitRepository", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteDockerImage", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteGitRepository indicates an expected call of DeleteGitRepository.
-func (mr *MockTransactionQuerierMockRecorder) DeleteGitRepository(ctx, id any) *MockTransactionQuerierDeleteGitRepositoryCall {
+// DeleteDockerImage indicates an expected call of DeleteDockerImage.
+func (mr *MockTransactionQuerierMockRecorder) DeleteDockerImage(ctx, id any) *MockTransactionQuerierDeleteDockerImageCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGitRepository", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteGitRepository), ctx, id)
-	return &MockTransactionQuerierDeleteGitRepositoryCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDockerImage", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteDockerImage), ctx, id)
+	return &MockTransactionQuerierDeleteDockerImageCall{Call: call}
 }
 
-// MockTransactionQuerierDeleteGitRepositoryCall wrap *gomock.Call
-type MockTransactionQuerierDeleteGitRepositoryCall struct {
+// MockTransactionQuerierDeleteDockerImageCall wrap *gomock.Call
+type MockTransactionQuerierDeleteDockerImageCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierDeleteGitRepositoryCall) Return(arg0 error) *MockTransactionQuerierDeleteGitRepositoryCall {
+func (c *MockTransactionQuerierDeleteDockerImageCall) Return(arg0 error) *MockTransactionQuerierDeleteDockerImageCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierDeleteGitRepositoryCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteGitRepositoryCall {
+func (c *MockTransactionQuerierDeleteDockerImageCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteDockerImageCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierDeleteGitRepositoryCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteGitRepositoryCall {
+func (c *MockTransactionQuerierDeleteDockerImageCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteDockerImageCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteMongoDatabase mocks base method.
-func (m *MockTransactionQuerier) DeleteMongoDatabase(ctx context.Context, id int64) error {
+// DeleteElasticsearchDatabase mocks base method.
+func (m *MockTransactionQuerier) DeleteElasticsearchDatabase(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteMongoDatabase", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteElasticsearchDatabase", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteMongoDatabase indicates an expected call of DeleteMongoDatabase.
-func (mr *MockTransactionQuerierMockRecorder) DeleteMongoDatabase(ctx, id any) *MockTransactionQuerierDeleteMongoDatabaseCall {
+// DeleteElasticsearchDatabase indicates an expected call of DeleteElasticsearchDatabase.
+func (mr *MockTransactionQuerierMockRecorder) DeleteElasticsearchDatabase(ctx, id any) *MockTransactionQuerierDeleteElasticsearchDatabaseCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMongoDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteMongoDatabase), ctx, id)
-	return &MockTransactionQuerierDeleteMongoDatabaseCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteElasticsearchDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteElasticsearchDatabase), ctx, id)
+	return &MockTransactionQuerierDeleteElasticsearchDatabaseCall{Call: call}
 }
 
-// MockTransactionQuerierDeleteMongoDatabaseCall wrap *gomock.Call
-type MockTransactionQuerierDeleteMongoDatabaseCall struct {
+// MockTransactionQuerierDeleteElasticsearchDatabaseCall wrap *gomock.Call
+type MockTransactionQuerierDeleteElasticsearchDatabaseCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierDeleteMongoDatabaseCall) Return(arg0 error) *MockTransactionQuerierDeleteMongoDatabaseCall {
+func (c *MockTransactionQuerierDeleteElasticsearchDatabaseCall) Return(arg0 error) *MockTransactionQuerierDeleteElasticsearchDatabaseCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierDeleteMongoDatabaseCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteMongoDatabaseCall {
+func (c *MockTransactionQuerierDeleteElasticsearchDatabaseCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteElasticsearchDatabaseCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierDeleteMongoDatabaseCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteMongoDatabaseCall {
+func (c *MockTransactionQuerierDeleteElasticsearchDatabaseCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteElasticsearchDatabaseCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteMysqlDatabase mocks base method.
-func (m *MockTransactionQuerier) DeleteMysqlDatabase(ctx context.Context, id int64) error {
+// DeleteEtcdDatabase mocks base method.
+func (m *MockTransactionQuerier) DeleteEtcdDatabase(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteMysqlDatabase", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteEtcdDatabase", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteMysqlDatabase indicates an expected call of DeleteMysqlDatabase.
-func (mr *MockTransactionQuerierMockRecorder) DeleteMysqlDatabase(ctx, id any) *MockTransactionQuerierDeleteMysqlDatabaseCall {
+// DeleteEtcdDatabase indicates an expected call of DeleteEtcdDatabase.
+func (mr *MockTransactionQuerierMockRecorder) DeleteEtcdDatabase(ctx, id any) *MockTransactionQuerierDeleteEtcdDatabaseCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMysqlDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteMysqlDatabase), ctx, id)
-	return &MockTransactionQuerierDeleteMysqlDatabaseCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEtcdDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteEtcdDatabase), ctx, id)
+	return &MockTransactionQuerierDeleteEtcdDatabaseCall{Call: call}
 }
 
-// MockTransactionQuerierDeleteMysqlDatabaseCall wrap *gomock.Call
-type MockTransactionQuerierDeleteMysqlDatabaseCall struct {
+// MockTransactionQuerierDeleteEtcdDatabaseCall wrap *gomock.Call
+type MockTransactionQuerierDeleteEtcdDatabaseCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierDeleteMysqlDatabaseCall) Return(arg0 error) *MockTransactionQuerierDeleteMysqlDatabaseCall {
+func (c *MockTransactionQuerierDeleteEtcdDatabaseCall) Return(arg0 error) *MockTransactionQuerierDeleteEtcdDatabaseCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierDeleteMysqlDatabaseCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteMysqlDatabaseCall {
+func (c *MockTransactionQuerierDeleteEtcdDatabaseCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteEtcdDatabaseCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierDeleteMysqlDatabaseCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteMysqlDatabaseCall {
+func (c *MockTransactionQuerierDeleteEtcdDatabaseCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteEtcdDatabaseCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteNvdCveByName mocks base method.
-func (m *MockTransactionQuerier) DeleteNvdCveByName(ctx context.Context, cveID string) error {
+// DeleteGitRepository mocks base method.
+func (m *MockTransactionQuerier) DeleteGitRepository(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteNvdCveByName", ctx, cveID)
+	ret := m.ctrl.Call(m, "DeleteGitRepository", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteNvdCveByName indicates an expected call of DeleteNvdCveByName.
-func (mr *MockTransactionQuerierMockRecorder) DeleteNvdCveByName(ctx, cveID any) *MockTransactionQuerierDeleteNvdCveByNameCall {
+// DeleteGitRepository indicates an expected call of DeleteGitRepository.
+func (mr *MockTransactionQuerierMockRecorder) DeleteGitRepository(ctx, id any) *MockTransactionQuerierDeleteGitRepositoryCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNvdCveByName", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteNvdCveByName), ctx, cveID)
-	return &MockTransactionQuerierDeleteNvdCveByNameCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGitRepository", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteGitRepository), ctx, id)
+	return &MockTransactionQuerierDeleteGitRepositoryCall{Call: call}
 }
 
-// MockTransactionQuerierDeleteNvdCveByNameCall wrap *gomock.Call
-type MockTransactionQuerierDeleteNvdCveByNameCall struct {
+// MockTransactionQuerierDeleteGitRepositoryCall wrap *gomock.Call
+type MockTransactionQuerierDeleteGitRepositoryCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierDeleteNvdCveByNameCall) Return(arg0 error) *MockTransactionQuerierDeleteNvdCveByNameCall {
+func (c *MockTransactionQuerierDeleteGitRepositoryCall) Return(arg0 error) *MockTransactionQuerierDeleteGitRepositoryCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierDeleteNvdCveByNameCall) Do(f func(context.Context, string) error) *MockTransactionQuerierDeleteNvdCveByNameCall {
+func (c *MockTransactionQuerierDeleteGitRepositoryCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteGitRepositoryCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierDeleteNvdCveByNameCall) DoAndReturn(f func(context.Context, string) error) *MockTransactionQuerierDeleteNvdCveByNameCall {
+func (c *MockTransactionQuerierDeleteGitRepositoryCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteGitRepositoryCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteOrganization mocks base method.
-func (m *MockTransactionQuerier) DeleteOrganization(ctx context.Context, id int64) error {
+// DeleteMongoDatabase mocks base method.
+func (m *MockTransactionQuerier) DeleteMongoDatabase(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOrganization", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteMongoDatabase", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteOrganization indicates an expected call of DeleteOrganization.
-func (mr *MockTransactionQuerierMockRecorder) DeleteOrganization(ctx, id any) *MockTransactionQuerierDeleteOrganizationCall {
+// DeleteMongoDatabase indicates an expected call of DeleteMongoDatabase.
+func (mr *MockTransactionQuerierMockRecorder) DeleteMongoDatabase(ctx, id any) *MockTransactionQuerierDeleteMongoDatabaseCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganization", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteOrganization), ctx, id)
-	return &MockTransactionQuerierDeleteOrganizationCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMongoDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteMongoDatabase), ctx, id)
+	return &MockTransactionQuerierDeleteMongoDatabaseCall{Call: call}
 }
 
-// MockTransactionQuerierDeleteOrganizationCall wrap *gomock.Call
-type MockTransactionQuerierDeleteOrganizationCall struct {
+// MockTransactionQuerierDeleteMongoDatabaseCall wrap *gomock.Call
+type MockTransactionQuerierDeleteMongoDatabaseCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierDeleteOrganizationCall) Return(arg0 error) *MockTransactionQuerierDeleteOrganizationCall {
+func (c *MockTransactionQuerierDeleteMongoDatabaseCall) Return(arg0 error) *MockTransactionQuerierDeleteMongoDatabaseCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierDeleteOrganizationCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteOrganizationCall {
+func (c *MockTransactionQuerierDeleteMongoDatabaseCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteMongoDatabaseCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierDeleteOrganizationCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteOrganizationCall {
+func (c *MockTransactionQuerierDeleteMongoDatabaseCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteMongoDatabaseCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeletePostgresDatabase mocks base method.
-func (m *MockTransactionQuerier) DeletePostgresDatabase(ctx context.Context, id int64) error {
+// DeleteMssqlDatabase mocks base method.
+func (m *MockTransactionQuerier) DeleteMssqlDatabase(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePostgresDatabase", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteMssqlDatabase", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeletePostgresDatabase indicates an expected call of DeletePostgresDatabase.
-func (mr *MockTransactionQuerierMockRecorder) DeletePostgresDatabase(ctx, id any) *MockTransactionQuerierDeletePostgresDatabaseCall {
+// DeleteMssqlDatabase indicates an expected call of DeleteMssqlDatabase.
+func (mr *MockTransactionQuerierMockRecorder) DeleteMssqlDatabase(ctx, id any) *MockTransactionQuerierDeleteMssqlDatabaseCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePostgresDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).DeletePostgresDatabase), ctx, id)
-	return &MockTransactionQuerierDeletePostgresDatabaseCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMssqlDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteMssqlDatabase), ctx, id)
+	return &MockTransactionQuerierDeleteMssqlDatabaseCall{Call: call}
 }
 
-// MockTransactionQuerierDeletePostgresDatabaseCall wrap *gomock.Call
-type MockTransactionQuerierDeletePostgresDatabaseCall struct {
+// MockTransactionQuerierDeleteMssqlDatabaseCall wrap *gomock.Call
+type MockTransactionQuerierDeleteMssqlDatabaseCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierDeletePostgresDatabaseCall) Return(arg0 error) *MockTransactionQuerierDeletePostgresDatabaseCall {
+func (c *MockTransactionQuerierDeleteMssqlDatabaseCall) Return(arg0 error) *MockTransactionQuerierDeleteMssqlDatabaseCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierDeletePostgresDatabaseCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeletePostgresDatabaseCall {
+func (c *MockTransactionQuerierDeleteMssqlDatabaseCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteMssqlDatabaseCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierDeletePostgresDatabaseCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeletePostgresDatabaseCall {
+func (c *MockTransactionQuerierDeleteMssqlDatabaseCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteMssqlDatabaseCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteProject mocks base method.
-func (m *MockTransactionQuerier) DeleteProject(ctx context.Context, id int64) (*queries.Project, error) {
+// DeleteMysqlDatabase mocks base method.
+func (m *MockTransactionQuerier) DeleteMysqlDatabase(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteProject", ctx, id)
-	ret0, _ := ret[0].(*queries.Project)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteMysqlDatabase", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// DeleteProject indicates an expected call of DeleteProject.
-func (mr *MockTransactionQuerierMockRecorder) DeleteProject(ctx, id any) *MockTransactionQuerierDeleteProjectCall {
+// DeleteMysqlDatabase indicates an expected call of DeleteMysqlDatabase.
+func (mr *MockTransactionQuerierMockRecorder) DeleteMysqlDatabase(ctx, id any) *MockTransactionQuerierDeleteMysqlDatabaseCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteProject), ctx, id)
-	return &MockTransactionQuerierDeleteProjectCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMysqlDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteMysqlDatabase), ctx, id)
+	return &MockTransactionQuerierDeleteMysqlDatabaseCall{Call: call}
 }
 
-// MockTransactionQuerierDeleteProjectCall wrap *gomock.Call
-type MockTransactionQuerierDeleteProjectCall struct {
+// MockTransactionQuerierDeleteMysqlDatabaseCall wrap *gomock.Call
+type MockTransactionQuerierDeleteMysqlDatabaseCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierDeleteProjectCall) Return(arg0 *queries.Project, arg1 error) *MockTransactionQuerierDeleteProjectCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockTransactionQuerierDeleteMysqlDatabaseCall) Return(arg0 error) *MockTransactionQuerierDeleteMysqlDatabaseCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierDeleteProjectCall) Do(f func(context.Context, int64) (*queries.Project, error)) *MockTransactionQuerierDeleteProjectCall {
+func (c *MockTransactionQuerierDeleteMysqlDatabaseCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteMysqlDatabaseCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierDeleteProjectCall) DoAndReturn(f func(context.Context, int64) (*queries.Project, error)) *MockTransactionQuerierDeleteProjectCall {
+func (c *MockTransactionQuerierDeleteMysqlDatabaseCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteMysqlDatabaseCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteRedisDatabase mocks base method.
-func (m *MockTransactionQuerier) DeleteRedisDatabase(ctx context.Context, id int64) error {
+// DeleteNvdCveByName mocks base method.
+func (m *MockTransactionQuerier) DeleteNvdCveByName(ctx context.Context, cveID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRedisDatabase", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteNvdCveByName", ctx, cveID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteRedisDatabase indicates an expected call of DeleteRedisDatabase.
-func (mr *MockTransactionQuerierMockRecorder) DeleteRedisDatabase(ctx, id any) *MockTransactionQuerierDeleteRedisDatabaseCall {
+// DeleteNvdCveByName indicates an expected call of DeleteNvdCveByName.
+func (mr *MockTransactionQuerierMockRecorder) DeleteNvdCveByName(ctx, cveID any) *MockTransactionQuerierDeleteNvdCveByNameCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRedisDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteRedisDatabase), ctx, id)
-	return &MockTransactionQuerierDeleteRedisDatabaseCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNvdCveByName", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteNvdCveByName), ctx, cveID)
+	return &MockTransactionQuerierDeleteNvdCveByNameCall{Call: call}
 }
 
-// MockTransactionQuerierDeleteRedisDatabaseCall wrap *gomock.Call
-type MockTransactionQuerierDeleteRedisDatabaseCall struct {
+// MockTransactionQuerierDeleteNvdCveByNameCall wrap *gomock.Call
+type MockTransactionQuerierDeleteNvdCveByNameCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierDeleteRedisDatabaseCall) Return(arg0 error) *MockTransactionQuerierDeleteRedisDatabaseCall {
+func (c *MockTransactionQuerierDeleteNvdCveByNameCall) Return(arg0 error) *MockTransactionQuerierDeleteNvdCveByNameCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierDeleteRedisDatabaseCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteRedisDatabaseCall {
+func (c *MockTransactionQuerierDeleteNvdCveByNameCall) Do(f func(context.Context, string) error) *MockTransactionQuerierDeleteNvdCveByNameCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierDeleteRedisDatabaseCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteRedisDatabaseCall {
+func (c *MockTransactionQuerierDeleteNvdCveByNameCall) DoAndReturn(f func(context.Context, string) error) *MockTransactionQuerierDeleteNvdCveByNameCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteRememberMeTokenByUserAndToken mocks base method.
-func (m *MockTransactionQuerier) DeleteRememberMeTokenByUserAndToken(ctx context.Context, arg queries.DeleteRememberMeTokenByUserAndTokenParams) error {
+// DeleteNvdCveRangesForCve mocks base method.
+func (m *MockTransactionQuerier) DeleteNvdCveRangesForCve(ctx context.Context, arg queries.DeleteNvdCveRangesForCveParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRememberMeTokenByUserAndToken", ctx, arg)
+	ret := m.ctrl.Call(m, "DeleteNvdCveRangesForCve", ctx, arg)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteRememberMeTokenByUserAndToken indicates an expected call of DeleteRememberMeTokenByUserAndToken.
-func (mr *MockTransactionQuerierMockRecorder) DeleteRememberMeTokenByUserAndToken(ctx, arg any) *MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall {
+// DeleteNvdCveRangesForCve indicates an expected call of DeleteNvdCveRangesForCve.
+func (mr *MockTransactionQuerierMockRecorder) DeleteNvdCveRangesForCve(ctx, arg any) *MockTransactionQuerierDeleteNvdCveRangesForCveCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRememberMeTokenByUserAndToken", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteRememberMeTokenByUserAndToken), ctx, arg)
-	return &MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNvdCveRangesForCve", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteNvdCveRangesForCve), ctx, arg)
+	return &MockTransactionQuerierDeleteNvdCveRangesForCveCall{Call: call}
 }
 
-// MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall wrap *gomock.Call
-type MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall struct {
+// MockTransactionQuerierDeleteNvdCveRangesForCveCall wrap *gomock.Call
+type MockTransactionQuerierDeleteNvdCveRangesForCveCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall) Return(arg0 error) *MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall {
+func (c *MockTransactionQuerierDeleteNvdCveRangesForCveCall) Return(arg0 error) *MockTransactionQuerierDeleteNvdCveRangesForCveCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall) Do(f func(context.Context, queries.DeleteRememberMeTokenByUserAndTokenParams) error) *MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall {
+func (c *MockTransactionQuerierDeleteNvdCveRangesForCveCall) Do(f func(context.Context, queries.DeleteNvdCveRangesForCveParams) error) *MockTransactionQuerierDeleteNvdCveRangesForCveCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall) DoAndReturn(f func(context.Context, queries.DeleteRememberMeTokenByUserAndTokenParams) error) *MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall {
+func (c *MockTransactionQuerierDeleteNvdCveRangesForCveCall) DoAndReturn(f func(context.Context, queries.DeleteNvdCveRangesForCveParams) error) *MockTransactionQuerierDeleteNvdCveRangesForCveCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteRememberMeTokensForUser mocks base method.
-func (m *MockTransactionQuerier) DeleteRememberMeTokensForUser(ctx context.Context, userID int64) error {
+// DeleteOrganization mocks base method.
+func (m *MockTransactionQuerier) DeleteOrganization(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRememberMeTokensForUser", ctx, userID)
+	ret := m.ctrl.Call(m, "DeleteOrganization", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteRememberMeTokensForUser indicates an expected call of DeleteRememberMeTokensForUser.
-func (mr *MockTransactionQuerierMockRecorder) DeleteRememberMeTokensForUser(ctx, userID any) *MockTransactionQuerierDeleteRememberMeTokensForUserCall {
+// DeleteOrganization indicates an expected call of DeleteOrganization.
+func (mr *MockTransactionQuerierMockRecorder) DeleteOrganization(ctx, id any) *MockTransactionQuerierDeleteOrganizationCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRememberMeTokensForUser", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteRememberMeTokensForUser), ctx, userID)
-	return &MockTransactionQuerierDeleteRememberMeTokensForUserCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganization", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteOrganization), ctx, id)
+	return &MockTransactionQuerierDeleteOrganizationCall{Call: call}
 }
 
-// MockTransactionQuerierDeleteRememberMeTokensForUserCall wrap *gomock.Call
-type MockTransactionQuerierDeleteRememberMeTokensForUserCall struct {
+// MockTransactionQuerierDeleteOrganizationCall wrap *gomock.Call
+type MockTransactionQuerierDeleteOrganizationCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierDeleteRememberMeTokensForUserCall) Return(arg0 error) *MockTransactionQuerierDeleteRememberMeTokensForUserCall {
+func (c *MockTransactionQuerierDeleteOrganizationCall) Return(arg0 error) *MockTransactionQuerierDeleteOrganizationCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierDeleteRememberMeTokensForUserCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteRememberMeTokensForUserCall {
+func (c *MockTransactionQuerierDeleteOrganizationCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteOrganizationCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierDeleteRememberMeTokensForUserCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteRememberMeTokensForUserCall {
+func (c *MockTransactionQuerierDeleteOrganizationCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteOrganizationCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteUser mocks base method.
-func (m *MockTransactionQuerier) DeleteUser(ctx context.Context, id int64) error {
+// DeletePostgresDatabase mocks base method.
+func (m *MockTransactionQuerier) DeletePostgresDatabase(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUser", ctx, id)
+	ret := m.ctrl.Call(m, "DeletePostgresDatabase", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteUser indicates an expected call of DeleteUser.
-func (mr *MockTransactionQuerierMockRecorder) DeleteUser(ctx, id any) *MockTransactionQuerierDeleteUserCall {
+// DeletePostgresDatabase indicates an expected call of DeletePostgresDatabase.
+func (mr *MockTransactionQuerierMockRecorder) DeletePostgresDatabase(ctx, id any) *MockTransactionQuerierDeletePostgresDatabaseCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteUser), ctx, id)
-	return &MockTransactionQuerierDeleteUserCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePostgresDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).DeletePostgresDatabase), ctx, id)
+	return &MockTransactionQuerierDeletePostgresDatabaseCall{Call: call}
 }
 
-// MockTransactionQuerierDeleteUserCall wrap *gomock.Call
-type MockTransactionQuerierDeleteUserCall struct {
+// MockTransactionQuerierDeletePostgresDatabaseCall wrap *gomock.Call
+type MockTransactionQuerierDeletePostgresDatabaseCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierDeleteUserCall) Return(arg0 error) *MockTransactionQuerierDeleteUserCall {
+func (c *MockTransactionQuerierDeletePostgresDatabaseCall) Return(arg0 error) *MockTransactionQuerierDeletePostgresDatabaseCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierDeleteUserCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteUserCall {
+func (c *MockTransactionQuerierDeletePostgresDatabaseCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeletePostgresDatabaseCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierDeleteUserCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteUserCall {
+func (c *MockTransactionQuerierDeletePostgresDatabaseCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeletePostgresDatabaseCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteWorker mocks base method.
-func (m *MockTransactionQuerier) DeleteWorker(ctx context.Context, id int64) (*queries.Worker, error) {
+// DeleteProject mocks base method.
+func (m *MockTransactionQuerier) DeleteProject(ctx context.Context, id int64) (*queries.Project, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteWorker", ctx, id)
-	ret0, _ := ret[0].(*queries.Worker)
+	ret := m.ctrl.Call(m, "DeleteProject", ctx, id)
+	ret0, _ := ret[0].(*queries.Project)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteWorker indicates an expected call of DeleteWorker.
-func (mr *MockTransactionQuerierMockRecorder) DeleteWorker(ctx, id any) *MockTransactionQuerierDeleteWorkerCall {
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockTransactionQuerierMockRecorder) DeleteProject(ctx, id any) *MockTransactionQuerierDeleteProjectCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorker", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteWorker), ctx, id)
-	return &MockTransactionQuerierDeleteWorkerCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteProject), ctx, id)
+	return &MockTransactionQuerierDeleteProjectCall{Call: call}
 }
 
-// MockTransactionQuerierDeleteWorkerCall wrap *gomock.Call
-type MockTransactionQuerierDeleteWorkerCall struct {
+// MockTransactionQuerierDeleteProjectCall wrap *gomock.Call
+type MockTransactionQuerierDeleteProjectCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierDeleteWorkerCall) Return(arg0 *queries.Worker, arg1 error) *MockTransactionQuerierDeleteWorkerCall {
+func (c *MockTransactionQuerierDeleteProjectCall) Return(arg0 *queries.Project, arg1 error) *MockTransactionQuerierDeleteProjectCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierDeleteWorkerCall) Do(f func(context.Context, int64) (*queries.Worker, error)) *MockTransactionQuerierDeleteWorkerCall {
+func (c *MockTransactionQuerierDeleteProjectCall) Do(f func(context.Context, int64) (*queries.Project, error)) *MockTransactionQuerierDeleteProjectCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierDeleteWorkerCall) DoAndReturn(f func(context.Context, int64) (*queries.Worker, error)) *MockTransactionQuerierDeleteWorkerCall {
+func (c *MockTransactionQuerierDeleteProjectCall) DoAndReturn(f func(context.Context, int64) (*queries.Project, error)) *MockTransactionQuerierDeleteProjectCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// EndTransaction mocks base method.
-func (m *MockTransactionQuerier) EndTransaction(ctx context.Context, rollback bool) error {
+// DeleteProjectDetectorSet mocks base method.
+func (m *MockTransactionQuerier) DeleteProjectDetectorSet(ctx context.Context, projectID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EndTransaction", ctx, rollback)
+	ret := m.ctrl.Call(m, "DeleteProjectDetectorSet", ctx, projectID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// EndTransaction indicates an expected call of EndTransaction.
-func (mr *MockTransactionQuerierMockRecorder) EndTransaction(ctx, rollback any) *MockTransactionQuerierEndTransactionCall {
+// DeleteProjectDetectorSet indicates an expected call of DeleteProjectDetectorSet.
+func (mr *MockTransactionQuerierMockRecorder) DeleteProjectDetectorSet(ctx, projectID any) *MockTransactionQuerierDeleteProjectDetectorSetCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTransaction", reflect.TypeOf((*MockTransactionQuerier)(nil).EndTransaction), ctx, rollback)
-	return &MockTransactionQuerierEndTransactionCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectDetectorSet", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteProjectDetectorSet), ctx, projectID)
+	return &MockTransactionQuerierDeleteProjectDetectorSetCall{Call: call}
 }
 
-// MockTransactionQuerierEndTransactionCall wrap *gomock.Call
-type MockTransactionQuerierEndTransactionCall struct {
+// MockTransactionQuerierDeleteProjectDetectorSetCall wrap *gomock.Call
+type MockTransactionQuerierDeleteProjectDetectorSetCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierEndTransactionCall) Return(arg0 error) *MockTransactionQuerierEndTransactionCall {
+func (c *MockTransactionQuerierDeleteProjectDetectorSetCall) Return(arg0 error) *MockTransactionQuerierDeleteProjectDetectorSetCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierEndTransactionCall) Do(f func(context.Context, bool) error) *MockTransactionQuerierEndTransactionCall {
+func (c *MockTransactionQuerierDeleteProjectDetectorSetCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteProjectDetectorSetCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierEndTransactionCall) DoAndReturn(f func(context.Context, bool) error) *MockTransactionQuerierEndTransactionCall {
+func (c *MockTransactionQuerierDeleteProjectDetectorSetCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteProjectDetectorSetCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetAllOrganizationMembersForOrganizationsThatContainUser mocks base method.
-func (m *MockTransactionQuerier) GetAllOrganizationMembersForOrganizationsThatContainUser(ctx context.Context, userID int64) ([]*queries.GetAllOrganizationMembersForOrganizationsThatContainUserRow, error) {
+// DeleteProjectIgnoredCve mocks base method.
+func (m *MockTransactionQuerier) DeleteProjectIgnoredCve(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllOrganizationMembersForOrganizationsThatContainUser", ctx, userID)
-	ret0, _ := ret[0].([]*queries.GetAllOrganizationMembersForOrganizationsThatContainUserRow)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteProjectIgnoredCve", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetAllOrganizationMembersForOrganizationsThatContainUser indicates an expected call of GetAllOrganizationMembersForOrganizationsThatContainUser.
-func (mr *MockTransactionQuerierMockRecorder) GetAllOrganizationMembersForOrganizationsThatContainUser(ctx, userID any) *MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall {
+// DeleteProjectIgnoredCve indicates an expected call of DeleteProjectIgnoredCve.
+func (mr *MockTransactionQuerierMockRecorder) DeleteProjectIgnoredCve(ctx, id any) *MockTransactionQuerierDeleteProjectIgnoredCveCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllOrganizationMembersForOrganizationsThatContainUser", reflect.TypeOf((*MockTransactionQuerier)(nil).GetAllOrganizationMembersForOrganizationsThatContainUser), ctx, userID)
-	return &MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectIgnoredCve", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteProjectIgnoredCve), ctx, id)
+	return &MockTransactionQuerierDeleteProjectIgnoredCveCall{Call: call}
 }
 
-// MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall wrap *gomock.Call
-type MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall struct {
+// MockTransactionQuerierDeleteProjectIgnoredCveCall wrap *gomock.Call
+type MockTransactionQuerierDeleteProjectIgnoredCveCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall) Return(arg0 []*queries.GetAllOrganizationMembersForOrganizationsThatContainUserRow, arg1 error) *MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockTransactionQuerierDeleteProjectIgnoredCveCall) Return(arg0 error) *MockTransactionQuerierDeleteProjectIgnoredCveCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall) Do(f func(context.Context, int64) ([]*queries.GetAllOrganizationMembersForOrganizationsThatContainUserRow, error)) *MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall {
+func (c *MockTransactionQuerierDeleteProjectIgnoredCveCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteProjectIgnoredCveCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall) DoAndReturn(f func(context.Context, int64) ([]*queries.GetAllOrganizationMembersForOrganizationsThatContainUserRow, error)) *MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall {
+func (c *MockTransactionQuerierDeleteProjectIgnoredCveCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteProjectIgnoredCveCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetAllOrganizationProjectsForUser mocks base method.
-func (m *MockTransactionQuerier) GetAllOrganizationProjectsForUser(ctx context.Context, userID int64) ([]*queries.GetAllOrganizationProjectsForUserRow, error) {
+// DeleteProjectPasswordRules mocks base method.
+func (m *MockTransactionQuerier) DeleteProjectPasswordRules(ctx context.Context, projectID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllOrganizationProjectsForUser", ctx, userID)
-	ret0, _ := ret[0].([]*queries.GetAllOrganizationProjectsForUserRow)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteProjectPasswordRules", ctx, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetAllOrganizationProjectsForUser indicates an expected call of GetAllOrganizationProjectsForUser.
-func (mr *MockTransactionQuerierMockRecorder) GetAllOrganizationProjectsForUser(ctx, userID any) *MockTransactionQuerierGetAllOrganizationProjectsForUserCall {
+// DeleteProjectPasswordRules indicates an expected call of DeleteProjectPasswordRules.
+func (mr *MockTransactionQuerierMockRecorder) DeleteProjectPasswordRules(ctx, projectID any) *MockTransactionQuerierDeleteProjectPasswordRulesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllOrganizationProjectsForUser", reflect.TypeOf((*MockTransactionQuerier)(nil).GetAllOrganizationProjectsForUser), ctx, userID)
-	return &MockTransactionQuerierGetAllOrganizationProjectsForUserCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectPasswordRules", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteProjectPasswordRules), ctx, projectID)
+	return &MockTransactionQuerierDeleteProjectPasswordRulesCall{Call: call}
 }
 
-// MockTransactionQuerierGetAllOrganizationProjectsForUserCall wrap *gomock.Call
-type MockTransactionQuerierGetAllOrganizationProjectsForUserCall struct {
+// MockTransactionQuerierDeleteProjectPasswordRulesCall wrap *gomock.Call
+type MockTransactionQuerierDeleteProjectPasswordRulesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetAllOrganizationProjectsForUserCall) Return(arg0 []*queries.GetAllOrganizationProjectsForUserRow, arg1 error) *MockTransactionQuerierGetAllOrganizationProjectsForUserCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockTransactionQuerierDeleteProjectPasswordRulesCall) Return(arg0 error) *MockTransactionQuerierDeleteProjectPasswordRulesCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetAllOrganizationProjectsForUserCall) Do(f func(context.Context, int64) ([]*queries.GetAllOrganizationProjectsForUserRow, error)) *MockTransactionQuerierGetAllOrganizationProjectsForUserCall {
+func (c *MockTransactionQuerierDeleteProjectPasswordRulesCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteProjectPasswordRulesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetAllOrganizationProjectsForUserCall) DoAndReturn(f func(context.Context, int64) ([]*queries.GetAllOrganizationProjectsForUserRow, error)) *MockTransactionQuerierGetAllOrganizationProjectsForUserCall {
+func (c *MockTransactionQuerierDeleteProjectPasswordRulesCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteProjectPasswordRulesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetBruteforcePasswordsForProjectCount mocks base method.
-func (m *MockTransactionQuerier) GetBruteforcePasswordsForProjectCount(ctx context.Context, projectID int64) (int64, error) {
+// DeleteRedisDatabase mocks base method.
+func (m *MockTransactionQuerier) DeleteRedisDatabase(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBruteforcePasswordsForProjectCount", ctx, projectID)
-	ret0, _ := ret[0].(int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteRedisDatabase", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetBruteforcePasswordsForProjectCount indicates an expected call of GetBruteforcePasswordsForProjectCount.
-func (mr *MockTransactionQuerierMockRecorder) GetBruteforcePasswordsForProjectCount(ctx, projectID any) *MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall {
+// DeleteRedisDatabase indicates an expected call of DeleteRedisDatabase.
+func (mr *MockTransactionQuerierMockRecorder) DeleteRedisDatabase(ctx, id any) *MockTransactionQuerierDeleteRedisDatabaseCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBruteforcePasswordsForProjectCount", reflect.TypeOf((*MockTransactionQuerier)(nil).GetBruteforcePasswordsForProjectCount), ctx, projectID)
-	return &MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRedisDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteRedisDatabase), ctx, id)
+	return &MockTransactionQuerierDeleteRedisDatabaseCall{Call: call}
 }
 
-// MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall wrap *gomock.Call
-type MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall struct {
+// MockTransactionQuerierDeleteRedisDatabaseCall wrap *gomock.Call
+type MockTransactionQuerierDeleteRedisDatabaseCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall) Return(arg0 int64, arg1 error) *MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockTransactionQuerierDeleteRedisDatabaseCall) Return(arg0 error) *MockTransactionQuerierDeleteRedisDatabaseCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall) Do(f func(context.Context, int64) (int64, error)) *MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall {
+func (c *MockTransactionQuerierDeleteRedisDatabaseCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteRedisDatabaseCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall) DoAndReturn(f func(context.Context, int64) (int64, error)) *MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall {
+func (c *MockTransactionQuerierDeleteRedisDatabaseCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteRedisDatabaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteRememberMeTokenByUserAndToken mocks base method.
+func (m *MockTransactionQuerier) DeleteRememberMeTokenByUserAndToken(ctx context.Context, arg queries.DeleteRememberMeTokenByUserAndTokenParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRememberMeTokenByUserAndToken", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRememberMeTokenByUserAndToken indicates an expected call of DeleteRememberMeTokenByUserAndToken.
+func (mr *MockTransactionQuerierMockRecorder) DeleteRememberMeTokenByUserAndToken(ctx, arg any) *MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRememberMeTokenByUserAndToken", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteRememberMeTokenByUserAndToken), ctx, arg)
+	return &MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall{Call: call}
+}
+
+// MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall wrap *gomock.Call
+type MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall) Return(arg0 error) *MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall) Do(f func(context.Context, queries.DeleteRememberMeTokenByUserAndTokenParams) error) *MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall) DoAndReturn(f func(context.Context, queries.DeleteRememberMeTokenByUserAndTokenParams) error) *MockTransactionQuerierDeleteRememberMeTokenByUserAndTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteRememberMeTokensForUser mocks base method.
+func (m *MockTransactionQuerier) DeleteRememberMeTokensForUser(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRememberMeTokensForUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRememberMeTokensForUser indicates an expected call of DeleteRememberMeTokensForUser.
+func (mr *MockTransactionQuerierMockRecorder) DeleteRememberMeTokensForUser(ctx, userID any) *MockTransactionQuerierDeleteRememberMeTokensForUserCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRememberMeTokensForUser", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteRememberMeTokensForUser), ctx, userID)
+	return &MockTransactionQuerierDeleteRememberMeTokensForUserCall{Call: call}
+}
+
+// MockTransactionQuerierDeleteRememberMeTokensForUserCall wrap *gomock.Call
+type MockTransactionQuerierDeleteRememberMeTokensForUserCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierDeleteRememberMeTokensForUserCall) Return(arg0 error) *MockTransactionQuerierDeleteRememberMeTokensForUserCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierDeleteRememberMeTokensForUserCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteRememberMeTokensForUserCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierDeleteRememberMeTokensForUserCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteRememberMeTokensForUserCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteRulePack mocks base method.
+func (m *MockTransactionQuerier) DeleteRulePack(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRulePack", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRulePack indicates an expected call of DeleteRulePack.
+func (mr *MockTransactionQuerierMockRecorder) DeleteRulePack(ctx, id any) *MockTransactionQuerierDeleteRulePackCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRulePack", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteRulePack), ctx, id)
+	return &MockTransactionQuerierDeleteRulePackCall{Call: call}
+}
+
+// MockTransactionQuerierDeleteRulePackCall wrap *gomock.Call
+type MockTransactionQuerierDeleteRulePackCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierDeleteRulePackCall) Return(arg0 error) *MockTransactionQuerierDeleteRulePackCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierDeleteRulePackCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteRulePackCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierDeleteRulePackCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteRulePackCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteUser mocks base method.
+func (m *MockTransactionQuerier) DeleteUser(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockTransactionQuerierMockRecorder) DeleteUser(ctx, id any) *MockTransactionQuerierDeleteUserCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteUser), ctx, id)
+	return &MockTransactionQuerierDeleteUserCall{Call: call}
+}
+
+// MockTransactionQuerierDeleteUserCall wrap *gomock.Call
+type MockTransactionQuerierDeleteUserCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierDeleteUserCall) Return(arg0 error) *MockTransactionQuerierDeleteUserCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierDeleteUserCall) Do(f func(context.Context, int64) error) *MockTransactionQuerierDeleteUserCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierDeleteUserCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierDeleteUserCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteWorker mocks base method.
+func (m *MockTransactionQuerier) DeleteWorker(ctx context.Context, id int64) (*queries.Worker, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorker", ctx, id)
+	ret0, _ := ret[0].(*queries.Worker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWorker indicates an expected call of DeleteWorker.
+func (mr *MockTransactionQuerierMockRecorder) DeleteWorker(ctx, id any) *MockTransactionQuerierDeleteWorkerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorker", reflect.TypeOf((*MockTransactionQuerier)(nil).DeleteWorker), ctx, id)
+	return &MockTransactionQuerierDeleteWorkerCall{Call: call}
+}
+
+// MockTransactionQuerierDeleteWorkerCall wrap *gomock.Call
+type MockTransactionQuerierDeleteWorkerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierDeleteWorkerCall) Return(arg0 *queries.Worker, arg1 error) *MockTransactionQuerierDeleteWorkerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierDeleteWorkerCall) Do(f func(context.Context, int64) (*queries.Worker, error)) *MockTransactionQuerierDeleteWorkerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierDeleteWorkerCall) DoAndReturn(f func(context.Context, int64) (*queries.Worker, error)) *MockTransactionQuerierDeleteWorkerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// EndTransaction mocks base method.
+func (m *MockTransactionQuerier) EndTransaction(ctx context.Context, rollback bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTransaction", ctx, rollback)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndTransaction indicates an expected call of EndTransaction.
+func (mr *MockTransactionQuerierMockRecorder) EndTransaction(ctx, rollback any) *MockTransactionQuerierEndTransactionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTransaction", reflect.TypeOf((*MockTransactionQuerier)(nil).EndTransaction), ctx, rollback)
+	return &MockTransactionQuerierEndTransactionCall{Call: call}
+}
+
+// MockTransactionQuerierEndTransactionCall wrap *gomock.Call
+type MockTransactionQuerierEndTransactionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierEndTransactionCall) Return(arg0 error) *MockTransactionQuerierEndTransactionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierEndTransactionCall) Do(f func(context.Context, bool) error) *MockTransactionQuerierEndTransactionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierEndTransactionCall) DoAndReturn(f func(context.Context, bool) error) *MockTransactionQuerierEndTransactionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FailScanBruteforceShard mocks base method.
+func (m *MockTransactionQuerier) FailScanBruteforceShard(ctx context.Context, arg queries.FailScanBruteforceShardParams) (*queries.ScanBruteforceShard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailScanBruteforceShard", ctx, arg)
+	ret0, _ := ret[0].(*queries.ScanBruteforceShard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailScanBruteforceShard indicates an expected call of FailScanBruteforceShard.
+func (mr *MockTransactionQuerierMockRecorder) FailScanBruteforceShard(ctx, arg any) *MockTransactionQuerierFailScanBruteforceShardCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailScanBruteforceShard", reflect.TypeOf((*MockTransactionQuerier)(nil).FailScanBruteforceShard), ctx, arg)
+	return &MockTransactionQuerierFailScanBruteforceShardCall{Call: call}
+}
+
+// MockTransactionQuerierFailScanBruteforceShardCall wrap *gomock.Call
+type MockTransactionQuerierFailScanBruteforceShardCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierFailScanBruteforceShardCall) Return(arg0 *queries.ScanBruteforceShard, arg1 error) *MockTransactionQuerierFailScanBruteforceShardCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierFailScanBruteforceShardCall) Do(f func(context.Context, queries.FailScanBruteforceShardParams) (*queries.ScanBruteforceShard, error)) *MockTransactionQuerierFailScanBruteforceShardCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierFailScanBruteforceShardCall) DoAndReturn(f func(context.Context, queries.FailScanBruteforceShardParams) (*queries.ScanBruteforceShard, error)) *MockTransactionQuerierFailScanBruteforceShardCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FinishScanBruteforceShard mocks base method.
+func (m *MockTransactionQuerier) FinishScanBruteforceShard(ctx context.Context, arg queries.FinishScanBruteforceShardParams) (*queries.ScanBruteforceShard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishScanBruteforceShard", ctx, arg)
+	ret0, _ := ret[0].(*queries.ScanBruteforceShard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishScanBruteforceShard indicates an expected call of FinishScanBruteforceShard.
+func (mr *MockTransactionQuerierMockRecorder) FinishScanBruteforceShard(ctx, arg any) *MockTransactionQuerierFinishScanBruteforceShardCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishScanBruteforceShard", reflect.TypeOf((*MockTransactionQuerier)(nil).FinishScanBruteforceShard), ctx, arg)
+	return &MockTransactionQuerierFinishScanBruteforceShardCall{Call: call}
+}
+
+// MockTransactionQuerierFinishScanBruteforceShardCall wrap *gomock.Call
+type MockTransactionQuerierFinishScanBruteforceShardCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierFinishScanBruteforceShardCall) Return(arg0 *queries.ScanBruteforceShard, arg1 error) *MockTransactionQuerierFinishScanBruteforceShardCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierFinishScanBruteforceShardCall) Do(f func(context.Context, queries.FinishScanBruteforceShardParams) (*queries.ScanBruteforceShard, error)) *MockTransactionQuerierFinishScanBruteforceShardCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierFinishScanBruteforceShardCall) DoAndReturn(f func(context.Context, queries.FinishScanBruteforceShardParams) (*queries.ScanBruteforceShard, error)) *MockTransactionQuerierFinishScanBruteforceShardCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAllOrganizationMembersForOrganizationsThatContainUser mocks base method.
+func (m *MockTransactionQuerier) GetAllOrganizationMembersForOrganizationsThatContainUser(ctx context.Context, userID int64) ([]*queries.GetAllOrganizationMembersForOrganizationsThatContainUserRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllOrganizationMembersForOrganizationsThatContainUser", ctx, userID)
+	ret0, _ := ret[0].([]*queries.GetAllOrganizationMembersForOrganizationsThatContainUserRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllOrganizationMembersForOrganizationsThatContainUser indicates an expected call of GetAllOrganizationMembersForOrganizationsThatContainUser.
+func (mr *MockTransactionQuerierMockRecorder) GetAllOrganizationMembersForOrganizationsThatContainUser(ctx, userID any) *MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllOrganizationMembersForOrganizationsThatContainUser", reflect.TypeOf((*MockTransactionQuerier)(nil).GetAllOrganizationMembersForOrganizationsThatContainUser), ctx, userID)
+	return &MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall{Call: call}
+}
+
+// MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall wrap *gomock.Call
+type MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall) Return(arg0 []*queries.GetAllOrganizationMembersForOrganizationsThatContainUserRow, arg1 error) *MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall) Do(f func(context.Context, int64) ([]*queries.GetAllOrganizationMembersForOrganizationsThatContainUserRow, error)) *MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall) DoAndReturn(f func(context.Context, int64) ([]*queries.GetAllOrganizationMembersForOrganizationsThatContainUserRow, error)) *MockTransactionQuerierGetAllOrganizationMembersForOrganizationsThatContainUserCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAllOrganizationProjectsForUser mocks base method.
+func (m *MockTransactionQuerier) GetAllOrganizationProjectsForUser(ctx context.Context, userID int64) ([]*queries.GetAllOrganizationProjectsForUserRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllOrganizationProjectsForUser", ctx, userID)
+	ret0, _ := ret[0].([]*queries.GetAllOrganizationProjectsForUserRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllOrganizationProjectsForUser indicates an expected call of GetAllOrganizationProjectsForUser.
+func (mr *MockTransactionQuerierMockRecorder) GetAllOrganizationProjectsForUser(ctx, userID any) *MockTransactionQuerierGetAllOrganizationProjectsForUserCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllOrganizationProjectsForUser", reflect.TypeOf((*MockTransactionQuerier)(nil).GetAllOrganizationProjectsForUser), ctx, userID)
+	return &MockTransactionQuerierGetAllOrganizationProjectsForUserCall{Call: call}
+}
+
+// MockTransactionQuerierGetAllOrganizationProjectsForUserCall wrap *gomock.Call
+type MockTransactionQuerierGetAllOrganizationProjectsForUserCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetAllOrganizationProjectsForUserCall) Return(arg0 []*queries.GetAllOrganizationProjectsForUserRow, arg1 error) *MockTransactionQuerierGetAllOrganizationProjectsForUserCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetAllOrganizationProjectsForUserCall) Do(f func(context.Context, int64) ([]*queries.GetAllOrganizationProjectsForUserRow, error)) *MockTransactionQuerierGetAllOrganizationProjectsForUserCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetAllOrganizationProjectsForUserCall) DoAndReturn(f func(context.Context, int64) ([]*queries.GetAllOrganizationProjectsForUserRow, error)) *MockTransactionQuerierGetAllOrganizationProjectsForUserCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetBruteforcePasswordsForProjectCount mocks base method.
+func (m *MockTransactionQuerier) GetBruteforcePasswordsForProjectCount(ctx context.Context, projectID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBruteforcePasswordsForProjectCount", ctx, projectID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBruteforcePasswordsForProjectCount indicates an expected call of GetBruteforcePasswordsForProjectCount.
+func (mr *MockTransactionQuerierMockRecorder) GetBruteforcePasswordsForProjectCount(ctx, projectID any) *MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBruteforcePasswordsForProjectCount", reflect.TypeOf((*MockTransactionQuerier)(nil).GetBruteforcePasswordsForProjectCount), ctx, projectID)
+	return &MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall{Call: call}
+}
+
+// MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall wrap *gomock.Call
+type MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall) Return(arg0 int64, arg1 error) *MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall) Do(f func(context.Context, int64) (int64, error)) *MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall) DoAndReturn(f func(context.Context, int64) (int64, error)) *MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -2100,506 +3104,935 @@ func (c *MockTransactionQuerierGetBruteforcePasswordsForProjectCountCall) DoAndR
 // GetBruteforcePasswordsPaginated mocks base method.
 func (m *MockTransactionQuerier) GetBruteforcePasswordsPaginated(ctx context.Context, arg queries.GetBruteforcePasswordsPaginatedParams) ([]*queries.DefaultBruteforcePassword, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBruteforcePasswordsPaginated", ctx, arg)
-	ret0, _ := ret[0].([]*queries.DefaultBruteforcePassword)
+	ret := m.ctrl.Call(m, "GetBruteforcePasswordsPaginated", ctx, arg)
+	ret0, _ := ret[0].([]*queries.DefaultBruteforcePassword)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBruteforcePasswordsPaginated indicates an expected call of GetBruteforcePasswordsPaginated.
+func (mr *MockTransactionQuerierMockRecorder) GetBruteforcePasswordsPaginated(ctx, arg any) *MockTransactionQuerierGetBruteforcePasswordsPaginatedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBruteforcePasswordsPaginated", reflect.TypeOf((*MockTransactionQuerier)(nil).GetBruteforcePasswordsPaginated), ctx, arg)
+	return &MockTransactionQuerierGetBruteforcePasswordsPaginatedCall{Call: call}
+}
+
+// MockTransactionQuerierGetBruteforcePasswordsPaginatedCall wrap *gomock.Call
+type MockTransactionQuerierGetBruteforcePasswordsPaginatedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetBruteforcePasswordsPaginatedCall) Return(arg0 []*queries.DefaultBruteforcePassword, arg1 error) *MockTransactionQuerierGetBruteforcePasswordsPaginatedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetBruteforcePasswordsPaginatedCall) Do(f func(context.Context, queries.GetBruteforcePasswordsPaginatedParams) ([]*queries.DefaultBruteforcePassword, error)) *MockTransactionQuerierGetBruteforcePasswordsPaginatedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetBruteforcePasswordsPaginatedCall) DoAndReturn(f func(context.Context, queries.GetBruteforcePasswordsPaginatedParams) ([]*queries.DefaultBruteforcePassword, error)) *MockTransactionQuerierGetBruteforcePasswordsPaginatedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetBruteforcePasswordsSpecificForProject mocks base method.
+func (m *MockTransactionQuerier) GetBruteforcePasswordsSpecificForProject(ctx context.Context, projectID int64) ([]sql.NullString, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBruteforcePasswordsSpecificForProject", ctx, projectID)
+	ret0, _ := ret[0].([]sql.NullString)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBruteforcePasswordsSpecificForProject indicates an expected call of GetBruteforcePasswordsSpecificForProject.
+func (mr *MockTransactionQuerierMockRecorder) GetBruteforcePasswordsSpecificForProject(ctx, projectID any) *MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBruteforcePasswordsSpecificForProject", reflect.TypeOf((*MockTransactionQuerier)(nil).GetBruteforcePasswordsSpecificForProject), ctx, projectID)
+	return &MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall{Call: call}
+}
+
+// MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall wrap *gomock.Call
+type MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall) Return(arg0 []sql.NullString, arg1 error) *MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall) Do(f func(context.Context, int64) ([]sql.NullString, error)) *MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall) DoAndReturn(f func(context.Context, int64) ([]sql.NullString, error)) *MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetBruteforcedPasswords mocks base method.
+func (m *MockTransactionQuerier) GetBruteforcedPasswords(ctx context.Context, arg queries.GetBruteforcedPasswordsParams) (*queries.BruteforcedPassword, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBruteforcedPasswords", ctx, arg)
+	ret0, _ := ret[0].(*queries.BruteforcedPassword)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBruteforcedPasswords indicates an expected call of GetBruteforcedPasswords.
+func (mr *MockTransactionQuerierMockRecorder) GetBruteforcedPasswords(ctx, arg any) *MockTransactionQuerierGetBruteforcedPasswordsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBruteforcedPasswords", reflect.TypeOf((*MockTransactionQuerier)(nil).GetBruteforcedPasswords), ctx, arg)
+	return &MockTransactionQuerierGetBruteforcedPasswordsCall{Call: call}
+}
+
+// MockTransactionQuerierGetBruteforcedPasswordsCall wrap *gomock.Call
+type MockTransactionQuerierGetBruteforcedPasswordsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetBruteforcedPasswordsCall) Return(arg0 *queries.BruteforcedPassword, arg1 error) *MockTransactionQuerierGetBruteforcedPasswordsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetBruteforcedPasswordsCall) Do(f func(context.Context, queries.GetBruteforcedPasswordsParams) (*queries.BruteforcedPassword, error)) *MockTransactionQuerierGetBruteforcedPasswordsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetBruteforcedPasswordsCall) DoAndReturn(f func(context.Context, queries.GetBruteforcedPasswordsParams) (*queries.BruteforcedPassword, error)) *MockTransactionQuerierGetBruteforcedPasswordsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCPEByProductAndVersion mocks base method.
+func (m *MockTransactionQuerier) GetCPEByProductAndVersion(ctx context.Context, arg queries.GetCPEByProductAndVersionParams) (*queries.NvdCpe, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCPEByProductAndVersion", ctx, arg)
+	ret0, _ := ret[0].(*queries.NvdCpe)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCPEByProductAndVersion indicates an expected call of GetCPEByProductAndVersion.
+func (mr *MockTransactionQuerierMockRecorder) GetCPEByProductAndVersion(ctx, arg any) *MockTransactionQuerierGetCPEByProductAndVersionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCPEByProductAndVersion", reflect.TypeOf((*MockTransactionQuerier)(nil).GetCPEByProductAndVersion), ctx, arg)
+	return &MockTransactionQuerierGetCPEByProductAndVersionCall{Call: call}
+}
+
+// MockTransactionQuerierGetCPEByProductAndVersionCall wrap *gomock.Call
+type MockTransactionQuerierGetCPEByProductAndVersionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetCPEByProductAndVersionCall) Return(arg0 *queries.NvdCpe, arg1 error) *MockTransactionQuerierGetCPEByProductAndVersionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetCPEByProductAndVersionCall) Do(f func(context.Context, queries.GetCPEByProductAndVersionParams) (*queries.NvdCpe, error)) *MockTransactionQuerierGetCPEByProductAndVersionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetCPEByProductAndVersionCall) DoAndReturn(f func(context.Context, queries.GetCPEByProductAndVersionParams) (*queries.NvdCpe, error)) *MockTransactionQuerierGetCPEByProductAndVersionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCountOfScanGroupsForProject mocks base method.
+func (m *MockTransactionQuerier) GetCountOfScanGroupsForProject(ctx context.Context, projectID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCountOfScanGroupsForProject", ctx, projectID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCountOfScanGroupsForProject indicates an expected call of GetCountOfScanGroupsForProject.
+func (mr *MockTransactionQuerierMockRecorder) GetCountOfScanGroupsForProject(ctx, projectID any) *MockTransactionQuerierGetCountOfScanGroupsForProjectCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountOfScanGroupsForProject", reflect.TypeOf((*MockTransactionQuerier)(nil).GetCountOfScanGroupsForProject), ctx, projectID)
+	return &MockTransactionQuerierGetCountOfScanGroupsForProjectCall{Call: call}
+}
+
+// MockTransactionQuerierGetCountOfScanGroupsForProjectCall wrap *gomock.Call
+type MockTransactionQuerierGetCountOfScanGroupsForProjectCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetCountOfScanGroupsForProjectCall) Return(arg0 int64, arg1 error) *MockTransactionQuerierGetCountOfScanGroupsForProjectCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetCountOfScanGroupsForProjectCall) Do(f func(context.Context, int64) (int64, error)) *MockTransactionQuerierGetCountOfScanGroupsForProjectCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetCountOfScanGroupsForProjectCall) DoAndReturn(f func(context.Context, int64) (int64, error)) *MockTransactionQuerierGetCountOfScanGroupsForProjectCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCveByCveID mocks base method.
+func (m *MockTransactionQuerier) GetCveByCveID(ctx context.Context, cveID string) (*queries.NvdCfe, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCveByCveID", ctx, cveID)
+	ret0, _ := ret[0].(*queries.NvdCfe)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCveByCveID indicates an expected call of GetCveByCveID.
+func (mr *MockTransactionQuerierMockRecorder) GetCveByCveID(ctx, cveID any) *MockTransactionQuerierGetCveByCveIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCveByCveID", reflect.TypeOf((*MockTransactionQuerier)(nil).GetCveByCveID), ctx, cveID)
+	return &MockTransactionQuerierGetCveByCveIDCall{Call: call}
+}
+
+// MockTransactionQuerierGetCveByCveIDCall wrap *gomock.Call
+type MockTransactionQuerierGetCveByCveIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetCveByCveIDCall) Return(arg0 *queries.NvdCfe, arg1 error) *MockTransactionQuerierGetCveByCveIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetCveByCveIDCall) Do(f func(context.Context, string) (*queries.NvdCfe, error)) *MockTransactionQuerierGetCveByCveIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetCveByCveIDCall) DoAndReturn(f func(context.Context, string) (*queries.NvdCfe, error)) *MockTransactionQuerierGetCveByCveIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCveCpeByCveAndCpe mocks base method.
+func (m *MockTransactionQuerier) GetCveCpeByCveAndCpe(ctx context.Context, arg queries.GetCveCpeByCveAndCpeParams) (*queries.NvdCveCpe, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCveCpeByCveAndCpe", ctx, arg)
+	ret0, _ := ret[0].(*queries.NvdCveCpe)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCveCpeByCveAndCpe indicates an expected call of GetCveCpeByCveAndCpe.
+func (mr *MockTransactionQuerierMockRecorder) GetCveCpeByCveAndCpe(ctx, arg any) *MockTransactionQuerierGetCveCpeByCveAndCpeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCveCpeByCveAndCpe", reflect.TypeOf((*MockTransactionQuerier)(nil).GetCveCpeByCveAndCpe), ctx, arg)
+	return &MockTransactionQuerierGetCveCpeByCveAndCpeCall{Call: call}
+}
+
+// MockTransactionQuerierGetCveCpeByCveAndCpeCall wrap *gomock.Call
+type MockTransactionQuerierGetCveCpeByCveAndCpeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetCveCpeByCveAndCpeCall) Return(arg0 *queries.NvdCveCpe, arg1 error) *MockTransactionQuerierGetCveCpeByCveAndCpeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetCveCpeByCveAndCpeCall) Do(f func(context.Context, queries.GetCveCpeByCveAndCpeParams) (*queries.NvdCveCpe, error)) *MockTransactionQuerierGetCveCpeByCveAndCpeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetCveCpeByCveAndCpeCall) DoAndReturn(f func(context.Context, queries.GetCveCpeByCveAndCpeParams) (*queries.NvdCveCpe, error)) *MockTransactionQuerierGetCveCpeByCveAndCpeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCvesByProductAndVersion mocks base method.
+func (m *MockTransactionQuerier) GetCvesByProductAndVersion(ctx context.Context, arg queries.GetCvesByProductAndVersionParams) ([]*queries.GetCvesByProductAndVersionRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCvesByProductAndVersion", ctx, arg)
+	ret0, _ := ret[0].([]*queries.GetCvesByProductAndVersionRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBruteforcePasswordsPaginated indicates an expected call of GetBruteforcePasswordsPaginated.
-func (mr *MockTransactionQuerierMockRecorder) GetBruteforcePasswordsPaginated(ctx, arg any) *MockTransactionQuerierGetBruteforcePasswordsPaginatedCall {
+// GetCvesByProductAndVersion indicates an expected call of GetCvesByProductAndVersion.
+func (mr *MockTransactionQuerierMockRecorder) GetCvesByProductAndVersion(ctx, arg any) *MockTransactionQuerierGetCvesByProductAndVersionCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBruteforcePasswordsPaginated", reflect.TypeOf((*MockTransactionQuerier)(nil).GetBruteforcePasswordsPaginated), ctx, arg)
-	return &MockTransactionQuerierGetBruteforcePasswordsPaginatedCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCvesByProductAndVersion", reflect.TypeOf((*MockTransactionQuerier)(nil).GetCvesByProductAndVersion), ctx, arg)
+	return &MockTransactionQuerierGetCvesByProductAndVersionCall{Call: call}
 }
 
-// MockTransactionQuerierGetBruteforcePasswordsPaginatedCall wrap *gomock.Call
-type MockTransactionQuerierGetBruteforcePasswordsPaginatedCall struct {
+// MockTransactionQuerierGetCvesByProductAndVersionCall wrap *gomock.Call
+type MockTransactionQuerierGetCvesByProductAndVersionCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetBruteforcePasswordsPaginatedCall) Return(arg0 []*queries.DefaultBruteforcePassword, arg1 error) *MockTransactionQuerierGetBruteforcePasswordsPaginatedCall {
+func (c *MockTransactionQuerierGetCvesByProductAndVersionCall) Return(arg0 []*queries.GetCvesByProductAndVersionRow, arg1 error) *MockTransactionQuerierGetCvesByProductAndVersionCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetBruteforcePasswordsPaginatedCall) Do(f func(context.Context, queries.GetBruteforcePasswordsPaginatedParams) ([]*queries.DefaultBruteforcePassword, error)) *MockTransactionQuerierGetBruteforcePasswordsPaginatedCall {
+func (c *MockTransactionQuerierGetCvesByProductAndVersionCall) Do(f func(context.Context, queries.GetCvesByProductAndVersionParams) ([]*queries.GetCvesByProductAndVersionRow, error)) *MockTransactionQuerierGetCvesByProductAndVersionCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetBruteforcePasswordsPaginatedCall) DoAndReturn(f func(context.Context, queries.GetBruteforcePasswordsPaginatedParams) ([]*queries.DefaultBruteforcePassword, error)) *MockTransactionQuerierGetBruteforcePasswordsPaginatedCall {
+func (c *MockTransactionQuerierGetCvesByProductAndVersionCall) DoAndReturn(f func(context.Context, queries.GetCvesByProductAndVersionParams) ([]*queries.GetCvesByProductAndVersionRow, error)) *MockTransactionQuerierGetCvesByProductAndVersionCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetBruteforcePasswordsSpecificForProject mocks base method.
-func (m *MockTransactionQuerier) GetBruteforcePasswordsSpecificForProject(ctx context.Context, projectID int64) ([]sql.NullString, error) {
+// GetDetectorSet mocks base method.
+func (m *MockTransactionQuerier) GetDetectorSet(ctx context.Context, id int64) (*queries.DetectorSet, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBruteforcePasswordsSpecificForProject", ctx, projectID)
-	ret0, _ := ret[0].([]sql.NullString)
+	ret := m.ctrl.Call(m, "GetDetectorSet", ctx, id)
+	ret0, _ := ret[0].(*queries.DetectorSet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBruteforcePasswordsSpecificForProject indicates an expected call of GetBruteforcePasswordsSpecificForProject.
-func (mr *MockTransactionQuerierMockRecorder) GetBruteforcePasswordsSpecificForProject(ctx, projectID any) *MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall {
+// GetDetectorSet indicates an expected call of GetDetectorSet.
+func (mr *MockTransactionQuerierMockRecorder) GetDetectorSet(ctx, id any) *MockTransactionQuerierGetDetectorSetCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBruteforcePasswordsSpecificForProject", reflect.TypeOf((*MockTransactionQuerier)(nil).GetBruteforcePasswordsSpecificForProject), ctx, projectID)
-	return &MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetectorSet", reflect.TypeOf((*MockTransactionQuerier)(nil).GetDetectorSet), ctx, id)
+	return &MockTransactionQuerierGetDetectorSetCall{Call: call}
 }
 
-// MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall wrap *gomock.Call
-type MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall struct {
+// MockTransactionQuerierGetDetectorSetCall wrap *gomock.Call
+type MockTransactionQuerierGetDetectorSetCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall) Return(arg0 []sql.NullString, arg1 error) *MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall {
+func (c *MockTransactionQuerierGetDetectorSetCall) Return(arg0 *queries.DetectorSet, arg1 error) *MockTransactionQuerierGetDetectorSetCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall) Do(f func(context.Context, int64) ([]sql.NullString, error)) *MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall {
+func (c *MockTransactionQuerierGetDetectorSetCall) Do(f func(context.Context, int64) (*queries.DetectorSet, error)) *MockTransactionQuerierGetDetectorSetCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall) DoAndReturn(f func(context.Context, int64) ([]sql.NullString, error)) *MockTransactionQuerierGetBruteforcePasswordsSpecificForProjectCall {
+func (c *MockTransactionQuerierGetDetectorSetCall) DoAndReturn(f func(context.Context, int64) (*queries.DetectorSet, error)) *MockTransactionQuerierGetDetectorSetCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetBruteforcedPasswords mocks base method.
-func (m *MockTransactionQuerier) GetBruteforcedPasswords(ctx context.Context, arg queries.GetBruteforcedPasswordsParams) (*queries.BruteforcedPassword, error) {
+// GetDetectorSetForProject mocks base method.
+func (m *MockTransactionQuerier) GetDetectorSetForProject(ctx context.Context, projectID int64) (*queries.DetectorSet, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBruteforcedPasswords", ctx, arg)
-	ret0, _ := ret[0].(*queries.BruteforcedPassword)
+	ret := m.ctrl.Call(m, "GetDetectorSetForProject", ctx, projectID)
+	ret0, _ := ret[0].(*queries.DetectorSet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBruteforcedPasswords indicates an expected call of GetBruteforcedPasswords.
-func (mr *MockTransactionQuerierMockRecorder) GetBruteforcedPasswords(ctx, arg any) *MockTransactionQuerierGetBruteforcedPasswordsCall {
+// GetDetectorSetForProject indicates an expected call of GetDetectorSetForProject.
+func (mr *MockTransactionQuerierMockRecorder) GetDetectorSetForProject(ctx, projectID any) *MockTransactionQuerierGetDetectorSetForProjectCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBruteforcedPasswords", reflect.TypeOf((*MockTransactionQuerier)(nil).GetBruteforcedPasswords), ctx, arg)
-	return &MockTransactionQuerierGetBruteforcedPasswordsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetectorSetForProject", reflect.TypeOf((*MockTransactionQuerier)(nil).GetDetectorSetForProject), ctx, projectID)
+	return &MockTransactionQuerierGetDetectorSetForProjectCall{Call: call}
 }
 
-// MockTransactionQuerierGetBruteforcedPasswordsCall wrap *gomock.Call
-type MockTransactionQuerierGetBruteforcedPasswordsCall struct {
+// MockTransactionQuerierGetDetectorSetForProjectCall wrap *gomock.Call
+type MockTransactionQuerierGetDetectorSetForProjectCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetBruteforcedPasswordsCall) Return(arg0 *queries.BruteforcedPassword, arg1 error) *MockTransactionQuerierGetBruteforcedPasswordsCall {
+func (c *MockTransactionQuerierGetDetectorSetForProjectCall) Return(arg0 *queries.DetectorSet, arg1 error) *MockTransactionQuerierGetDetectorSetForProjectCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetBruteforcedPasswordsCall) Do(f func(context.Context, queries.GetBruteforcedPasswordsParams) (*queries.BruteforcedPassword, error)) *MockTransactionQuerierGetBruteforcedPasswordsCall {
+func (c *MockTransactionQuerierGetDetectorSetForProjectCall) Do(f func(context.Context, int64) (*queries.DetectorSet, error)) *MockTransactionQuerierGetDetectorSetForProjectCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetBruteforcedPasswordsCall) DoAndReturn(f func(context.Context, queries.GetBruteforcedPasswordsParams) (*queries.BruteforcedPassword, error)) *MockTransactionQuerierGetBruteforcedPasswordsCall {
+func (c *MockTransactionQuerierGetDetectorSetForProjectCall) DoAndReturn(f func(context.Context, int64) (*queries.DetectorSet, error)) *MockTransactionQuerierGetDetectorSetForProjectCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetCPEByProductAndVersion mocks base method.
-func (m *MockTransactionQuerier) GetCPEByProductAndVersion(ctx context.Context, arg queries.GetCPEByProductAndVersionParams) (*queries.NvdCpe, error) {
+// GetDetectorSetsForOrganization mocks base method.
+func (m *MockTransactionQuerier) GetDetectorSetsForOrganization(ctx context.Context, organizationID int64) ([]*queries.DetectorSet, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCPEByProductAndVersion", ctx, arg)
-	ret0, _ := ret[0].(*queries.NvdCpe)
+	ret := m.ctrl.Call(m, "GetDetectorSetsForOrganization", ctx, organizationID)
+	ret0, _ := ret[0].([]*queries.DetectorSet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCPEByProductAndVersion indicates an expected call of GetCPEByProductAndVersion.
-func (mr *MockTransactionQuerierMockRecorder) GetCPEByProductAndVersion(ctx, arg any) *MockTransactionQuerierGetCPEByProductAndVersionCall {
+// GetDetectorSetsForOrganization indicates an expected call of GetDetectorSetsForOrganization.
+func (mr *MockTransactionQuerierMockRecorder) GetDetectorSetsForOrganization(ctx, organizationID any) *MockTransactionQuerierGetDetectorSetsForOrganizationCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCPEByProductAndVersion", reflect.TypeOf((*MockTransactionQuerier)(nil).GetCPEByProductAndVersion), ctx, arg)
-	return &MockTransactionQuerierGetCPEByProductAndVersionCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetectorSetsForOrganization", reflect.TypeOf((*MockTransactionQuerier)(nil).GetDetectorSetsForOrganization), ctx, organizationID)
+	return &MockTransactionQuerierGetDetectorSetsForOrganizationCall{Call: call}
 }
 
-// MockTransactionQuerierGetCPEByProductAndVersionCall wrap *gomock.Call
-type MockTransactionQuerierGetCPEByProductAndVersionCall struct {
+// MockTransactionQuerierGetDetectorSetsForOrganizationCall wrap *gomock.Call
+type MockTransactionQuerierGetDetectorSetsForOrganizationCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetCPEByProductAndVersionCall) Return(arg0 *queries.NvdCpe, arg1 error) *MockTransactionQuerierGetCPEByProductAndVersionCall {
+func (c *MockTransactionQuerierGetDetectorSetsForOrganizationCall) Return(arg0 []*queries.DetectorSet, arg1 error) *MockTransactionQuerierGetDetectorSetsForOrganizationCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetCPEByProductAndVersionCall) Do(f func(context.Context, queries.GetCPEByProductAndVersionParams) (*queries.NvdCpe, error)) *MockTransactionQuerierGetCPEByProductAndVersionCall {
+func (c *MockTransactionQuerierGetDetectorSetsForOrganizationCall) Do(f func(context.Context, int64) ([]*queries.DetectorSet, error)) *MockTransactionQuerierGetDetectorSetsForOrganizationCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetCPEByProductAndVersionCall) DoAndReturn(f func(context.Context, queries.GetCPEByProductAndVersionParams) (*queries.NvdCpe, error)) *MockTransactionQuerierGetCPEByProductAndVersionCall {
+func (c *MockTransactionQuerierGetDetectorSetsForOrganizationCall) DoAndReturn(f func(context.Context, int64) ([]*queries.DetectorSet, error)) *MockTransactionQuerierGetDetectorSetsForOrganizationCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetCountOfScanGroupsForProject mocks base method.
-func (m *MockTransactionQuerier) GetCountOfScanGroupsForProject(ctx context.Context, projectID int64) (int64, error) {
+// GetDockerImage mocks base method.
+func (m *MockTransactionQuerier) GetDockerImage(ctx context.Context, arg queries.GetDockerImageParams) (*queries.GetDockerImageRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCountOfScanGroupsForProject", ctx, projectID)
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "GetDockerImage", ctx, arg)
+	ret0, _ := ret[0].(*queries.GetDockerImageRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCountOfScanGroupsForProject indicates an expected call of GetCountOfScanGroupsForProject.
-func (mr *MockTransactionQuerierMockRecorder) GetCountOfScanGroupsForProject(ctx, projectID any) *MockTransactionQuerierGetCountOfScanGroupsForProjectCall {
+// GetDockerImage indicates an expected call of GetDockerImage.
+func (mr *MockTransactionQuerierMockRecorder) GetDockerImage(ctx, arg any) *MockTransactionQuerierGetDockerImageCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountOfScanGroupsForProject", reflect.TypeOf((*MockTransactionQuerier)(nil).GetCountOfScanGroupsForProject), ctx, projectID)
-	return &MockTransactionQuerierGetCountOfScanGroupsForProjectCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDockerImage", reflect.TypeOf((*MockTransactionQuerier)(nil).GetDockerImage), ctx, arg)
+	return &MockTransactionQuerierGetDockerImageCall{Call: call}
 }
 
-// MockTransactionQuerierGetCountOfScanGroupsForProjectCall wrap *gomock.Call
-type MockTransactionQuerierGetCountOfScanGroupsForProjectCall struct {
+// MockTransactionQuerierGetDockerImageCall wrap *gomock.Call
+type MockTransactionQuerierGetDockerImageCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetCountOfScanGroupsForProjectCall) Return(arg0 int64, arg1 error) *MockTransactionQuerierGetCountOfScanGroupsForProjectCall {
+func (c *MockTransactionQuerierGetDockerImageCall) Return(arg0 *queries.GetDockerImageRow, arg1 error) *MockTransactionQuerierGetDockerImageCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetCountOfScanGroupsForProjectCall) Do(f func(context.Context, int64) (int64, error)) *MockTransactionQuerierGetCountOfScanGroupsForProjectCall {
+func (c *MockTransactionQuerierGetDockerImageCall) Do(f func(context.Context, queries.GetDockerImageParams) (*queries.GetDockerImageRow, error)) *MockTransactionQuerierGetDockerImageCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetCountOfScanGroupsForProjectCall) DoAndReturn(f func(context.Context, int64) (int64, error)) *MockTransactionQuerierGetCountOfScanGroupsForProjectCall {
+func (c *MockTransactionQuerierGetDockerImageCall) DoAndReturn(f func(context.Context, queries.GetDockerImageParams) (*queries.GetDockerImageRow, error)) *MockTransactionQuerierGetDockerImageCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetCveByCveID mocks base method.
-func (m *MockTransactionQuerier) GetCveByCveID(ctx context.Context, cveID string) (*queries.NvdCfe, error) {
+// GetDockerImagesForProject mocks base method.
+func (m *MockTransactionQuerier) GetDockerImagesForProject(ctx context.Context, arg queries.GetDockerImagesForProjectParams) ([]*queries.GetDockerImagesForProjectRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCveByCveID", ctx, cveID)
-	ret0, _ := ret[0].(*queries.NvdCfe)
+	ret := m.ctrl.Call(m, "GetDockerImagesForProject", ctx, arg)
+	ret0, _ := ret[0].([]*queries.GetDockerImagesForProjectRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDockerImagesForProject indicates an expected call of GetDockerImagesForProject.
+func (mr *MockTransactionQuerierMockRecorder) GetDockerImagesForProject(ctx, arg any) *MockTransactionQuerierGetDockerImagesForProjectCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDockerImagesForProject", reflect.TypeOf((*MockTransactionQuerier)(nil).GetDockerImagesForProject), ctx, arg)
+	return &MockTransactionQuerierGetDockerImagesForProjectCall{Call: call}
+}
+
+// MockTransactionQuerierGetDockerImagesForProjectCall wrap *gomock.Call
+type MockTransactionQuerierGetDockerImagesForProjectCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetDockerImagesForProjectCall) Return(arg0 []*queries.GetDockerImagesForProjectRow, arg1 error) *MockTransactionQuerierGetDockerImagesForProjectCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetDockerImagesForProjectCall) Do(f func(context.Context, queries.GetDockerImagesForProjectParams) ([]*queries.GetDockerImagesForProjectRow, error)) *MockTransactionQuerierGetDockerImagesForProjectCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetDockerImagesForProjectCall) DoAndReturn(f func(context.Context, queries.GetDockerImagesForProjectParams) ([]*queries.GetDockerImagesForProjectRow, error)) *MockTransactionQuerierGetDockerImagesForProjectCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetDockerLayersAndResultsForImage mocks base method.
+func (m *MockTransactionQuerier) GetDockerLayersAndResultsForImage(ctx context.Context, imageID int64) ([]*queries.GetDockerLayersAndResultsForImageRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDockerLayersAndResultsForImage", ctx, imageID)
+	ret0, _ := ret[0].([]*queries.GetDockerLayersAndResultsForImageRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDockerLayersAndResultsForImage indicates an expected call of GetDockerLayersAndResultsForImage.
+func (mr *MockTransactionQuerierMockRecorder) GetDockerLayersAndResultsForImage(ctx, imageID any) *MockTransactionQuerierGetDockerLayersAndResultsForImageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDockerLayersAndResultsForImage", reflect.TypeOf((*MockTransactionQuerier)(nil).GetDockerLayersAndResultsForImage), ctx, imageID)
+	return &MockTransactionQuerierGetDockerLayersAndResultsForImageCall{Call: call}
+}
+
+// MockTransactionQuerierGetDockerLayersAndResultsForImageCall wrap *gomock.Call
+type MockTransactionQuerierGetDockerLayersAndResultsForImageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetDockerLayersAndResultsForImageCall) Return(arg0 []*queries.GetDockerLayersAndResultsForImageRow, arg1 error) *MockTransactionQuerierGetDockerLayersAndResultsForImageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetDockerLayersAndResultsForImageCall) Do(f func(context.Context, int64) ([]*queries.GetDockerLayersAndResultsForImageRow, error)) *MockTransactionQuerierGetDockerLayersAndResultsForImageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetDockerLayersAndResultsForImageCall) DoAndReturn(f func(context.Context, int64) ([]*queries.GetDockerLayersAndResultsForImageRow, error)) *MockTransactionQuerierGetDockerLayersAndResultsForImageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetDockerScanByScanAndRepo mocks base method.
+func (m *MockTransactionQuerier) GetDockerScanByScanAndRepo(ctx context.Context, arg queries.GetDockerScanByScanAndRepoParams) (*queries.GetDockerScanByScanAndRepoRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDockerScanByScanAndRepo", ctx, arg)
+	ret0, _ := ret[0].(*queries.GetDockerScanByScanAndRepoRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDockerScanByScanAndRepo indicates an expected call of GetDockerScanByScanAndRepo.
+func (mr *MockTransactionQuerierMockRecorder) GetDockerScanByScanAndRepo(ctx, arg any) *MockTransactionQuerierGetDockerScanByScanAndRepoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDockerScanByScanAndRepo", reflect.TypeOf((*MockTransactionQuerier)(nil).GetDockerScanByScanAndRepo), ctx, arg)
+	return &MockTransactionQuerierGetDockerScanByScanAndRepoCall{Call: call}
+}
+
+// MockTransactionQuerierGetDockerScanByScanAndRepoCall wrap *gomock.Call
+type MockTransactionQuerierGetDockerScanByScanAndRepoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetDockerScanByScanAndRepoCall) Return(arg0 *queries.GetDockerScanByScanAndRepoRow, arg1 error) *MockTransactionQuerierGetDockerScanByScanAndRepoCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetDockerScanByScanAndRepoCall) Do(f func(context.Context, queries.GetDockerScanByScanAndRepoParams) (*queries.GetDockerScanByScanAndRepoRow, error)) *MockTransactionQuerierGetDockerScanByScanAndRepoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetDockerScanByScanAndRepoCall) DoAndReturn(f func(context.Context, queries.GetDockerScanByScanAndRepoParams) (*queries.GetDockerScanByScanAndRepoRow, error)) *MockTransactionQuerierGetDockerScanByScanAndRepoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetDockerScannedLayersForImage mocks base method.
+func (m *MockTransactionQuerier) GetDockerScannedLayersForImage(ctx context.Context, imageID int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDockerScannedLayersForImage", ctx, imageID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDockerScannedLayersForImage indicates an expected call of GetDockerScannedLayersForImage.
+func (mr *MockTransactionQuerierMockRecorder) GetDockerScannedLayersForImage(ctx, imageID any) *MockTransactionQuerierGetDockerScannedLayersForImageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDockerScannedLayersForImage", reflect.TypeOf((*MockTransactionQuerier)(nil).GetDockerScannedLayersForImage), ctx, imageID)
+	return &MockTransactionQuerierGetDockerScannedLayersForImageCall{Call: call}
+}
+
+// MockTransactionQuerierGetDockerScannedLayersForImageCall wrap *gomock.Call
+type MockTransactionQuerierGetDockerScannedLayersForImageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetDockerScannedLayersForImageCall) Return(arg0 []string, arg1 error) *MockTransactionQuerierGetDockerScannedLayersForImageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetDockerScannedLayersForImageCall) Do(f func(context.Context, int64) ([]string, error)) *MockTransactionQuerierGetDockerScannedLayersForImageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetDockerScannedLayersForImageCall) DoAndReturn(f func(context.Context, int64) ([]string, error)) *MockTransactionQuerierGetDockerScannedLayersForImageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetElasticsearchDatabase mocks base method.
+func (m *MockTransactionQuerier) GetElasticsearchDatabase(ctx context.Context, arg queries.GetElasticsearchDatabaseParams) (*queries.GetElasticsearchDatabaseRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetElasticsearchDatabase", ctx, arg)
+	ret0, _ := ret[0].(*queries.GetElasticsearchDatabaseRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCveByCveID indicates an expected call of GetCveByCveID.
-func (mr *MockTransactionQuerierMockRecorder) GetCveByCveID(ctx, cveID any) *MockTransactionQuerierGetCveByCveIDCall {
+// GetElasticsearchDatabase indicates an expected call of GetElasticsearchDatabase.
+func (mr *MockTransactionQuerierMockRecorder) GetElasticsearchDatabase(ctx, arg any) *MockTransactionQuerierGetElasticsearchDatabaseCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCveByCveID", reflect.TypeOf((*MockTransactionQuerier)(nil).GetCveByCveID), ctx, cveID)
-	return &MockTransactionQuerierGetCveByCveIDCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetElasticsearchDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).GetElasticsearchDatabase), ctx, arg)
+	return &MockTransactionQuerierGetElasticsearchDatabaseCall{Call: call}
 }
 
-// MockTransactionQuerierGetCveByCveIDCall wrap *gomock.Call
-type MockTransactionQuerierGetCveByCveIDCall struct {
+// MockTransactionQuerierGetElasticsearchDatabaseCall wrap *gomock.Call
+type MockTransactionQuerierGetElasticsearchDatabaseCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetCveByCveIDCall) Return(arg0 *queries.NvdCfe, arg1 error) *MockTransactionQuerierGetCveByCveIDCall {
+func (c *MockTransactionQuerierGetElasticsearchDatabaseCall) Return(arg0 *queries.GetElasticsearchDatabaseRow, arg1 error) *MockTransactionQuerierGetElasticsearchDatabaseCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetCveByCveIDCall) Do(f func(context.Context, string) (*queries.NvdCfe, error)) *MockTransactionQuerierGetCveByCveIDCall {
+func (c *MockTransactionQuerierGetElasticsearchDatabaseCall) Do(f func(context.Context, queries.GetElasticsearchDatabaseParams) (*queries.GetElasticsearchDatabaseRow, error)) *MockTransactionQuerierGetElasticsearchDatabaseCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetCveByCveIDCall) DoAndReturn(f func(context.Context, string) (*queries.NvdCfe, error)) *MockTransactionQuerierGetCveByCveIDCall {
+func (c *MockTransactionQuerierGetElasticsearchDatabaseCall) DoAndReturn(f func(context.Context, queries.GetElasticsearchDatabaseParams) (*queries.GetElasticsearchDatabaseRow, error)) *MockTransactionQuerierGetElasticsearchDatabaseCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetCveCpeByCveAndCpe mocks base method.
-func (m *MockTransactionQuerier) GetCveCpeByCveAndCpe(ctx context.Context, arg queries.GetCveCpeByCveAndCpeParams) (*queries.NvdCveCpe, error) {
+// GetElasticsearchDatabasesForProject mocks base method.
+func (m *MockTransactionQuerier) GetElasticsearchDatabasesForProject(ctx context.Context, arg queries.GetElasticsearchDatabasesForProjectParams) ([]*queries.GetElasticsearchDatabasesForProjectRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCveCpeByCveAndCpe", ctx, arg)
-	ret0, _ := ret[0].(*queries.NvdCveCpe)
+	ret := m.ctrl.Call(m, "GetElasticsearchDatabasesForProject", ctx, arg)
+	ret0, _ := ret[0].([]*queries.GetElasticsearchDatabasesForProjectRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCveCpeByCveAndCpe indicates an expected call of GetCveCpeByCveAndCpe.
-func (mr *MockTransactionQuerierMockRecorder) GetCveCpeByCveAndCpe(ctx, arg any) *MockTransactionQuerierGetCveCpeByCveAndCpeCall {
+// GetElasticsearchDatabasesForProject indicates an expected call of GetElasticsearchDatabasesForProject.
+func (mr *MockTransactionQuerierMockRecorder) GetElasticsearchDatabasesForProject(ctx, arg any) *MockTransactionQuerierGetElasticsearchDatabasesForProjectCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCveCpeByCveAndCpe", reflect.TypeOf((*MockTransactionQuerier)(nil).GetCveCpeByCveAndCpe), ctx, arg)
-	return &MockTransactionQuerierGetCveCpeByCveAndCpeCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetElasticsearchDatabasesForProject", reflect.TypeOf((*MockTransactionQuerier)(nil).GetElasticsearchDatabasesForProject), ctx, arg)
+	return &MockTransactionQuerierGetElasticsearchDatabasesForProjectCall{Call: call}
 }
 
-// MockTransactionQuerierGetCveCpeByCveAndCpeCall wrap *gomock.Call
-type MockTransactionQuerierGetCveCpeByCveAndCpeCall struct {
+// MockTransactionQuerierGetElasticsearchDatabasesForProjectCall wrap *gomock.Call
+type MockTransactionQuerierGetElasticsearchDatabasesForProjectCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetCveCpeByCveAndCpeCall) Return(arg0 *queries.NvdCveCpe, arg1 error) *MockTransactionQuerierGetCveCpeByCveAndCpeCall {
+func (c *MockTransactionQuerierGetElasticsearchDatabasesForProjectCall) Return(arg0 []*queries.GetElasticsearchDatabasesForProjectRow, arg1 error) *MockTransactionQuerierGetElasticsearchDatabasesForProjectCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetCveCpeByCveAndCpeCall) Do(f func(context.Context, queries.GetCveCpeByCveAndCpeParams) (*queries.NvdCveCpe, error)) *MockTransactionQuerierGetCveCpeByCveAndCpeCall {
+func (c *MockTransactionQuerierGetElasticsearchDatabasesForProjectCall) Do(f func(context.Context, queries.GetElasticsearchDatabasesForProjectParams) ([]*queries.GetElasticsearchDatabasesForProjectRow, error)) *MockTransactionQuerierGetElasticsearchDatabasesForProjectCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetCveCpeByCveAndCpeCall) DoAndReturn(f func(context.Context, queries.GetCveCpeByCveAndCpeParams) (*queries.NvdCveCpe, error)) *MockTransactionQuerierGetCveCpeByCveAndCpeCall {
+func (c *MockTransactionQuerierGetElasticsearchDatabasesForProjectCall) DoAndReturn(f func(context.Context, queries.GetElasticsearchDatabasesForProjectParams) ([]*queries.GetElasticsearchDatabasesForProjectRow, error)) *MockTransactionQuerierGetElasticsearchDatabasesForProjectCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetCvesByProductAndVersion mocks base method.
-func (m *MockTransactionQuerier) GetCvesByProductAndVersion(ctx context.Context, arg queries.GetCvesByProductAndVersionParams) ([]*queries.GetCvesByProductAndVersionRow, error) {
+// GetElasticsearchScan mocks base method.
+func (m *MockTransactionQuerier) GetElasticsearchScan(ctx context.Context, id int64) (*queries.ElasticsearchScan, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCvesByProductAndVersion", ctx, arg)
-	ret0, _ := ret[0].([]*queries.GetCvesByProductAndVersionRow)
+	ret := m.ctrl.Call(m, "GetElasticsearchScan", ctx, id)
+	ret0, _ := ret[0].(*queries.ElasticsearchScan)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCvesByProductAndVersion indicates an expected call of GetCvesByProductAndVersion.
-func (mr *MockTransactionQuerierMockRecorder) GetCvesByProductAndVersion(ctx, arg any) *MockTransactionQuerierGetCvesByProductAndVersionCall {
+// GetElasticsearchScan indicates an expected call of GetElasticsearchScan.
+func (mr *MockTransactionQuerierMockRecorder) GetElasticsearchScan(ctx, id any) *MockTransactionQuerierGetElasticsearchScanCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCvesByProductAndVersion", reflect.TypeOf((*MockTransactionQuerier)(nil).GetCvesByProductAndVersion), ctx, arg)
-	return &MockTransactionQuerierGetCvesByProductAndVersionCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetElasticsearchScan", reflect.TypeOf((*MockTransactionQuerier)(nil).GetElasticsearchScan), ctx, id)
+	return &MockTransactionQuerierGetElasticsearchScanCall{Call: call}
 }
 
-// MockTransactionQuerierGetCvesByProductAndVersionCall wrap *gomock.Call
-type MockTransactionQuerierGetCvesByProductAndVersionCall struct {
+// MockTransactionQuerierGetElasticsearchScanCall wrap *gomock.Call
+type MockTransactionQuerierGetElasticsearchScanCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetCvesByProductAndVersionCall) Return(arg0 []*queries.GetCvesByProductAndVersionRow, arg1 error) *MockTransactionQuerierGetCvesByProductAndVersionCall {
+func (c *MockTransactionQuerierGetElasticsearchScanCall) Return(arg0 *queries.ElasticsearchScan, arg1 error) *MockTransactionQuerierGetElasticsearchScanCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetCvesByProductAndVersionCall) Do(f func(context.Context, queries.GetCvesByProductAndVersionParams) ([]*queries.GetCvesByProductAndVersionRow, error)) *MockTransactionQuerierGetCvesByProductAndVersionCall {
+func (c *MockTransactionQuerierGetElasticsearchScanCall) Do(f func(context.Context, int64) (*queries.ElasticsearchScan, error)) *MockTransactionQuerierGetElasticsearchScanCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetCvesByProductAndVersionCall) DoAndReturn(f func(context.Context, queries.GetCvesByProductAndVersionParams) ([]*queries.GetCvesByProductAndVersionRow, error)) *MockTransactionQuerierGetCvesByProductAndVersionCall {
+func (c *MockTransactionQuerierGetElasticsearchScanCall) DoAndReturn(f func(context.Context, int64) (*queries.ElasticsearchScan, error)) *MockTransactionQuerierGetElasticsearchScanCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetDockerImage mocks base method.
-func (m *MockTransactionQuerier) GetDockerImage(ctx context.Context, arg queries.GetDockerImageParams) (*queries.GetDockerImageRow, error) {
+// GetElasticsearchScanByScanID mocks base method.
+func (m *MockTransactionQuerier) GetElasticsearchScanByScanID(ctx context.Context, scanID int64) (*queries.ElasticsearchScan, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDockerImage", ctx, arg)
-	ret0, _ := ret[0].(*queries.GetDockerImageRow)
+	ret := m.ctrl.Call(m, "GetElasticsearchScanByScanID", ctx, scanID)
+	ret0, _ := ret[0].(*queries.ElasticsearchScan)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDockerImage indicates an expected call of GetDockerImage.
-func (mr *MockTransactionQuerierMockRecorder) GetDockerImage(ctx, arg any) *MockTransactionQuerierGetDockerImageCall {
+// GetElasticsearchScanByScanID indicates an expected call of GetElasticsearchScanByScanID.
+func (mr *MockTransactionQuerierMockRecorder) GetElasticsearchScanByScanID(ctx, scanID any) *MockTransactionQuerierGetElasticsearchScanByScanIDCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDockerImage", reflect.TypeOf((*MockTransactionQuerier)(nil).GetDockerImage), ctx, arg)
-	return &MockTransactionQuerierGetDockerImageCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetElasticsearchScanByScanID", reflect.TypeOf((*MockTransactionQuerier)(nil).GetElasticsearchScanByScanID), ctx, scanID)
+	return &MockTransactionQuerierGetElasticsearchScanByScanIDCall{Call: call}
 }
 
-// MockTransactionQuerierGetDockerImageCall wrap *gomock.Call
-type MockTransactionQuerierGetDockerImageCall struct {
+// MockTransactionQuerierGetElasticsearchScanByScanIDCall wrap *gomock.Call
+type MockTransactionQuerierGetElasticsearchScanByScanIDCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetDockerImageCall) Return(arg0 *queries.GetDockerImageRow, arg1 error) *MockTransactionQuerierGetDockerImageCall {
+func (c *MockTransactionQuerierGetElasticsearchScanByScanIDCall) Return(arg0 *queries.ElasticsearchScan, arg1 error) *MockTransactionQuerierGetElasticsearchScanByScanIDCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetDockerImageCall) Do(f func(context.Context, queries.GetDockerImageParams) (*queries.GetDockerImageRow, error)) *MockTransactionQuerierGetDockerImageCall {
+func (c *MockTransactionQuerierGetElasticsearchScanByScanIDCall) Do(f func(context.Context, int64) (*queries.ElasticsearchScan, error)) *MockTransactionQuerierGetElasticsearchScanByScanIDCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetDockerImageCall) DoAndReturn(f func(context.Context, queries.GetDockerImageParams) (*queries.GetDockerImageRow, error)) *MockTransactionQuerierGetDockerImageCall {
+func (c *MockTransactionQuerierGetElasticsearchScanByScanIDCall) DoAndReturn(f func(context.Context, int64) (*queries.ElasticsearchScan, error)) *MockTransactionQuerierGetElasticsearchScanByScanIDCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetDockerImagesForProject mocks base method.
-func (m *MockTransactionQuerier) GetDockerImagesForProject(ctx context.Context, arg queries.GetDockerImagesForProjectParams) ([]*queries.GetDockerImagesForProjectRow, error) {
+// GetEtcdDatabase mocks base method.
+func (m *MockTransactionQuerier) GetEtcdDatabase(ctx context.Context, arg queries.GetEtcdDatabaseParams) (*queries.GetEtcdDatabaseRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDockerImagesForProject", ctx, arg)
-	ret0, _ := ret[0].([]*queries.GetDockerImagesForProjectRow)
+	ret := m.ctrl.Call(m, "GetEtcdDatabase", ctx, arg)
+	ret0, _ := ret[0].(*queries.GetEtcdDatabaseRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDockerImagesForProject indicates an expected call of GetDockerImagesForProject.
-func (mr *MockTransactionQuerierMockRecorder) GetDockerImagesForProject(ctx, arg any) *MockTransactionQuerierGetDockerImagesForProjectCall {
+// GetEtcdDatabase indicates an expected call of GetEtcdDatabase.
+func (mr *MockTransactionQuerierMockRecorder) GetEtcdDatabase(ctx, arg any) *MockTransactionQuerierGetEtcdDatabaseCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDockerImagesForProject", reflect.TypeOf((*MockTransactionQuerier)(nil).GetDockerImagesForProject), ctx, arg)
-	return &MockTransactionQuerierGetDockerImagesForProjectCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEtcdDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).GetEtcdDatabase), ctx, arg)
+	return &MockTransactionQuerierGetEtcdDatabaseCall{Call: call}
 }
 
-// MockTransactionQuerierGetDockerImagesForProjectCall wrap *gomock.Call
-type MockTransactionQuerierGetDockerImagesForProjectCall struct {
+// MockTransactionQuerierGetEtcdDatabaseCall wrap *gomock.Call
+type MockTransactionQuerierGetEtcdDatabaseCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetDockerImagesForProjectCall) Return(arg0 []*queries.GetDockerImagesForProjectRow, arg1 error) *MockTransactionQuerierGetDockerImagesForProjectCall {
+func (c *MockTransactionQuerierGetEtcdDatabaseCall) Return(arg0 *queries.GetEtcdDatabaseRow, arg1 error) *MockTransactionQuerierGetEtcdDatabaseCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetDockerImagesForProjectCall) Do(f func(context.Context, queries.GetDockerImagesForProjectParams) ([]*queries.GetDockerImagesForProjectRow, error)) *MockTransactionQuerierGetDockerImagesForProjectCall {
+func (c *MockTransactionQuerierGetEtcdDatabaseCall) Do(f func(context.Context, queries.GetEtcdDatabaseParams) (*queries.GetEtcdDatabaseRow, error)) *MockTransactionQuerierGetEtcdDatabaseCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetDockerImagesForProjectCall) DoAndReturn(f func(context.Context, queries.GetDockerImagesForProjectParams) ([]*queries.GetDockerImagesForProjectRow, error)) *MockTransactionQuerierGetDockerImagesForProjectCall {
+func (c *MockTransactionQuerierGetEtcdDatabaseCall) DoAndReturn(f func(context.Context, queries.GetEtcdDatabaseParams) (*queries.GetEtcdDatabaseRow, error)) *MockTransactionQuerierGetEtcdDatabaseCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetDockerLayersAndResultsForImage mocks base method.
-func (m *MockTransactionQuerier) GetDockerLayersAndResultsForImage(ctx context.Context, imageID int64) ([]*queries.GetDockerLayersAndResultsForImageRow, error) {
+// GetEtcdDatabasesForProject mocks base method.
+func (m *MockTransactionQuerier) GetEtcdDatabasesForProject(ctx context.Context, arg queries.GetEtcdDatabasesForProjectParams) ([]*queries.GetEtcdDatabasesForProjectRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDockerLayersAndResultsForImage", ctx, imageID)
-	ret0, _ := ret[0].([]*queries.GetDockerLayersAndResultsForImageRow)
+	ret := m.ctrl.Call(m, "GetEtcdDatabasesForProject", ctx, arg)
+	ret0, _ := ret[0].([]*queries.GetEtcdDatabasesForProjectRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDockerLayersAndResultsForImage indicates an expected call of GetDockerLayersAndResultsForImage.
-func (mr *MockTransactionQuerierMockRecorder) GetDockerLayersAndResultsForImage(ctx, imageID any) *MockTransactionQuerierGetDockerLayersAndResultsForImageCall {
+// GetEtcdDatabasesForProject indicates an expected call of GetEtcdDatabasesForProject.
+func (mr *MockTransactionQuerierMockRecorder) GetEtcdDatabasesForProject(ctx, arg any) *MockTransactionQuerierGetEtcdDatabasesForProjectCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDockerLayersAndResultsForImage", reflect.TypeOf((*MockTransactionQuerier)(nil).GetDockerLayersAndResultsForImage), ctx, imageID)
-	return &MockTransactionQuerierGetDockerLayersAndResultsForImageCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEtcdDatabasesForProject", reflect.TypeOf((*MockTransactionQuerier)(nil).GetEtcdDatabasesForProject), ctx, arg)
+	return &MockTransactionQuerierGetEtcdDatabasesForProjectCall{Call: call}
 }
 
-// MockTransactionQuerierGetDockerLayersAndResultsForImageCall wrap *gomock.Call
-type MockTransactionQuerierGetDockerLayersAndResultsForImageCall struct {
+// MockTransactionQuerierGetEtcdDatabasesForProjectCall wrap *gomock.Call
+type MockTransactionQuerierGetEtcdDatabasesForProjectCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetDockerLayersAndResultsForImageCall) Return(arg0 []*queries.GetDockerLayersAndResultsForImageRow, arg1 error) *MockTransactionQuerierGetDockerLayersAndResultsForImageCall {
+func (c *MockTransactionQuerierGetEtcdDatabasesForProjectCall) Return(arg0 []*queries.GetEtcdDatabasesForProjectRow, arg1 error) *MockTransactionQuerierGetEtcdDatabasesForProjectCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetDockerLayersAndResultsForImageCall) Do(f func(context.Context, int64) ([]*queries.GetDockerLayersAndResultsForImageRow, error)) *MockTransactionQuerierGetDockerLayersAndResultsForImageCall {
+func (c *MockTransactionQuerierGetEtcdDatabasesForProjectCall) Do(f func(context.Context, queries.GetEtcdDatabasesForProjectParams) ([]*queries.GetEtcdDatabasesForProjectRow, error)) *MockTransactionQuerierGetEtcdDatabasesForProjectCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetDockerLayersAndResultsForImageCall) DoAndReturn(f func(context.Context, int64) ([]*queries.GetDockerLayersAndResultsForImageRow, error)) *MockTransactionQuerierGetDockerLayersAndResultsForImageCall {
+func (c *MockTransactionQuerierGetEtcdDatabasesForProjectCall) DoAndReturn(f func(context.Context, queries.GetEtcdDatabasesForProjectParams) ([]*queries.GetEtcdDatabasesForProjectRow, error)) *MockTransactionQuerierGetEtcdDatabasesForProjectCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetDockerScanByScanAndRepo mocks base method.
-func (m *MockTransactionQuerier) GetDockerScanByScanAndRepo(ctx context.Context, arg queries.GetDockerScanByScanAndRepoParams) (*queries.GetDockerScanByScanAndRepoRow, error) {
+// GetEtcdScan mocks base method.
+func (m *MockTransactionQuerier) GetEtcdScan(ctx context.Context, id int64) (*queries.EtcdScan, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDockerScanByScanAndRepo", ctx, arg)
-	ret0, _ := ret[0].(*queries.GetDockerScanByScanAndRepoRow)
+	ret := m.ctrl.Call(m, "GetEtcdScan", ctx, id)
+	ret0, _ := ret[0].(*queries.EtcdScan)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDockerScanByScanAndRepo indicates an expected call of GetDockerScanByScanAndRepo.
-func (mr *MockTransactionQuerierMockRecorder) GetDockerScanByScanAndRepo(ctx, arg any) *MockTransactionQuerierGetDockerScanByScanAndRepoCall {
+// GetEtcdScan indicates an expected call of GetEtcdScan.
+func (mr *MockTransactionQuerierMockRecorder) GetEtcdScan(ctx, id any) *MockTransactionQuerierGetEtcdScanCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDockerScanByScanAndRepo", reflect.TypeOf((*MockTransactionQuerier)(nil).GetDockerScanByScanAndRepo), ctx, arg)
-	return &MockTransactionQuerierGetDockerScanByScanAndRepoCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEtcdScan", reflect.TypeOf((*MockTransactionQuerier)(nil).GetEtcdScan), ctx, id)
+	return &MockTransactionQuerierGetEtcdScanCall{Call: call}
 }
 
-// MockTransactionQuerierGetDockerScanByScanAndRepoCall wrap *gomock.Call
-type MockTransactionQuerierGetDockerScanByScanAndRepoCall struct {
+// MockTransactionQuerierGetEtcdScanCall wrap *gomock.Call
+type MockTransactionQuerierGetEtcdScanCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetDockerScanByScanAndRepoCall) Return(arg0 *queries.GetDockerScanByScanAndRepoRow, arg1 error) *MockTransactionQuerierGetDockerScanByScanAndRepoCall {
+func (c *MockTransactionQuerierGetEtcdScanCall) Return(arg0 *queries.EtcdScan, arg1 error) *MockTransactionQuerierGetEtcdScanCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetDockerScanByScanAndRepoCall) Do(f func(context.Context, queries.GetDockerScanByScanAndRepoParams) (*queries.GetDockerScanByScanAndRepoRow, error)) *MockTransactionQuerierGetDockerScanByScanAndRepoCall {
+func (c *MockTransactionQuerierGetEtcdScanCall) Do(f func(context.Context, int64) (*queries.EtcdScan, error)) *MockTransactionQuerierGetEtcdScanCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetDockerScanByScanAndRepoCall) DoAndReturn(f func(context.Context, queries.GetDockerScanByScanAndRepoParams) (*queries.GetDockerScanByScanAndRepoRow, error)) *MockTransactionQuerierGetDockerScanByScanAndRepoCall {
+func (c *MockTransactionQuerierGetEtcdScanCall) DoAndReturn(f func(context.Context, int64) (*queries.EtcdScan, error)) *MockTransactionQuerierGetEtcdScanCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetDockerScannedLayersForImage mocks base method.
-func (m *MockTransactionQuerier) GetDockerScannedLayersForImage(ctx context.Context, imageID int64) ([]string, error) {
+// GetEtcdScanByScanID mocks base method.
+func (m *MockTransactionQuerier) GetEtcdScanByScanID(ctx context.Context, scanID int64) (*queries.EtcdScan, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDockerScannedLayersForImage", ctx, imageID)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "GetEtcdScanByScanID", ctx, scanID)
+	ret0, _ := ret[0].(*queries.EtcdScan)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDockerScannedLayersForImage indicates an expected call of GetDockerScannedLayersForImage.
-func (mr *MockTransactionQuerierMockRecorder) GetDockerScannedLayersForImage(ctx, imageID any) *MockTransactionQuerierGetDockerScannedLayersForImageCall {
+// GetEtcdScanByScanID indicates an expected call of GetEtcdScanByScanID.
+func (mr *MockTransactionQuerierMockRecorder) GetEtcdScanByScanID(ctx, scanID any) *MockTransactionQuerierGetEtcdScanByScanIDCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDockerScannedLayersForImage", reflect.TypeOf((*MockTransactionQuerier)(nil).GetDockerScannedLayersForImage), ctx, imageID)
-	return &MockTransactionQuerierGetDockerScannedLayersForImageCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEtcdScanByScanID", reflect.TypeOf((*MockTransactionQuerier)(nil).GetEtcdScanByScanID), ctx, scanID)
+	return &MockTransactionQuerierGetEtcdScanByScanIDCall{Call: call}
 }
 
-// MockTransactionQuerierGetDockerScannedLayersForImageCall wrap *gomock.Call
-type MockTransactionQuerierGetDockerScannedLayersForImageCall struct {
+// MockTransactionQuerierGetEtcdScanByScanIDCall wrap *gomock.Call
+type MockTransactionQuerierGetEtcdScanByScanIDCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetDockerScannedLayersForImageCall) Return(arg0 []string, arg1 error) *MockTransactionQuerierGetDockerScannedLayersForImageCall {
+func (c *MockTransactionQuerierGetEtcdScanByScanIDCall) Return(arg0 *queries.EtcdScan, arg1 error) *MockTransactionQuerierGetEtcdScanByScanIDCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetDockerScannedLayersForImageCall) Do(f func(context.Context, int64) ([]string, error)) *MockTransactionQuerierGetDockerScannedLayersForImageCall {
+func (c *MockTransactionQuerierGetEtcdScanByScanIDCall) Do(f func(context.Context, int64) (*queries.EtcdScan, error)) *MockTransactionQuerierGetEtcdScanByScanIDCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetDockerScannedLayersForImageCall) DoAndReturn(f func(context.Context, int64) ([]string, error)) *MockTransactionQuerierGetDockerScannedLayersForImageCall {
+func (c *MockTransactionQuerierGetEtcdScanByScanIDCall) DoAndReturn(f func(context.Context, int64) (*queries.EtcdScan, error)) *MockTransactionQuerierGetEtcdScanByScanIDCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -2841,233 +4274,389 @@ func (c *MockTransactionQuerierGetGitScannedCommitsForProjectCall) DoAndReturn(f
 // GetGitScannedCommitsForProjectBatch mocks base method.
 func (m *MockTransactionQuerier) GetGitScannedCommitsForProjectBatch(ctx context.Context, arg queries.GetGitScannedCommitsForProjectBatchParams) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGitScannedCommitsForProjectBatch", ctx, arg)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "GetGitScannedCommitsForProjectBatch", ctx, arg)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGitScannedCommitsForProjectBatch indicates an expected call of GetGitScannedCommitsForProjectBatch.
+func (mr *MockTransactionQuerierMockRecorder) GetGitScannedCommitsForProjectBatch(ctx, arg any) *MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGitScannedCommitsForProjectBatch", reflect.TypeOf((*MockTransactionQuerier)(nil).GetGitScannedCommitsForProjectBatch), ctx, arg)
+	return &MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall{Call: call}
+}
+
+// MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall wrap *gomock.Call
+type MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall) Return(arg0 []string, arg1 error) *MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall) Do(f func(context.Context, queries.GetGitScannedCommitsForProjectBatchParams) ([]string, error)) *MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall) DoAndReturn(f func(context.Context, queries.GetGitScannedCommitsForProjectBatchParams) ([]string, error)) *MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetInvalidTOTPSecretForUser mocks base method.
+func (m *MockTransactionQuerier) GetInvalidTOTPSecretForUser(ctx context.Context, userID int64) (*queries.TotpSecretToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvalidTOTPSecretForUser", ctx, userID)
+	ret0, _ := ret[0].(*queries.TotpSecretToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvalidTOTPSecretForUser indicates an expected call of GetInvalidTOTPSecretForUser.
+func (mr *MockTransactionQuerierMockRecorder) GetInvalidTOTPSecretForUser(ctx, userID any) *MockTransactionQuerierGetInvalidTOTPSecretForUserCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvalidTOTPSecretForUser", reflect.TypeOf((*MockTransactionQuerier)(nil).GetInvalidTOTPSecretForUser), ctx, userID)
+	return &MockTransactionQuerierGetInvalidTOTPSecretForUserCall{Call: call}
+}
+
+// MockTransactionQuerierGetInvalidTOTPSecretForUserCall wrap *gomock.Call
+type MockTransactionQuerierGetInvalidTOTPSecretForUserCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetInvalidTOTPSecretForUserCall) Return(arg0 *queries.TotpSecretToken, arg1 error) *MockTransactionQuerierGetInvalidTOTPSecretForUserCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetInvalidTOTPSecretForUserCall) Do(f func(context.Context, int64) (*queries.TotpSecretToken, error)) *MockTransactionQuerierGetInvalidTOTPSecretForUserCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetInvalidTOTPSecretForUserCall) DoAndReturn(f func(context.Context, int64) (*queries.TotpSecretToken, error)) *MockTransactionQuerierGetInvalidTOTPSecretForUserCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMongoDatabase mocks base method.
+func (m *MockTransactionQuerier) GetMongoDatabase(ctx context.Context, arg queries.GetMongoDatabaseParams) (*queries.GetMongoDatabaseRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMongoDatabase", ctx, arg)
+	ret0, _ := ret[0].(*queries.GetMongoDatabaseRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMongoDatabase indicates an expected call of GetMongoDatabase.
+func (mr *MockTransactionQuerierMockRecorder) GetMongoDatabase(ctx, arg any) *MockTransactionQuerierGetMongoDatabaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMongoDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).GetMongoDatabase), ctx, arg)
+	return &MockTransactionQuerierGetMongoDatabaseCall{Call: call}
+}
+
+// MockTransactionQuerierGetMongoDatabaseCall wrap *gomock.Call
+type MockTransactionQuerierGetMongoDatabaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetMongoDatabaseCall) Return(arg0 *queries.GetMongoDatabaseRow, arg1 error) *MockTransactionQuerierGetMongoDatabaseCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetMongoDatabaseCall) Do(f func(context.Context, queries.GetMongoDatabaseParams) (*queries.GetMongoDatabaseRow, error)) *MockTransactionQuerierGetMongoDatabaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetMongoDatabaseCall) DoAndReturn(f func(context.Context, queries.GetMongoDatabaseParams) (*queries.GetMongoDatabaseRow, error)) *MockTransactionQuerierGetMongoDatabaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMongoDatabasesForProject mocks base method.
+func (m *MockTransactionQuerier) GetMongoDatabasesForProject(ctx context.Context, arg queries.GetMongoDatabasesForProjectParams) ([]*queries.GetMongoDatabasesForProjectRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMongoDatabasesForProject", ctx, arg)
+	ret0, _ := ret[0].([]*queries.GetMongoDatabasesForProjectRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMongoDatabasesForProject indicates an expected call of GetMongoDatabasesForProject.
+func (mr *MockTransactionQuerierMockRecorder) GetMongoDatabasesForProject(ctx, arg any) *MockTransactionQuerierGetMongoDatabasesForProjectCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMongoDatabasesForProject", reflect.TypeOf((*MockTransactionQuerier)(nil).GetMongoDatabasesForProject), ctx, arg)
+	return &MockTransactionQuerierGetMongoDatabasesForProjectCall{Call: call}
+}
+
+// MockTransactionQuerierGetMongoDatabasesForProjectCall wrap *gomock.Call
+type MockTransactionQuerierGetMongoDatabasesForProjectCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetMongoDatabasesForProjectCall) Return(arg0 []*queries.GetMongoDatabasesForProjectRow, arg1 error) *MockTransactionQuerierGetMongoDatabasesForProjectCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetMongoDatabasesForProjectCall) Do(f func(context.Context, queries.GetMongoDatabasesForProjectParams) ([]*queries.GetMongoDatabasesForProjectRow, error)) *MockTransactionQuerierGetMongoDatabasesForProjectCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetMongoDatabasesForProjectCall) DoAndReturn(f func(context.Context, queries.GetMongoDatabasesForProjectParams) ([]*queries.GetMongoDatabasesForProjectRow, error)) *MockTransactionQuerierGetMongoDatabasesForProjectCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMongoScan mocks base method.
+func (m *MockTransactionQuerier) GetMongoScan(ctx context.Context, id int64) (*queries.MongoScan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMongoScan", ctx, id)
+	ret0, _ := ret[0].(*queries.MongoScan)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetGitScannedCommitsForProjectBatch indicates an expected call of GetGitScannedCommitsForProjectBatch.
-func (mr *MockTransactionQuerierMockRecorder) GetGitScannedCommitsForProjectBatch(ctx, arg any) *MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall {
+// GetMongoScan indicates an expected call of GetMongoScan.
+func (mr *MockTransactionQuerierMockRecorder) GetMongoScan(ctx, id any) *MockTransactionQuerierGetMongoScanCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGitScannedCommitsForProjectBatch", reflect.TypeOf((*MockTransactionQuerier)(nil).GetGitScannedCommitsForProjectBatch), ctx, arg)
-	return &MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMongoScan", reflect.TypeOf((*MockTransactionQuerier)(nil).GetMongoScan), ctx, id)
+	return &MockTransactionQuerierGetMongoScanCall{Call: call}
 }
 
-// MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall wrap *gomock.Call
-type MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall struct {
+// MockTransactionQuerierGetMongoScanCall wrap *gomock.Call
+type MockTransactionQuerierGetMongoScanCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall) Return(arg0 []string, arg1 error) *MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall {
+func (c *MockTransactionQuerierGetMongoScanCall) Return(arg0 *queries.MongoScan, arg1 error) *MockTransactionQuerierGetMongoScanCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall) Do(f func(context.Context, queries.GetGitScannedCommitsForProjectBatchParams) ([]string, error)) *MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall {
+func (c *MockTransactionQuerierGetMongoScanCall) Do(f func(context.Context, int64) (*queries.MongoScan, error)) *MockTransactionQuerierGetMongoScanCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall) DoAndReturn(f func(context.Context, queries.GetGitScannedCommitsForProjectBatchParams) ([]string, error)) *MockTransactionQuerierGetGitScannedCommitsForProjectBatchCall {
+func (c *MockTransactionQuerierGetMongoScanCall) DoAndReturn(f func(context.Context, int64) (*queries.MongoScan, error)) *MockTransactionQuerierGetMongoScanCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetInvalidTOTPSecretForUser mocks base method.
-func (m *MockTransactionQuerier) GetInvalidTOTPSecretForUser(ctx context.Context, userID int64) (*queries.TotpSecretToken, error) {
+// GetMongoScanByScanID mocks base method.
+func (m *MockTransactionQuerier) GetMongoScanByScanID(ctx context.Context, scanID int64) (*queries.MongoScan, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetInvalidTOTPSecretForUser", ctx, userID)
-	ret0, _ := ret[0].(*queries.TotpSecretToken)
+	ret := m.ctrl.Call(m, "GetMongoScanByScanID", ctx, scanID)
+	ret0, _ := ret[0].(*queries.MongoScan)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetInvalidTOTPSecretForUser indicates an expected call of GetInvalidTOTPSecretForUser.
-func (mr *MockTransactionQuerierMockRecorder) GetInvalidTOTPSecretForUser(ctx, userID any) *MockTransactionQuerierGetInvalidTOTPSecretForUserCall {
+// GetMongoScanByScanID indicates an expected call of GetMongoScanByScanID.
+func (mr *MockTransactionQuerierMockRecorder) GetMongoScanByScanID(ctx, scanID any) *MockTransactionQuerierGetMongoScanByScanIDCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvalidTOTPSecretForUser", reflect.TypeOf((*MockTransactionQuerier)(nil).GetInvalidTOTPSecretForUser), ctx, userID)
-	return &MockTransactionQuerierGetInvalidTOTPSecretForUserCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMongoScanByScanID", reflect.TypeOf((*MockTransactionQuerier)(nil).GetMongoScanByScanID), ctx, scanID)
+	return &MockTransactionQuerierGetMongoScanByScanIDCall{Call: call}
 }
 
-// MockTransactionQuerierGetInvalidTOTPSecretForUserCall wrap *gomock.Call
-type MockTransactionQuerierGetInvalidTOTPSecretForUserCall struct {
+// MockTransactionQuerierGetMongoScanByScanIDCall wrap *gomock.Call
+type MockTransactionQuerierGetMongoScanByScanIDCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetInvalidTOTPSecretForUserCall) Return(arg0 *queries.TotpSecretToken, arg1 error) *MockTransactionQuerierGetInvalidTOTPSecretForUserCall {
+func (c *MockTransactionQuerierGetMongoScanByScanIDCall) Return(arg0 *queries.MongoScan, arg1 error) *MockTransactionQuerierGetMongoScanByScanIDCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetInvalidTOTPSecretForUserCall) Do(f func(context.Context, int64) (*queries.TotpSecretToken, error)) *MockTransactionQuerierGetInvalidTOTPSecretForUserCall {
+func (c *MockTransactionQuerierGetMongoScanByScanIDCall) Do(f func(context.Context, int64) (*queries.MongoScan, error)) *MockTransactionQuerierGetMongoScanByScanIDCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetInvalidTOTPSecretForUserCall) DoAndReturn(f func(context.Context, int64) (*queries.TotpSecretToken, error)) *MockTransactionQuerierGetInvalidTOTPSecretForUserCall {
+func (c *MockTransactionQuerierGetMongoScanByScanIDCall) DoAndReturn(f func(context.Context, int64) (*queries.MongoScan, error)) *MockTransactionQuerierGetMongoScanByScanIDCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetMongoDatabase mocks base method.
-func (m *MockTransactionQuerier) GetMongoDatabase(ctx context.Context, arg queries.GetMongoDatabaseParams) (*queries.GetMongoDatabaseRow, error) {
+// GetMssqlDatabase mocks base method.
+func (m *MockTransactionQuerier) GetMssqlDatabase(ctx context.Context, arg queries.GetMssqlDatabaseParams) (*queries.GetMssqlDatabaseRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMongoDatabase", ctx, arg)
-	ret0, _ := ret[0].(*queries.GetMongoDatabaseRow)
+	ret := m.ctrl.Call(m, "GetMssqlDatabase", ctx, arg)
+	ret0, _ := ret[0].(*queries.GetMssqlDatabaseRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMongoDatabase indicates an expected call of GetMongoDatabase.
-func (mr *MockTransactionQuerierMockRecorder) GetMongoDatabase(ctx, arg any) *MockTransactionQuerierGetMongoDatabaseCall {
+// GetMssqlDatabase indicates an expected call of GetMssqlDatabase.
+func (mr *MockTransactionQuerierMockRecorder) GetMssqlDatabase(ctx, arg any) *MockTransactionQuerierGetMssqlDatabaseCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMongoDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).GetMongoDatabase), ctx, arg)
-	return &MockTransactionQuerierGetMongoDatabaseCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMssqlDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).GetMssqlDatabase), ctx, arg)
+	return &MockTransactionQuerierGetMssqlDatabaseCall{Call: call}
 }
 
-// MockTransactionQuerierGetMongoDatabaseCall wrap *gomock.Call
-type MockTransactionQuerierGetMongoDatabaseCall struct {
+// MockTransactionQuerierGetMssqlDatabaseCall wrap *gomock.Call
+type MockTransactionQuerierGetMssqlDatabaseCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetMongoDatabaseCall) Return(arg0 *queries.GetMongoDatabaseRow, arg1 error) *MockTransactionQuerierGetMongoDatabaseCall {
+func (c *MockTransactionQuerierGetMssqlDatabaseCall) Return(arg0 *queries.GetMssqlDatabaseRow, arg1 error) *MockTransactionQuerierGetMssqlDatabaseCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetMongoDatabaseCall) Do(f func(context.Context, queries.GetMongoDatabaseParams) (*queries.GetMongoDatabaseRow, error)) *MockTransactionQuerierGetMongoDatabaseCall {
+func (c *MockTransactionQuerierGetMssqlDatabaseCall) Do(f func(context.Context, queries.GetMssqlDatabaseParams) (*queries.GetMssqlDatabaseRow, error)) *MockTransactionQuerierGetMssqlDatabaseCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetMongoDatabaseCall) DoAndReturn(f func(context.Context, queries.GetMongoDatabaseParams) (*queries.GetMongoDatabaseRow, error)) *MockTransactionQuerierGetMongoDatabaseCall {
+func (c *MockTransactionQuerierGetMssqlDatabaseCall) DoAndReturn(f func(context.Context, queries.GetMssqlDatabaseParams) (*queries.GetMssqlDatabaseRow, error)) *MockTransactionQuerierGetMssqlDatabaseCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetMongoDatabasesForProject mocks base method.
-func (m *MockTransactionQuerier) GetMongoDatabasesForProject(ctx context.Context, arg queries.GetMongoDatabasesForProjectParams) ([]*queries.GetMongoDatabasesForProjectRow, error) {
+// GetMssqlDatabasesForProject mocks base method.
+func (m *MockTransactionQuerier) GetMssqlDatabasesForProject(ctx context.Context, arg queries.GetMssqlDatabasesForProjectParams) ([]*queries.GetMssqlDatabasesForProjectRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMongoDatabasesForProject", ctx, arg)
-	ret0, _ := ret[0].([]*queries.GetMongoDatabasesForProjectRow)
+	ret := m.ctrl.Call(m, "GetMssqlDatabasesForProject", ctx, arg)
+	ret0, _ := ret[0].([]*queries.GetMssqlDatabasesForProjectRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMongoDatabasesForProject indicates an expected call of GetMongoDatabasesForProject.
-func (mr *MockTransactionQuerierMockRecorder) GetMongoDatabasesForProject(ctx, arg any) *MockTransactionQuerierGetMongoDatabasesForProjectCall {
+// GetMssqlDatabasesForProject indicates an expected call of GetMssqlDatabasesForProject.
+func (mr *MockTransactionQuerierMockRecorder) GetMssqlDatabasesForProject(ctx, arg any) *MockTransactionQuerierGetMssqlDatabasesForProjectCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMongoDatabasesForProject", reflect.TypeOf((*MockTransactionQuerier)(nil).GetMongoDatabasesForProject), ctx, arg)
-	return &MockTransactionQuerierGetMongoDatabasesForProjectCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMssqlDatabasesForProject", reflect.TypeOf((*MockTransactionQuerier)(nil).GetMssqlDatabasesForProject), ctx, arg)
+	return &MockTransactionQuerierGetMssqlDatabasesForProjectCall{Call: call}
 }
 
-// MockTransactionQuerierGetMongoDatabasesForProjectCall wrap *gomock.Call
-type MockTransactionQuerierGetMongoDatabasesForProjectCall struct {
+// MockTransactionQuerierGetMssqlDatabasesForProjectCall wrap *gomock.Call
+type MockTransactionQuerierGetMssqlDatabasesForProjectCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetMongoDatabasesForProjectCall) Return(arg0 []*queries.GetMongoDatabasesForProjectRow, arg1 error) *MockTransactionQuerierGetMongoDatabasesForProjectCall {
+func (c *MockTransactionQuerierGetMssqlDatabasesForProjectCall) Return(arg0 []*queries.GetMssqlDatabasesForProjectRow, arg1 error) *MockTransactionQuerierGetMssqlDatabasesForProjectCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetMongoDatabasesForProjectCall) Do(f func(context.Context, queries.GetMongoDatabasesForProjectParams) ([]*queries.GetMongoDatabasesForProjectRow, error)) *MockTransactionQuerierGetMongoDatabasesForProjectCall {
+func (c *MockTransactionQuerierGetMssqlDatabasesForProjectCall) Do(f func(context.Context, queries.GetMssqlDatabasesForProjectParams) ([]*queries.GetMssqlDatabasesForProjectRow, error)) *MockTransactionQuerierGetMssqlDatabasesForProjectCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetMongoDatabasesForProjectCall) DoAndReturn(f func(context.Context, queries.GetMongoDatabasesForProjectParams) ([]*queries.GetMongoDatabasesForProjectRow, error)) *MockTransactionQuerierGetMongoDatabasesForProjectCall {
+func (c *MockTransactionQuerierGetMssqlDatabasesForProjectCall) DoAndReturn(f func(context.Context, queries.GetMssqlDatabasesForProjectParams) ([]*queries.GetMssqlDatabasesForProjectRow, error)) *MockTransactionQuerierGetMssqlDatabasesForProjectCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetMongoScan mocks base method.
-func (m *MockTransactionQuerier) GetMongoScan(ctx context.Context, id int64) (*queries.MongoScan, error) {
+// GetMssqlScan mocks base method.
+func (m *MockTransactionQuerier) GetMssqlScan(ctx context.Context, id int64) (*queries.MssqlScan, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMongoScan", ctx, id)
-	ret0, _ := ret[0].(*queries.MongoScan)
+	ret := m.ctrl.Call(m, "GetMssqlScan", ctx, id)
+	ret0, _ := ret[0].(*queries.MssqlScan)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMongoScan indicates an expected call of GetMongoScan.
-func (mr *MockTransactionQuerierMockRecorder) GetMongoScan(ctx, id any) *MockTransactionQuerierGetMongoScanCall {
+// GetMssqlScan indicates an expected call of GetMssqlScan.
+func (mr *MockTransactionQuerierMockRecorder) GetMssqlScan(ctx, id any) *MockTransactionQuerierGetMssqlScanCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMongoScan", reflect.TypeOf((*MockTransactionQuerier)(nil).GetMongoScan), ctx, id)
-	return &MockTransactionQuerierGetMongoScanCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMssqlScan", reflect.TypeOf((*MockTransactionQuerier)(nil).GetMssqlScan), ctx, id)
+	return &MockTransactionQuerierGetMssqlScanCall{Call: call}
 }
 
-// MockTransactionQuerierGetMongoScanCall wrap *gomock.Call
-type MockTransactionQuerierGetMongoScanCall struct {
+// MockTransactionQuerierGetMssqlScanCall wrap *gomock.Call
+type MockTransactionQuerierGetMssqlScanCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetMongoScanCall) Return(arg0 *queries.MongoScan, arg1 error) *MockTransactionQuerierGetMongoScanCall {
+func (c *MockTransactionQuerierGetMssqlScanCall) Return(arg0 *queries.MssqlScan, arg1 error) *MockTransactionQuerierGetMssqlScanCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetMongoScanCall) Do(f func(context.Context, int64) (*queries.MongoScan, error)) *MockTransactionQuerierGetMongoScanCall {
+func (c *MockTransactionQuerierGetMssqlScanCall) Do(f func(context.Context, int64) (*queries.MssqlScan, error)) *MockTransactionQuerierGetMssqlScanCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetMongoScanCall) DoAndReturn(f func(context.Context, int64) (*queries.MongoScan, error)) *MockTransactionQuerierGetMongoScanCall {
+func (c *MockTransactionQuerierGetMssqlScanCall) DoAndReturn(f func(context.Context, int64) (*queries.MssqlScan, error)) *MockTransactionQuerierGetMssqlScanCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetMongoScanByScanID mocks base method.
-func (m *MockTransactionQuerier) GetMongoScanByScanID(ctx context.Context, scanID int64) (*queries.MongoScan, error) {
+// GetMssqlScanByScanID mocks base method.
+func (m *MockTransactionQuerier) GetMssqlScanByScanID(ctx context.Context, scanID int64) (*queries.MssqlScan, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMongoScanByScanID", ctx, scanID)
-	ret0, _ := ret[0].(*queries.MongoScan)
+	ret := m.ctrl.Call(m, "GetMssqlScanByScanID", ctx, scanID)
+	ret0, _ := ret[0].(*queries.MssqlScan)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMongoScanByScanID indicates an expected call of GetMongoScanByScanID.
-func (mr *MockTransactionQuerierMockRecorder) GetMongoScanByScanID(ctx, scanID any) *MockTransactionQuerierGetMongoScanByScanIDCall {
+// GetMssqlScanByScanID indicates an expected call of GetMssqlScanByScanID.
+func (mr *MockTransactionQuerierMockRecorder) GetMssqlScanByScanID(ctx, scanID any) *MockTransactionQuerierGetMssqlScanByScanIDCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMongoScanByScanID", reflect.TypeOf((*MockTransactionQuerier)(nil).GetMongoScanByScanID), ctx, scanID)
-	return &MockTransactionQuerierGetMongoScanByScanIDCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMssqlScanByScanID", reflect.TypeOf((*MockTransactionQuerier)(nil).GetMssqlScanByScanID), ctx, scanID)
+	return &MockTransactionQuerierGetMssqlScanByScanIDCall{Call: call}
 }
 
-// MockTransactionQuerierGetMongoScanByScanIDCall wrap *gomock.Call
-type MockTransactionQuerierGetMongoScanByScanIDCall struct {
+// MockTransactionQuerierGetMssqlScanByScanIDCall wrap *gomock.Call
+type MockTransactionQuerierGetMssqlScanByScanIDCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetMongoScanByScanIDCall) Return(arg0 *queries.MongoScan, arg1 error) *MockTransactionQuerierGetMongoScanByScanIDCall {
+func (c *MockTransactionQuerierGetMssqlScanByScanIDCall) Return(arg0 *queries.MssqlScan, arg1 error) *MockTransactionQuerierGetMssqlScanByScanIDCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetMongoScanByScanIDCall) Do(f func(context.Context, int64) (*queries.MongoScan, error)) *MockTransactionQuerierGetMongoScanByScanIDCall {
+func (c *MockTransactionQuerierGetMssqlScanByScanIDCall) Do(f func(context.Context, int64) (*queries.MssqlScan, error)) *MockTransactionQuerierGetMssqlScanByScanIDCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetMongoScanByScanIDCall) DoAndReturn(f func(context.Context, int64) (*queries.MongoScan, error)) *MockTransactionQuerierGetMongoScanByScanIDCall {
+func (c *MockTransactionQuerierGetMssqlScanByScanIDCall) DoAndReturn(f func(context.Context, int64) (*queries.MssqlScan, error)) *MockTransactionQuerierGetMssqlScanByScanIDCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -3673,181 +5262,337 @@ func (mr *MockTransactionQuerierMockRecorder) GetPostgresDatabasesForProject(ctx
 	return &MockTransactionQuerierGetPostgresDatabasesForProjectCall{Call: call}
 }
 
-// MockTransactionQuerierGetPostgresDatabasesForProjectCall wrap *gomock.Call
-type MockTransactionQuerierGetPostgresDatabasesForProjectCall struct {
+// MockTransactionQuerierGetPostgresDatabasesForProjectCall wrap *gomock.Call
+type MockTransactionQuerierGetPostgresDatabasesForProjectCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetPostgresDatabasesForProjectCall) Return(arg0 []*queries.GetPostgresDatabasesForProjectRow, arg1 error) *MockTransactionQuerierGetPostgresDatabasesForProjectCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetPostgresDatabasesForProjectCall) Do(f func(context.Context, queries.GetPostgresDatabasesForProjectParams) ([]*queries.GetPostgresDatabasesForProjectRow, error)) *MockTransactionQuerierGetPostgresDatabasesForProjectCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetPostgresDatabasesForProjectCall) DoAndReturn(f func(context.Context, queries.GetPostgresDatabasesForProjectParams) ([]*queries.GetPostgresDatabasesForProjectRow, error)) *MockTransactionQuerierGetPostgresDatabasesForProjectCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetPostgresScan mocks base method.
+func (m *MockTransactionQuerier) GetPostgresScan(ctx context.Context, id int64) (*queries.PostgresScan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostgresScan", ctx, id)
+	ret0, _ := ret[0].(*queries.PostgresScan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostgresScan indicates an expected call of GetPostgresScan.
+func (mr *MockTransactionQuerierMockRecorder) GetPostgresScan(ctx, id any) *MockTransactionQuerierGetPostgresScanCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostgresScan", reflect.TypeOf((*MockTransactionQuerier)(nil).GetPostgresScan), ctx, id)
+	return &MockTransactionQuerierGetPostgresScanCall{Call: call}
+}
+
+// MockTransactionQuerierGetPostgresScanCall wrap *gomock.Call
+type MockTransactionQuerierGetPostgresScanCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetPostgresScanCall) Return(arg0 *queries.PostgresScan, arg1 error) *MockTransactionQuerierGetPostgresScanCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetPostgresScanCall) Do(f func(context.Context, int64) (*queries.PostgresScan, error)) *MockTransactionQuerierGetPostgresScanCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetPostgresScanCall) DoAndReturn(f func(context.Context, int64) (*queries.PostgresScan, error)) *MockTransactionQuerierGetPostgresScanCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetPostgresScanByScanID mocks base method.
+func (m *MockTransactionQuerier) GetPostgresScanByScanID(ctx context.Context, scanID int64) (*queries.PostgresScan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostgresScanByScanID", ctx, scanID)
+	ret0, _ := ret[0].(*queries.PostgresScan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostgresScanByScanID indicates an expected call of GetPostgresScanByScanID.
+func (mr *MockTransactionQuerierMockRecorder) GetPostgresScanByScanID(ctx, scanID any) *MockTransactionQuerierGetPostgresScanByScanIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostgresScanByScanID", reflect.TypeOf((*MockTransactionQuerier)(nil).GetPostgresScanByScanID), ctx, scanID)
+	return &MockTransactionQuerierGetPostgresScanByScanIDCall{Call: call}
+}
+
+// MockTransactionQuerierGetPostgresScanByScanIDCall wrap *gomock.Call
+type MockTransactionQuerierGetPostgresScanByScanIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetPostgresScanByScanIDCall) Return(arg0 *queries.PostgresScan, arg1 error) *MockTransactionQuerierGetPostgresScanByScanIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetPostgresScanByScanIDCall) Do(f func(context.Context, int64) (*queries.PostgresScan, error)) *MockTransactionQuerierGetPostgresScanByScanIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetPostgresScanByScanIDCall) DoAndReturn(f func(context.Context, int64) (*queries.PostgresScan, error)) *MockTransactionQuerierGetPostgresScanByScanIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetProject mocks base method.
+func (m *MockTransactionQuerier) GetProject(ctx context.Context, id int64) (*queries.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProject", ctx, id)
+	ret0, _ := ret[0].(*queries.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProject indicates an expected call of GetProject.
+func (mr *MockTransactionQuerierMockRecorder) GetProject(ctx, id any) *MockTransactionQuerierGetProjectCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockTransactionQuerier)(nil).GetProject), ctx, id)
+	return &MockTransactionQuerierGetProjectCall{Call: call}
+}
+
+// MockTransactionQuerierGetProjectCall wrap *gomock.Call
+type MockTransactionQuerierGetProjectCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetProjectCall) Return(arg0 *queries.Project, arg1 error) *MockTransactionQuerierGetProjectCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetProjectCall) Do(f func(context.Context, int64) (*queries.Project, error)) *MockTransactionQuerierGetProjectCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetProjectCall) DoAndReturn(f func(context.Context, int64) (*queries.Project, error)) *MockTransactionQuerierGetProjectCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetProjectByOrganizationAndName mocks base method.
+func (m *MockTransactionQuerier) GetProjectByOrganizationAndName(ctx context.Context, arg queries.GetProjectByOrganizationAndNameParams) (*queries.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectByOrganizationAndName", ctx, arg)
+	ret0, _ := ret[0].(*queries.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectByOrganizationAndName indicates an expected call of GetProjectByOrganizationAndName.
+func (mr *MockTransactionQuerierMockRecorder) GetProjectByOrganizationAndName(ctx, arg any) *MockTransactionQuerierGetProjectByOrganizationAndNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectByOrganizationAndName", reflect.TypeOf((*MockTransactionQuerier)(nil).GetProjectByOrganizationAndName), ctx, arg)
+	return &MockTransactionQuerierGetProjectByOrganizationAndNameCall{Call: call}
+}
+
+// MockTransactionQuerierGetProjectByOrganizationAndNameCall wrap *gomock.Call
+type MockTransactionQuerierGetProjectByOrganizationAndNameCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetPostgresDatabasesForProjectCall) Return(arg0 []*queries.GetPostgresDatabasesForProjectRow, arg1 error) *MockTransactionQuerierGetPostgresDatabasesForProjectCall {
+func (c *MockTransactionQuerierGetProjectByOrganizationAndNameCall) Return(arg0 *queries.Project, arg1 error) *MockTransactionQuerierGetProjectByOrganizationAndNameCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetPostgresDatabasesForProjectCall) Do(f func(context.Context, queries.GetPostgresDatabasesForProjectParams) ([]*queries.GetPostgresDatabasesForProjectRow, error)) *MockTransactionQuerierGetPostgresDatabasesForProjectCall {
+func (c *MockTransactionQuerierGetProjectByOrganizationAndNameCall) Do(f func(context.Context, queries.GetProjectByOrganizationAndNameParams) (*queries.Project, error)) *MockTransactionQuerierGetProjectByOrganizationAndNameCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetPostgresDatabasesForProjectCall) DoAndReturn(f func(context.Context, queries.GetPostgresDatabasesForProjectParams) ([]*queries.GetPostgresDatabasesForProjectRow, error)) *MockTransactionQuerierGetPostgresDatabasesForProjectCall {
+func (c *MockTransactionQuerierGetProjectByOrganizationAndNameCall) DoAndReturn(f func(context.Context, queries.GetProjectByOrganizationAndNameParams) (*queries.Project, error)) *MockTransactionQuerierGetProjectByOrganizationAndNameCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetPostgresScan mocks base method.
-func (m *MockTransactionQuerier) GetPostgresScan(ctx context.Context, id int64) (*queries.PostgresScan, error) {
+// GetProjectIgnoredCve mocks base method.
+func (m *MockTransactionQuerier) GetProjectIgnoredCve(ctx context.Context, id int64) (*queries.ProjectIgnoredCfe, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPostgresScan", ctx, id)
-	ret0, _ := ret[0].(*queries.PostgresScan)
+	ret := m.ctrl.Call(m, "GetProjectIgnoredCve", ctx, id)
+	ret0, _ := ret[0].(*queries.ProjectIgnoredCfe)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPostgresScan indicates an expected call of GetPostgresScan.
-func (mr *MockTransactionQuerierMockRecorder) GetPostgresScan(ctx, id any) *MockTransactionQuerierGetPostgresScanCall {
+// GetProjectIgnoredCve indicates an expected call of GetProjectIgnoredCve.
+func (mr *MockTransactionQuerierMockRecorder) GetProjectIgnoredCve(ctx, id any) *MockTransactionQuerierGetProjectIgnoredCveCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostgresScan", reflect.TypeOf((*MockTransactionQuerier)(nil).GetPostgresScan), ctx, id)
-	return &MockTransactionQuerierGetPostgresScanCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectIgnoredCve", reflect.TypeOf((*MockTransactionQuerier)(nil).GetProjectIgnoredCve), ctx, id)
+	return &MockTransactionQuerierGetProjectIgnoredCveCall{Call: call}
 }
 
-// MockTransactionQuerierGetPostgresScanCall wrap *gomock.Call
-type MockTransactionQuerierGetPostgresScanCall struct {
+// MockTransactionQuerierGetProjectIgnoredCveCall wrap *gomock.Call
+type MockTransactionQuerierGetProjectIgnoredCveCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetPostgresScanCall) Return(arg0 *queries.PostgresScan, arg1 error) *MockTransactionQuerierGetPostgresScanCall {
+func (c *MockTransactionQuerierGetProjectIgnoredCveCall) Return(arg0 *queries.ProjectIgnoredCfe, arg1 error) *MockTransactionQuerierGetProjectIgnoredCveCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetPostgresScanCall) Do(f func(context.Context, int64) (*queries.PostgresScan, error)) *MockTransactionQuerierGetPostgresScanCall {
+func (c *MockTransactionQuerierGetProjectIgnoredCveCall) Do(f func(context.Context, int64) (*queries.ProjectIgnoredCfe, error)) *MockTransactionQuerierGetProjectIgnoredCveCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetPostgresScanCall) DoAndReturn(f func(context.Context, int64) (*queries.PostgresScan, error)) *MockTransactionQuerierGetPostgresScanCall {
+func (c *MockTransactionQuerierGetProjectIgnoredCveCall) DoAndReturn(f func(context.Context, int64) (*queries.ProjectIgnoredCfe, error)) *MockTransactionQuerierGetProjectIgnoredCveCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetPostgresScanByScanID mocks base method.
-func (m *MockTransactionQuerier) GetPostgresScanByScanID(ctx context.Context, scanID int64) (*queries.PostgresScan, error) {
+// GetProjectIgnoredCves mocks base method.
+func (m *MockTransactionQuerier) GetProjectIgnoredCves(ctx context.Context, projectID int64) ([]*queries.ProjectIgnoredCfe, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPostgresScanByScanID", ctx, scanID)
-	ret0, _ := ret[0].(*queries.PostgresScan)
+	ret := m.ctrl.Call(m, "GetProjectIgnoredCves", ctx, projectID)
+	ret0, _ := ret[0].([]*queries.ProjectIgnoredCfe)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPostgresScanByScanID indicates an expected call of GetPostgresScanByScanID.
-func (mr *MockTransactionQuerierMockRecorder) GetPostgresScanByScanID(ctx, scanID any) *MockTransactionQuerierGetPostgresScanByScanIDCall {
+// GetProjectIgnoredCves indicates an expected call of GetProjectIgnoredCves.
+func (mr *MockTransactionQuerierMockRecorder) GetProjectIgnoredCves(ctx, projectID any) *MockTransactionQuerierGetProjectIgnoredCvesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostgresScanByScanID", reflect.TypeOf((*MockTransactionQuerier)(nil).GetPostgresScanByScanID), ctx, scanID)
-	return &MockTransactionQuerierGetPostgresScanByScanIDCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectIgnoredCves", reflect.TypeOf((*MockTransactionQuerier)(nil).GetProjectIgnoredCves), ctx, projectID)
+	return &MockTransactionQuerierGetProjectIgnoredCvesCall{Call: call}
 }
 
-// MockTransactionQuerierGetPostgresScanByScanIDCall wrap *gomock.Call
-type MockTransactionQuerierGetPostgresScanByScanIDCall struct {
+// MockTransactionQuerierGetProjectIgnoredCvesCall wrap *gomock.Call
+type MockTransactionQuerierGetProjectIgnoredCvesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetPostgresScanByScanIDCall) Return(arg0 *queries.PostgresScan, arg1 error) *MockTransactionQuerierGetPostgresScanByScanIDCall {
+func (c *MockTransactionQuerierGetProjectIgnoredCvesCall) Return(arg0 []*queries.ProjectIgnoredCfe, arg1 error) *MockTransactionQuerierGetProjectIgnoredCvesCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetPostgresScanByScanIDCall) Do(f func(context.Context, int64) (*queries.PostgresScan, error)) *MockTransactionQuerierGetPostgresScanByScanIDCall {
+func (c *MockTransactionQuerierGetProjectIgnoredCvesCall) Do(f func(context.Context, int64) ([]*queries.ProjectIgnoredCfe, error)) *MockTransactionQuerierGetProjectIgnoredCvesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetPostgresScanByScanIDCall) DoAndReturn(f func(context.Context, int64) (*queries.PostgresScan, error)) *MockTransactionQuerierGetPostgresScanByScanIDCall {
+func (c *MockTransactionQuerierGetProjectIgnoredCvesCall) DoAndReturn(f func(context.Context, int64) ([]*queries.ProjectIgnoredCfe, error)) *MockTransactionQuerierGetProjectIgnoredCvesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetProject mocks base method.
-func (m *MockTransactionQuerier) GetProject(ctx context.Context, id int64) (*queries.Project, error) {
+// GetProjectInfoForElasticsearchScanByScanID mocks base method.
+func (m *MockTransactionQuerier) GetProjectInfoForElasticsearchScanByScanID(ctx context.Context, arg queries.GetProjectInfoForElasticsearchScanByScanIDParams) (*queries.GetProjectInfoForElasticsearchScanByScanIDRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProject", ctx, id)
-	ret0, _ := ret[0].(*queries.Project)
+	ret := m.ctrl.Call(m, "GetProjectInfoForElasticsearchScanByScanID", ctx, arg)
+	ret0, _ := ret[0].(*queries.GetProjectInfoForElasticsearchScanByScanIDRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetProject indicates an expected call of GetProject.
-func (mr *MockTransactionQuerierMockRecorder) GetProject(ctx, id any) *MockTransactionQuerierGetProjectCall {
+// GetProjectInfoForElasticsearchScanByScanID indicates an expected call of GetProjectInfoForElasticsearchScanByScanID.
+func (mr *MockTransactionQuerierMockRecorder) GetProjectInfoForElasticsearchScanByScanID(ctx, arg any) *MockTransactionQuerierGetProjectInfoForElasticsearchScanByScanIDCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockTransactionQuerier)(nil).GetProject), ctx, id)
-	return &MockTransactionQuerierGetProjectCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectInfoForElasticsearchScanByScanID", reflect.TypeOf((*MockTransactionQuerier)(nil).GetProjectInfoForElasticsearchScanByScanID), ctx, arg)
+	return &MockTransactionQuerierGetProjectInfoForElasticsearchScanByScanIDCall{Call: call}
 }
 
-// MockTransactionQuerierGetProjectCall wrap *gomock.Call
-type MockTransactionQuerierGetProjectCall struct {
+// MockTransactionQuerierGetProjectInfoForElasticsearchScanByScanIDCall wrap *gomock.Call
+type MockTransactionQuerierGetProjectInfoForElasticsearchScanByScanIDCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetProjectCall) Return(arg0 *queries.Project, arg1 error) *MockTransactionQuerierGetProjectCall {
+func (c *MockTransactionQuerierGetProjectInfoForElasticsearchScanByScanIDCall) Return(arg0 *queries.GetProjectInfoForElasticsearchScanByScanIDRow, arg1 error) *MockTransactionQuerierGetProjectInfoForElasticsearchScanByScanIDCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetProjectCall) Do(f func(context.Context, int64) (*queries.Project, error)) *MockTransactionQuerierGetProjectCall {
+func (c *MockTransactionQuerierGetProjectInfoForElasticsearchScanByScanIDCall) Do(f func(context.Context, queries.GetProjectInfoForElasticsearchScanByScanIDParams) (*queries.GetProjectInfoForElasticsearchScanByScanIDRow, error)) *MockTransactionQuerierGetProjectInfoForElasticsearchScanByScanIDCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetProjectCall) DoAndReturn(f func(context.Context, int64) (*queries.Project, error)) *MockTransactionQuerierGetProjectCall {
+func (c *MockTransactionQuerierGetProjectInfoForElasticsearchScanByScanIDCall) DoAndReturn(f func(context.Context, queries.GetProjectInfoForElasticsearchScanByScanIDParams) (*queries.GetProjectInfoForElasticsearchScanByScanIDRow, error)) *MockTransactionQuerierGetProjectInfoForElasticsearchScanByScanIDCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetProjectByOrganizationAndName mocks base method.
-func (m *MockTransactionQuerier) GetProjectByOrganizationAndName(ctx context.Context, arg queries.GetProjectByOrganizationAndNameParams) (*queries.Project, error) {
+// GetProjectInfoForEtcdScanByScanID mocks base method.
+func (m *MockTransactionQuerier) GetProjectInfoForEtcdScanByScanID(ctx context.Context, arg queries.GetProjectInfoForEtcdScanByScanIDParams) (*queries.GetProjectInfoForEtcdScanByScanIDRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProjectByOrganizationAndName", ctx, arg)
-	ret0, _ := ret[0].(*queries.Project)
+	ret := m.ctrl.Call(m, "GetProjectInfoForEtcdScanByScanID", ctx, arg)
+	ret0, _ := ret[0].(*queries.GetProjectInfoForEtcdScanByScanIDRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetProjectByOrganizationAndName indicates an expected call of GetProjectByOrganizationAndName.
-func (mr *MockTransactionQuerierMockRecorder) GetProjectByOrganizationAndName(ctx, arg any) *MockTransactionQuerierGetProjectByOrganizationAndNameCall {
+// GetProjectInfoForEtcdScanByScanID indicates an expected call of GetProjectInfoForEtcdScanByScanID.
+func (mr *MockTransactionQuerierMockRecorder) GetProjectInfoForEtcdScanByScanID(ctx, arg any) *MockTransactionQuerierGetProjectInfoForEtcdScanByScanIDCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectByOrganizationAndName", reflect.TypeOf((*MockTransactionQuerier)(nil).GetProjectByOrganizationAndName), ctx, arg)
-	return &MockTransactionQuerierGetProjectByOrganizationAndNameCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectInfoForEtcdScanByScanID", reflect.TypeOf((*MockTransactionQuerier)(nil).GetProjectInfoForEtcdScanByScanID), ctx, arg)
+	return &MockTransactionQuerierGetProjectInfoForEtcdScanByScanIDCall{Call: call}
 }
 
-// MockTransactionQuerierGetProjectByOrganizationAndNameCall wrap *gomock.Call
-type MockTransactionQuerierGetProjectByOrganizationAndNameCall struct {
+// MockTransactionQuerierGetProjectInfoForEtcdScanByScanIDCall wrap *gomock.Call
+type MockTransactionQuerierGetProjectInfoForEtcdScanByScanIDCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetProjectByOrganizationAndNameCall) Return(arg0 *queries.Project, arg1 error) *MockTransactionQuerierGetProjectByOrganizationAndNameCall {
+func (c *MockTransactionQuerierGetProjectInfoForEtcdScanByScanIDCall) Return(arg0 *queries.GetProjectInfoForEtcdScanByScanIDRow, arg1 error) *MockTransactionQuerierGetProjectInfoForEtcdScanByScanIDCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetProjectByOrganizationAndNameCall) Do(f func(context.Context, queries.GetProjectByOrganizationAndNameParams) (*queries.Project, error)) *MockTransactionQuerierGetProjectByOrganizationAndNameCall {
+func (c *MockTransactionQuerierGetProjectInfoForEtcdScanByScanIDCall) Do(f func(context.Context, queries.GetProjectInfoForEtcdScanByScanIDParams) (*queries.GetProjectInfoForEtcdScanByScanIDRow, error)) *MockTransactionQuerierGetProjectInfoForEtcdScanByScanIDCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetProjectByOrganizationAndNameCall) DoAndReturn(f func(context.Context, queries.GetProjectByOrganizationAndNameParams) (*queries.Project, error)) *MockTransactionQuerierGetProjectByOrganizationAndNameCall {
+func (c *MockTransactionQuerierGetProjectInfoForEtcdScanByScanIDCall) DoAndReturn(f func(context.Context, queries.GetProjectInfoForEtcdScanByScanIDParams) (*queries.GetProjectInfoForEtcdScanByScanIDRow, error)) *MockTransactionQuerierGetProjectInfoForEtcdScanByScanIDCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -3891,6 +5636,45 @@ func (c *MockTransactionQuerierGetProjectInfoForMongoScanByScanIDCall) DoAndRetu
 	return c
 }
 
+// GetProjectInfoForMssqlScanByScanID mocks base method.
+func (m *MockTransactionQuerier) GetProjectInfoForMssqlScanByScanID(ctx context.Context, arg queries.GetProjectInfoForMssqlScanByScanIDParams) (*queries.GetProjectInfoForMssqlScanByScanIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectInfoForMssqlScanByScanID", ctx, arg)
+	ret0, _ := ret[0].(*queries.GetProjectInfoForMssqlScanByScanIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectInfoForMssqlScanByScanID indicates an expected call of GetProjectInfoForMssqlScanByScanID.
+func (mr *MockTransactionQuerierMockRecorder) GetProjectInfoForMssqlScanByScanID(ctx, arg any) *MockTransactionQuerierGetProjectInfoForMssqlScanByScanIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectInfoForMssqlScanByScanID", reflect.TypeOf((*MockTransactionQuerier)(nil).GetProjectInfoForMssqlScanByScanID), ctx, arg)
+	return &MockTransactionQuerierGetProjectInfoForMssqlScanByScanIDCall{Call: call}
+}
+
+// MockTransactionQuerierGetProjectInfoForMssqlScanByScanIDCall wrap *gomock.Call
+type MockTransactionQuerierGetProjectInfoForMssqlScanByScanIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetProjectInfoForMssqlScanByScanIDCall) Return(arg0 *queries.GetProjectInfoForMssqlScanByScanIDRow, arg1 error) *MockTransactionQuerierGetProjectInfoForMssqlScanByScanIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetProjectInfoForMssqlScanByScanIDCall) Do(f func(context.Context, queries.GetProjectInfoForMssqlScanByScanIDParams) (*queries.GetProjectInfoForMssqlScanByScanIDRow, error)) *MockTransactionQuerierGetProjectInfoForMssqlScanByScanIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetProjectInfoForMssqlScanByScanIDCall) DoAndReturn(f func(context.Context, queries.GetProjectInfoForMssqlScanByScanIDParams) (*queries.GetProjectInfoForMssqlScanByScanIDRow, error)) *MockTransactionQuerierGetProjectInfoForMssqlScanByScanIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetProjectInfoForMysqlScanByScanID mocks base method.
 func (m *MockTransactionQuerier) GetProjectInfoForMysqlScanByScanID(ctx context.Context, arg queries.GetProjectInfoForMysqlScanByScanIDParams) (*queries.GetProjectInfoForMysqlScanByScanIDRow, error) {
 	m.ctrl.T.Helper()
@@ -4008,6 +5792,84 @@ func (c *MockTransactionQuerierGetProjectInfoForRedisScanByScanIDCall) DoAndRetu
 	return c
 }
 
+// GetProjectPasswordCandidates mocks base method.
+func (m *MockTransactionQuerier) GetProjectPasswordCandidates(ctx context.Context, projectID int64) ([]*queries.GetProjectPasswordCandidatesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectPasswordCandidates", ctx, projectID)
+	ret0, _ := ret[0].([]*queries.GetProjectPasswordCandidatesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectPasswordCandidates indicates an expected call of GetProjectPasswordCandidates.
+func (mr *MockTransactionQuerierMockRecorder) GetProjectPasswordCandidates(ctx, projectID any) *MockTransactionQuerierGetProjectPasswordCandidatesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectPasswordCandidates", reflect.TypeOf((*MockTransactionQuerier)(nil).GetProjectPasswordCandidates), ctx, projectID)
+	return &MockTransactionQuerierGetProjectPasswordCandidatesCall{Call: call}
+}
+
+// MockTransactionQuerierGetProjectPasswordCandidatesCall wrap *gomock.Call
+type MockTransactionQuerierGetProjectPasswordCandidatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetProjectPasswordCandidatesCall) Return(arg0 []*queries.GetProjectPasswordCandidatesRow, arg1 error) *MockTransactionQuerierGetProjectPasswordCandidatesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetProjectPasswordCandidatesCall) Do(f func(context.Context, int64) ([]*queries.GetProjectPasswordCandidatesRow, error)) *MockTransactionQuerierGetProjectPasswordCandidatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetProjectPasswordCandidatesCall) DoAndReturn(f func(context.Context, int64) ([]*queries.GetProjectPasswordCandidatesRow, error)) *MockTransactionQuerierGetProjectPasswordCandidatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetProjectPasswordRules mocks base method.
+func (m *MockTransactionQuerier) GetProjectPasswordRules(ctx context.Context, projectID int64) (*queries.ProjectPasswordRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectPasswordRules", ctx, projectID)
+	ret0, _ := ret[0].(*queries.ProjectPasswordRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectPasswordRules indicates an expected call of GetProjectPasswordRules.
+func (mr *MockTransactionQuerierMockRecorder) GetProjectPasswordRules(ctx, projectID any) *MockTransactionQuerierGetProjectPasswordRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectPasswordRules", reflect.TypeOf((*MockTransactionQuerier)(nil).GetProjectPasswordRules), ctx, projectID)
+	return &MockTransactionQuerierGetProjectPasswordRulesCall{Call: call}
+}
+
+// MockTransactionQuerierGetProjectPasswordRulesCall wrap *gomock.Call
+type MockTransactionQuerierGetProjectPasswordRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetProjectPasswordRulesCall) Return(arg0 *queries.ProjectPasswordRule, arg1 error) *MockTransactionQuerierGetProjectPasswordRulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetProjectPasswordRulesCall) Do(f func(context.Context, int64) (*queries.ProjectPasswordRule, error)) *MockTransactionQuerierGetProjectPasswordRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetProjectPasswordRulesCall) DoAndReturn(f func(context.Context, int64) (*queries.ProjectPasswordRule, error)) *MockTransactionQuerierGetProjectPasswordRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetProjectWithStats mocks base method.
 func (m *MockTransactionQuerier) GetProjectWithStats(ctx context.Context, id int64) (*queries.GetProjectWithStatsRow, error) {
 	m.ctrl.T.Helper()
@@ -4167,155 +6029,272 @@ func (c *MockTransactionQuerierGetRedisDatabaseCall) DoAndReturn(f func(context.
 // GetRedisDatabasesForProject mocks base method.
 func (m *MockTransactionQuerier) GetRedisDatabasesForProject(ctx context.Context, arg queries.GetRedisDatabasesForProjectParams) ([]*queries.GetRedisDatabasesForProjectRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRedisDatabasesForProject", ctx, arg)
-	ret0, _ := ret[0].([]*queries.GetRedisDatabasesForProjectRow)
+	ret := m.ctrl.Call(m, "GetRedisDatabasesForProject", ctx, arg)
+	ret0, _ := ret[0].([]*queries.GetRedisDatabasesForProjectRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRedisDatabasesForProject indicates an expected call of GetRedisDatabasesForProject.
+func (mr *MockTransactionQuerierMockRecorder) GetRedisDatabasesForProject(ctx, arg any) *MockTransactionQuerierGetRedisDatabasesForProjectCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedisDatabasesForProject", reflect.TypeOf((*MockTransactionQuerier)(nil).GetRedisDatabasesForProject), ctx, arg)
+	return &MockTransactionQuerierGetRedisDatabasesForProjectCall{Call: call}
+}
+
+// MockTransactionQuerierGetRedisDatabasesForProjectCall wrap *gomock.Call
+type MockTransactionQuerierGetRedisDatabasesForProjectCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetRedisDatabasesForProjectCall) Return(arg0 []*queries.GetRedisDatabasesForProjectRow, arg1 error) *MockTransactionQuerierGetRedisDatabasesForProjectCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetRedisDatabasesForProjectCall) Do(f func(context.Context, queries.GetRedisDatabasesForProjectParams) ([]*queries.GetRedisDatabasesForProjectRow, error)) *MockTransactionQuerierGetRedisDatabasesForProjectCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetRedisDatabasesForProjectCall) DoAndReturn(f func(context.Context, queries.GetRedisDatabasesForProjectParams) ([]*queries.GetRedisDatabasesForProjectRow, error)) *MockTransactionQuerierGetRedisDatabasesForProjectCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetRedisScan mocks base method.
+func (m *MockTransactionQuerier) GetRedisScan(ctx context.Context, id int64) (*queries.RedisScan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRedisScan", ctx, id)
+	ret0, _ := ret[0].(*queries.RedisScan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRedisScan indicates an expected call of GetRedisScan.
+func (mr *MockTransactionQuerierMockRecorder) GetRedisScan(ctx, id any) *MockTransactionQuerierGetRedisScanCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedisScan", reflect.TypeOf((*MockTransactionQuerier)(nil).GetRedisScan), ctx, id)
+	return &MockTransactionQuerierGetRedisScanCall{Call: call}
+}
+
+// MockTransactionQuerierGetRedisScanCall wrap *gomock.Call
+type MockTransactionQuerierGetRedisScanCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetRedisScanCall) Return(arg0 *queries.RedisScan, arg1 error) *MockTransactionQuerierGetRedisScanCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetRedisScanCall) Do(f func(context.Context, int64) (*queries.RedisScan, error)) *MockTransactionQuerierGetRedisScanCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetRedisScanCall) DoAndReturn(f func(context.Context, int64) (*queries.RedisScan, error)) *MockTransactionQuerierGetRedisScanCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetRedisScanByScanID mocks base method.
+func (m *MockTransactionQuerier) GetRedisScanByScanID(ctx context.Context, scanID int64) (*queries.RedisScan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRedisScanByScanID", ctx, scanID)
+	ret0, _ := ret[0].(*queries.RedisScan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRedisScanByScanID indicates an expected call of GetRedisScanByScanID.
+func (mr *MockTransactionQuerierMockRecorder) GetRedisScanByScanID(ctx, scanID any) *MockTransactionQuerierGetRedisScanByScanIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedisScanByScanID", reflect.TypeOf((*MockTransactionQuerier)(nil).GetRedisScanByScanID), ctx, scanID)
+	return &MockTransactionQuerierGetRedisScanByScanIDCall{Call: call}
+}
+
+// MockTransactionQuerierGetRedisScanByScanIDCall wrap *gomock.Call
+type MockTransactionQuerierGetRedisScanByScanIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetRedisScanByScanIDCall) Return(arg0 *queries.RedisScan, arg1 error) *MockTransactionQuerierGetRedisScanByScanIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetRedisScanByScanIDCall) Do(f func(context.Context, int64) (*queries.RedisScan, error)) *MockTransactionQuerierGetRedisScanByScanIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetRedisScanByScanIDCall) DoAndReturn(f func(context.Context, int64) (*queries.RedisScan, error)) *MockTransactionQuerierGetRedisScanByScanIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetResetPasswordToken mocks base method.
+func (m *MockTransactionQuerier) GetResetPasswordToken(ctx context.Context, id uuid.UUID) (*queries.ResetPasswordToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResetPasswordToken", ctx, id)
+	ret0, _ := ret[0].(*queries.ResetPasswordToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRedisDatabasesForProject indicates an expected call of GetRedisDatabasesForProject.
-func (mr *MockTransactionQuerierMockRecorder) GetRedisDatabasesForProject(ctx, arg any) *MockTransactionQuerierGetRedisDatabasesForProjectCall {
+// GetResetPasswordToken indicates an expected call of GetResetPasswordToken.
+func (mr *MockTransactionQuerierMockRecorder) GetResetPasswordToken(ctx, id any) *MockTransactionQuerierGetResetPasswordTokenCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedisDatabasesForProject", reflect.TypeOf((*MockTransactionQuerier)(nil).GetRedisDatabasesForProject), ctx, arg)
-	return &MockTransactionQuerierGetRedisDatabasesForProjectCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResetPasswordToken", reflect.TypeOf((*MockTransactionQuerier)(nil).GetResetPasswordToken), ctx, id)
+	return &MockTransactionQuerierGetResetPasswordTokenCall{Call: call}
 }
 
-// MockTransactionQuerierGetRedisDatabasesForProjectCall wrap *gomock.Call
-type MockTransactionQuerierGetRedisDatabasesForProjectCall struct {
+// MockTransactionQuerierGetResetPasswordTokenCall wrap *gomock.Call
+type MockTransactionQuerierGetResetPasswordTokenCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetRedisDatabasesForProjectCall) Return(arg0 []*queries.GetRedisDatabasesForProjectRow, arg1 error) *MockTransactionQuerierGetRedisDatabasesForProjectCall {
+func (c *MockTransactionQuerierGetResetPasswordTokenCall) Return(arg0 *queries.ResetPasswordToken, arg1 error) *MockTransactionQuerierGetResetPasswordTokenCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetRedisDatabasesForProjectCall) Do(f func(context.Context, queries.GetRedisDatabasesForProjectParams) ([]*queries.GetRedisDatabasesForProjectRow, error)) *MockTransactionQuerierGetRedisDatabasesForProjectCall {
+func (c *MockTransactionQuerierGetResetPasswordTokenCall) Do(f func(context.Context, uuid.UUID) (*queries.ResetPasswordToken, error)) *MockTransactionQuerierGetResetPasswordTokenCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetRedisDatabasesForProjectCall) DoAndReturn(f func(context.Context, queries.GetRedisDatabasesForProjectParams) ([]*queries.GetRedisDatabasesForProjectRow, error)) *MockTransactionQuerierGetRedisDatabasesForProjectCall {
+func (c *MockTransactionQuerierGetResetPasswordTokenCall) DoAndReturn(f func(context.Context, uuid.UUID) (*queries.ResetPasswordToken, error)) *MockTransactionQuerierGetResetPasswordTokenCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetRedisScan mocks base method.
-func (m *MockTransactionQuerier) GetRedisScan(ctx context.Context, id int64) (*queries.RedisScan, error) {
+// GetRulePack mocks base method.
+func (m *MockTransactionQuerier) GetRulePack(ctx context.Context, id int64) (*queries.RulePack, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRedisScan", ctx, id)
-	ret0, _ := ret[0].(*queries.RedisScan)
+	ret := m.ctrl.Call(m, "GetRulePack", ctx, id)
+	ret0, _ := ret[0].(*queries.RulePack)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRedisScan indicates an expected call of GetRedisScan.
-func (mr *MockTransactionQuerierMockRecorder) GetRedisScan(ctx, id any) *MockTransactionQuerierGetRedisScanCall {
+// GetRulePack indicates an expected call of GetRulePack.
+func (mr *MockTransactionQuerierMockRecorder) GetRulePack(ctx, id any) *MockTransactionQuerierGetRulePackCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedisScan", reflect.TypeOf((*MockTransactionQuerier)(nil).GetRedisScan), ctx, id)
-	return &MockTransactionQuerierGetRedisScanCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRulePack", reflect.TypeOf((*MockTransactionQuerier)(nil).GetRulePack), ctx, id)
+	return &MockTransactionQuerierGetRulePackCall{Call: call}
 }
 
-// MockTransactionQuerierGetRedisScanCall wrap *gomock.Call
-type MockTransactionQuerierGetRedisScanCall struct {
+// MockTransactionQuerierGetRulePackCall wrap *gomock.Call
+type MockTransactionQuerierGetRulePackCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetRedisScanCall) Return(arg0 *queries.RedisScan, arg1 error) *MockTransactionQuerierGetRedisScanCall {
+func (c *MockTransactionQuerierGetRulePackCall) Return(arg0 *queries.RulePack, arg1 error) *MockTransactionQuerierGetRulePackCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetRedisScanCall) Do(f func(context.Context, int64) (*queries.RedisScan, error)) *MockTransactionQuerierGetRedisScanCall {
+func (c *MockTransactionQuerierGetRulePackCall) Do(f func(context.Context, int64) (*queries.RulePack, error)) *MockTransactionQuerierGetRulePackCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetRedisScanCall) DoAndReturn(f func(context.Context, int64) (*queries.RedisScan, error)) *MockTransactionQuerierGetRedisScanCall {
+func (c *MockTransactionQuerierGetRulePackCall) DoAndReturn(f func(context.Context, int64) (*queries.RulePack, error)) *MockTransactionQuerierGetRulePackCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetRedisScanByScanID mocks base method.
-func (m *MockTransactionQuerier) GetRedisScanByScanID(ctx context.Context, scanID int64) (*queries.RedisScan, error) {
+// GetRulePacksForOrganization mocks base method.
+func (m *MockTransactionQuerier) GetRulePacksForOrganization(ctx context.Context, organizationID int64) ([]*queries.RulePack, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRedisScanByScanID", ctx, scanID)
-	ret0, _ := ret[0].(*queries.RedisScan)
+	ret := m.ctrl.Call(m, "GetRulePacksForOrganization", ctx, organizationID)
+	ret0, _ := ret[0].([]*queries.RulePack)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRedisScanByScanID indicates an expected call of GetRedisScanByScanID.
-func (mr *MockTransactionQuerierMockRecorder) GetRedisScanByScanID(ctx, scanID any) *MockTransactionQuerierGetRedisScanByScanIDCall {
+// GetRulePacksForOrganization indicates an expected call of GetRulePacksForOrganization.
+func (mr *MockTransactionQuerierMockRecorder) GetRulePacksForOrganization(ctx, organizationID any) *MockTransactionQuerierGetRulePacksForOrganizationCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedisScanByScanID", reflect.TypeOf((*MockTransactionQuerier)(nil).GetRedisScanByScanID), ctx, scanID)
-	return &MockTransactionQuerierGetRedisScanByScanIDCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRulePacksForOrganization", reflect.TypeOf((*MockTransactionQuerier)(nil).GetRulePacksForOrganization), ctx, organizationID)
+	return &MockTransactionQuerierGetRulePacksForOrganizationCall{Call: call}
 }
 
-// MockTransactionQuerierGetRedisScanByScanIDCall wrap *gomock.Call
-type MockTransactionQuerierGetRedisScanByScanIDCall struct {
+// MockTransactionQuerierGetRulePacksForOrganizationCall wrap *gomock.Call
+type MockTransactionQuerierGetRulePacksForOrganizationCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetRedisScanByScanIDCall) Return(arg0 *queries.RedisScan, arg1 error) *MockTransactionQuerierGetRedisScanByScanIDCall {
+func (c *MockTransactionQuerierGetRulePacksForOrganizationCall) Return(arg0 []*queries.RulePack, arg1 error) *MockTransactionQuerierGetRulePacksForOrganizationCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetRedisScanByScanIDCall) Do(f func(context.Context, int64) (*queries.RedisScan, error)) *MockTransactionQuerierGetRedisScanByScanIDCall {
+func (c *MockTransactionQuerierGetRulePacksForOrganizationCall) Do(f func(context.Context, int64) ([]*queries.RulePack, error)) *MockTransactionQuerierGetRulePacksForOrganizationCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetRedisScanByScanIDCall) DoAndReturn(f func(context.Context, int64) (*queries.RedisScan, error)) *MockTransactionQuerierGetRedisScanByScanIDCall {
+func (c *MockTransactionQuerierGetRulePacksForOrganizationCall) DoAndReturn(f func(context.Context, int64) ([]*queries.RulePack, error)) *MockTransactionQuerierGetRulePacksForOrganizationCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetResetPasswordToken mocks base method.
-func (m *MockTransactionQuerier) GetResetPasswordToken(ctx context.Context, id uuid.UUID) (*queries.ResetPasswordToken, error) {
+// GetRulePacksForProject mocks base method.
+func (m *MockTransactionQuerier) GetRulePacksForProject(ctx context.Context, id int64) ([]*queries.RulePack, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetResetPasswordToken", ctx, id)
-	ret0, _ := ret[0].(*queries.ResetPasswordToken)
+	ret := m.ctrl.Call(m, "GetRulePacksForProject", ctx, id)
+	ret0, _ := ret[0].([]*queries.RulePack)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetResetPasswordToken indicates an expected call of GetResetPasswordToken.
-func (mr *MockTransactionQuerierMockRecorder) GetResetPasswordToken(ctx, id any) *MockTransactionQuerierGetResetPasswordTokenCall {
+// GetRulePacksForProject indicates an expected call of GetRulePacksForProject.
+func (mr *MockTransactionQuerierMockRecorder) GetRulePacksForProject(ctx, id any) *MockTransactionQuerierGetRulePacksForProjectCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResetPasswordToken", reflect.TypeOf((*MockTransactionQuerier)(nil).GetResetPasswordToken), ctx, id)
-	return &MockTransactionQuerierGetResetPasswordTokenCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRulePacksForProject", reflect.TypeOf((*MockTransactionQuerier)(nil).GetRulePacksForProject), ctx, id)
+	return &MockTransactionQuerierGetRulePacksForProjectCall{Call: call}
 }
 
-// MockTransactionQuerierGetResetPasswordTokenCall wrap *gomock.Call
-type MockTransactionQuerierGetResetPasswordTokenCall struct {
+// MockTransactionQuerierGetRulePacksForProjectCall wrap *gomock.Call
+type MockTransactionQuerierGetRulePacksForProjectCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierGetResetPasswordTokenCall) Return(arg0 *queries.ResetPasswordToken, arg1 error) *MockTransactionQuerierGetResetPasswordTokenCall {
+func (c *MockTransactionQuerierGetRulePacksForProjectCall) Return(arg0 []*queries.RulePack, arg1 error) *MockTransactionQuerierGetRulePacksForProjectCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierGetResetPasswordTokenCall) Do(f func(context.Context, uuid.UUID) (*queries.ResetPasswordToken, error)) *MockTransactionQuerierGetResetPasswordTokenCall {
+func (c *MockTransactionQuerierGetRulePacksForProjectCall) Do(f func(context.Context, int64) ([]*queries.RulePack, error)) *MockTransactionQuerierGetRulePacksForProjectCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierGetResetPasswordTokenCall) DoAndReturn(f func(context.Context, uuid.UUID) (*queries.ResetPasswordToken, error)) *MockTransactionQuerierGetResetPasswordTokenCall {
+func (c *MockTransactionQuerierGetRulePacksForProjectCall) DoAndReturn(f func(context.Context, int64) ([]*queries.RulePack, error)) *MockTransactionQuerierGetRulePacksForProjectCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -4398,6 +6377,84 @@ func (c *MockTransactionQuerierGetScanBruteforceResultsCall) DoAndReturn(f func(
 	return c
 }
 
+// GetScanBruteforceShards mocks base method.
+func (m *MockTransactionQuerier) GetScanBruteforceShards(ctx context.Context, arg queries.GetScanBruteforceShardsParams) ([]*queries.ScanBruteforceShard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScanBruteforceShards", ctx, arg)
+	ret0, _ := ret[0].([]*queries.ScanBruteforceShard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScanBruteforceShards indicates an expected call of GetScanBruteforceShards.
+func (mr *MockTransactionQuerierMockRecorder) GetScanBruteforceShards(ctx, arg any) *MockTransactionQuerierGetScanBruteforceShardsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScanBruteforceShards", reflect.TypeOf((*MockTransactionQuerier)(nil).GetScanBruteforceShards), ctx, arg)
+	return &MockTransactionQuerierGetScanBruteforceShardsCall{Call: call}
+}
+
+// MockTransactionQuerierGetScanBruteforceShardsCall wrap *gomock.Call
+type MockTransactionQuerierGetScanBruteforceShardsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetScanBruteforceShardsCall) Return(arg0 []*queries.ScanBruteforceShard, arg1 error) *MockTransactionQuerierGetScanBruteforceShardsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetScanBruteforceShardsCall) Do(f func(context.Context, queries.GetScanBruteforceShardsParams) ([]*queries.ScanBruteforceShard, error)) *MockTransactionQuerierGetScanBruteforceShardsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetScanBruteforceShardsCall) DoAndReturn(f func(context.Context, queries.GetScanBruteforceShardsParams) ([]*queries.ScanBruteforceShard, error)) *MockTransactionQuerierGetScanBruteforceShardsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetScanCveResults mocks base method.
+func (m *MockTransactionQuerier) GetScanCveResults(ctx context.Context, scanID int64) ([]*queries.ScanCveResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScanCveResults", ctx, scanID)
+	ret0, _ := ret[0].([]*queries.ScanCveResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScanCveResults indicates an expected call of GetScanCveResults.
+func (mr *MockTransactionQuerierMockRecorder) GetScanCveResults(ctx, scanID any) *MockTransactionQuerierGetScanCveResultsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScanCveResults", reflect.TypeOf((*MockTransactionQuerier)(nil).GetScanCveResults), ctx, scanID)
+	return &MockTransactionQuerierGetScanCveResultsCall{Call: call}
+}
+
+// MockTransactionQuerierGetScanCveResultsCall wrap *gomock.Call
+type MockTransactionQuerierGetScanCveResultsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetScanCveResultsCall) Return(arg0 []*queries.ScanCveResult, arg1 error) *MockTransactionQuerierGetScanCveResultsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetScanCveResultsCall) Do(f func(context.Context, int64) ([]*queries.ScanCveResult, error)) *MockTransactionQuerierGetScanCveResultsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetScanCveResultsCall) DoAndReturn(f func(context.Context, int64) ([]*queries.ScanCveResult, error)) *MockTransactionQuerierGetScanCveResultsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetScanGroup mocks base method.
 func (m *MockTransactionQuerier) GetScanGroup(ctx context.Context, id int64) (*queries.ScanGroup, error) {
 	m.ctrl.T.Helper()
@@ -4476,6 +6533,45 @@ func (c *MockTransactionQuerierGetScanGroupsForProjectCall) DoAndReturn(f func(c
 	return c
 }
 
+// GetScanResultFindings mocks base method.
+func (m *MockTransactionQuerier) GetScanResultFindings(ctx context.Context, scanID int64) ([]*queries.ScanResultFinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScanResultFindings", ctx, scanID)
+	ret0, _ := ret[0].([]*queries.ScanResultFinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScanResultFindings indicates an expected call of GetScanResultFindings.
+func (mr *MockTransactionQuerierMockRecorder) GetScanResultFindings(ctx, scanID any) *MockTransactionQuerierGetScanResultFindingsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScanResultFindings", reflect.TypeOf((*MockTransactionQuerier)(nil).GetScanResultFindings), ctx, scanID)
+	return &MockTransactionQuerierGetScanResultFindingsCall{Call: call}
+}
+
+// MockTransactionQuerierGetScanResultFindingsCall wrap *gomock.Call
+type MockTransactionQuerierGetScanResultFindingsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierGetScanResultFindingsCall) Return(arg0 []*queries.ScanResultFinding, arg1 error) *MockTransactionQuerierGetScanResultFindingsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierGetScanResultFindingsCall) Do(f func(context.Context, int64) ([]*queries.ScanResultFinding, error)) *MockTransactionQuerierGetScanResultFindingsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierGetScanResultFindingsCall) DoAndReturn(f func(context.Context, int64) ([]*queries.ScanResultFinding, error)) *MockTransactionQuerierGetScanResultFindingsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetScanResults mocks base method.
 func (m *MockTransactionQuerier) GetScanResults(ctx context.Context, scanID int64) ([]*queries.ScanResult, error) {
 	m.ctrl.T.Helper()
@@ -5365,163 +7461,279 @@ func (c *MockTransactionQuerierInvalidateTOTPSecretForUserCall) Do(f func(contex
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierInvalidateTOTPSecretForUserCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierInvalidateTOTPSecretForUserCall {
+func (c *MockTransactionQuerierInvalidateTOTPSecretForUserCall) DoAndReturn(f func(context.Context, int64) error) *MockTransactionQuerierInvalidateTOTPSecretForUserCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListUsers mocks base method.
+func (m *MockTransactionQuerier) ListUsers(ctx context.Context) ([]*queries.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx)
+	ret0, _ := ret[0].([]*queries.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockTransactionQuerierMockRecorder) ListUsers(ctx any) *MockTransactionQuerierListUsersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockTransactionQuerier)(nil).ListUsers), ctx)
+	return &MockTransactionQuerierListUsersCall{Call: call}
+}
+
+// MockTransactionQuerierListUsersCall wrap *gomock.Call
+type MockTransactionQuerierListUsersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierListUsersCall) Return(arg0 []*queries.User, arg1 error) *MockTransactionQuerierListUsersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierListUsersCall) Do(f func(context.Context) ([]*queries.User, error)) *MockTransactionQuerierListUsersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierListUsersCall) DoAndReturn(f func(context.Context) ([]*queries.User, error)) *MockTransactionQuerierListUsersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListUsersPaginated mocks base method.
+func (m *MockTransactionQuerier) ListUsersPaginated(ctx context.Context, arg queries.ListUsersPaginatedParams) ([]*queries.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsersPaginated", ctx, arg)
+	ret0, _ := ret[0].([]*queries.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsersPaginated indicates an expected call of ListUsersPaginated.
+func (mr *MockTransactionQuerierMockRecorder) ListUsersPaginated(ctx, arg any) *MockTransactionQuerierListUsersPaginatedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersPaginated", reflect.TypeOf((*MockTransactionQuerier)(nil).ListUsersPaginated), ctx, arg)
+	return &MockTransactionQuerierListUsersPaginatedCall{Call: call}
+}
+
+// MockTransactionQuerierListUsersPaginatedCall wrap *gomock.Call
+type MockTransactionQuerierListUsersPaginatedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierListUsersPaginatedCall) Return(arg0 []*queries.User, arg1 error) *MockTransactionQuerierListUsersPaginatedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierListUsersPaginatedCall) Do(f func(context.Context, queries.ListUsersPaginatedParams) ([]*queries.User, error)) *MockTransactionQuerierListUsersPaginatedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierListUsersPaginatedCall) DoAndReturn(f func(context.Context, queries.ListUsersPaginatedParams) ([]*queries.User, error)) *MockTransactionQuerierListUsersPaginatedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveOrganizationUser mocks base method.
+func (m *MockTransactionQuerier) RemoveOrganizationUser(ctx context.Context, arg queries.RemoveOrganizationUserParams) (*queries.OrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOrganizationUser", ctx, arg)
+	ret0, _ := ret[0].(*queries.OrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveOrganizationUser indicates an expected call of RemoveOrganizationUser.
+func (mr *MockTransactionQuerierMockRecorder) RemoveOrganizationUser(ctx, arg any) *MockTransactionQuerierRemoveOrganizationUserCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrganizationUser", reflect.TypeOf((*MockTransactionQuerier)(nil).RemoveOrganizationUser), ctx, arg)
+	return &MockTransactionQuerierRemoveOrganizationUserCall{Call: call}
+}
+
+// MockTransactionQuerierRemoveOrganizationUserCall wrap *gomock.Call
+type MockTransactionQuerierRemoveOrganizationUserCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierRemoveOrganizationUserCall) Return(arg0 *queries.OrganizationMember, arg1 error) *MockTransactionQuerierRemoveOrganizationUserCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierRemoveOrganizationUserCall) Do(f func(context.Context, queries.RemoveOrganizationUserParams) (*queries.OrganizationMember, error)) *MockTransactionQuerierRemoveOrganizationUserCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierRemoveOrganizationUserCall) DoAndReturn(f func(context.Context, queries.RemoveOrganizationUserParams) (*queries.OrganizationMember, error)) *MockTransactionQuerierRemoveOrganizationUserCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ListUsers mocks base method.
-func (m *MockTransactionQuerier) ListUsers(ctx context.Context) ([]*queries.User, error) {
+// SetDockerImageDetectorSet mocks base method.
+func (m *MockTransactionQuerier) SetDockerImageDetectorSet(ctx context.Context, arg queries.SetDockerImageDetectorSetParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUsers", ctx)
-	ret0, _ := ret[0].([]*queries.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SetDockerImageDetectorSet", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListUsers indicates an expected call of ListUsers.
-func (mr *MockTransactionQuerierMockRecorder) ListUsers(ctx any) *MockTransactionQuerierListUsersCall {
+// SetDockerImageDetectorSet indicates an expected call of SetDockerImageDetectorSet.
+func (mr *MockTransactionQuerierMockRecorder) SetDockerImageDetectorSet(ctx, arg any) *MockTransactionQuerierSetDockerImageDetectorSetCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockTransactionQuerier)(nil).ListUsers), ctx)
-	return &MockTransactionQuerierListUsersCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDockerImageDetectorSet", reflect.TypeOf((*MockTransactionQuerier)(nil).SetDockerImageDetectorSet), ctx, arg)
+	return &MockTransactionQuerierSetDockerImageDetectorSetCall{Call: call}
 }
 
-// MockTransactionQuerierListUsersCall wrap *gomock.Call
-type MockTransactionQuerierListUsersCall struct {
+// MockTransactionQuerierSetDockerImageDetectorSetCall wrap *gomock.Call
+type MockTransactionQuerierSetDockerImageDetectorSetCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierListUsersCall) Return(arg0 []*queries.User, arg1 error) *MockTransactionQuerierListUsersCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockTransactionQuerierSetDockerImageDetectorSetCall) Return(arg0 error) *MockTransactionQuerierSetDockerImageDetectorSetCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierListUsersCall) Do(f func(context.Context) ([]*queries.User, error)) *MockTransactionQuerierListUsersCall {
+func (c *MockTransactionQuerierSetDockerImageDetectorSetCall) Do(f func(context.Context, queries.SetDockerImageDetectorSetParams) error) *MockTransactionQuerierSetDockerImageDetectorSetCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierListUsersCall) DoAndReturn(f func(context.Context) ([]*queries.User, error)) *MockTransactionQuerierListUsersCall {
+func (c *MockTransactionQuerierSetDockerImageDetectorSetCall) DoAndReturn(f func(context.Context, queries.SetDockerImageDetectorSetParams) error) *MockTransactionQuerierSetDockerImageDetectorSetCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ListUsersPaginated mocks base method.
-func (m *MockTransactionQuerier) ListUsersPaginated(ctx context.Context, arg queries.ListUsersPaginatedParams) ([]*queries.User, error) {
+// SetOrganizationPermissionsForUser mocks base method.
+func (m *MockTransactionQuerier) SetOrganizationPermissionsForUser(ctx context.Context, arg queries.SetOrganizationPermissionsForUserParams) (*queries.OrganizationMember, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUsersPaginated", ctx, arg)
-	ret0, _ := ret[0].([]*queries.User)
+	ret := m.ctrl.Call(m, "SetOrganizationPermissionsForUser", ctx, arg)
+	ret0, _ := ret[0].(*queries.OrganizationMember)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListUsersPaginated indicates an expected call of ListUsersPaginated.
-func (mr *MockTransactionQuerierMockRecorder) ListUsersPaginated(ctx, arg any) *MockTransactionQuerierListUsersPaginatedCall {
+// SetOrganizationPermissionsForUser indicates an expected call of SetOrganizationPermissionsForUser.
+func (mr *MockTransactionQuerierMockRecorder) SetOrganizationPermissionsForUser(ctx, arg any) *MockTransactionQuerierSetOrganizationPermissionsForUserCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersPaginated", reflect.TypeOf((*MockTransactionQuerier)(nil).ListUsersPaginated), ctx, arg)
-	return &MockTransactionQuerierListUsersPaginatedCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOrganizationPermissionsForUser", reflect.TypeOf((*MockTransactionQuerier)(nil).SetOrganizationPermissionsForUser), ctx, arg)
+	return &MockTransactionQuerierSetOrganizationPermissionsForUserCall{Call: call}
 }
 
-// MockTransactionQuerierListUsersPaginatedCall wrap *gomock.Call
-type MockTransactionQuerierListUsersPaginatedCall struct {
+// MockTransactionQuerierSetOrganizationPermissionsForUserCall wrap *gomock.Call
+type MockTransactionQuerierSetOrganizationPermissionsForUserCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierListUsersPaginatedCall) Return(arg0 []*queries.User, arg1 error) *MockTransactionQuerierListUsersPaginatedCall {
+func (c *MockTransactionQuerierSetOrganizationPermissionsForUserCall) Return(arg0 *queries.OrganizationMember, arg1 error) *MockTransactionQuerierSetOrganizationPermissionsForUserCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierListUsersPaginatedCall) Do(f func(context.Context, queries.ListUsersPaginatedParams) ([]*queries.User, error)) *MockTransactionQuerierListUsersPaginatedCall {
+func (c *MockTransactionQuerierSetOrganizationPermissionsForUserCall) Do(f func(context.Context, queries.SetOrganizationPermissionsForUserParams) (*queries.OrganizationMember, error)) *MockTransactionQuerierSetOrganizationPermissionsForUserCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierListUsersPaginatedCall) DoAndReturn(f func(context.Context, queries.ListUsersPaginatedParams) ([]*queries.User, error)) *MockTransactionQuerierListUsersPaginatedCall {
+func (c *MockTransactionQuerierSetOrganizationPermissionsForUserCall) DoAndReturn(f func(context.Context, queries.SetOrganizationPermissionsForUserParams) (*queries.OrganizationMember, error)) *MockTransactionQuerierSetOrganizationPermissionsForUserCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RemoveOrganizationUser mocks base method.
-func (m *MockTransactionQuerier) RemoveOrganizationUser(ctx context.Context, arg queries.RemoveOrganizationUserParams) (*queries.OrganizationMember, error) {
+// SetProjectDetectorSet mocks base method.
+func (m *MockTransactionQuerier) SetProjectDetectorSet(ctx context.Context, arg queries.SetProjectDetectorSetParams) (*queries.ProjectDetectorSet, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveOrganizationUser", ctx, arg)
-	ret0, _ := ret[0].(*queries.OrganizationMember)
+	ret := m.ctrl.Call(m, "SetProjectDetectorSet", ctx, arg)
+	ret0, _ := ret[0].(*queries.ProjectDetectorSet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RemoveOrganizationUser indicates an expected call of RemoveOrganizationUser.
-func (mr *MockTransactionQuerierMockRecorder) RemoveOrganizationUser(ctx, arg any) *MockTransactionQuerierRemoveOrganizationUserCall {
+// SetProjectDetectorSet indicates an expected call of SetProjectDetectorSet.
+func (mr *MockTransactionQuerierMockRecorder) SetProjectDetectorSet(ctx, arg any) *MockTransactionQuerierSetProjectDetectorSetCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrganizationUser", reflect.TypeOf((*MockTransactionQuerier)(nil).RemoveOrganizationUser), ctx, arg)
-	return &MockTransactionQuerierRemoveOrganizationUserCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectDetectorSet", reflect.TypeOf((*MockTransactionQuerier)(nil).SetProjectDetectorSet), ctx, arg)
+	return &MockTransactionQuerierSetProjectDetectorSetCall{Call: call}
 }
 
-// MockTransactionQuerierRemoveOrganizationUserCall wrap *gomock.Call
-type MockTransactionQuerierRemoveOrganizationUserCall struct {
+// MockTransactionQuerierSetProjectDetectorSetCall wrap *gomock.Call
+type MockTransactionQuerierSetProjectDetectorSetCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierRemoveOrganizationUserCall) Return(arg0 *queries.OrganizationMember, arg1 error) *MockTransactionQuerierRemoveOrganizationUserCall {
+func (c *MockTransactionQuerierSetProjectDetectorSetCall) Return(arg0 *queries.ProjectDetectorSet, arg1 error) *MockTransactionQuerierSetProjectDetectorSetCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierRemoveOrganizationUserCall) Do(f func(context.Context, queries.RemoveOrganizationUserParams) (*queries.OrganizationMember, error)) *MockTransactionQuerierRemoveOrganizationUserCall {
+func (c *MockTransactionQuerierSetProjectDetectorSetCall) Do(f func(context.Context, queries.SetProjectDetectorSetParams) (*queries.ProjectDetectorSet, error)) *MockTransactionQuerierSetProjectDetectorSetCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierRemoveOrganizationUserCall) DoAndReturn(f func(context.Context, queries.RemoveOrganizationUserParams) (*queries.OrganizationMember, error)) *MockTransactionQuerierRemoveOrganizationUserCall {
+func (c *MockTransactionQuerierSetProjectDetectorSetCall) DoAndReturn(f func(context.Context, queries.SetProjectDetectorSetParams) (*queries.ProjectDetectorSet, error)) *MockTransactionQuerierSetProjectDetectorSetCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SetOrganizationPermissionsForUser mocks base method.
-func (m *MockTransactionQuerier) SetOrganizationPermissionsForUser(ctx context.Context, arg queries.SetOrganizationPermissionsForUserParams) (*queries.OrganizationMember, error) {
+// SetProjectPasswordRules mocks base method.
+func (m *MockTransactionQuerier) SetProjectPasswordRules(ctx context.Context, arg queries.SetProjectPasswordRulesParams) (*queries.ProjectPasswordRule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetOrganizationPermissionsForUser", ctx, arg)
-	ret0, _ := ret[0].(*queries.OrganizationMember)
+	ret := m.ctrl.Call(m, "SetProjectPasswordRules", ctx, arg)
+	ret0, _ := ret[0].(*queries.ProjectPasswordRule)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SetOrganizationPermissionsForUser indicates an expected call of SetOrganizationPermissionsForUser.
-func (mr *MockTransactionQuerierMockRecorder) SetOrganizationPermissionsForUser(ctx, arg any) *MockTransactionQuerierSetOrganizationPermissionsForUserCall {
+// SetProjectPasswordRules indicates an expected call of SetProjectPasswordRules.
+func (mr *MockTransactionQuerierMockRecorder) SetProjectPasswordRules(ctx, arg any) *MockTransactionQuerierSetProjectPasswordRulesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOrganizationPermissionsForUser", reflect.TypeOf((*MockTransactionQuerier)(nil).SetOrganizationPermissionsForUser), ctx, arg)
-	return &MockTransactionQuerierSetOrganizationPermissionsForUserCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectPasswordRules", reflect.TypeOf((*MockTransactionQuerier)(nil).SetProjectPasswordRules), ctx, arg)
+	return &MockTransactionQuerierSetProjectPasswordRulesCall{Call: call}
 }
 
-// MockTransactionQuerierSetOrganizationPermissionsForUserCall wrap *gomock.Call
-type MockTransactionQuerierSetOrganizationPermissionsForUserCall struct {
+// MockTransactionQuerierSetProjectPasswordRulesCall wrap *gomock.Call
+type MockTransactionQuerierSetProjectPasswordRulesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionQuerierSetOrganizationPermissionsForUserCall) Return(arg0 *queries.OrganizationMember, arg1 error) *MockTransactionQuerierSetOrganizationPermissionsForUserCall {
+func (c *MockTransactionQuerierSetProjectPasswordRulesCall) Return(arg0 *queries.ProjectPasswordRule, arg1 error) *MockTransactionQuerierSetProjectPasswordRulesCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionQuerierSetOrganizationPermissionsForUserCall) Do(f func(context.Context, queries.SetOrganizationPermissionsForUserParams) (*queries.OrganizationMember, error)) *MockTransactionQuerierSetOrganizationPermissionsForUserCall {
+func (c *MockTransactionQuerierSetProjectPasswordRulesCall) Do(f func(context.Context, queries.SetProjectPasswordRulesParams) (*queries.ProjectPasswordRule, error)) *MockTransactionQuerierSetProjectPasswordRulesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionQuerierSetOrganizationPermissionsForUserCall) DoAndReturn(f func(context.Context, queries.SetOrganizationPermissionsForUserParams) (*queries.OrganizationMember, error)) *MockTransactionQuerierSetOrganizationPermissionsForUserCall {
+func (c *MockTransactionQuerierSetProjectPasswordRulesCall) DoAndReturn(f func(context.Context, queries.SetProjectPasswordRulesParams) (*queries.ProjectPasswordRule, error)) *MockTransactionQuerierSetProjectPasswordRulesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -5643,6 +7855,158 @@ func (c *MockTransactionQuerierUpdateDockerImageCall) DoAndReturn(f func(context
 	return c
 }
 
+// UpdateElasticsearchDatabase mocks base method.
+func (m *MockTransactionQuerier) UpdateElasticsearchDatabase(ctx context.Context, arg queries.UpdateElasticsearchDatabaseParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateElasticsearchDatabase", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateElasticsearchDatabase indicates an expected call of UpdateElasticsearchDatabase.
+func (mr *MockTransactionQuerierMockRecorder) UpdateElasticsearchDatabase(ctx, arg any) *MockTransactionQuerierUpdateElasticsearchDatabaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateElasticsearchDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).UpdateElasticsearchDatabase), ctx, arg)
+	return &MockTransactionQuerierUpdateElasticsearchDatabaseCall{Call: call}
+}
+
+// MockTransactionQuerierUpdateElasticsearchDatabaseCall wrap *gomock.Call
+type MockTransactionQuerierUpdateElasticsearchDatabaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierUpdateElasticsearchDatabaseCall) Return(arg0 error) *MockTransactionQuerierUpdateElasticsearchDatabaseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierUpdateElasticsearchDatabaseCall) Do(f func(context.Context, queries.UpdateElasticsearchDatabaseParams) error) *MockTransactionQuerierUpdateElasticsearchDatabaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierUpdateElasticsearchDatabaseCall) DoAndReturn(f func(context.Context, queries.UpdateElasticsearchDatabaseParams) error) *MockTransactionQuerierUpdateElasticsearchDatabaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateElasticsearchVersion mocks base method.
+func (m *MockTransactionQuerier) UpdateElasticsearchVersion(ctx context.Context, arg queries.UpdateElasticsearchVersionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateElasticsearchVersion", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateElasticsearchVersion indicates an expected call of UpdateElasticsearchVersion.
+func (mr *MockTransactionQuerierMockRecorder) UpdateElasticsearchVersion(ctx, arg any) *MockTransactionQuerierUpdateElasticsearchVersionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateElasticsearchVersion", reflect.TypeOf((*MockTransactionQuerier)(nil).UpdateElasticsearchVersion), ctx, arg)
+	return &MockTransactionQuerierUpdateElasticsearchVersionCall{Call: call}
+}
+
+// MockTransactionQuerierUpdateElasticsearchVersionCall wrap *gomock.Call
+type MockTransactionQuerierUpdateElasticsearchVersionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierUpdateElasticsearchVersionCall) Return(arg0 error) *MockTransactionQuerierUpdateElasticsearchVersionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierUpdateElasticsearchVersionCall) Do(f func(context.Context, queries.UpdateElasticsearchVersionParams) error) *MockTransactionQuerierUpdateElasticsearchVersionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierUpdateElasticsearchVersionCall) DoAndReturn(f func(context.Context, queries.UpdateElasticsearchVersionParams) error) *MockTransactionQuerierUpdateElasticsearchVersionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateEtcdDatabase mocks base method.
+func (m *MockTransactionQuerier) UpdateEtcdDatabase(ctx context.Context, arg queries.UpdateEtcdDatabaseParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEtcdDatabase", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEtcdDatabase indicates an expected call of UpdateEtcdDatabase.
+func (mr *MockTransactionQuerierMockRecorder) UpdateEtcdDatabase(ctx, arg any) *MockTransactionQuerierUpdateEtcdDatabaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEtcdDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).UpdateEtcdDatabase), ctx, arg)
+	return &MockTransactionQuerierUpdateEtcdDatabaseCall{Call: call}
+}
+
+// MockTransactionQuerierUpdateEtcdDatabaseCall wrap *gomock.Call
+type MockTransactionQuerierUpdateEtcdDatabaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierUpdateEtcdDatabaseCall) Return(arg0 error) *MockTransactionQuerierUpdateEtcdDatabaseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierUpdateEtcdDatabaseCall) Do(f func(context.Context, queries.UpdateEtcdDatabaseParams) error) *MockTransactionQuerierUpdateEtcdDatabaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierUpdateEtcdDatabaseCall) DoAndReturn(f func(context.Context, queries.UpdateEtcdDatabaseParams) error) *MockTransactionQuerierUpdateEtcdDatabaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateEtcdVersion mocks base method.
+func (m *MockTransactionQuerier) UpdateEtcdVersion(ctx context.Context, arg queries.UpdateEtcdVersionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEtcdVersion", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEtcdVersion indicates an expected call of UpdateEtcdVersion.
+func (mr *MockTransactionQuerierMockRecorder) UpdateEtcdVersion(ctx, arg any) *MockTransactionQuerierUpdateEtcdVersionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEtcdVersion", reflect.TypeOf((*MockTransactionQuerier)(nil).UpdateEtcdVersion), ctx, arg)
+	return &MockTransactionQuerierUpdateEtcdVersionCall{Call: call}
+}
+
+// MockTransactionQuerierUpdateEtcdVersionCall wrap *gomock.Call
+type MockTransactionQuerierUpdateEtcdVersionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierUpdateEtcdVersionCall) Return(arg0 error) *MockTransactionQuerierUpdateEtcdVersionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierUpdateEtcdVersionCall) Do(f func(context.Context, queries.UpdateEtcdVersionParams) error) *MockTransactionQuerierUpdateEtcdVersionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierUpdateEtcdVersionCall) DoAndReturn(f func(context.Context, queries.UpdateEtcdVersionParams) error) *MockTransactionQuerierUpdateEtcdVersionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateGitRepository mocks base method.
 func (m *MockTransactionQuerier) UpdateGitRepository(ctx context.Context, arg queries.UpdateGitRepositoryParams) (*queries.GitRepository, error) {
 	m.ctrl.T.Helper()
@@ -5758,6 +8122,82 @@ func (c *MockTransactionQuerierUpdateMongoVersionCall) DoAndReturn(f func(contex
 	return c
 }
 
+// UpdateMssqlDatabase mocks base method.
+func (m *MockTransactionQuerier) UpdateMssqlDatabase(ctx context.Context, arg queries.UpdateMssqlDatabaseParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMssqlDatabase", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMssqlDatabase indicates an expected call of UpdateMssqlDatabase.
+func (mr *MockTransactionQuerierMockRecorder) UpdateMssqlDatabase(ctx, arg any) *MockTransactionQuerierUpdateMssqlDatabaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMssqlDatabase", reflect.TypeOf((*MockTransactionQuerier)(nil).UpdateMssqlDatabase), ctx, arg)
+	return &MockTransactionQuerierUpdateMssqlDatabaseCall{Call: call}
+}
+
+// MockTransactionQuerierUpdateMssqlDatabaseCall wrap *gomock.Call
+type MockTransactionQuerierUpdateMssqlDatabaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierUpdateMssqlDatabaseCall) Return(arg0 error) *MockTransactionQuerierUpdateMssqlDatabaseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierUpdateMssqlDatabaseCall) Do(f func(context.Context, queries.UpdateMssqlDatabaseParams) error) *MockTransactionQuerierUpdateMssqlDatabaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierUpdateMssqlDatabaseCall) DoAndReturn(f func(context.Context, queries.UpdateMssqlDatabaseParams) error) *MockTransactionQuerierUpdateMssqlDatabaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateMssqlVersion mocks base method.
+func (m *MockTransactionQuerier) UpdateMssqlVersion(ctx context.Context, arg queries.UpdateMssqlVersionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMssqlVersion", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMssqlVersion indicates an expected call of UpdateMssqlVersion.
+func (mr *MockTransactionQuerierMockRecorder) UpdateMssqlVersion(ctx, arg any) *MockTransactionQuerierUpdateMssqlVersionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMssqlVersion", reflect.TypeOf((*MockTransactionQuerier)(nil).UpdateMssqlVersion), ctx, arg)
+	return &MockTransactionQuerierUpdateMssqlVersionCall{Call: call}
+}
+
+// MockTransactionQuerierUpdateMssqlVersionCall wrap *gomock.Call
+type MockTransactionQuerierUpdateMssqlVersionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierUpdateMssqlVersionCall) Return(arg0 error) *MockTransactionQuerierUpdateMssqlVersionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierUpdateMssqlVersionCall) Do(f func(context.Context, queries.UpdateMssqlVersionParams) error) *MockTransactionQuerierUpdateMssqlVersionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierUpdateMssqlVersionCall) DoAndReturn(f func(context.Context, queries.UpdateMssqlVersionParams) error) *MockTransactionQuerierUpdateMssqlVersionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateMysqlDatabase mocks base method.
 func (m *MockTransactionQuerier) UpdateMysqlDatabase(ctx context.Context, arg queries.UpdateMysqlDatabaseParams) error {
 	m.ctrl.T.Helper()
@@ -6101,6 +8541,45 @@ func (c *MockTransactionQuerierUpdateScanBruteforceResultCall) DoAndReturn(f fun
 	return c
 }
 
+// UpdateScanBruteforceShardProgress mocks base method.
+func (m *MockTransactionQuerier) UpdateScanBruteforceShardProgress(ctx context.Context, arg queries.UpdateScanBruteforceShardProgressParams) (*queries.ScanBruteforceShard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScanBruteforceShardProgress", ctx, arg)
+	ret0, _ := ret[0].(*queries.ScanBruteforceShard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScanBruteforceShardProgress indicates an expected call of UpdateScanBruteforceShardProgress.
+func (mr *MockTransactionQuerierMockRecorder) UpdateScanBruteforceShardProgress(ctx, arg any) *MockTransactionQuerierUpdateScanBruteforceShardProgressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScanBruteforceShardProgress", reflect.TypeOf((*MockTransactionQuerier)(nil).UpdateScanBruteforceShardProgress), ctx, arg)
+	return &MockTransactionQuerierUpdateScanBruteforceShardProgressCall{Call: call}
+}
+
+// MockTransactionQuerierUpdateScanBruteforceShardProgressCall wrap *gomock.Call
+type MockTransactionQuerierUpdateScanBruteforceShardProgressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionQuerierUpdateScanBruteforceShardProgressCall) Return(arg0 *queries.ScanBruteforceShard, arg1 error) *MockTransactionQuerierUpdateScanBruteforceShardProgressCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionQuerierUpdateScanBruteforceShardProgressCall) Do(f func(context.Context, queries.UpdateScanBruteforceShardProgressParams) (*queries.ScanBruteforceShard, error)) *MockTransactionQuerierUpdateScanBruteforceShardProgressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionQuerierUpdateScanBruteforceShardProgressCall) DoAndReturn(f func(context.Context, queries.UpdateScanBruteforceShardProgressParams) (*queries.ScanBruteforceShard, error)) *MockTransactionQuerierUpdateScanBruteforceShardProgressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateScanStatus mocks base method.
 func (m *MockTransactionQuerier) UpdateScanStatus(ctx context.Context, arg queries.UpdateScanStatusParams) error {
 	m.ctrl.T.Helper()
@@ -5,8 +5,8 @@ ON CONFLICT
     DO NOTHING;
 
 -- name: CreateBruteforcedPassword :one
-INSERT INTO bruteforced_passwords(hash, username, PASSWORD, last_bruteforce_id, project_id)
-    VALUES ($1, $2, $3, $4, $5)
+INSERT INTO bruteforced_passwords(hash, username, PASSWORD, last_bruteforce_id, last_bruteforce_fingerprint, project_id)
+    VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING
     *;
 
@@ -15,7 +15,8 @@ UPDATE
     bruteforced_passwords
 SET
     last_bruteforce_id = $2,
-    PASSWORD = $3
+    PASSWORD = $3,
+    last_bruteforce_fingerprint = $4
 WHERE
     id = $1
 RETURNING
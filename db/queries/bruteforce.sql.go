@@ -11,18 +11,19 @@ import (
 )
 
 const createBruteforcedPassword = `-- name: CreateBruteforcedPassword :one
-INSERT INTO bruteforced_passwords(hash, username, PASSWORD, last_bruteforce_id, project_id)
-    VALUES ($1, $2, $3, $4, $5)
+INSERT INTO bruteforced_passwords(hash, username, PASSWORD, last_bruteforce_id, last_bruteforce_fingerprint, project_id)
+    VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING
-    id, hash, username, password, last_bruteforce_id, project_id
+    id, hash, username, password, last_bruteforce_id, last_bruteforce_fingerprint, project_id
 `
 
 type CreateBruteforcedPasswordParams struct {
-	Hash             string         `json:"hash"`
-	Username         string         `json:"username"`
-	Password         sql.NullString `json:"password"`
-	LastBruteforceID sql.NullInt64  `json:"last_bruteforce_id"`
-	ProjectID        sql.NullInt64  `json:"project_id"`
+	Hash                      string         `json:"hash"`
+	Username                  string         `json:"username"`
+	Password                  sql.NullString `json:"password"`
+	LastBruteforceID          sql.NullInt64  `json:"last_bruteforce_id"`
+	LastBruteforceFingerprint string         `json:"last_bruteforce_fingerprint"`
+	ProjectID                 sql.NullInt64  `json:"project_id"`
 }
 
 func (q *Queries) CreateBruteforcedPassword(ctx context.Context, arg CreateBruteforcedPasswordParams) (*BruteforcedPassword, error) {
@@ -31,6 +32,7 @@ func (q *Queries) CreateBruteforcedPassword(ctx context.Context, arg CreateBrute
 		arg.Username,
 		arg.Password,
 		arg.LastBruteforceID,
+		arg.LastBruteforceFingerprint,
 		arg.ProjectID,
 	)
 	var i BruteforcedPassword
@@ -40,6 +42,7 @@ func (q *Queries) CreateBruteforcedPassword(ctx context.Context, arg CreateBrute
 		&i.Username,
 		&i.Password,
 		&i.LastBruteforceID,
+		&i.LastBruteforceFingerprint,
 		&i.ProjectID,
 	)
 	return &i, err
@@ -184,7 +187,7 @@ func (q *Queries) GetBruteforcePasswordsSpecificForProject(ctx context.Context,
 
 const getBruteforcedPasswords = `-- name: GetBruteforcedPasswords :one
 SELECT
-    id, hash, username, password, last_bruteforce_id, project_id
+    id, hash, username, password, last_bruteforce_id, last_bruteforce_fingerprint, project_id
 FROM
     bruteforced_passwords
 WHERE
@@ -210,6 +213,7 @@ func (q *Queries) GetBruteforcedPasswords(ctx context.Context, arg GetBruteforce
 		&i.Username,
 		&i.Password,
 		&i.LastBruteforceID,
+		&i.LastBruteforceFingerprint,
 		&i.ProjectID,
 	)
 	return &i, err
@@ -277,21 +281,28 @@ UPDATE
     bruteforced_passwords
 SET
     last_bruteforce_id = $2,
-    PASSWORD = $3
+    PASSWORD = $3,
+    last_bruteforce_fingerprint = $4
 WHERE
     id = $1
 RETURNING
-    id, hash, username, password, last_bruteforce_id, project_id
+    id, hash, username, password, last_bruteforce_id, last_bruteforce_fingerprint, project_id
 `
 
 type UpdateBruteforcedPasswordParams struct {
-	ID               int64          `json:"id"`
-	LastBruteforceID sql.NullInt64  `json:"last_bruteforce_id"`
-	Password         sql.NullString `json:"password"`
+	ID                        int64          `json:"id"`
+	LastBruteforceID          sql.NullInt64  `json:"last_bruteforce_id"`
+	Password                  sql.NullString `json:"password"`
+	LastBruteforceFingerprint string         `json:"last_bruteforce_fingerprint"`
 }
 
 func (q *Queries) UpdateBruteforcedPassword(ctx context.Context, arg UpdateBruteforcedPasswordParams) (*BruteforcedPassword, error) {
-	row := q.db.QueryRow(ctx, updateBruteforcedPassword, arg.ID, arg.LastBruteforceID, arg.Password)
+	row := q.db.QueryRow(ctx, updateBruteforcedPassword,
+		arg.ID,
+		arg.LastBruteforceID,
+		arg.Password,
+		arg.LastBruteforceFingerprint,
+	)
 	var i BruteforcedPassword
 	err := row.Scan(
 		&i.ID,
@@ -299,6 +310,7 @@ func (q *Queries) UpdateBruteforcedPassword(ctx context.Context, arg UpdateBrute
 		&i.Username,
 		&i.Password,
 		&i.LastBruteforceID,
+		&i.LastBruteforceFingerprint,
 		&i.ProjectID,
 	)
 	return &i, err
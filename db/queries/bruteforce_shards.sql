@@ -0,0 +1,116 @@
+-- name: CreateScanBruteforceShards :many
+INSERT INTO scan_bruteforce_shards(scan_id, scan_type, username, hash, start_id, end_id, next_id)
+SELECT
+    sqlc.arg(scan_id),
+    sqlc.arg(scan_type),
+    sqlc.arg(username),
+    sqlc.arg(hash),
+    shard_start,
+    CASE WHEN shard_start + sqlc.arg(shard_size)::bigint >= sqlc.arg(start_id)::bigint + sqlc.arg(count)::bigint THEN
+        NULL
+    ELSE
+        shard_start + sqlc.arg(shard_size)::bigint
+    END,
+    shard_start
+FROM
+    generate_series(sqlc.arg(start_id)::bigint, sqlc.arg(start_id)::bigint + GREATEST(sqlc.arg(count)::bigint - 1, 0), sqlc.arg(shard_size)::bigint) AS shard_start
+RETURNING
+    *;
+
+-- name: GetScanBruteforceShards :many
+SELECT
+    *
+FROM
+    scan_bruteforce_shards
+WHERE
+    scan_id = $1
+    AND scan_type = $2
+    AND username = $3
+ORDER BY
+    start_id;
+
+-- name: ClaimScanBruteforceShard :one
+UPDATE
+    scan_bruteforce_shards
+SET
+    status = 1,
+    worker_id = sqlc.arg(worker_id),
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    id = (
+        SELECT
+            scan_bruteforce_shards.id
+        FROM
+            scan_bruteforce_shards
+            INNER JOIN scans ON scan_bruteforce_shards.scan_id = scans.id
+            INNER JOIN scan_groups ON scans.scan_group_id = scan_groups.id
+            INNER JOIN projects ON scan_groups.project_id = projects.id
+        WHERE
+            projects.organization_id = sqlc.arg(organization_id)
+            AND (scan_bruteforce_shards.status = 0
+                OR (scan_bruteforce_shards.status = 1
+                    AND scan_bruteforce_shards.updated_at < CURRENT_TIMESTAMP - interval '2 minutes'))
+        ORDER BY
+            scan_bruteforce_shards.id
+        LIMIT 1
+        FOR UPDATE
+            OF scan_bruteforce_shards SKIP LOCKED)
+RETURNING
+    *;
+
+-- name: UpdateScanBruteforceShardProgress :one
+UPDATE
+    scan_bruteforce_shards
+SET
+    tried = $3,
+    next_id = $4,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    id = $1
+    AND worker_id = $2
+    AND status = 1
+RETURNING
+    *;
+
+-- name: FinishScanBruteforceShard :one
+UPDATE
+    scan_bruteforce_shards
+SET
+    status = 2,
+    tried = $3,
+    next_id = $4,
+    password = $5,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    id = $1
+    AND worker_id = $2
+    AND status = 1
+RETURNING
+    *;
+
+-- name: FailScanBruteforceShard :one
+UPDATE
+    scan_bruteforce_shards
+SET
+    status = 4,
+    tried = $3,
+    next_id = $4,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    id = $1
+    AND worker_id = $2
+    AND status = 1
+RETURNING
+    *;
+
+-- name: CancelScanBruteforceShards :exec
+UPDATE
+    scan_bruteforce_shards
+SET
+    status = 3,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    scan_id = $1
+    AND scan_type = $2
+    AND username = $3
+    AND status IN (0, 1);
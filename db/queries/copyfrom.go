@@ -33,6 +33,10 @@ func (r iteratorForCreateDockerLayerResultsForProject) Values() ([]interface{},
 		r.rows[0].Name,
 		r.rows[0].Line,
 		r.rows[0].LineNumber,
+		r.rows[0].EndLineNumber,
+		r.rows[0].Provider,
+		r.rows[0].Confidence,
+		r.rows[0].ExpiresAt,
 		r.rows[0].Match,
 		r.rows[0].Probability,
 		r.rows[0].Username,
@@ -47,7 +51,7 @@ func (r iteratorForCreateDockerLayerResultsForProject) Err() error {
 }
 
 func (q *Queries) CreateDockerLayerResultsForProject(ctx context.Context, arg []CreateDockerLayerResultsForProjectParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"docker_results"}, []string{"layer_id", "name", "line", "line_number", "match", "probability", "username", "password", "filename", "previous_lines"}, &iteratorForCreateDockerLayerResultsForProject{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"docker_results"}, []string{"layer_id", "name", "line", "line_number", "end_line_number", "provider", "confidence", "expires_at", "match", "probability", "username", "password", "filename", "previous_lines"}, &iteratorForCreateDockerLayerResultsForProject{rows: arg})
 }
 
 // iteratorForCreateGitResultForCommit implements pgx.CopyFromSource.
@@ -74,6 +78,10 @@ func (r iteratorForCreateGitResultForCommit) Values() ([]interface{}, error) {
 		r.rows[0].Name,
 		r.rows[0].Line,
 		r.rows[0].LineNumber,
+		r.rows[0].EndLineNumber,
+		r.rows[0].Provider,
+		r.rows[0].Confidence,
+		r.rows[0].ExpiresAt,
 		r.rows[0].Match,
 		r.rows[0].Probability,
 		r.rows[0].Username,
@@ -87,5 +95,5 @@ func (r iteratorForCreateGitResultForCommit) Err() error {
 }
 
 func (q *Queries) CreateGitResultForCommit(ctx context.Context, arg []CreateGitResultForCommitParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"git_results"}, []string{"commit", "name", "line", "line_number", "match", "probability", "username", "password", "filename"}, &iteratorForCreateGitResultForCommit{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"git_results"}, []string{"commit", "name", "line", "line_number", "end_line_number", "provider", "confidence", "expires_at", "match", "probability", "username", "password", "filename"}, &iteratorForCreateGitResultForCommit{rows: arg})
 }
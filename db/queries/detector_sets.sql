@@ -0,0 +1,50 @@
+-- name: CreateDetectorSet :one
+INSERT INTO detector_sets(organization_id, name, format, content, use_default)
+    VALUES ($1, $2, $3, $4, $5)
+RETURNING
+    *;
+
+-- name: GetDetectorSet :one
+SELECT
+    *
+FROM
+    detector_sets
+WHERE
+    id = $1
+LIMIT 1;
+
+-- name: GetDetectorSetsForOrganization :many
+SELECT
+    *
+FROM
+    detector_sets
+WHERE
+    organization_id = $1
+ORDER BY
+    id;
+
+-- name: DeleteDetectorSet :exec
+DELETE FROM detector_sets
+WHERE id = $1;
+
+-- name: GetDetectorSetForProject :one
+SELECT
+    detector_sets.*
+FROM
+    detector_sets
+    INNER JOIN project_detector_sets ON project_detector_sets.detector_set_id = detector_sets.id
+WHERE
+    project_detector_sets.project_id = $1;
+
+-- name: SetProjectDetectorSet :one
+INSERT INTO project_detector_sets(project_id, detector_set_id)
+    VALUES ($1, $2)
+ON CONFLICT (project_id)
+    DO UPDATE SET
+        detector_set_id = EXCLUDED.detector_set_id, updated_at = CURRENT_TIMESTAMP
+    RETURNING
+        *;
+
+-- name: DeleteProjectDetectorSet :exec
+DELETE FROM project_detector_sets
+WHERE project_id = $1;
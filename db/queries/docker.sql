@@ -18,7 +18,8 @@ SELECT
     project_id,
     docker_image,
     decrypt_data(project_id, sqlc.arg(salt_key), username) AS username,
-    decrypt_data(project_id, sqlc.arg(salt_key), PASSWORD) AS PASSWORD
+    decrypt_data(project_id, sqlc.arg(salt_key), PASSWORD) AS PASSWORD,
+    detector_set_id
 FROM
     docker_images
 WHERE
@@ -30,14 +31,15 @@ SELECT
     project_id,
     docker_image,
     decrypt_data(project_id, sqlc.arg(salt_key), username) AS username,
-    decrypt_data(project_id, sqlc.arg(salt_key), PASSWORD) AS PASSWORD
+    decrypt_data(project_id, sqlc.arg(salt_key), PASSWORD) AS PASSWORD,
+    detector_set_id
 FROM
     docker_images
 WHERE
     project_id = $1;
 
 -- name: CreateDockerLayerResultsForProject :copyfrom
-INSERT INTO docker_results(layer_id, name, line, line_number, MATCH, probability, username, PASSWORD, filename, previous_lines)
+INSERT INTO docker_results(layer_id, name, line, line_number, end_line_number, provider, confidence, expires_at, MATCH, probability, username, PASSWORD, filename, previous_lines)
     VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
 
 -- name: DeleteDockerImage :exec
@@ -114,3 +116,10 @@ WHERE
     scans.scan_group_id = $1
     AND image_id = $2;
 
+-- name: SetDockerImageDetectorSet :exec
+UPDATE
+    docker_images
+SET
+    detector_set_id = $2
+WHERE
+    id = $1;
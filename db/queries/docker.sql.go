@@ -16,7 +16,7 @@ const createDockerImage = `-- name: CreateDockerImage :one
 INSERT INTO docker_images(project_id, docker_image, username, PASSWORD)
     VALUES ($1, $2, encrypt_data($1, $3, $4), encrypt_data($1, $3, $5))
 RETURNING
-    id, project_id, docker_image, username, password, min_probability, probability_decrease_multiplier, probability_increase_multiplier, entropy_threshold, logistic_growth_rate, created_at
+    id, project_id, docker_image, username, password, min_probability, probability_decrease_multiplier, probability_increase_multiplier, entropy_threshold, logistic_growth_rate, detector_set_id, created_at
 `
 
 type CreateDockerImageParams struct {
@@ -47,22 +47,27 @@ func (q *Queries) CreateDockerImage(ctx context.Context, arg CreateDockerImagePa
 		&i.ProbabilityIncreaseMultiplier,
 		&i.EntropyThreshold,
 		&i.LogisticGrowthRate,
+		&i.DetectorSetID,
 		&i.CreatedAt,
 	)
 	return &i, err
 }
 
 type CreateDockerLayerResultsForProjectParams struct {
-	LayerID       int64          `json:"layer_id"`
-	Name          string         `json:"name"`
-	Line          string         `json:"line"`
-	LineNumber    int32          `json:"line_number"`
-	Match         string         `json:"match"`
-	Probability   float64        `json:"probability"`
-	Username      sql.NullString `json:"username"`
-	Password      sql.NullString `json:"password"`
-	Filename      string         `json:"filename"`
-	PreviousLines string         `json:"previous_lines"`
+	LayerID       int64              `json:"layer_id"`
+	Name          string             `json:"name"`
+	Line          string             `json:"line"`
+	LineNumber    int32              `json:"line_number"`
+	EndLineNumber int32              `json:"end_line_number"`
+	Provider      string             `json:"provider"`
+	Confidence    string             `json:"confidence"`
+	ExpiresAt     pgtype.Timestamptz `json:"expires_at"`
+	Match         string             `json:"match"`
+	Probability   float64            `json:"probability"`
+	Username      sql.NullString     `json:"username"`
+	Password      sql.NullString     `json:"password"`
+	Filename      string             `json:"filename"`
+	PreviousLines string             `json:"previous_lines"`
 }
 
 const createDockerScan = `-- name: CreateDockerScan :one
@@ -124,7 +129,8 @@ SELECT
     project_id,
     docker_image,
     decrypt_data(project_id, $2, username) AS username,
-    decrypt_data(project_id, $2, PASSWORD) AS PASSWORD
+    decrypt_data(project_id, $2, PASSWORD) AS PASSWORD,
+    detector_set_id
 FROM
     docker_images
 WHERE
@@ -137,11 +143,12 @@ type GetDockerImageParams struct {
 }
 
 type GetDockerImageRow struct {
-	ID          int64  `json:"id"`
-	ProjectID   int64  `json:"project_id"`
-	DockerImage string `json:"docker_image"`
-	Username    string `json:"username"`
-	Password    string `json:"password"`
+	ID            int64         `json:"id"`
+	ProjectID     int64         `json:"project_id"`
+	DockerImage   string        `json:"docker_image"`
+	Username      string        `json:"username"`
+	Password      string        `json:"password"`
+	DetectorSetID sql.NullInt64 `json:"detector_set_id"`
 }
 
 func (q *Queries) GetDockerImage(ctx context.Context, arg GetDockerImageParams) (*GetDockerImageRow, error) {
@@ -153,6 +160,7 @@ func (q *Queries) GetDockerImage(ctx context.Context, arg GetDockerImageParams)
 		&i.DockerImage,
 		&i.Username,
 		&i.Password,
+		&i.DetectorSetID,
 	)
 	return &i, err
 }
@@ -163,7 +171,8 @@ SELECT
     project_id,
     docker_image,
     decrypt_data(project_id, $2, username) AS username,
-    decrypt_data(project_id, $2, PASSWORD) AS PASSWORD
+    decrypt_data(project_id, $2, PASSWORD) AS PASSWORD,
+    detector_set_id
 FROM
     docker_images
 WHERE
@@ -176,11 +185,12 @@ type GetDockerImagesForProjectParams struct {
 }
 
 type GetDockerImagesForProjectRow struct {
-	ID          int64  `json:"id"`
-	ProjectID   int64  `json:"project_id"`
-	DockerImage string `json:"docker_image"`
-	Username    string `json:"username"`
-	Password    string `json:"password"`
+	ID            int64         `json:"id"`
+	ProjectID     int64         `json:"project_id"`
+	DockerImage   string        `json:"docker_image"`
+	Username      string        `json:"username"`
+	Password      string        `json:"password"`
+	DetectorSetID sql.NullInt64 `json:"detector_set_id"`
 }
 
 func (q *Queries) GetDockerImagesForProject(ctx context.Context, arg GetDockerImagesForProjectParams) ([]*GetDockerImagesForProjectRow, error) {
@@ -198,6 +208,7 @@ func (q *Queries) GetDockerImagesForProject(ctx context.Context, arg GetDockerIm
 			&i.DockerImage,
 			&i.Username,
 			&i.Password,
+			&i.DetectorSetID,
 		); err != nil {
 			return nil, err
 		}
@@ -211,14 +222,14 @@ func (q *Queries) GetDockerImagesForProject(ctx context.Context, arg GetDockerIm
 
 const getDockerLayersAndResultsForImage = `-- name: GetDockerLayersAndResultsForImage :many
 SELECT
-    lid, image_id, layer_hash, scanned_at, id, layer_id, name, line, line_number, previous_lines, match, probability, username, password, filename, created_at
+    lid, image_id, layer_hash, scanned_at, id, layer_id, name, line, line_number, end_line_number, provider, confidence, expires_at, previous_lines, match, probability, username, password, filename, created_at
 FROM ((
         SELECT
             docker_layers.id AS lid,
             docker_layers.image_id,
             docker_layers.layer_hash,
             docker_layers.scanned_at,
-            docker_results.id, docker_results.layer_id, docker_results.name, docker_results.line, docker_results.line_number, docker_results.previous_lines, docker_results.match, docker_results.probability, docker_results.username, docker_results.password, docker_results.filename, docker_results.created_at
+            docker_results.id, docker_results.layer_id, docker_results.name, docker_results.line, docker_results.line_number, docker_results.end_line_number, docker_results.provider, docker_results.confidence, docker_results.expires_at, docker_results.previous_lines, docker_results.match, docker_results.probability, docker_results.username, docker_results.password, docker_results.filename, docker_results.created_at
         FROM
             docker_layers
         LEFT JOIN docker_results ON docker_layers.id = docker_results.layer_id
@@ -234,7 +245,7 @@ UNION (
         docker_layers.image_id,
         docker_layers.layer_hash,
         docker_layers.scanned_at,
-        docker_results.id, docker_results.layer_id, docker_results.name, docker_results.line, docker_results.line_number, docker_results.previous_lines, docker_results.match, docker_results.probability, docker_results.username, docker_results.password, docker_results.filename, docker_results.created_at
+        docker_results.id, docker_results.layer_id, docker_results.name, docker_results.line, docker_results.line_number, docker_results.end_line_number, docker_results.provider, docker_results.confidence, docker_results.expires_at, docker_results.previous_lines, docker_results.match, docker_results.probability, docker_results.username, docker_results.password, docker_results.filename, docker_results.created_at
     FROM
         docker_layers
     LEFT JOIN docker_results ON docker_layers.id = docker_results.layer_id
@@ -255,6 +266,10 @@ type GetDockerLayersAndResultsForImageRow struct {
 	Name          sql.NullString     `json:"name"`
 	Line          sql.NullString     `json:"line"`
 	LineNumber    sql.NullInt32      `json:"line_number"`
+	EndLineNumber sql.NullInt32      `json:"end_line_number"`
+	Provider      sql.NullString     `json:"provider"`
+	Confidence    sql.NullString     `json:"confidence"`
+	ExpiresAt     pgtype.Timestamptz `json:"expires_at"`
 	PreviousLines sql.NullString     `json:"previous_lines"`
 	Match         sql.NullString     `json:"match"`
 	Probability   sql.NullFloat64    `json:"probability"`
@@ -283,6 +298,10 @@ func (q *Queries) GetDockerLayersAndResultsForImage(ctx context.Context, imageID
 			&i.Name,
 			&i.Line,
 			&i.LineNumber,
+			&i.EndLineNumber,
+			&i.Provider,
+			&i.Confidence,
+			&i.ExpiresAt,
 			&i.PreviousLines,
 			&i.Match,
 			&i.Probability,
@@ -371,6 +390,25 @@ func (q *Queries) GetDockerScannedLayersForImage(ctx context.Context, imageID in
 	return items, nil
 }
 
+const setDockerImageDetectorSet = `-- name: SetDockerImageDetectorSet :exec
+UPDATE
+    docker_images
+SET
+    detector_set_id = $2
+WHERE
+    id = $1
+`
+
+type SetDockerImageDetectorSetParams struct {
+	ID            int64         `json:"id"`
+	DetectorSetID sql.NullInt64 `json:"detector_set_id"`
+}
+
+func (q *Queries) SetDockerImageDetectorSet(ctx context.Context, arg SetDockerImageDetectorSetParams) error {
+	_, err := q.db.Exec(ctx, setDockerImageDetectorSet, arg.ID, arg.DetectorSetID)
+	return err
+}
+
 const updateDockerImage = `-- name: UpdateDockerImage :one
 UPDATE
     docker_images
@@ -381,7 +419,7 @@ SET
 WHERE
     id = $1
 RETURNING
-    id, project_id, docker_image, username, password, min_probability, probability_decrease_multiplier, probability_increase_multiplier, entropy_threshold, logistic_growth_rate, created_at
+    id, project_id, docker_image, username, password, min_probability, probability_decrease_multiplier, probability_increase_multiplier, entropy_threshold, logistic_growth_rate, detector_set_id, created_at
 `
 
 type UpdateDockerImageParams struct {
@@ -414,6 +452,7 @@ func (q *Queries) UpdateDockerImage(ctx context.Context, arg UpdateDockerImagePa
 		&i.ProbabilityIncreaseMultiplier,
 		&i.EntropyThreshold,
 		&i.LogisticGrowthRate,
+		&i.DetectorSetID,
 		&i.CreatedAt,
 	)
 	return &i, err
@@ -0,0 +1,110 @@
+-- name: CreateElasticsearchScan :one
+INSERT INTO elasticsearch_scans(scan_id, database_id)
+    VALUES ($1, $2)
+RETURNING
+    *;
+
+-- name: GetElasticsearchScan :one
+SELECT
+    *
+FROM
+    elasticsearch_scans
+WHERE
+    id = $1
+LIMIT 1;
+
+-- name: GetElasticsearchScanByScanID :one
+SELECT
+    *
+FROM
+    elasticsearch_scans
+WHERE
+    scan_id = $1
+LIMIT 1;
+
+-- name: UpdateElasticsearchVersion :exec
+UPDATE
+    elasticsearch_databases
+SET
+    version = $2
+WHERE
+    id = $1;
+
+-- name: UpdateElasticsearchDatabase :exec
+UPDATE
+    elasticsearch_databases
+SET
+    host = $2,
+    port = $3,
+    username = encrypt_data(sqlc.arg(project_id), sqlc.arg(salt_key), sqlc.arg(username)),
+    PASSWORD = encrypt_data(sqlc.arg(project_id), sqlc.arg(salt_key), sqlc.arg(PASSWORD)),
+    version = $4
+WHERE
+    id = $1;
+
+-- name: GetElasticsearchDatabasesForProject :many
+SELECT
+    id,
+    project_id,
+    host,
+    port,
+    decrypt_data(project_id, sqlc.arg(salt_key), username) AS username,
+    decrypt_data(project_id, sqlc.arg(salt_key), PASSWORD) AS PASSWORD,
+    version,
+    created_at
+FROM
+    elasticsearch_databases
+WHERE
+    project_id = $1;
+
+-- name: GetElasticsearchDatabase :one
+SELECT
+    id,
+    project_id,
+    host,
+    port,
+    decrypt_data(project_id, sqlc.arg(salt_key), username) AS username,
+    decrypt_data(project_id, sqlc.arg(salt_key), PASSWORD) AS PASSWORD,
+    version,
+    created_at,
+(
+        SELECT
+            COUNT(*)
+        FROM
+            elasticsearch_scans
+        WHERE
+            elasticsearch_scans.database_id = elasticsearch_databases.id) AS scan_count
+FROM
+    elasticsearch_databases
+WHERE
+    elasticsearch_databases.id = $1;
+
+-- name: GetProjectInfoForElasticsearchScanByScanID :one
+SELECT
+    sqlc.embed(projects),
+    elasticsearch_databases.id AS database_id,
+    elasticsearch_databases.project_id AS database_project_id,
+    elasticsearch_databases.host AS database_host,
+    elasticsearch_databases.port AS database_port,
+    decrypt_data(elasticsearch_databases.project_id, sqlc.arg(salt_key), elasticsearch_databases.username) AS database_username,
+    decrypt_data(elasticsearch_databases.project_id, sqlc.arg(salt_key), elasticsearch_databases.PASSWORD) AS database_PASSWORD,
+    elasticsearch_databases.version AS database_version,
+    elasticsearch_databases.created_at AS database_created_at,
+    sqlc.embed(elasticsearch_scans)
+FROM
+    projects
+    JOIN elasticsearch_databases ON elasticsearch_databases.project_id = projects.id
+    JOIN elasticsearch_scans ON elasticsearch_scans.database_id = elasticsearch_databases.id
+WHERE
+    elasticsearch_scans.scan_id = $1;
+
+-- name: CreateElasticsearchDatabase :one
+INSERT INTO elasticsearch_databases(project_id, host, port, username, PASSWORD, version)
+    VALUES ($1, $2, $3, encrypt_data($1, sqlc.arg(salt_key), sqlc.arg(username)), encrypt_data($1, sqlc.arg(salt_key), sqlc.arg(PASSWORD)), $4)
+RETURNING
+    *;
+
+-- name: DeleteElasticsearchDatabase :exec
+DELETE FROM elasticsearch_databases
+WHERE id = $1;
+
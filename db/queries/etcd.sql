@@ -0,0 +1,110 @@
+-- name: CreateEtcdScan :one
+INSERT INTO etcd_scans(scan_id, database_id)
+    VALUES ($1, $2)
+RETURNING
+    *;
+
+-- name: GetEtcdScan :one
+SELECT
+    *
+FROM
+    etcd_scans
+WHERE
+    id = $1
+LIMIT 1;
+
+-- name: GetEtcdScanByScanID :one
+SELECT
+    *
+FROM
+    etcd_scans
+WHERE
+    scan_id = $1
+LIMIT 1;
+
+-- name: UpdateEtcdVersion :exec
+UPDATE
+    etcd_databases
+SET
+    version = $2
+WHERE
+    id = $1;
+
+-- name: UpdateEtcdDatabase :exec
+UPDATE
+    etcd_databases
+SET
+    host = $2,
+    port = $3,
+    username = encrypt_data(sqlc.arg(project_id), sqlc.arg(salt_key), sqlc.arg(username)),
+    PASSWORD = encrypt_data(sqlc.arg(project_id), sqlc.arg(salt_key), sqlc.arg(PASSWORD)),
+    version = $4
+WHERE
+    id = $1;
+
+-- name: GetEtcdDatabasesForProject :many
+SELECT
+    id,
+    project_id,
+    host,
+    port,
+    decrypt_data(project_id, sqlc.arg(salt_key), username) AS username,
+    decrypt_data(project_id, sqlc.arg(salt_key), PASSWORD) AS PASSWORD,
+    version,
+    created_at
+FROM
+    etcd_databases
+WHERE
+    project_id = $1;
+
+-- name: GetEtcdDatabase :one
+SELECT
+    id,
+    project_id,
+    host,
+    port,
+    decrypt_data(project_id, sqlc.arg(salt_key), username) AS username,
+    decrypt_data(project_id, sqlc.arg(salt_key), PASSWORD) AS PASSWORD,
+    version,
+    created_at,
+(
+        SELECT
+            COUNT(*)
+        FROM
+            etcd_scans
+        WHERE
+            etcd_scans.database_id = etcd_databases.id) AS scan_count
+FROM
+    etcd_databases
+WHERE
+    etcd_databases.id = $1;
+
+-- name: GetProjectInfoForEtcdScanByScanID :one
+SELECT
+    sqlc.embed(projects),
+    etcd_databases.id AS database_id,
+    etcd_databases.project_id AS database_project_id,
+    etcd_databases.host AS database_host,
+    etcd_databases.port AS database_port,
+    decrypt_data(etcd_databases.project_id, sqlc.arg(salt_key), etcd_databases.username) AS database_username,
+    decrypt_data(etcd_databases.project_id, sqlc.arg(salt_key), etcd_databases.PASSWORD) AS database_PASSWORD,
+    etcd_databases.version AS database_version,
+    etcd_databases.created_at AS database_created_at,
+    sqlc.embed(etcd_scans)
+FROM
+    projects
+    JOIN etcd_databases ON etcd_databases.project_id = projects.id
+    JOIN etcd_scans ON etcd_scans.database_id = etcd_databases.id
+WHERE
+    etcd_scans.scan_id = $1;
+
+-- name: CreateEtcdDatabase :one
+INSERT INTO etcd_databases(project_id, host, port, username, PASSWORD, version)
+    VALUES ($1, $2, $3, encrypt_data($1, sqlc.arg(salt_key), sqlc.arg(username)), encrypt_data($1, sqlc.arg(salt_key), sqlc.arg(PASSWORD)), $4)
+RETURNING
+    *;
+
+-- name: DeleteEtcdDatabase :exec
+DELETE FROM etcd_databases
+WHERE id = $1;
+
@@ -51,7 +51,7 @@ WHERE
 
 -- name: CreateGitResultForCommit :copyfrom
 INSERT INTO git_results(
-COMMIT, name, line, line_number, MATCH, probability, username, PASSWORD, filename)
+COMMIT, name, line, line_number, end_line_number, provider, confidence, expires_at, MATCH, probability, username, PASSWORD, filename)
     VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
 
 -- name: DeleteGitRepository :exec
@@ -90,15 +90,19 @@ func (q *Queries) CreateGitRepository(ctx context.Context, arg CreateGitReposito
 }
 
 type CreateGitResultForCommitParams struct {
-	Commit      int64          `json:"commit"`
-	Name        string         `json:"name"`
-	Line        string         `json:"line"`
-	LineNumber  int32          `json:"line_number"`
-	Match       string         `json:"match"`
-	Probability float64        `json:"probability"`
-	Username    sql.NullString `json:"username"`
-	Password    sql.NullString `json:"password"`
-	Filename    string         `json:"filename"`
+	Commit        int64              `json:"commit"`
+	Name          string             `json:"name"`
+	Line          string             `json:"line"`
+	LineNumber    int32              `json:"line_number"`
+	EndLineNumber int32              `json:"end_line_number"`
+	Provider      string             `json:"provider"`
+	Confidence    string             `json:"confidence"`
+	ExpiresAt     pgtype.Timestamptz `json:"expires_at"`
+	Match         string             `json:"match"`
+	Probability   float64            `json:"probability"`
+	Username      sql.NullString     `json:"username"`
+	Password      sql.NullString     `json:"password"`
+	Filename      string             `json:"filename"`
 }
 
 const createGitScan = `-- name: CreateGitScan :one
@@ -132,7 +136,7 @@ func (q *Queries) DeleteGitRepository(ctx context.Context, id int64) error {
 
 const getGitCommitsWithResults = `-- name: GetGitCommitsWithResults :many
 SELECT
-    commit_id, repository_id, commit_hash, author, author_email, commit_date, description, commit_created_at, id, commit, name, line, line_number, match, probability, username, password, filename, created_at
+    commit_id, repository_id, commit_hash, author, author_email, commit_date, description, commit_created_at, id, commit, name, line, line_number, end_line_number, provider, confidence, expires_at, match, probability, username, password, filename, created_at
 FROM ((
         SELECT
             git_commits.id AS commit_id,
@@ -143,7 +147,7 @@ FROM ((
             git_commits.commit_date,
             git_commits.description,
             git_commits.created_at AS commit_created_at,
-            git_results.id, git_results.commit, git_results.name, git_results.line, git_results.line_number, git_results.match, git_results.probability, git_results.username, git_results.password, git_results.filename, git_results.created_at
+            git_results.id, git_results.commit, git_results.name, git_results.line, git_results.line_number, git_results.end_line_number, git_results.provider, git_results.confidence, git_results.expires_at, git_results.match, git_results.probability, git_results.username, git_results.password, git_results.filename, git_results.created_at
         FROM
             git_commits
         LEFT JOIN git_results ON git_commits.id = git_results.commit
@@ -163,7 +167,7 @@ UNION (
         git_commits.commit_date,
         git_commits.description,
         git_commits.created_at AS commit_created_at,
-        git_results.id, git_results.commit, git_results.name, git_results.line, git_results.line_number, git_results.match, git_results.probability, git_results.username, git_results.password, git_results.filename, git_results.created_at
+        git_results.id, git_results.commit, git_results.name, git_results.line, git_results.line_number, git_results.end_line_number, git_results.provider, git_results.confidence, git_results.expires_at, git_results.match, git_results.probability, git_results.username, git_results.password, git_results.filename, git_results.created_at
     FROM
         git_commits
     LEFT JOIN git_results ON git_commits.id = git_results.commit
@@ -188,6 +192,10 @@ type GetGitCommitsWithResultsRow struct {
 	Name            sql.NullString     `json:"name"`
 	Line            sql.NullString     `json:"line"`
 	LineNumber      sql.NullInt32      `json:"line_number"`
+	EndLineNumber   sql.NullInt32      `json:"end_line_number"`
+	Provider        sql.NullString     `json:"provider"`
+	Confidence      sql.NullString     `json:"confidence"`
+	ExpiresAt       pgtype.Timestamptz `json:"expires_at"`
 	Match           sql.NullString     `json:"match"`
 	Probability     sql.NullFloat64    `json:"probability"`
 	Username        sql.NullString     `json:"username"`
@@ -219,6 +227,10 @@ func (q *Queries) GetGitCommitsWithResults(ctx context.Context, repositoryID int
 			&i.Name,
 			&i.Line,
 			&i.LineNumber,
+			&i.EndLineNumber,
+			&i.Provider,
+			&i.Confidence,
+			&i.ExpiresAt,
 			&i.Match,
 			&i.Probability,
 			&i.Username,
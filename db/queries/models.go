@@ -12,12 +12,13 @@ import (
 )
 
 type BruteforcedPassword struct {
-	ID               int64          `json:"id"`
-	Hash             string         `json:"hash"`
-	Username         string         `json:"username"`
-	Password         sql.NullString `json:"password"`
-	LastBruteforceID sql.NullInt64  `json:"last_bruteforce_id"`
-	ProjectID        sql.NullInt64  `json:"project_id"`
+	ID                        int64          `json:"id"`
+	Hash                      string         `json:"hash"`
+	Username                  string         `json:"username"`
+	Password                  sql.NullString `json:"password"`
+	LastBruteforceID          sql.NullInt64  `json:"last_bruteforce_id"`
+	LastBruteforceFingerprint string         `json:"last_bruteforce_fingerprint"`
+	ProjectID                 sql.NullInt64  `json:"project_id"`
 }
 
 type DefaultBruteforcePassword struct {
@@ -25,6 +26,16 @@ type DefaultBruteforcePassword struct {
 	Password string `json:"password"`
 }
 
+type DetectorSet struct {
+	ID             int64              `json:"id"`
+	OrganizationID int64              `json:"organization_id"`
+	Name           string             `json:"name"`
+	Format         string             `json:"format"`
+	Content        string             `json:"content"`
+	UseDefault     bool               `json:"use_default"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
 type DockerImage struct {
 	ID                            int64              `json:"id"`
 	ProjectID                     int64              `json:"project_id"`
@@ -36,6 +47,7 @@ type DockerImage struct {
 	ProbabilityIncreaseMultiplier sql.NullFloat64    `json:"probability_increase_multiplier"`
 	EntropyThreshold              sql.NullFloat64    `json:"entropy_threshold"`
 	LogisticGrowthRate            sql.NullFloat64    `json:"logistic_growth_rate"`
+	DetectorSetID                 sql.NullInt64      `json:"detector_set_id"`
 	CreatedAt                     pgtype.Timestamptz `json:"created_at"`
 }
 
@@ -52,6 +64,10 @@ type DockerResult struct {
 	Name          string             `json:"name"`
 	Line          string             `json:"line"`
 	LineNumber    int32              `json:"line_number"`
+	EndLineNumber int32              `json:"end_line_number"`
+	Provider      string             `json:"provider"`
+	Confidence    string             `json:"confidence"`
+	ExpiresAt     pgtype.Timestamptz `json:"expires_at"`
 	PreviousLines string             `json:"previous_lines"`
 	Match         string             `json:"match"`
 	Probability   float64            `json:"probability"`
@@ -67,6 +83,40 @@ type DockerScan struct {
 	ImageID int64 `json:"image_id"`
 }
 
+type ElasticsearchDatabase struct {
+	ID        int64              `json:"id"`
+	ProjectID int64              `json:"project_id"`
+	Host      string             `json:"host"`
+	Port      int32              `json:"port"`
+	Username  string             `json:"username"`
+	Password  string             `json:"password"`
+	Version   sql.NullString     `json:"version"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type ElasticsearchScan struct {
+	ID         int64 `json:"id"`
+	ScanID     int64 `json:"scan_id"`
+	DatabaseID int64 `json:"database_id"`
+}
+
+type EtcdDatabase struct {
+	ID        int64              `json:"id"`
+	ProjectID int64              `json:"project_id"`
+	Host      string             `json:"host"`
+	Port      int32              `json:"port"`
+	Username  string             `json:"username"`
+	Password  string             `json:"password"`
+	Version   sql.NullString     `json:"version"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type EtcdScan struct {
+	ID         int64 `json:"id"`
+	ScanID     int64 `json:"scan_id"`
+	DatabaseID int64 `json:"database_id"`
+}
+
 type GitCommit struct {
 	ID           int64              `json:"id"`
 	RepositoryID int64              `json:"repository_id"`
@@ -89,17 +139,21 @@ type GitRepository struct {
 }
 
 type GitResult struct {
-	ID          int64              `json:"id"`
-	Commit      int64              `json:"commit"`
-	Name        string             `json:"name"`
-	Line        string             `json:"line"`
-	LineNumber  int32              `json:"line_number"`
-	Match       string             `json:"match"`
-	Probability float64            `json:"probability"`
-	Username    sql.NullString     `json:"username"`
-	Password    sql.NullString     `json:"password"`
-	Filename    string             `json:"filename"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	ID            int64              `json:"id"`
+	Commit        int64              `json:"commit"`
+	Name          string             `json:"name"`
+	Line          string             `json:"line"`
+	LineNumber    int32              `json:"line_number"`
+	EndLineNumber int32              `json:"end_line_number"`
+	Provider      string             `json:"provider"`
+	Confidence    string             `json:"confidence"`
+	ExpiresAt     pgtype.Timestamptz `json:"expires_at"`
+	Match         string             `json:"match"`
+	Probability   float64            `json:"probability"`
+	Username      sql.NullString     `json:"username"`
+	Password      sql.NullString     `json:"password"`
+	Filename      string             `json:"filename"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 }
 
 type GitScan struct {
@@ -126,6 +180,24 @@ type MongoScan struct {
 	DatabaseID int64 `json:"database_id"`
 }
 
+type MssqlDatabase struct {
+	ID           int64              `json:"id"`
+	ProjectID    int64              `json:"project_id"`
+	Host         string             `json:"host"`
+	Port         int32              `json:"port"`
+	DatabaseName string             `json:"database_name"`
+	Username     string             `json:"username"`
+	Password     string             `json:"password"`
+	Version      sql.NullString     `json:"version"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+}
+
+type MssqlScan struct {
+	ID         int64 `json:"id"`
+	ScanID     int64 `json:"scan_id"`
+	DatabaseID int64 `json:"database_id"`
+}
+
 type MysqlDatabase struct {
 	ID           int64              `json:"id"`
 	ProjectID    int64              `json:"project_id"`
@@ -151,6 +223,7 @@ type NvdCfe struct {
 	Published    pgtype.Timestamptz `json:"published"`
 	LastModified pgtype.Timestamptz `json:"last_modified"`
 	Score        float64            `json:"score"`
+	Vector       string             `json:"vector"`
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
 }
 
@@ -169,6 +242,18 @@ type NvdCveCpe struct {
 	CpeID int64 `json:"cpe_id"`
 }
 
+type NvdCveRange struct {
+	ID                    int64          `json:"id"`
+	CveID                 int64          `json:"cve_id"`
+	DatabaseType          int32          `json:"database_type"`
+	Criteria              string         `json:"criteria"`
+	VersionExact          sql.NullString `json:"version_exact"`
+	VersionStartIncluding sql.NullString `json:"version_start_including"`
+	VersionStartExcluding sql.NullString `json:"version_start_excluding"`
+	VersionEndIncluding   sql.NullString `json:"version_end_including"`
+	VersionEndExcluding   sql.NullString `json:"version_end_excluding"`
+}
+
 type Organization struct {
 	ID            int64              `json:"id"`
 	Name          string             `json:"name"`
@@ -210,6 +295,27 @@ type Project struct {
 	CreatedAt      pgtype.Timestamptz `json:"created_at"`
 }
 
+type ProjectDetectorSet struct {
+	ProjectID     int64              `json:"project_id"`
+	DetectorSetID int64              `json:"detector_set_id"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+}
+
+type ProjectIgnoredCfe struct {
+	ID        int64              `json:"id"`
+	ProjectID int64              `json:"project_id"`
+	CveID     string             `json:"cve_id"`
+	Reason    string             `json:"reason"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type ProjectPasswordRule struct {
+	ProjectID  int64              `json:"project_id"`
+	UseDefault bool               `json:"use_default"`
+	Rules      string             `json:"rules"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
 type RedisDatabase struct {
 	ID        int64              `json:"id"`
 	ProjectID int64              `json:"project_id"`
@@ -241,6 +347,15 @@ type ResetPasswordToken struct {
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 }
 
+type RulePack struct {
+	ID             int64              `json:"id"`
+	OrganizationID int64              `json:"organization_id"`
+	Name           string             `json:"name"`
+	Content        string             `json:"content"`
+	Enabled        bool               `json:"enabled"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
 type Scan struct {
 	ID          int64              `json:"id"`
 	ScanGroupID int64              `json:"scan_group_id"`
@@ -263,6 +378,34 @@ type ScanBruteforceResult struct {
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 }
 
+type ScanBruteforceShard struct {
+	ID        int64              `json:"id"`
+	ScanID    int64              `json:"scan_id"`
+	ScanType  int32              `json:"scan_type"`
+	Username  string             `json:"username"`
+	Hash      string             `json:"hash"`
+	StartID   int64              `json:"start_id"`
+	EndID     sql.NullInt64      `json:"end_id"`
+	NextID    int64              `json:"next_id"`
+	Tried     int64              `json:"tried"`
+	Password  sql.NullString     `json:"password"`
+	Status    int32              `json:"status"`
+	WorkerID  sql.NullInt64      `json:"worker_id"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type ScanCveResult struct {
+	ID           int64              `json:"id"`
+	ScanResultID int64              `json:"scan_result_id"`
+	CveID        string             `json:"cve_id"`
+	Score        float64            `json:"score"`
+	Vector       string             `json:"vector"`
+	Description  string             `json:"description"`
+	Published    pgtype.Timestamptz `json:"published"`
+	FixedIn      string             `json:"fixed_in"`
+}
+
 type ScanGroup struct {
 	ID        int64              `json:"id"`
 	ProjectID int64              `json:"project_id"`
@@ -279,6 +422,19 @@ type ScanResult struct {
 	CreatedAt  pgtype.Timestamptz `json:"created_at"`
 }
 
+type ScanResultFinding struct {
+	ID            int64    `json:"id"`
+	ScanResultID  int64    `json:"scan_result_id"`
+	RuleID        string   `json:"rule_id"`
+	Title         string   `json:"title"`
+	Description   string   `json:"description"`
+	Remediation   string   `json:"remediation"`
+	ReferenceUrls []string `json:"reference_urls"`
+	ObjectType    string   `json:"object_type"`
+	ObjectName    string   `json:"object_name"`
+	Evidence      string   `json:"evidence"`
+}
+
 type TotpSecretToken struct {
 	ID         int64              `json:"id"`
 	UserID     int64              `json:"user_id"`
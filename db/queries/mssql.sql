@@ -0,0 +1,114 @@
+-- name: CreateMssqlScan :one
+INSERT INTO mssql_scans(scan_id, database_id)
+    VALUES ($1, $2)
+RETURNING
+    *;
+
+-- name: GetMssqlScan :one
+SELECT
+    *
+FROM
+    mssql_scans
+WHERE
+    id = $1
+LIMIT 1;
+
+-- name: GetMssqlScanByScanID :one
+SELECT
+    *
+FROM
+    mssql_scans
+WHERE
+    scan_id = $1
+LIMIT 1;
+
+-- name: UpdateMssqlVersion :exec
+UPDATE
+    mssql_databases
+SET
+    version = $2
+WHERE
+    id = $1;
+
+-- name: UpdateMssqlDatabase :exec
+UPDATE
+    mssql_databases
+SET
+    database_name = $2,
+    host = $3,
+    port = $4,
+    username = encrypt_data(sqlc.arg(project_id), sqlc.arg(salt_key), sqlc.arg(username)),
+    PASSWORD = encrypt_data(sqlc.arg(project_id), sqlc.arg(salt_key), sqlc.arg(PASSWORD)),
+    version = $5
+WHERE
+    id = $1;
+
+-- name: GetMssqlDatabasesForProject :many
+SELECT
+    id,
+    project_id,
+    host,
+    port,
+    database_name,
+    decrypt_data(project_id, sqlc.arg(salt_key), username) AS username,
+    decrypt_data(project_id, sqlc.arg(salt_key), PASSWORD) AS PASSWORD,
+    version,
+    created_at
+FROM
+    mssql_databases
+WHERE
+    project_id = $1;
+
+-- name: GetMssqlDatabase :one
+SELECT
+    id,
+    project_id,
+    host,
+    port,
+    database_name,
+    decrypt_data(project_id, sqlc.arg(salt_key), username) AS username,
+    decrypt_data(project_id, sqlc.arg(salt_key), PASSWORD) AS PASSWORD,
+    version,
+    created_at,
+(
+        SELECT
+            COUNT(*)
+        FROM
+            Mssql_scans
+        WHERE
+            Mssql_scans.database_id = mssql_databases.id) AS scan_count
+FROM
+    mssql_databases
+WHERE
+    mssql_databases.id = $1;
+
+-- name: GetProjectInfoForMssqlScanByScanID :one
+SELECT
+    sqlc.embed(projects),
+    mssql_databases.id AS database_id,
+    mssql_databases.project_id AS database_project_id,
+    mssql_databases.host AS database_host,
+    mssql_databases.port AS database_port,
+    mssql_databases.database_name AS database_database_name,
+    decrypt_data(project_id, sqlc.arg(salt_key), mssql_databases.username) AS database_username,
+    decrypt_data(project_id, sqlc.arg(salt_key), mssql_databases.PASSWORD) AS database_PASSWORD,
+    mssql_databases.version AS database_version,
+    mssql_databases.created_at AS database_created_at,
+    sqlc.embed(mssql_scans)
+FROM
+    projects
+    JOIN mssql_databases ON mssql_databases.project_id = projects.id
+    JOIN mssql_scans ON mssql_scans.database_id = mssql_databases.id
+WHERE
+    mssql_scans.scan_id = $1;
+
+-- name: CreateMssqlDatabase :one
+INSERT INTO mssql_databases(project_id, database_name, host, port, username, PASSWORD, version)
+    VALUES ($1, $2, $3, $4, encrypt_data($1, sqlc.arg(salt_key), sqlc.arg(username)), encrypt_data($1, sqlc.arg(salt_key), sqlc.arg(PASSWORD)), $5)
+RETURNING
+    *;
+
+-- name: DeleteMssqlDatabase :exec
+DELETE FROM mssql_databases
+WHERE id = $1;
+
@@ -22,8 +22,8 @@ WHERE
     id = $1;
 
 -- name: CreateNvdCve :one
-INSERT INTO nvd_cves(cve_id, description, published, last_modified, score)
-    VALUES ($1, $2, $3, $4, $5)
+INSERT INTO nvd_cves(cve_id, description, published, last_modified, score, vector)
+    VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING
     *;
 
@@ -70,14 +70,52 @@ WHERE
 
 -- name: GetCvesByProductAndVersion :many
 SELECT
-    sqlc.embed(nvd_cves)
+    sqlc.embed(nvd_cves),
+    COALESCE((
+        SELECT
+            nvd_cve_ranges.version_end_excluding
+        FROM nvd_cve_ranges
+        WHERE
+            nvd_cve_ranges.cve_id = nvd_cves.id
+            AND nvd_cve_ranges.database_type = sqlc.arg(database_type)
+            AND nvd_cve_ranges.version_end_excluding IS NOT NULL
+            AND nvd_range_contains(nvd_cve_ranges, sqlc.arg(version))
+        ORDER BY
+            nvd_version_key(nvd_cve_ranges.version_end_excluding)
+        LIMIT 1), '')::text AS fixed_in
 FROM
     nvd_cves
-    INNER JOIN nvd_cve_cpes ON nvd_cve_cpes.cve_id = nvd_cves.id
-    INNER JOIN nvd_cpes ON nvd_cve_cpes.cpe_id = nvd_cpes.id
 WHERE
-    nvd_cpes.database_type = $1
-    AND nvd_cpes.version = $2;
+    EXISTS (
+        SELECT
+            1
+        FROM
+            nvd_cve_cpes
+            INNER JOIN nvd_cpes ON nvd_cve_cpes.cpe_id = nvd_cpes.id
+        WHERE
+            nvd_cve_cpes.cve_id = nvd_cves.id
+            AND nvd_cpes.database_type = sqlc.arg(database_type)
+            AND nvd_version_key(nvd_cpes.version) = nvd_version_key(sqlc.arg(version)))
+    OR EXISTS (
+        SELECT
+            1
+        FROM
+            nvd_cve_ranges
+        WHERE
+            nvd_cve_ranges.cve_id = nvd_cves.id
+            AND nvd_cve_ranges.database_type = sqlc.arg(database_type)
+            AND nvd_range_contains(nvd_cve_ranges, sqlc.arg(version)));
+
+-- name: CreateNvdCveRange :one
+INSERT INTO nvd_cve_ranges(cve_id, database_type, criteria, version_exact, version_start_including, version_start_excluding, version_end_including, version_end_excluding)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING
+    *;
+
+-- name: DeleteNvdCveRangesForCve :exec
+DELETE FROM nvd_cve_ranges
+WHERE cve_id = $1
+    AND database_type = $2;
 
 -- name: DeleteNvdCveByName :exec
 DELETE FROM nvd_cves
@@ -46,10 +46,10 @@ func (q *Queries) CreateNvdCPE(ctx context.Context, arg CreateNvdCPEParams) (*Nv
 }
 
 const createNvdCve = `-- name: CreateNvdCve :one
-INSERT INTO nvd_cves(cve_id, description, published, last_modified, score)
-    VALUES ($1, $2, $3, $4, $5)
+INSERT INTO nvd_cves(cve_id, description, published, last_modified, score, vector)
+    VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING
-    id, cve_id, description, published, last_modified, score, created_at
+    id, cve_id, description, published, last_modified, score, vector, created_at
 `
 
 type CreateNvdCveParams struct {
@@ -58,6 +58,7 @@ type CreateNvdCveParams struct {
 	Published    pgtype.Timestamptz `json:"published"`
 	LastModified pgtype.Timestamptz `json:"last_modified"`
 	Score        float64            `json:"score"`
+	Vector       string             `json:"vector"`
 }
 
 func (q *Queries) CreateNvdCve(ctx context.Context, arg CreateNvdCveParams) (*NvdCfe, error) {
@@ -67,6 +68,7 @@ func (q *Queries) CreateNvdCve(ctx context.Context, arg CreateNvdCveParams) (*Nv
 		arg.Published,
 		arg.LastModified,
 		arg.Score,
+		arg.Vector,
 	)
 	var i NvdCfe
 	err := row.Scan(
@@ -76,6 +78,7 @@ func (q *Queries) CreateNvdCve(ctx context.Context, arg CreateNvdCveParams) (*Nv
 		&i.Published,
 		&i.LastModified,
 		&i.Score,
+		&i.Vector,
 		&i.CreatedAt,
 	)
 	return &i, err
@@ -100,6 +103,50 @@ func (q *Queries) CreateNvdCveCPE(ctx context.Context, arg CreateNvdCveCPEParams
 	return &i, err
 }
 
+const createNvdCveRange = `-- name: CreateNvdCveRange :one
+INSERT INTO nvd_cve_ranges(cve_id, database_type, criteria, version_exact, version_start_including, version_start_excluding, version_end_including, version_end_excluding)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING
+    id, cve_id, database_type, criteria, version_exact, version_start_including, version_start_excluding, version_end_including, version_end_excluding
+`
+
+type CreateNvdCveRangeParams struct {
+	CveID                 int64          `json:"cve_id"`
+	DatabaseType          int32          `json:"database_type"`
+	Criteria              string         `json:"criteria"`
+	VersionExact          sql.NullString `json:"version_exact"`
+	VersionStartIncluding sql.NullString `json:"version_start_including"`
+	VersionStartExcluding sql.NullString `json:"version_start_excluding"`
+	VersionEndIncluding   sql.NullString `json:"version_end_including"`
+	VersionEndExcluding   sql.NullString `json:"version_end_excluding"`
+}
+
+func (q *Queries) CreateNvdCveRange(ctx context.Context, arg CreateNvdCveRangeParams) (*NvdCveRange, error) {
+	row := q.db.QueryRow(ctx, createNvdCveRange,
+		arg.CveID,
+		arg.DatabaseType,
+		arg.Criteria,
+		arg.VersionExact,
+		arg.VersionStartIncluding,
+		arg.VersionStartExcluding,
+		arg.VersionEndIncluding,
+		arg.VersionEndExcluding,
+	)
+	var i NvdCveRange
+	err := row.Scan(
+		&i.ID,
+		&i.CveID,
+		&i.DatabaseType,
+		&i.Criteria,
+		&i.VersionExact,
+		&i.VersionStartIncluding,
+		&i.VersionStartExcluding,
+		&i.VersionEndIncluding,
+		&i.VersionEndExcluding,
+	)
+	return &i, err
+}
+
 const deleteNvdCveByName = `-- name: DeleteNvdCveByName :exec
 DELETE FROM nvd_cves
 WHERE cve_id = $1
@@ -110,6 +157,22 @@ func (q *Queries) DeleteNvdCveByName(ctx context.Context, cveID string) error {
 	return err
 }
 
+const deleteNvdCveRangesForCve = `-- name: DeleteNvdCveRangesForCve :exec
+DELETE FROM nvd_cve_ranges
+WHERE cve_id = $1
+    AND database_type = $2
+`
+
+type DeleteNvdCveRangesForCveParams struct {
+	CveID        int64 `json:"cve_id"`
+	DatabaseType int32 `json:"database_type"`
+}
+
+func (q *Queries) DeleteNvdCveRangesForCve(ctx context.Context, arg DeleteNvdCveRangesForCveParams) error {
+	_, err := q.db.Exec(ctx, deleteNvdCveRangesForCve, arg.CveID, arg.DatabaseType)
+	return err
+}
+
 const getCPEByProductAndVersion = `-- name: GetCPEByProductAndVersion :one
 SELECT
     id, cpe, database_type, version, last_modified, created_at
@@ -142,7 +205,7 @@ func (q *Queries) GetCPEByProductAndVersion(ctx context.Context, arg GetCPEByPro
 
 const getCveByCveID = `-- name: GetCveByCveID :one
 SELECT
-    id, cve_id, description, published, last_modified, score, created_at
+    id, cve_id, description, published, last_modified, score, vector, created_at
 FROM
     nvd_cves
 WHERE
@@ -159,6 +222,7 @@ func (q *Queries) GetCveByCveID(ctx context.Context, cveID string) (*NvdCfe, err
 		&i.Published,
 		&i.LastModified,
 		&i.Score,
+		&i.Vector,
 		&i.CreatedAt,
 	)
 	return &i, err
@@ -188,14 +252,41 @@ func (q *Queries) GetCveCpeByCveAndCpe(ctx context.Context, arg GetCveCpeByCveAn
 
 const getCvesByProductAndVersion = `-- name: GetCvesByProductAndVersion :many
 SELECT
-    nvd_cves.id, nvd_cves.cve_id, nvd_cves.description, nvd_cves.published, nvd_cves.last_modified, nvd_cves.score, nvd_cves.created_at
+    nvd_cves.id, nvd_cves.cve_id, nvd_cves.description, nvd_cves.published, nvd_cves.last_modified, nvd_cves.score, nvd_cves.vector, nvd_cves.created_at,
+    COALESCE((
+        SELECT
+            nvd_cve_ranges.version_end_excluding
+        FROM nvd_cve_ranges
+        WHERE
+            nvd_cve_ranges.cve_id = nvd_cves.id
+            AND nvd_cve_ranges.database_type = $1
+            AND nvd_cve_ranges.version_end_excluding IS NOT NULL
+            AND nvd_range_contains(nvd_cve_ranges, $2)
+        ORDER BY
+            nvd_version_key(nvd_cve_ranges.version_end_excluding)
+        LIMIT 1), '')::text AS fixed_in
 FROM
     nvd_cves
-    INNER JOIN nvd_cve_cpes ON nvd_cve_cpes.cve_id = nvd_cves.id
-    INNER JOIN nvd_cpes ON nvd_cve_cpes.cpe_id = nvd_cpes.id
 WHERE
-    nvd_cpes.database_type = $1
-    AND nvd_cpes.version = $2
+    EXISTS (
+        SELECT
+            1
+        FROM
+            nvd_cve_cpes
+            INNER JOIN nvd_cpes ON nvd_cve_cpes.cpe_id = nvd_cpes.id
+        WHERE
+            nvd_cve_cpes.cve_id = nvd_cves.id
+            AND nvd_cpes.database_type = $1
+            AND nvd_version_key(nvd_cpes.version) = nvd_version_key($2))
+    OR EXISTS (
+        SELECT
+            1
+        FROM
+            nvd_cve_ranges
+        WHERE
+            nvd_cve_ranges.cve_id = nvd_cves.id
+            AND nvd_cve_ranges.database_type = $1
+            AND nvd_range_contains(nvd_cve_ranges, $2))
 `
 
 type GetCvesByProductAndVersionParams struct {
@@ -204,7 +295,8 @@ type GetCvesByProductAndVersionParams struct {
 }
 
 type GetCvesByProductAndVersionRow struct {
-	NvdCfe NvdCfe `json:"nvd_cfe"`
+	NvdCfe  NvdCfe `json:"nvd_cfe"`
+	FixedIn string `json:"fixed_in"`
 }
 
 func (q *Queries) GetCvesByProductAndVersion(ctx context.Context, arg GetCvesByProductAndVersionParams) ([]*GetCvesByProductAndVersionRow, error) {
@@ -223,7 +315,9 @@ func (q *Queries) GetCvesByProductAndVersion(ctx context.Context, arg GetCvesByP
 			&i.NvdCfe.Published,
 			&i.NvdCfe.LastModified,
 			&i.NvdCfe.Score,
+			&i.NvdCfe.Vector,
 			&i.NvdCfe.CreatedAt,
+			&i.FixedIn,
 		); err != nil {
 			return nil, err
 		}
@@ -273,7 +367,7 @@ func (q *Queries) GetNvdCPEsByDBType(ctx context.Context, databaseType int32) ([
 
 const getNvdCveByCveID = `-- name: GetNvdCveByCveID :one
 SELECT
-    id, cve_id, description, published, last_modified, score, created_at
+    id, cve_id, description, published, last_modified, score, vector, created_at
 FROM
     nvd_cves
 WHERE
@@ -290,6 +384,7 @@ func (q *Queries) GetNvdCveByCveID(ctx context.Context, cveID string) (*NvdCfe,
 		&i.Published,
 		&i.LastModified,
 		&i.Score,
+		&i.Vector,
 		&i.CreatedAt,
 	)
 	return &i, err
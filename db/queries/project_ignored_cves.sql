@@ -0,0 +1,27 @@
+-- name: CreateProjectIgnoredCve :one
+INSERT INTO project_ignored_cves(project_id, cve_id, reason)
+    VALUES ($1, $2, $3)
+RETURNING
+    *;
+
+-- name: GetProjectIgnoredCve :one
+SELECT
+    *
+FROM
+    project_ignored_cves
+WHERE
+    id = $1;
+
+-- name: GetProjectIgnoredCves :many
+SELECT
+    *
+FROM
+    project_ignored_cves
+WHERE
+    project_id = $1
+ORDER BY
+    cve_id;
+
+-- name: DeleteProjectIgnoredCve :exec
+DELETE FROM project_ignored_cves
+WHERE id = $1;
@@ -0,0 +1,134 @@
+-- name: GetProjectPasswordCandidates :many
+SELECT
+    candidates.candidate::text AS candidate,
+    candidates.source::text AS source,
+    candidates.reference::text AS reference,
+    candidates.location::text AS location,
+    candidates.priority::integer AS priority
+FROM (
+    SELECT
+        git_results.PASSWORD AS candidate,
+        'git' AS source,
+        git_commits.commit_hash AS reference,
+        git_results.filename AS location,
+        1 AS priority
+    FROM
+        git_results
+        INNER JOIN git_commits ON git_results.commit = git_commits.id
+        INNER JOIN git_repositories ON git_commits.repository_id = git_repositories.id
+    WHERE
+        git_repositories.project_id = $1
+        AND git_results.PASSWORD IS NOT NULL
+    UNION ALL
+    SELECT
+        docker_results.PASSWORD,
+        'docker',
+        docker_layers.layer_hash,
+        docker_results.filename,
+        1
+    FROM
+        docker_results
+        INNER JOIN docker_layers ON docker_results.layer_id = docker_layers.id
+        INNER JOIN docker_images ON docker_layers.image_id = docker_images.id
+    WHERE
+        docker_images.project_id = $1
+        AND docker_results.PASSWORD IS NOT NULL
+    UNION ALL
+    SELECT
+        git_results.username,
+        'git-username',
+        git_commits.commit_hash,
+        git_results.filename,
+        2
+    FROM
+        git_results
+        INNER JOIN git_commits ON git_results.commit = git_commits.id
+        INNER JOIN git_repositories ON git_commits.repository_id = git_repositories.id
+    WHERE
+        git_repositories.project_id = $1
+        AND git_results.username IS NOT NULL
+    UNION ALL
+    SELECT
+        docker_results.username,
+        'docker-username',
+        docker_layers.layer_hash,
+        docker_results.filename,
+        2
+    FROM
+        docker_results
+        INNER JOIN docker_layers ON docker_results.layer_id = docker_layers.id
+        INNER JOIN docker_images ON docker_layers.image_id = docker_images.id
+    WHERE
+        docker_images.project_id = $1
+        AND docker_results.username IS NOT NULL
+    UNION ALL
+    SELECT
+        database_name,
+        'database',
+        host,
+        'postgres',
+        3
+    FROM
+        postgres_databases
+    WHERE
+        project_id = $1
+    UNION ALL
+    SELECT
+        database_name,
+        'database',
+        host,
+        'mysql',
+        3
+    FROM
+        mysql_databases
+    WHERE
+        project_id = $1
+    UNION ALL
+    SELECT
+        database_name,
+        'database',
+        host,
+        'mongodb',
+        3
+    FROM
+        mongo_databases
+    WHERE
+        project_id = $1
+    UNION ALL
+    SELECT
+        database_name,
+        'database',
+        host,
+        'mssql',
+        3
+    FROM
+        mssql_databases
+    WHERE
+        project_id = $1
+    UNION ALL
+    SELECT
+        projects.name,
+        'project',
+        projects.name,
+        '',
+        4
+    FROM
+        projects
+    WHERE
+        projects.id = $1
+    UNION ALL
+    SELECT
+        organizations.name,
+        'organization',
+        organizations.name,
+        '',
+        4
+    FROM
+        projects
+        INNER JOIN organizations ON projects.organization_id = organizations.id
+    WHERE
+        projects.id = $1) AS candidates
+WHERE
+    candidates.candidate <> ''
+ORDER BY
+    candidates.priority;
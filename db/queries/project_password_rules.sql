@@ -0,0 +1,20 @@
+-- name: GetProjectPasswordRules :one
+SELECT
+    *
+FROM
+    project_password_rules
+WHERE
+    project_id = $1;
+
+-- name: SetProjectPasswordRules :one
+INSERT INTO project_password_rules(project_id, use_default, rules)
+    VALUES ($1, $2, $3)
+ON CONFLICT (project_id)
+    DO UPDATE SET
+        use_default = EXCLUDED.use_default, rules = EXCLUDED.rules, updated_at = CURRENT_TIMESTAMP
+    RETURNING
+        *;
+
+-- name: DeleteProjectPasswordRules :exec
+DELETE FROM project_password_rules
+WHERE project_id = $1;
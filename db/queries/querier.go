@@ -15,52 +15,78 @@ type Querier interface {
 	AddOrganizationUser(ctx context.Context, arg AddOrganizationUserParams) (*OrganizationMember, error)
 	AddUserToOrganization(ctx context.Context, arg AddUserToOrganizationParams) error
 	BindScanToWorker(ctx context.Context, arg BindScanToWorkerParams) (*Scan, error)
+	CancelScanBruteforceShards(ctx context.Context, arg CancelScanBruteforceShardsParams) error
+	ClaimScanBruteforceShard(ctx context.Context, arg ClaimScanBruteforceShardParams) (*ScanBruteforceShard, error)
 	CountUsers(ctx context.Context) (int64, error)
 	CreateBruteforcedPassword(ctx context.Context, arg CreateBruteforcedPasswordParams) (*BruteforcedPassword, error)
+	CreateDetectorSet(ctx context.Context, arg CreateDetectorSetParams) (*DetectorSet, error)
 	CreateDockerImage(ctx context.Context, arg CreateDockerImageParams) (*DockerImage, error)
 	CreateDockerLayerResultsForProject(ctx context.Context, arg []CreateDockerLayerResultsForProjectParams) (int64, error)
 	CreateDockerScan(ctx context.Context, arg CreateDockerScanParams) (*DockerScan, error)
 	CreateDockerScannedLayerForProject(ctx context.Context, arg CreateDockerScannedLayerForProjectParams) (*DockerLayer, error)
+	CreateElasticsearchDatabase(ctx context.Context, arg CreateElasticsearchDatabaseParams) (*ElasticsearchDatabase, error)
+	CreateElasticsearchScan(ctx context.Context, arg CreateElasticsearchScanParams) (*ElasticsearchScan, error)
+	CreateEtcdDatabase(ctx context.Context, arg CreateEtcdDatabaseParams) (*EtcdDatabase, error)
+	CreateEtcdScan(ctx context.Context, arg CreateEtcdScanParams) (*EtcdScan, error)
 	CreateGitCommitForProject(ctx context.Context, arg CreateGitCommitForProjectParams) (*GitCommit, error)
 	CreateGitRepository(ctx context.Context, arg CreateGitRepositoryParams) (*GitRepository, error)
 	CreateGitResultForCommit(ctx context.Context, arg []CreateGitResultForCommitParams) (int64, error)
 	CreateGitScan(ctx context.Context, arg CreateGitScanParams) (*GitScan, error)
 	CreateMongoDatabase(ctx context.Context, arg CreateMongoDatabaseParams) (*MongoDatabase, error)
 	CreateMongoScan(ctx context.Context, arg CreateMongoScanParams) (*MongoScan, error)
+	CreateMssqlDatabase(ctx context.Context, arg CreateMssqlDatabaseParams) (*MssqlDatabase, error)
+	CreateMssqlScan(ctx context.Context, arg CreateMssqlScanParams) (*MssqlScan, error)
 	CreateMysqlDatabase(ctx context.Context, arg CreateMysqlDatabaseParams) (*MysqlDatabase, error)
 	CreateMysqlScan(ctx context.Context, arg CreateMysqlScanParams) (*MysqlScan, error)
 	CreateNvdCPE(ctx context.Context, arg CreateNvdCPEParams) (*NvdCpe, error)
 	CreateNvdCve(ctx context.Context, arg CreateNvdCveParams) (*NvdCfe, error)
 	CreateNvdCveCPE(ctx context.Context, arg CreateNvdCveCPEParams) (*NvdCveCpe, error)
+	CreateNvdCveRange(ctx context.Context, arg CreateNvdCveRangeParams) (*NvdCveRange, error)
 	CreateOrganization(ctx context.Context, name string) (*Organization, error)
 	CreatePostgresDatabase(ctx context.Context, arg CreatePostgresDatabaseParams) (*PostgresDatabase, error)
 	CreatePostgresScan(ctx context.Context, arg CreatePostgresScanParams) (*PostgresScan, error)
 	CreateProject(ctx context.Context, arg CreateProjectParams) (*Project, error)
+	CreateProjectIgnoredCve(ctx context.Context, arg CreateProjectIgnoredCveParams) (*ProjectIgnoredCfe, error)
 	CreateRedisDatabase(ctx context.Context, arg CreateRedisDatabaseParams) (*RedisDatabase, error)
 	CreateRedisScan(ctx context.Context, arg CreateRedisScanParams) (*RedisScan, error)
 	CreateRememberMeToken(ctx context.Context, arg CreateRememberMeTokenParams) (*RememberMeToken, error)
 	CreateResetPasswordToken(ctx context.Context, arg CreateResetPasswordTokenParams) (*ResetPasswordToken, error)
+	CreateRulePack(ctx context.Context, arg CreateRulePackParams) (*RulePack, error)
 	CreateScan(ctx context.Context, arg CreateScanParams) (*Scan, error)
 	CreateScanBruteforceResult(ctx context.Context, arg CreateScanBruteforceResultParams) (*ScanBruteforceResult, error)
+	CreateScanBruteforceShards(ctx context.Context, arg CreateScanBruteforceShardsParams) ([]*ScanBruteforceShard, error)
+	CreateScanCveResult(ctx context.Context, arg CreateScanCveResultParams) (*ScanCveResult, error)
+	CreateScanFindingResult(ctx context.Context, arg CreateScanFindingResultParams) (*ScanResultFinding, error)
 	CreateScanGroup(ctx context.Context, arg CreateScanGroupParams) (*ScanGroup, error)
 	CreateScanResult(ctx context.Context, arg CreateScanResultParams) (*ScanResult, error)
 	CreateTOTPSecretForUser(ctx context.Context, arg CreateTOTPSecretForUserParams) (*TotpSecretToken, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (*User, error)
 	CreateWebauthnCredential(ctx context.Context, arg CreateWebauthnCredentialParams) (*WebauthnCredential, error)
 	CreateWorker(ctx context.Context, arg CreateWorkerParams) (*Worker, error)
+	DeleteDetectorSet(ctx context.Context, id int64) error
 	DeleteDockerImage(ctx context.Context, id int64) error
+	DeleteElasticsearchDatabase(ctx context.Context, id int64) error
+	DeleteEtcdDatabase(ctx context.Context, id int64) error
 	DeleteGitRepository(ctx context.Context, id int64) error
 	DeleteMongoDatabase(ctx context.Context, id int64) error
+	DeleteMssqlDatabase(ctx context.Context, id int64) error
 	DeleteMysqlDatabase(ctx context.Context, id int64) error
 	DeleteNvdCveByName(ctx context.Context, cveID string) error
+	DeleteNvdCveRangesForCve(ctx context.Context, arg DeleteNvdCveRangesForCveParams) error
 	DeleteOrganization(ctx context.Context, id int64) error
 	DeletePostgresDatabase(ctx context.Context, id int64) error
 	DeleteProject(ctx context.Context, id int64) (*Project, error)
+	DeleteProjectDetectorSet(ctx context.Context, projectID int64) error
+	DeleteProjectIgnoredCve(ctx context.Context, id int64) error
+	DeleteProjectPasswordRules(ctx context.Context, projectID int64) error
 	DeleteRedisDatabase(ctx context.Context, id int64) error
 	DeleteRememberMeTokenByUserAndToken(ctx context.Context, arg DeleteRememberMeTokenByUserAndTokenParams) error
 	DeleteRememberMeTokensForUser(ctx context.Context, userID int64) error
+	DeleteRulePack(ctx context.Context, id int64) error
 	DeleteUser(ctx context.Context, id int64) error
 	DeleteWorker(ctx context.Context, id int64) (*Worker, error)
+	FailScanBruteforceShard(ctx context.Context, arg FailScanBruteforceShardParams) (*ScanBruteforceShard, error)
+	FinishScanBruteforceShard(ctx context.Context, arg FinishScanBruteforceShardParams) (*ScanBruteforceShard, error)
 	GetAllOrganizationMembersForOrganizationsThatContainUser(ctx context.Context, userID int64) ([]*GetAllOrganizationMembersForOrganizationsThatContainUserRow, error)
 	GetAllOrganizationProjectsForUser(ctx context.Context, userID int64) ([]*GetAllOrganizationProjectsForUserRow, error)
 	GetBruteforcePasswordsForProjectCount(ctx context.Context, projectID int64) (int64, error)
@@ -72,11 +98,22 @@ type Querier interface {
 	GetCveByCveID(ctx context.Context, cveID string) (*NvdCfe, error)
 	GetCveCpeByCveAndCpe(ctx context.Context, arg GetCveCpeByCveAndCpeParams) (*NvdCveCpe, error)
 	GetCvesByProductAndVersion(ctx context.Context, arg GetCvesByProductAndVersionParams) ([]*GetCvesByProductAndVersionRow, error)
+	GetDetectorSet(ctx context.Context, id int64) (*DetectorSet, error)
+	GetDetectorSetForProject(ctx context.Context, projectID int64) (*DetectorSet, error)
+	GetDetectorSetsForOrganization(ctx context.Context, organizationID int64) ([]*DetectorSet, error)
 	GetDockerImage(ctx context.Context, arg GetDockerImageParams) (*GetDockerImageRow, error)
 	GetDockerImagesForProject(ctx context.Context, arg GetDockerImagesForProjectParams) ([]*GetDockerImagesForProjectRow, error)
 	GetDockerLayersAndResultsForImage(ctx context.Context, imageID int64) ([]*GetDockerLayersAndResultsForImageRow, error)
 	GetDockerScanByScanAndRepo(ctx context.Context, arg GetDockerScanByScanAndRepoParams) (*GetDockerScanByScanAndRepoRow, error)
 	GetDockerScannedLayersForImage(ctx context.Context, imageID int64) ([]string, error)
+	GetElasticsearchDatabase(ctx context.Context, arg GetElasticsearchDatabaseParams) (*GetElasticsearchDatabaseRow, error)
+	GetElasticsearchDatabasesForProject(ctx context.Context, arg GetElasticsearchDatabasesForProjectParams) ([]*GetElasticsearchDatabasesForProjectRow, error)
+	GetElasticsearchScan(ctx context.Context, id int64) (*ElasticsearchScan, error)
+	GetElasticsearchScanByScanID(ctx context.Context, scanID int64) (*ElasticsearchScan, error)
+	GetEtcdDatabase(ctx context.Context, arg GetEtcdDatabaseParams) (*GetEtcdDatabaseRow, error)
+	GetEtcdDatabasesForProject(ctx context.Context, arg GetEtcdDatabasesForProjectParams) ([]*GetEtcdDatabasesForProjectRow, error)
+	GetEtcdScan(ctx context.Context, id int64) (*EtcdScan, error)
+	GetEtcdScanByScanID(ctx context.Context, scanID int64) (*EtcdScan, error)
 	GetGitCommitsWithResults(ctx context.Context, repositoryID int64) ([]*GetGitCommitsWithResultsRow, error)
 	GetGitRepositoriesForProject(ctx context.Context, arg GetGitRepositoriesForProjectParams) ([]*GetGitRepositoriesForProjectRow, error)
 	GetGitRepository(ctx context.Context, arg GetGitRepositoryParams) (*GetGitRepositoryRow, error)
@@ -89,6 +126,10 @@ type Querier interface {
 	GetMongoDatabasesForProject(ctx context.Context, arg GetMongoDatabasesForProjectParams) ([]*GetMongoDatabasesForProjectRow, error)
 	GetMongoScan(ctx context.Context, id int64) (*MongoScan, error)
 	GetMongoScanByScanID(ctx context.Context, scanID int64) (*MongoScan, error)
+	GetMssqlDatabase(ctx context.Context, arg GetMssqlDatabaseParams) (*GetMssqlDatabaseRow, error)
+	GetMssqlDatabasesForProject(ctx context.Context, arg GetMssqlDatabasesForProjectParams) ([]*GetMssqlDatabasesForProjectRow, error)
+	GetMssqlScan(ctx context.Context, id int64) (*MssqlScan, error)
+	GetMssqlScanByScanID(ctx context.Context, scanID int64) (*MssqlScan, error)
 	GetMysqlDatabase(ctx context.Context, arg GetMysqlDatabaseParams) (*GetMysqlDatabaseRow, error)
 	GetMysqlDatabasesForProject(ctx context.Context, arg GetMysqlDatabasesForProjectParams) ([]*GetMysqlDatabasesForProjectRow, error)
 	GetMysqlScan(ctx context.Context, id int64) (*MysqlScan, error)
@@ -109,10 +150,17 @@ type Querier interface {
 	GetPostgresScanByScanID(ctx context.Context, scanID int64) (*PostgresScan, error)
 	GetProject(ctx context.Context, id int64) (*Project, error)
 	GetProjectByOrganizationAndName(ctx context.Context, arg GetProjectByOrganizationAndNameParams) (*Project, error)
+	GetProjectIgnoredCve(ctx context.Context, id int64) (*ProjectIgnoredCfe, error)
+	GetProjectIgnoredCves(ctx context.Context, projectID int64) ([]*ProjectIgnoredCfe, error)
+	GetProjectInfoForElasticsearchScanByScanID(ctx context.Context, arg GetProjectInfoForElasticsearchScanByScanIDParams) (*GetProjectInfoForElasticsearchScanByScanIDRow, error)
+	GetProjectInfoForEtcdScanByScanID(ctx context.Context, arg GetProjectInfoForEtcdScanByScanIDParams) (*GetProjectInfoForEtcdScanByScanIDRow, error)
 	GetProjectInfoForMongoScanByScanID(ctx context.Context, arg GetProjectInfoForMongoScanByScanIDParams) (*GetProjectInfoForMongoScanByScanIDRow, error)
+	GetProjectInfoForMssqlScanByScanID(ctx context.Context, arg GetProjectInfoForMssqlScanByScanIDParams) (*GetProjectInfoForMssqlScanByScanIDRow, error)
 	GetProjectInfoForMysqlScanByScanID(ctx context.Context, arg GetProjectInfoForMysqlScanByScanIDParams) (*GetProjectInfoForMysqlScanByScanIDRow, error)
 	GetProjectInfoForPostgresScanByScanID(ctx context.Context, arg GetProjectInfoForPostgresScanByScanIDParams) (*GetProjectInfoForPostgresScanByScanIDRow, error)
 	GetProjectInfoForRedisScanByScanID(ctx context.Context, arg GetProjectInfoForRedisScanByScanIDParams) (*GetProjectInfoForRedisScanByScanIDRow, error)
+	GetProjectPasswordCandidates(ctx context.Context, projectID int64) ([]*GetProjectPasswordCandidatesRow, error)
+	GetProjectPasswordRules(ctx context.Context, projectID int64) (*ProjectPasswordRule, error)
 	GetProjectWithStats(ctx context.Context, id int64) (*GetProjectWithStatsRow, error)
 	GetProjects(ctx context.Context) ([]*Project, error)
 	GetProjectsByOrganization(ctx context.Context, organizationID int64) ([]*Project, error)
@@ -121,10 +169,16 @@ type Querier interface {
 	GetRedisScan(ctx context.Context, id int64) (*RedisScan, error)
 	GetRedisScanByScanID(ctx context.Context, scanID int64) (*RedisScan, error)
 	GetResetPasswordToken(ctx context.Context, id uuid.UUID) (*ResetPasswordToken, error)
+	GetRulePack(ctx context.Context, id int64) (*RulePack, error)
+	GetRulePacksForOrganization(ctx context.Context, organizationID int64) ([]*RulePack, error)
+	GetRulePacksForProject(ctx context.Context, id int64) ([]*RulePack, error)
 	GetScan(ctx context.Context, id int64) (*GetScanRow, error)
 	GetScanBruteforceResults(ctx context.Context, scanID int64) ([]*ScanBruteforceResult, error)
+	GetScanBruteforceShards(ctx context.Context, arg GetScanBruteforceShardsParams) ([]*ScanBruteforceShard, error)
+	GetScanCveResults(ctx context.Context, scanID int64) ([]*ScanCveResult, error)
 	GetScanGroup(ctx context.Context, id int64) (*ScanGroup, error)
 	GetScanGroupsForProject(ctx context.Context, projectID int64) ([]*GetScanGroupsForProjectRow, error)
+	GetScanResultFindings(ctx context.Context, scanID int64) ([]*ScanResultFinding, error)
 	GetScanResults(ctx context.Context, scanID int64) ([]*ScanResult, error)
 	GetScanResultsByScanIdAndScanSource(ctx context.Context, arg GetScanResultsByScanIdAndScanSourceParams) ([]*ScanResult, error)
 	GetScansForProject(ctx context.Context, projectID int64) ([]*GetScansForProjectRow, error)
@@ -151,12 +205,21 @@ type Querier interface {
 	ListUsers(ctx context.Context) ([]*User, error)
 	ListUsersPaginated(ctx context.Context, arg ListUsersPaginatedParams) ([]*User, error)
 	RemoveOrganizationUser(ctx context.Context, arg RemoveOrganizationUserParams) (*OrganizationMember, error)
+	SetDockerImageDetectorSet(ctx context.Context, arg SetDockerImageDetectorSetParams) error
 	SetOrganizationPermissionsForUser(ctx context.Context, arg SetOrganizationPermissionsForUserParams) (*OrganizationMember, error)
+	SetProjectDetectorSet(ctx context.Context, arg SetProjectDetectorSetParams) (*ProjectDetectorSet, error)
+	SetProjectPasswordRules(ctx context.Context, arg SetProjectPasswordRulesParams) (*ProjectPasswordRule, error)
 	UpdateBruteforcedPassword(ctx context.Context, arg UpdateBruteforcedPasswordParams) (*BruteforcedPassword, error)
 	UpdateDockerImage(ctx context.Context, arg UpdateDockerImageParams) (*DockerImage, error)
+	UpdateElasticsearchDatabase(ctx context.Context, arg UpdateElasticsearchDatabaseParams) error
+	UpdateElasticsearchVersion(ctx context.Context, arg UpdateElasticsearchVersionParams) error
+	UpdateEtcdDatabase(ctx context.Context, arg UpdateEtcdDatabaseParams) error
+	UpdateEtcdVersion(ctx context.Context, arg UpdateEtcdVersionParams) error
 	UpdateGitRepository(ctx context.Context, arg UpdateGitRepositoryParams) (*GitRepository, error)
 	UpdateMongoDatabase(ctx context.Context, arg UpdateMongoDatabaseParams) error
 	UpdateMongoVersion(ctx context.Context, arg UpdateMongoVersionParams) error
+	UpdateMssqlDatabase(ctx context.Context, arg UpdateMssqlDatabaseParams) error
+	UpdateMssqlVersion(ctx context.Context, arg UpdateMssqlVersionParams) error
 	UpdateMysqlDatabase(ctx context.Context, arg UpdateMysqlDatabaseParams) error
 	UpdateMysqlVersion(ctx context.Context, arg UpdateMysqlVersionParams) error
 	UpdateNvdCPE(ctx context.Context, arg UpdateNvdCPEParams) error
@@ -166,6 +229,7 @@ type Querier interface {
 	UpdateRedisDatabase(ctx context.Context, arg UpdateRedisDatabaseParams) error
 	UpdateRedisVersion(ctx context.Context, arg UpdateRedisVersionParams) error
 	UpdateScanBruteforceResult(ctx context.Context, arg UpdateScanBruteforceResultParams) error
+	UpdateScanBruteforceShardProgress(ctx context.Context, arg UpdateScanBruteforceShardProgressParams) (*ScanBruteforceShard, error)
 	UpdateScanStatus(ctx context.Context, arg UpdateScanStatusParams) error
 	UpdateUser(ctx context.Context, arg UpdateUserParams) error
 	UpdateWebauthnCredential(ctx context.Context, arg UpdateWebauthnCredentialParams) (*WebauthnCredential, error)
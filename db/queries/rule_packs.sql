@@ -0,0 +1,40 @@
+-- name: CreateRulePack :one
+INSERT INTO rule_packs(organization_id, name, content, enabled)
+    VALUES ($1, $2, $3, $4)
+RETURNING
+    *;
+
+-- name: GetRulePack :one
+SELECT
+    *
+FROM
+    rule_packs
+WHERE
+    id = $1
+LIMIT 1;
+
+-- name: GetRulePacksForOrganization :many
+SELECT
+    *
+FROM
+    rule_packs
+WHERE
+    organization_id = $1
+ORDER BY
+    id;
+
+-- name: GetRulePacksForProject :many
+SELECT
+    rule_packs.*
+FROM
+    rule_packs
+    INNER JOIN projects ON projects.organization_id = rule_packs.organization_id
+WHERE
+    projects.id = $1
+    AND rule_packs.enabled = TRUE
+ORDER BY
+    rule_packs.id;
+
+-- name: DeleteRulePack :exec
+DELETE FROM rule_packs
+WHERE id = $1;
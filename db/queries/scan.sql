@@ -158,3 +158,63 @@ FROM
 WHERE
     scan_group_id = $1;
 
+
+-- name: CreateScanCveResult :one
+WITH result AS (
+INSERT INTO scan_results(scan_id, severity, message, scan_source)
+        VALUES ($1, $2, $3, $4)
+    RETURNING
+        id)
+    INSERT INTO scan_cve_results(scan_result_id, cve_id, score, vector, description, published, fixed_in)
+    SELECT
+        result.id,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10
+    FROM
+        result
+    RETURNING
+        *;
+
+-- name: GetScanCveResults :many
+SELECT
+    scan_cve_results.*
+FROM
+    scan_cve_results
+    INNER JOIN scan_results ON scan_results.id = scan_cve_results.scan_result_id
+WHERE
+    scan_results.scan_id = $1;
+
+-- name: CreateScanFindingResult :one
+WITH result AS (
+INSERT INTO scan_results(scan_id, severity, message, scan_source)
+        VALUES ($1, $2, $3, $4)
+    RETURNING
+        id)
+    INSERT INTO scan_result_findings(scan_result_id, rule_id, title, description, remediation, reference_urls, object_type, object_name, evidence)
+    SELECT
+        result.id,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12
+    FROM
+        result
+    RETURNING
+        *;
+
+-- name: GetScanResultFindings :many
+SELECT
+    scan_result_findings.*
+FROM
+    scan_result_findings
+    INNER JOIN scan_results ON scan_results.id = scan_result_findings.scan_result_id
+WHERE
+    scan_results.scan_id = $1;
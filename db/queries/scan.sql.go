@@ -86,6 +86,136 @@ func (q *Queries) CreateScanBruteforceResult(ctx context.Context, arg CreateScan
 	return &i, err
 }
 
+const createScanCveResult = `-- name: CreateScanCveResult :one
+WITH result AS (
+INSERT INTO scan_results(scan_id, severity, message, scan_source)
+        VALUES ($1, $2, $3, $4)
+    RETURNING
+        id)
+    INSERT INTO scan_cve_results(scan_result_id, cve_id, score, vector, description, published, fixed_in)
+    SELECT
+        result.id,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10
+    FROM
+        result
+    RETURNING
+        id, scan_result_id, cve_id, score, vector, description, published, fixed_in
+`
+
+type CreateScanCveResultParams struct {
+	ScanID      int64              `json:"scan_id"`
+	Severity    int32              `json:"severity"`
+	Message     string             `json:"message"`
+	ScanSource  int32              `json:"scan_source"`
+	CveID       string             `json:"cve_id"`
+	Score       float64            `json:"score"`
+	Vector      string             `json:"vector"`
+	Description string             `json:"description"`
+	Published   pgtype.Timestamptz `json:"published"`
+	FixedIn     string             `json:"fixed_in"`
+}
+
+func (q *Queries) CreateScanCveResult(ctx context.Context, arg CreateScanCveResultParams) (*ScanCveResult, error) {
+	row := q.db.QueryRow(ctx, createScanCveResult,
+		arg.ScanID,
+		arg.Severity,
+		arg.Message,
+		arg.ScanSource,
+		arg.CveID,
+		arg.Score,
+		arg.Vector,
+		arg.Description,
+		arg.Published,
+		arg.FixedIn,
+	)
+	var i ScanCveResult
+	err := row.Scan(
+		&i.ID,
+		&i.ScanResultID,
+		&i.CveID,
+		&i.Score,
+		&i.Vector,
+		&i.Description,
+		&i.Published,
+		&i.FixedIn,
+	)
+	return &i, err
+}
+
+const createScanFindingResult = `-- name: CreateScanFindingResult :one
+WITH result AS (
+INSERT INTO scan_results(scan_id, severity, message, scan_source)
+        VALUES ($1, $2, $3, $4)
+    RETURNING
+        id)
+    INSERT INTO scan_result_findings(scan_result_id, rule_id, title, description, remediation, reference_urls, object_type, object_name, evidence)
+    SELECT
+        result.id,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12
+    FROM
+        result
+    RETURNING
+        id, scan_result_id, rule_id, title, description, remediation, reference_urls, object_type, object_name, evidence
+`
+
+type CreateScanFindingResultParams struct {
+	ScanID        int64    `json:"scan_id"`
+	Severity      int32    `json:"severity"`
+	Message       string   `json:"message"`
+	ScanSource    int32    `json:"scan_source"`
+	RuleID        string   `json:"rule_id"`
+	Title         string   `json:"title"`
+	Description   string   `json:"description"`
+	Remediation   string   `json:"remediation"`
+	ReferenceUrls []string `json:"reference_urls"`
+	ObjectType    string   `json:"object_type"`
+	ObjectName    string   `json:"object_name"`
+	Evidence      string   `json:"evidence"`
+}
+
+func (q *Queries) CreateScanFindingResult(ctx context.Context, arg CreateScanFindingResultParams) (*ScanResultFinding, error) {
+	row := q.db.QueryRow(ctx, createScanFindingResult,
+		arg.ScanID,
+		arg.Severity,
+		arg.Message,
+		arg.ScanSource,
+		arg.RuleID,
+		arg.Title,
+		arg.Description,
+		arg.Remediation,
+		arg.ReferenceUrls,
+		arg.ObjectType,
+		arg.ObjectName,
+		arg.Evidence,
+	)
+	var i ScanResultFinding
+	err := row.Scan(
+		&i.ID,
+		&i.ScanResultID,
+		&i.RuleID,
+		&i.Title,
+		&i.Description,
+		&i.Remediation,
+		&i.ReferenceUrls,
+		&i.ObjectType,
+		&i.ObjectName,
+		&i.Evidence,
+	)
+	return &i, err
+}
+
 const createScanGroup = `-- name: CreateScanGroup :one
 INSERT INTO scan_groups(project_id, created_by)
     VALUES ($1, $2)
@@ -235,6 +365,45 @@ func (q *Queries) GetScanBruteforceResults(ctx context.Context, scanID int64) ([
 	return items, nil
 }
 
+const getScanCveResults = `-- name: GetScanCveResults :many
+SELECT
+    scan_cve_results.id, scan_cve_results.scan_result_id, scan_cve_results.cve_id, scan_cve_results.score, scan_cve_results.vector, scan_cve_results.description, scan_cve_results.published, scan_cve_results.fixed_in
+FROM
+    scan_cve_results
+    INNER JOIN scan_results ON scan_results.id = scan_cve_results.scan_result_id
+WHERE
+    scan_results.scan_id = $1
+`
+
+func (q *Queries) GetScanCveResults(ctx context.Context, scanID int64) ([]*ScanCveResult, error) {
+	rows, err := q.db.Query(ctx, getScanCveResults, scanID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ScanCveResult
+	for rows.Next() {
+		var i ScanCveResult
+		if err := rows.Scan(
+			&i.ID,
+			&i.ScanResultID,
+			&i.CveID,
+			&i.Score,
+			&i.Vector,
+			&i.Description,
+			&i.Published,
+			&i.FixedIn,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getScanGroup = `-- name: GetScanGroup :one
 SELECT
     id, project_id, created_by, created_at
@@ -316,6 +485,47 @@ func (q *Queries) GetScanGroupsForProject(ctx context.Context, projectID int64)
 	return items, nil
 }
 
+const getScanResultFindings = `-- name: GetScanResultFindings :many
+SELECT
+    scan_result_findings.id, scan_result_findings.scan_result_id, scan_result_findings.rule_id, scan_result_findings.title, scan_result_findings.description, scan_result_findings.remediation, scan_result_findings.reference_urls, scan_result_findings.object_type, scan_result_findings.object_name, scan_result_findings.evidence
+FROM
+    scan_result_findings
+    INNER JOIN scan_results ON scan_results.id = scan_result_findings.scan_result_id
+WHERE
+    scan_results.scan_id = $1
+`
+
+func (q *Queries) GetScanResultFindings(ctx context.Context, scanID int64) ([]*ScanResultFinding, error) {
+	rows, err := q.db.Query(ctx, getScanResultFindings, scanID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ScanResultFinding
+	for rows.Next() {
+		var i ScanResultFinding
+		if err := rows.Scan(
+			&i.ID,
+			&i.ScanResultID,
+			&i.RuleID,
+			&i.Title,
+			&i.Description,
+			&i.Remediation,
+			&i.ReferenceUrls,
+			&i.ObjectType,
+			&i.ObjectName,
+			&i.Evidence,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getScanResults = `-- name: GetScanResults :many
 SELECT
     id, scan_id, severity, message, scan_source, created_at
@@ -0,0 +1,443 @@
+package file
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"path"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// ArchiveSeparator separates the path of an archive from the path of a file
+// inside it in the virtual file names of the results, for example
+// app.jar!/BOOT-INF/classes/application.yml.
+const ArchiveSeparator = "!/"
+
+const defaultMaxArchiveDepth = 5
+const defaultMaxUnpackedSize = 512 << 20
+const defaultMaxCompressionRatio = 100
+
+// minRatioCheckSize is the number of unpacked bytes after which the
+// compression ratio limit is enforced, so small files that compress very well
+// are still scanned.
+const minRatioCheckSize = 1 << 20
+
+const sniffSize = 8192
+const minPrintableStringLength = 6
+const maxPrintableStringLength = 4096
+const maxEmbeddedBlobsPerFile = 16
+const minEmbeddedBlobSize = 32
+
+var (
+	errUnpackedSizeLimit     = errors.New("unpacked size limit reached")
+	errCompressionRatioLimit = errors.New("compression ratio limit reached")
+)
+
+var embeddedBase64Regex = regexp.MustCompile(`[A-Za-z0-9+/]{64,}={0,2}`)
+
+type archiveKind int
+
+const (
+	archiveNone archiveKind = iota
+	archiveZip
+	archiveTar
+	archiveGzip
+	archiveBzip2
+)
+
+func sniffArchive(head []byte) archiveKind {
+	switch {
+	case bytes.HasPrefix(head, []byte("PK\x03\x04")), bytes.HasPrefix(head, []byte("PK\x05\x06")):
+		return archiveZip
+	case bytes.HasPrefix(head, []byte{0x1f, 0x8b}):
+		return archiveGzip
+	case len(head) > 3 && bytes.HasPrefix(head, []byte("BZh")) && head[3] >= '1' && head[3] <= '9':
+		return archiveBzip2
+	case len(head) >= 262 && bytes.Equal(head[257:262], []byte("ustar")):
+		return archiveTar
+	}
+	return archiveNone
+}
+
+// isText returns true if the start of a file looks like text rather than
+// binary content.
+func isText(head []byte) bool {
+	if bytes.IndexByte(head, 0) >= 0 {
+		return false
+	}
+	// The sniffed bytes can end in the middle of a rune.
+	for i := 0; i < utf8.UTFMax && len(head) > 0 && !utf8.Valid(head); i++ {
+		head = head[:len(head)-1]
+	}
+	if utf8.Valid(head) {
+		return true
+	}
+	printable := 0
+	for _, c := range head {
+		if c == '\n' || c == '\r' || c == '\t' || (c >= 32 && c <= 126) {
+			printable++
+		}
+	}
+	return float64(printable) >= 0.7*float64(len(head))
+}
+
+// unpackBudget is the number of bytes that can still be unpacked from a
+// scanned file, shared by all the archives nested in it.
+type unpackBudget struct {
+	remaining int64
+}
+
+// boundedReader reads unpacked content and stops with an error once the
+// budget is exhausted, or once the content is more than ratio times larger
+// than the compressed bytes returned by consumed.
+type boundedReader struct {
+	r        io.Reader
+	budget   *unpackBudget
+	consumed func() int64
+	ratio    float64
+
+	produced int64
+	err      error
+}
+
+func (b *boundedReader) Read(p []byte) (int, error) {
+	if b.err != nil {
+		return 0, b.err
+	}
+	n, err := b.r.Read(p)
+	b.produced += int64(n)
+	b.budget.remaining -= int64(n)
+	if b.budget.remaining < 0 {
+		b.err = errUnpackedSizeLimit
+	} else if b.consumed != nil && b.produced > minRatioCheckSize && float64(b.produced) > b.ratio*float64(max(b.consumed(), 1)) {
+		b.err = errCompressionRatioLimit
+	}
+	if b.err != nil {
+		return n, b.err
+	}
+	return n, err
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// printableStringsReader returns the runs of printable ASCII characters of a
+// binary file, one per line, like the strings command.
+type printableStringsReader struct {
+	src     *bufio.Reader
+	run     []byte
+	pending []byte
+	err     error
+}
+
+func newPrintableStringsReader(rd io.Reader) *printableStringsReader {
+	return &printableStringsReader{src: bufio.NewReader(rd)}
+}
+
+func (r *printableStringsReader) flush() {
+	if len(r.run) >= minPrintableStringLength {
+		r.pending = append(r.pending, r.run...)
+		r.pending = append(r.pending, '\n')
+	}
+	r.run = r.run[:0]
+}
+
+func (r *printableStringsReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		c, err := r.src.ReadByte()
+		if err != nil {
+			r.err = err
+			r.flush()
+			continue
+		}
+		if c == '\t' || (c >= 32 && c <= 126) {
+			r.run = append(r.run, c)
+			if len(r.run) >= maxPrintableStringLength {
+				r.flush()
+			}
+			continue
+		}
+		r.flush()
+	}
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+// decompressedName returns the name of the file inside a compressed file,
+// for example backup.sql for backup.sql.gz.
+func decompressedName(fileName string) string {
+	name := path.Base(fileName[strings.LastIndex(fileName, ArchiveSeparator)+1:])
+	switch ext := strings.ToLower(path.Ext(name)); ext {
+	case ".tgz", ".tbz", ".tbz2":
+		return strings.TrimSuffix(name, path.Ext(name)) + ".tar"
+	case ".gz", ".bz2":
+		return strings.TrimSuffix(name, path.Ext(name))
+	}
+	return name
+}
+
+// unpacker scans a file and, recursively, the files inside it if it is an
+// archive, a compressed file or a text file with embedded base64 content.
+type unpacker struct {
+	fs     *FileScanner
+	budget unpackBudget
+}
+
+func (u *unpacker) scan(ctx context.Context, fileName string, rd io.Reader, depth int) ([]ExtractResult, error) {
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("ExtractFromReader: context is done: %w", ctx.Err())
+	default:
+	}
+
+	br := bufio.NewReaderSize(rd, sniffSize)
+	head, err := br.Peek(sniffSize)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return nil, err
+	}
+
+	kind := sniffArchive(head)
+	if kind != archiveNone && depth >= u.fs.options.maxArchiveDepth {
+		slog.DebugContext(ctx, "Archive nested too deep, scanning it as binary", "fileName", fileName, "depth", depth)
+		kind = archiveNone
+	}
+
+	switch kind {
+	case archiveZip:
+		return u.scanZip(ctx, fileName, br, depth)
+	case archiveTar:
+		return u.scanTar(ctx, fileName, tar.NewReader(br), depth)
+	case archiveGzip, archiveBzip2:
+		compressed := &countingReader{r: br}
+		var decompressed io.Reader
+		if kind == archiveGzip {
+			gz, err := gzip.NewReader(compressed)
+			if err != nil {
+				slog.DebugContext(ctx, "Could not open gzip file", "fileName", fileName, "error", err)
+				return u.scanLeaf(ctx, fileName, br, head, depth)
+			}
+			gz.Multistream(true)
+			decompressed = gz
+		} else {
+			decompressed = bzip2.NewReader(compressed)
+		}
+		return u.scanCompressed(ctx, fileName, &boundedReader{
+			r:        decompressed,
+			budget:   &u.budget,
+			consumed: func() int64 { return compressed.n },
+			ratio:    u.fs.options.maxCompressionRatio,
+		}, depth)
+	}
+	return u.scanLeaf(ctx, fileName, br, head, depth)
+}
+
+// scanCompressed scans the content of a gzip or bzip2 file. Compressed tar
+// archives are scanned as if they were not compressed, so their files are
+// named archive.tar.gz!/file.
+func (u *unpacker) scanCompressed(ctx context.Context, fileName string, rd io.Reader, depth int) ([]ExtractResult, error) {
+	br := bufio.NewReaderSize(rd, sniffSize)
+	head, err := br.Peek(sniffSize)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		if isUnpackLimit(err) {
+			return nil, err
+		}
+		slog.DebugContext(ctx, "Could not decompress file", "fileName", fileName, "error", err)
+		return nil, nil
+	}
+	if sniffArchive(head) == archiveTar {
+		return u.scanTar(ctx, fileName, tar.NewReader(br), depth)
+	}
+	return u.scan(ctx, fileName+ArchiveSeparator+decompressedName(fileName), br, depth+1)
+}
+
+func (u *unpacker) scanTar(ctx context.Context, fileName string, archive *tar.Reader, depth int) ([]ExtractResult, error) {
+	var results []ExtractResult
+	for {
+		header, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			return results, nil
+		}
+		if isUnpackLimit(err) {
+			return results, err
+		}
+		if err != nil {
+			slog.DebugContext(ctx, "Could not read tar archive", "fileName", fileName, "error", err)
+			return results, nil
+		}
+		if !header.FileInfo().Mode().IsRegular() {
+			continue
+		}
+
+		fileResults, err := u.scan(ctx, fileName+ArchiveSeparator+strings.TrimPrefix(header.Name, "/"), archive, depth+1)
+		results = append(results, fileResults...)
+		if err != nil {
+			return results, err
+		}
+	}
+}
+
+func (u *unpacker) scanZip(ctx context.Context, fileName string, rd io.Reader, depth int) ([]ExtractResult, error) {
+	// Zip files are read from the end, so the whole archive is kept in
+	// memory.
+	content, err := io.ReadAll(io.LimitReader(rd, u.fs.options.maxUnpackedSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > u.fs.options.maxUnpackedSize {
+		return nil, errUnpackedSizeLimit
+	}
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		slog.DebugContext(ctx, "Could not open zip archive", "fileName", fileName, "error", err)
+		return u.scanLeaf(ctx, fileName, bytes.NewReader(content), content[:min(len(content), sniffSize)], depth)
+	}
+
+	var results []ExtractResult
+	for _, f := range archive.File {
+		if !f.Mode().IsRegular() {
+			continue
+		}
+		if int64(f.UncompressedSize64) > u.budget.remaining {
+			return results, errUnpackedSizeLimit
+		}
+		if f.UncompressedSize64 > minRatioCheckSize && float64(f.UncompressedSize64) > u.fs.options.maxCompressionRatio*float64(max(f.CompressedSize64, 1)) {
+			return results, errCompressionRatioLimit
+		}
+		fileResults, err := u.scanZipFile(ctx, fileName+ArchiveSeparator+f.Name, f, depth)
+		results = append(results, fileResults...)
+		if err != nil {
+			return results, err
+		}
+	}
+	return results, nil
+}
+
+func (u *unpacker) scanZipFile(ctx context.Context, fileName string, f *zip.File, depth int) ([]ExtractResult, error) {
+	rc, err := f.Open()
+	if err != nil {
+		slog.DebugContext(ctx, "Could not open file in zip archive", "fileName", fileName, "error", err)
+		return nil, nil
+	}
+	defer rc.Close()
+
+	compressed := int64(f.CompressedSize64)
+	return u.scan(ctx, fileName, &boundedReader{
+		r:        rc,
+		budget:   &u.budget,
+		consumed: func() int64 { return compressed },
+		ratio:    u.fs.options.maxCompressionRatio,
+	}, depth+1)
+}
+
+type embeddedBlob struct {
+	lineNumber int
+	content    []byte
+}
+
+// decodeEmbeddedBlob decodes a base64 string found in a text file. Only
+// blobs that contain an archive or text are kept, since other binary content
+// such as hashes and certificates has nothing more to scan.
+func decodeEmbeddedBlob(encoded string) ([]byte, bool) {
+	content, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		content, err = base64.RawStdEncoding.DecodeString(strings.TrimRight(encoded, "="))
+	}
+	if err != nil || len(content) < minEmbeddedBlobSize {
+		return nil, false
+	}
+	head := content[:min(len(content), sniffSize)]
+	if sniffArchive(head) == archiveNone && (!isText(head) || !utf8.Valid(content)) {
+		return nil, false
+	}
+	return content, true
+}
+
+// scanLeaf scans a file that is not an archive. Binary files are reduced to
+// their printable strings. Text files are scanned line by line, and as
+// structured config if their format is known, and the base64 blobs found in
+// their lines are scanned as files named file!/base64:line.
+func (u *unpacker) scanLeaf(ctx context.Context, fileName string, rd io.Reader, head []byte, depth int) ([]ExtractResult, error) {
+	if !isText(head) {
+		var results []ExtractResult
+		if looksLikePKCS12(fileName, head) {
+			content, err := io.ReadAll(io.LimitReader(rd, maxPKCS12Size))
+			if err != nil {
+				return nil, err
+			}
+			if result, ok := pkcs12Result(fileName, content); ok {
+				results = u.fs.keepBlockResults(ctx, []ExtractResult{result})
+			}
+			rd = io.MultiReader(bytes.NewReader(content), rd)
+		}
+		stringResults, err := u.fs.extractFromLines(ctx, fileName, newPrintableStringsReader(rd), nil)
+		return append(results, stringResults...), err
+	}
+
+	blobs := []embeddedBlob{}
+	onLine := func(lineNumber int, line string) {
+		if depth >= u.fs.options.maxArchiveDepth || len(blobs) >= maxEmbeddedBlobsPerFile {
+			return
+		}
+		for _, encoded := range embeddedBase64Regex.FindAllString(line, maxEmbeddedBlobsPerFile) {
+			if content, ok := decodeEmbeddedBlob(encoded); ok && len(blobs) < maxEmbeddedBlobsPerFile {
+				blobs = append(blobs, embeddedBlob{lineNumber: lineNumber, content: content})
+			}
+		}
+	}
+
+	var results []ExtractResult
+	var err error
+	if parser := configParserForFile(fileName); u.fs.options.structuredParsing && parser != nil {
+		var content []byte
+		content, err = io.ReadAll(io.LimitReader(rd, maxConfigFileSize+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(content) <= maxConfigFileSize {
+			results, err = u.fs.extractFromConfig(ctx, fileName, parser, content, onLine)
+		} else {
+			results, err = u.fs.extractFromLines(ctx, fileName, io.MultiReader(bytes.NewReader(content), rd), onLine)
+		}
+	} else {
+		results, err = u.fs.extractFromLines(ctx, fileName, rd, onLine)
+	}
+	if err != nil {
+		return results, err
+	}
+
+	for _, blob := range blobs {
+		blobResults, err := u.scan(ctx, fmt.Sprintf("%s%sbase64:%d", fileName, ArchiveSeparator, blob.lineNumber), bytes.NewReader(blob.content), depth+1)
+		results = append(results, blobResults...)
+		if err != nil {
+			return results, err
+		}
+	}
+	return results, nil
+}
+
+func isUnpackLimit(err error) bool {
+	return errors.Is(err, errUnpackedSizeLimit) || errors.Is(err, errCompressionRatioLimit)
+}
@@ -0,0 +1,457 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/crypto/pkcs12"
+	"golang.org/x/crypto/ssh"
+	"gopkg.in/yaml.v3"
+)
+
+// maxBlockLines and maxBlockSize bound the secrets that span several lines,
+// so an unterminated block does not keep the whole file in memory.
+const maxBlockLines = 500
+const maxBlockSize = 256 << 10
+
+const maxPKCS12Size = 1 << 20
+
+// encryptedKeyProbability is the probability of keys that are protected by a
+// passphrase. They are still reported, but as a lower risk than plain keys.
+const encryptedKeyProbability = 0.75
+
+const (
+	privateKeyDetectorID            = "private-key"
+	pkcs12DetectorID                = "pkcs12"
+	gcpServiceAccountDetectorID     = "gcp-service-account"
+	jsonCredentialsDetectorID       = "json-credentials"
+	kubeconfigCredentialsDetectorID = "kubeconfig-credentials"
+)
+
+var pemBeginRegex = regexp.MustCompile(`-----BEGIN ((?:[A-Z0-9]+ )*PRIVATE KEY)-----`)
+
+// jsonCredentialKeywords are the lowercase words that an object must contain
+// to be parsed as JSON credentials.
+var jsonCredentialKeywords = []string{"service_account", "password", "secret"}
+
+// parsePrivateKey checks that a PEM private key is well-formed. Keys that are
+// encrypted cannot be parsed without their passphrase, so only their
+// structure is checked.
+func parsePrivateKey(text []byte) (encrypted bool, ok bool) {
+	block, _ := pem.Decode(text)
+	if block == nil {
+		return false, false
+	}
+	if block.Type == "ENCRYPTED PRIVATE KEY" {
+		var value asn1.RawValue
+		_, err := asn1.Unmarshal(block.Bytes, &value)
+		return true, err == nil
+	}
+	if strings.Contains(block.Headers["Proc-Type"], "ENCRYPTED") {
+		return true, len(block.Bytes) > 0
+	}
+	_, err := ssh.ParseRawPrivateKey(pem.EncodeToMemory(block))
+	var passphraseMissing *ssh.PassphraseMissingError
+	if errors.As(err, &passphraseMissing) {
+		return true, true
+	}
+	return false, err == nil
+}
+
+func privateKeyResult(fileName string, startLine int, endLine int, line string, keyType string, encrypted bool) ExtractResult {
+	result := ExtractResult{
+		Name:          "Private Key",
+		DetectorID:    privateKeyDetectorID,
+		Line:          line,
+		LineNumber:    startLine,
+		EndLineNumber: endLine,
+		Match:         "-----BEGIN " + keyType + "-----",
+		Probability:   1,
+		FileName:      fileName,
+	}
+	if encrypted {
+		result.Name = "Encrypted Private Key"
+		result.Probability = encryptedKeyProbability
+	}
+	return result
+}
+
+type pemBlock struct {
+	startLine int
+	line      string
+	keyType   string
+	text      bytes.Buffer
+	lines     int
+}
+
+type jsonObjectStart struct {
+	offset int
+	line   int
+}
+
+// blockScanner finds the secrets that span several lines of a file, such as
+// PEM private keys and JSON credential objects, while the file is scanned
+// line by line.
+type blockScanner struct {
+	fileName string
+
+	pem *pemBlock
+
+	json     bytes.Buffer
+	objects  []jsonObjectStart
+	inString bool
+	escaped  bool
+}
+
+func newBlockScanner(fileName string) *blockScanner {
+	return &blockScanner{fileName: fileName}
+}
+
+func (b *blockScanner) scanLine(lineNumber int, line string) []ExtractResult {
+	var results []ExtractResult
+	if result, ok := b.scanPEMLine(lineNumber, line); ok {
+		results = append(results, result)
+	}
+	return append(results, b.scanJSONLine(lineNumber, line)...)
+}
+
+func (b *blockScanner) scanPEMLine(lineNumber int, line string) (ExtractResult, bool) {
+	if b.pem == nil {
+		match := pemBeginRegex.FindStringSubmatchIndex(line)
+		if match == nil {
+			return ExtractResult{}, false
+		}
+		b.pem = &pemBlock{startLine: lineNumber, line: line, keyType: line[match[2]:match[3]]}
+		line = line[match[0]:]
+		if strings.Contains(line, "-----END") {
+			// Keys stored in a single line, for example in JSON strings.
+			line = strings.ReplaceAll(line, `\n`, "\n")
+		} else {
+			line = line[:match[1]-match[0]]
+		}
+	} else {
+		line = strings.Trim(line, " \t\r\"',\\")
+	}
+
+	block := b.pem
+	end := strings.Index(line, "-----END")
+	if end >= 0 {
+		if footer := strings.Index(line[end+len("-----END"):], "-----"); footer >= 0 {
+			line = line[:end+len("-----END")+footer+len("-----")]
+		}
+	}
+	block.text.WriteString(line)
+	block.text.WriteByte('\n')
+	block.lines++
+
+	if end < 0 {
+		if block.lines > maxBlockLines || block.text.Len() > maxBlockSize {
+			b.pem = nil
+		}
+		return ExtractResult{}, false
+	}
+
+	b.pem = nil
+	encrypted, ok := parsePrivateKey(block.text.Bytes())
+	if !ok {
+		return ExtractResult{}, false
+	}
+	return privateKeyResult(b.fileName, block.startLine, lineNumber, block.line, block.keyType, encrypted), true
+}
+
+// scanJSONLine tracks the JSON objects of the file, ignoring the braces in
+// strings, and checks every object that closes on this line.
+func (b *blockScanner) scanJSONLine(lineNumber int, line string) []ExtractResult {
+	if len(b.objects) == 0 {
+		b.json.Reset()
+		b.inString = false
+		if !strings.Contains(line, "{") {
+			return nil
+		}
+	}
+
+	var results []ExtractResult
+	offset := b.json.Len()
+	b.json.WriteString(line)
+	b.json.WriteByte('\n')
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		if b.inString {
+			switch {
+			case b.escaped:
+				b.escaped = false
+			case c == '\\':
+				b.escaped = true
+			case c == '"':
+				b.inString = false
+			}
+			continue
+		}
+		switch c {
+		case '"':
+			if len(b.objects) > 0 {
+				b.inString = true
+			}
+		case '{':
+			b.objects = append(b.objects, jsonObjectStart{offset: offset + i, line: lineNumber})
+		case '}':
+			if len(b.objects) == 0 {
+				continue
+			}
+			start := b.objects[len(b.objects)-1]
+			b.objects = b.objects[:len(b.objects)-1]
+			object := b.json.Bytes()[start.offset : offset+i+1]
+			if result, ok := jsonCredentials(b.fileName, object, start.line, lineNumber); ok {
+				results = append(results, result)
+			}
+		}
+	}
+
+	if b.json.Len() > maxBlockSize || lineNumber-b.firstObjectLine() > maxBlockLines {
+		b.objects = nil
+	}
+	return results
+}
+
+func (b *blockScanner) firstObjectLine() int {
+	if len(b.objects) == 0 {
+		return 0
+	}
+	return b.objects[0].line
+}
+
+func stringField(fields map[string]any, names ...string) string {
+	for key, value := range fields {
+		normalized := normalizeConfigKey(key)
+		for _, name := range names {
+			if normalized == name {
+				if value, ok := value.(string); ok && !isConfigPlaceholder(value) {
+					return value
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// jsonCredentials checks whether a JSON object is a GCP service account key
+// or holds a username and password, a client ID and secret or an AWS access
+// key.
+func jsonCredentials(fileName string, object []byte, startLine int, endLine int) (ExtractResult, bool) {
+	lower := bytes.ToLower(object)
+	found := false
+	for _, keyword := range jsonCredentialKeywords {
+		if bytes.Contains(lower, []byte(keyword)) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return ExtractResult{}, false
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(object, &fields); err != nil {
+		return ExtractResult{}, false
+	}
+	result := ExtractResult{
+		Name:          "JSON Credentials",
+		DetectorID:    jsonCredentialsDetectorID,
+		Line:          strings.TrimSpace(string(object[:max(bytes.IndexByte(object, '\n'), 0)])),
+		LineNumber:    startLine,
+		EndLineNumber: endLine,
+		Probability:   1,
+		FileName:      fileName,
+	}
+	if result.Line == "" {
+		result.Line = string(object)
+	}
+
+	if stringField(fields, "type") == "service_account" {
+		privateKey := stringField(fields, "privatekey")
+		email := stringField(fields, "clientemail")
+		keyID := stringField(fields, "privatekeyid")
+		if privateKey == "" || email == "" || keyID == "" || stringField(fields, "projectid") == "" {
+			return ExtractResult{}, false
+		}
+		if encrypted, ok := parsePrivateKey([]byte(privateKey)); !ok || encrypted {
+			return ExtractResult{}, false
+		}
+		result.Name = "GCP Service Account Key"
+		result.DetectorID = gcpServiceAccountDetectorID
+		result.Username = email
+		result.Match = "private_key_id " + keyID
+		return result, true
+	}
+
+	pairs := [][2][]string{
+		{{"username", "user", "login"}, {"password", "passwd"}},
+		{{"clientid"}, {"clientsecret"}},
+		{{"accesskeyid", "awsaccesskeyid"}, {"secretaccesskey", "awssecretaccesskey"}},
+	}
+	for _, pair := range pairs {
+		username := stringField(fields, pair[0]...)
+		password := stringField(fields, pair[1]...)
+		if username != "" && password != "" {
+			result.Username = username
+			result.Password = password
+			result.Match = username + ":" + password
+			return result, true
+		}
+	}
+	return ExtractResult{}, false
+}
+
+// looksLikePKCS12 returns true for .p12 and .pfx files, and for files that
+// start like a PFX structure, a DER sequence with version 3.
+func looksLikePKCS12(fileName string, head []byte) bool {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".p12", ".pfx":
+		return true
+	}
+	return len(head) > 7 && head[0] == 0x30 && head[1] == 0x82 && bytes.Equal(head[4:7], []byte{0x02, 0x01, 0x03})
+}
+
+// pkcs12Result checks a PKCS#12 key store. Key stores that open without a
+// password are reported if they contain a private key. The others are
+// reported as a lower risk, since their content cannot be checked.
+func pkcs12Result(fileName string, content []byte) (ExtractResult, bool) {
+	result := ExtractResult{
+		Name:          "PKCS#12 Key Store",
+		DetectorID:    pkcs12DetectorID,
+		Line:          filepath.Base(fileName),
+		LineNumber:    1,
+		EndLineNumber: 1,
+		Match:         filepath.Base(fileName),
+		Probability:   1,
+		FileName:      fileName,
+	}
+
+	blocks, err := pkcs12.ToPEM(content, "")
+	var notImplemented pkcs12.NotImplementedError
+	if errors.Is(err, pkcs12.ErrIncorrectPassword) || errors.As(err, &notImplemented) {
+		result.Name = "Encrypted PKCS#12 Key Store"
+		result.Probability = encryptedKeyProbability
+		return result, true
+	}
+	if err != nil {
+		return ExtractResult{}, false
+	}
+	for _, block := range blocks {
+		if strings.HasSuffix(block.Type, "PRIVATE KEY") {
+			return result, true
+		}
+	}
+	return ExtractResult{}, false
+}
+
+func yamlNodeEndLine(node *yaml.Node) int {
+	end := node.Line
+	for _, child := range node.Content {
+		end = max(end, yamlNodeEndLine(child))
+	}
+	return end
+}
+
+func yamlMappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func yamlMappingString(node *yaml.Node, key string) string {
+	if value := yamlMappingValue(node, key); value != nil && value.Kind == yaml.ScalarNode {
+		return value.Value
+	}
+	return ""
+}
+
+// kubeconfigCredentials returns the users of a kubeconfig file that have a
+// client key that parses, a token or a username and password.
+func kubeconfigCredentials(fileName string, content []byte) []ExtractResult {
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil || len(document.Content) == 0 {
+		return nil
+	}
+	root := document.Content[0]
+	if yamlMappingString(root, "kind") != "Config" {
+		return nil
+	}
+	users := yamlMappingValue(root, "users")
+	if users == nil || users.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	lines := strings.Split(string(content), "\n")
+	var results []ExtractResult
+	for _, item := range users.Content {
+		user := yamlMappingValue(item, "user")
+		name := yamlMappingString(item, "name")
+		result := ExtractResult{
+			Name:          "Kubeconfig Credentials",
+			DetectorID:    kubeconfigCredentialsDetectorID,
+			LineNumber:    item.Line,
+			EndLineNumber: yamlNodeEndLine(item),
+			Username:      name,
+			Probability:   1,
+			FileName:      fileName,
+		}
+		if item.Line >= 1 && item.Line <= len(lines) {
+			result.Line = strings.TrimSpace(lines[item.Line-1])
+		}
+
+		if keyData := yamlMappingString(user, "client-key-data"); keyData != "" {
+			key, err := base64.StdEncoding.DecodeString(keyData)
+			if err != nil {
+				continue
+			}
+			encrypted, ok := parsePrivateKey(key)
+			if !ok {
+				continue
+			}
+			if encrypted {
+				result.Probability = encryptedKeyProbability
+			}
+			result.Match = fmt.Sprintf("client-key-data of user %s", name)
+		} else if token := yamlMappingString(user, "token"); token != "" && !isConfigPlaceholder(token) {
+			result.Match = fmt.Sprintf("token of user %s", name)
+		} else if password := yamlMappingString(user, "password"); password != "" && !isConfigPlaceholder(password) {
+			if username := yamlMappingString(user, "username"); username != "" {
+				result.Username = username
+			}
+			result.Password = password
+			result.Match = fmt.Sprintf("password of user %s", name)
+		} else {
+			continue
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// keepBlockResults filters the results of the block detectors like the
+// results of the line detectors.
+func (fs *FileScanner) keepBlockResults(ctx context.Context, results []ExtractResult) []ExtractResult {
+	kept := []ExtractResult{}
+	for _, result := range results {
+		if fs.keepResult(ctx, fs.allowlists, &result) {
+			kept = append(kept, result)
+		}
+	}
+	return kept
+}
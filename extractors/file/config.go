@@ -0,0 +1,613 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pelletier/go-toml/v2/unstable"
+	"gopkg.in/yaml.v3"
+)
+
+// configValue is a value of a structured config file, together with the path
+// of its key (for example spring.datasource.password) and the line where the
+// value starts.
+type configValue struct {
+	path       string
+	value      string
+	lineNumber int
+}
+
+type configParser func(content []byte) ([]configValue, error)
+
+// configParserForFile returns the parser for the format of the file, or nil
+// if the file is not a known config format.
+func configParserForFile(fileName string) configParser {
+	base := strings.ToLower(filepath.Base(fileName))
+	if base == "kubeconfig" || strings.HasSuffix(fileName, ".kube/config") {
+		return parseYAMLConfig
+	}
+	if base == ".env" || strings.HasPrefix(base, ".env.") || strings.HasSuffix(base, ".env") {
+		return parseEnvConfig
+	}
+	switch filepath.Ext(base) {
+	case ".yaml", ".yml":
+		return parseYAMLConfig
+	case ".json":
+		return parseJSONConfig
+	case ".xml", ".config":
+		return parseXMLConfig
+	case ".properties":
+		return parsePropertiesConfig
+	case ".toml":
+		return parseTOMLConfig
+	case ".kubeconfig":
+		return parseYAMLConfig
+	}
+	return nil
+}
+
+func joinConfigPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// lineOffsets maps byte offsets of a file to line numbers.
+type lineOffsets []int
+
+func newLineOffsets(content []byte) lineOffsets {
+	offsets := lineOffsets{}
+	for i, c := range content {
+		if c == '\n' {
+			offsets = append(offsets, i)
+		}
+	}
+	return offsets
+}
+
+func (l lineOffsets) line(offset int) int {
+	return sort.SearchInts(l, offset) + 1
+}
+
+func parseYAMLConfig(content []byte) ([]configValue, error) {
+	values := []configValue{}
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		var document yaml.Node
+		err := decoder.Decode(&document)
+		if errors.Is(err, io.EOF) {
+			return values, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not parse yaml: %w", err)
+		}
+		walkYAMLNode(&document, "", &values)
+	}
+}
+
+func walkYAMLNode(node *yaml.Node, path string, values *[]configValue) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			walkYAMLNode(child, path, values)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			walkYAMLNode(node.Content[i+1], joinConfigPath(path, node.Content[i].Value), values)
+		}
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			walkYAMLNode(child, fmt.Sprintf("%s[%d]", path, i), values)
+		}
+	case yaml.ScalarNode:
+		*values = append(*values, configValue{path: path, value: node.Value, lineNumber: node.Line})
+	}
+}
+
+func parseJSONConfig(content []byte) ([]configValue, error) {
+	values := []configValue{}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	if err := walkJSON(decoder, newLineOffsets(content), "", &values); err != nil {
+		return nil, fmt.Errorf("could not parse json: %w", err)
+	}
+	return values, nil
+}
+
+func walkJSON(decoder *json.Decoder, lines lineOffsets, path string, values *[]configValue) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	switch token := token.(type) {
+	case json.Delim:
+		for i := 0; decoder.More(); i++ {
+			childPath := fmt.Sprintf("%s[%d]", path, i)
+			if token == '{' {
+				key, err := decoder.Token()
+				if err != nil {
+					return err
+				}
+				childPath = joinConfigPath(path, fmt.Sprint(key))
+			}
+			if err := walkJSON(decoder, lines, childPath, values); err != nil {
+				return err
+			}
+		}
+		_, err := decoder.Token()
+		return err
+	case string:
+		*values = append(*values, configValue{path: path, value: token, lineNumber: lines.line(int(decoder.InputOffset()) - 1)})
+	case json.Number:
+		*values = append(*values, configValue{path: path, value: token.String(), lineNumber: lines.line(int(decoder.InputOffset()) - 1)})
+	}
+	return nil
+}
+
+// parseXMLConfig reads the text and the attributes of the elements. Elements
+// with a key or name attribute, such as the add elements of web.config
+// files, use it in the path instead of the element name, and their value
+// attribute is reported as the value of that path.
+func parseXMLConfig(content []byte) ([]configValue, error) {
+	values := []configValue{}
+	lines := newLineOffsets(content)
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	decoder.Strict = false
+
+	paths := []string{}
+	for {
+		start := int(decoder.InputOffset())
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return values, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not parse xml: %w", err)
+		}
+
+		path := ""
+		if len(paths) > 0 {
+			path = paths[len(paths)-1]
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			name := token.Name.Local
+			for _, attr := range token.Attr {
+				if attr.Name.Local == "key" || attr.Name.Local == "name" {
+					name = attr.Value
+					break
+				}
+			}
+			path = joinConfigPath(path, name)
+			paths = append(paths, path)
+
+			tag := content[start:decoder.InputOffset()]
+			for _, attr := range token.Attr {
+				if attr.Name.Local == "key" || attr.Name.Local == "name" {
+					continue
+				}
+				attrPath := joinConfigPath(path, attr.Name.Local)
+				if attr.Name.Local == "value" {
+					attrPath = path
+				}
+				offset := start + max(bytes.Index(tag, []byte(attr.Name.Local+"=")), 0)
+				values = append(values, configValue{path: attrPath, value: attr.Value, lineNumber: lines.line(offset)})
+			}
+		case xml.EndElement:
+			if len(paths) > 0 {
+				paths = paths[:len(paths)-1]
+			}
+		case xml.CharData:
+			text := strings.TrimSpace(string(token))
+			if text == "" || path == "" {
+				continue
+			}
+			offset := start + len(token) - len(bytes.TrimLeft(token, " \t\r\n"))
+			values = append(values, configValue{path: path, value: text, lineNumber: lines.line(offset)})
+		}
+	}
+}
+
+var propertiesEscapes = map[byte]string{'t': "\t", 'n': "\n", 'r': "\r", 'f': "\f"}
+
+// unescapeProperties removes the escapes of a key or value of a Java
+// properties file.
+func unescapeProperties(value string) string {
+	var builder strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' || i+1 == len(value) {
+			builder.WriteByte(value[i])
+			continue
+		}
+		i++
+		if escaped, ok := propertiesEscapes[value[i]]; ok {
+			builder.WriteString(escaped)
+			continue
+		}
+		if value[i] == 'u' && i+4 < len(value) {
+			if r, err := strconv.ParseUint(value[i+1:i+5], 16, 32); err == nil {
+				builder.WriteRune(rune(r))
+				i += 4
+				continue
+			}
+		}
+		builder.WriteByte(value[i])
+	}
+	return builder.String()
+}
+
+// parsePropertiesConfig parses Java properties files, including values that
+// continue on the next line after a trailing backslash.
+func parsePropertiesConfig(content []byte) ([]configValue, error) {
+	values := []configValue{}
+	rawLines := strings.Split(string(content), "\n")
+	for i := 0; i < len(rawLines); i++ {
+		lineNumber := i + 1
+		line := strings.TrimLeft(strings.TrimRight(rawLines[i], "\r"), " \t\f")
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+		for (len(line)-len(strings.TrimRight(line, "\\")))%2 == 1 && i+1 < len(rawLines) {
+			i++
+			line = line[:len(line)-1] + strings.TrimLeft(strings.TrimRight(rawLines[i], "\r"), " \t\f")
+		}
+
+		separator := len(line)
+		for j := 0; j < len(line); j++ {
+			if line[j] == '\\' {
+				j++
+				continue
+			}
+			if line[j] == '=' || line[j] == ':' || line[j] == ' ' || line[j] == '\t' {
+				separator = j
+				break
+			}
+		}
+		key := line[:separator]
+		value := strings.TrimLeft(line[separator:], " \t\f")
+		if value != "" && (value[0] == '=' || value[0] == ':') {
+			value = strings.TrimLeft(value[1:], " \t\f")
+		}
+		values = append(values, configValue{path: unescapeProperties(key), value: unescapeProperties(value), lineNumber: lineNumber})
+	}
+	return values, nil
+}
+
+func parseTOMLConfig(content []byte) ([]configValue, error) {
+	values := []configValue{}
+	arrayTables := map[string]int{}
+	table := ""
+
+	parser := unstable.Parser{}
+	parser.Reset(content)
+	for parser.NextExpression() {
+		expression := parser.Expression()
+		switch expression.Kind {
+		case unstable.Table, unstable.ArrayTable:
+			table = tomlKey(expression.Key())
+			if expression.Kind == unstable.ArrayTable {
+				index := arrayTables[table]
+				arrayTables[table]++
+				table = fmt.Sprintf("%s[%d]", table, index)
+			}
+		case unstable.KeyValue:
+			walkTOMLKeyValue(&parser, expression, table, &values)
+		}
+	}
+	if err := parser.Error(); err != nil {
+		return nil, fmt.Errorf("could not parse toml: %w", err)
+	}
+	return values, nil
+}
+
+func tomlKey(it unstable.Iterator) string {
+	parts := []string{}
+	for it.Next() {
+		parts = append(parts, string(it.Node().Data))
+	}
+	return strings.Join(parts, ".")
+}
+
+func walkTOMLKeyValue(parser *unstable.Parser, node *unstable.Node, path string, values *[]configValue) {
+	it := node.Key()
+	lineNumber := 0
+	if it.Next() {
+		lineNumber = parser.Shape(it.Node().Raw).Start.Line
+	}
+	walkTOMLValue(parser, node.Value(), joinConfigPath(path, tomlKey(node.Key())), lineNumber, values)
+}
+
+func walkTOMLValue(parser *unstable.Parser, node *unstable.Node, path string, lineNumber int, values *[]configValue) {
+	switch node.Kind {
+	case unstable.String:
+		if node.Raw.Length > 0 {
+			lineNumber = parser.Shape(node.Raw).Start.Line
+		}
+		*values = append(*values, configValue{path: path, value: string(node.Data), lineNumber: lineNumber})
+	case unstable.Integer:
+		*values = append(*values, configValue{path: path, value: string(node.Data), lineNumber: lineNumber})
+	case unstable.InlineTable:
+		children := node.Children()
+		for children.Next() {
+			walkTOMLKeyValue(parser, children.Node(), path, values)
+		}
+	case unstable.Array:
+		children := node.Children()
+		for i := 0; children.Next(); i++ {
+			walkTOMLValue(parser, children.Node(), fmt.Sprintf("%s[%d]", path, i), lineNumber, values)
+		}
+	}
+}
+
+var envEscapes = map[byte]string{'n': "\n", 'r': "\r", 't': "\t", '"': "\"", '\\': "\\", '$': "$"}
+
+// parseEnvConfig parses .env files. Values can be unquoted, in single quotes
+// (taken literally) or in double quotes, where escapes are replaced and the
+// value can span several lines.
+func parseEnvConfig(content []byte) ([]configValue, error) {
+	values := []configValue{}
+	lines := strings.Split(string(content), "\n")
+	for i := 0; i < len(lines); i++ {
+		lineNumber := i + 1
+		line := strings.TrimSpace(lines[i])
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		line = strings.TrimSpace(strings.TrimPrefix(line, "export "))
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		switch {
+		case strings.HasPrefix(value, "'"):
+			if end := strings.Index(value[1:], "'"); end >= 0 {
+				value = value[1 : end+1]
+			}
+		case strings.HasPrefix(value, "\""):
+			var builder strings.Builder
+			rest := value[1:]
+			for closed := false; !closed; {
+				for j := 0; j < len(rest); j++ {
+					if rest[j] == '\\' && j+1 < len(rest) {
+						if escaped, ok := envEscapes[rest[j+1]]; ok {
+							builder.WriteString(escaped)
+							j++
+							continue
+						}
+					}
+					if rest[j] == '"' {
+						closed = true
+						break
+					}
+					builder.WriteByte(rest[j])
+				}
+				if closed || i+1 == len(lines) {
+					break
+				}
+				i++
+				builder.WriteByte('\n')
+				rest = strings.TrimRight(lines[i], "\r")
+			}
+			value = builder.String()
+		default:
+			if comment := strings.Index(value, " #"); comment >= 0 {
+				value = strings.TrimSpace(value[:comment])
+			}
+		}
+		values = append(values, configValue{path: key, value: value, lineNumber: lineNumber})
+	}
+	return values, nil
+}
+
+// splitConnectionString splits connection strings made of several key=value
+// pairs separated by semicolons, such as the ADO.NET and JDBC ones, into
+// values whose path is the path of the connection string followed by the key.
+// Other values are returned unchanged.
+func splitConnectionString(value configValue) []configValue {
+	if !strings.Contains(value.value, ";") || strings.Count(value.value, "=") < 2 {
+		return []configValue{value}
+	}
+	values := []configValue{value}
+	for _, part := range strings.Split(value.value, ";") {
+		key, partValue, ok := strings.Cut(part, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" || !utf8.ValidString(key) {
+			continue
+		}
+		values = append(values, configValue{
+			path:       joinConfigPath(value.path, key),
+			value:      strings.Trim(strings.TrimSpace(partValue), `"'`),
+			lineNumber: value.lineNumber,
+		})
+	}
+	return values
+}
+
+const configSecretName = "Config Secret"
+const configSecretDetectorID = "config-secret"
+
+// maxConfigFileSize is the size of the largest file that is parsed as a
+// structured config. Larger files are only scanned line by line.
+const maxConfigFileSize = 10 << 20
+
+// configSecretKeys are the words that mark the key of a config value as a
+// secret, with the probability they give to the value on their own. The key
+// is compared in lowercase, without separators.
+var configSecretKeys = []struct {
+	word        string
+	probability float64
+}{
+	{"password", 0.8},
+	{"passwd", 0.8},
+	{"pwd", 0.8},
+	{"secret", 0.7},
+	{"apikey", 0.7},
+	{"accesskey", 0.7},
+	{"privatekey", 0.7},
+	{"token", 0.6},
+	{"credential", 0.5},
+	{"pass", 0.5},
+	{"auth", 0.3},
+	{"key", 0.2},
+}
+
+// configNotSecretSuffixes are the ends of keys that describe a secret without
+// containing it, for example password.file or token-expiration.
+var configNotSecretSuffixes = []string{
+	"file", "path", "dir", "length", "size", "policy", "expiration", "expiry",
+	"timeout", "ttl", "type", "algorithm", "name", "id", "enabled", "url", "header",
+}
+
+// configUsernameKeys are the keys whose value is used as the username of the
+// secrets with the same parent path.
+var configUsernameKeys = []string{"user", "username", "userid", "uid", "login"}
+
+func normalizeConfigKey(key string) string {
+	return strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToLower(key))
+}
+
+func lastConfigKey(path string) string {
+	key := path[strings.LastIndex(path, ".")+1:]
+	if index := strings.Index(key, "["); index > 0 {
+		key = key[:index]
+	}
+	return key
+}
+
+func parentConfigPath(path string) string {
+	if index := strings.LastIndex(path, "."); index >= 0 {
+		return path[:index]
+	}
+	return ""
+}
+
+// configKeyProbability returns the probability that the value of the key is a
+// secret, based only on the name of the key.
+func configKeyProbability(path string) float64 {
+	key := normalizeConfigKey(lastConfigKey(path))
+	for _, suffix := range configNotSecretSuffixes {
+		if key != suffix && strings.HasSuffix(key, suffix) {
+			return 0
+		}
+	}
+	for _, secretKey := range configSecretKeys {
+		if strings.Contains(key, secretKey.word) {
+			return secretKey.probability
+		}
+	}
+	return 0
+}
+
+// isConfigPlaceholder returns true for values that reference a secret stored
+// elsewhere, such as ${DB_PASSWORD} or {{ .Values.password }}.
+func isConfigPlaceholder(value string) bool {
+	return strings.Contains(value, "${") || strings.Contains(value, "{{") ||
+		strings.HasPrefix(value, "$") || strings.HasPrefix(value, "%(") ||
+		(strings.HasPrefix(value, "<") && strings.HasSuffix(value, ">"))
+}
+
+// extractFromConfig scans a structured config file both line by line and by
+// its key paths. The values whose key looks like a secret are scored by
+// combining the probability given by the key with the one of the value, so
+// short passwords under a password key are found even if they are not random
+// enough on their own.
+func (fs *FileScanner) extractFromConfig(ctx context.Context, fileName string, parser configParser, content []byte, onLine func(lineNumber int, line string)) ([]ExtractResult, error) {
+	results, err := fs.extractFromLines(ctx, fileName, bytes.NewReader(content), onLine)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.Contains(content, []byte("kind: Config")) {
+		results = append(results, fs.keepBlockResults(ctx, kubeconfigCredentials(fileName, content))...)
+	}
+
+	parsed, err := parser(content)
+	if err != nil {
+		slog.DebugContext(ctx, "Could not parse config file", "fileName", fileName, "error", err)
+		return results, nil
+	}
+
+	values := []configValue{}
+	for _, value := range parsed {
+		values = append(values, splitConnectionString(value)...)
+	}
+
+	usernames := map[string]string{}
+	for _, value := range values {
+		if slices.Contains(configUsernameKeys, normalizeConfigKey(lastConfigKey(value.path))) {
+			usernames[parentConfigPath(value.path)] = value.value
+		}
+	}
+
+	// Values already reported by a detector on their line, or inside a
+	// secret that spans several lines, are skipped.
+	found := map[string]struct{}{}
+	blockLines := map[int]struct{}{}
+	for _, result := range results {
+		found[fmt.Sprintf("%d:%s", result.LineNumber, result.Password)] = struct{}{}
+		for line := result.LineNumber + 1; line <= result.EndLineNumber; line++ {
+			blockLines[line] = struct{}{}
+		}
+		if result.EndLineNumber > result.LineNumber {
+			blockLines[result.LineNumber] = struct{}{}
+		}
+	}
+
+	lines := strings.Split(string(content), "\n")
+	probability := fs.calculateProbabilityCommonWithMultiplier(1)
+	for _, value := range values {
+		keyProbability := configKeyProbability(value.path)
+		if keyProbability == 0 || value.value == "" || isConfigPlaceholder(value.value) || strings.Contains(value.value, "\n") {
+			continue
+		}
+		if value.lineNumber < 1 || value.lineNumber > len(lines) {
+			continue
+		}
+		if _, ok := found[fmt.Sprintf("%d:%s", value.lineNumber, value.value)]; ok {
+			continue
+		}
+		if _, ok := blockLines[value.lineNumber]; ok {
+			continue
+		}
+
+		line := strings.TrimRight(lines[value.lineNumber-1], "\r")
+		previousLines := strings.Join(lines[max(value.lineNumber-1-keepPreviousLines, 0):value.lineNumber-1], "\n")
+		result := ExtractResult{
+			Name:          configSecretName,
+			DetectorID:    configSecretDetectorID,
+			Line:          line,
+			LineNumber:    value.lineNumber,
+			EndLineNumber: value.lineNumber,
+			Match:         value.path + "=" + value.value,
+			Username:      usernames[parentConfigPath(value.path)],
+			Password:      value.value,
+			FileName:      fileName,
+			PreviousLines: previousLines,
+		}
+		// Combine the key and the value as independent evidence.
+		result.Probability = 1 - (1-keyProbability)*(1-probability(line, value.value))
+
+		if !fs.keepResult(ctx, fs.allowlists, &result) {
+			continue
+		}
+		found[fmt.Sprintf("%d:%s", value.lineNumber, value.value)] = struct{}{}
+		results = append(results, result)
+	}
+	return results, nil
+}
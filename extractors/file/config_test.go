@@ -0,0 +1,115 @@
+package file
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestConfigParsers(t *testing.T) {
+	tests := []struct {
+		fileName string
+		content  string
+		path     string
+		value    string
+		line     int
+	}{
+		{"application.yml", "spring:\n  datasource:\n    username: app\n    password: s3cr3t\n", "spring.datasource.password", "s3cr3t", 4},
+		{"values.yaml", "a: 1\n---\nusers:\n  - name: admin\n    password: s3cr3t\n", "users[0].password", "s3cr3t", 5},
+		{"appsettings.json", "{\n\t\"Database\": {\n\t\t\"Password\": \"s3cr3t\",\n\t\t\"Port\": 5432\n\t}\n}\n", "Database.Password", "s3cr3t", 3},
+		{"appsettings.json", "{\"hosts\": [\"a\", {\"token\": \"s3cr3t\"}]}", "hosts[1].token", "s3cr3t", 1},
+		{"web.config", "<configuration>\n  <appSettings>\n    <add key=\"DbPassword\"\n         value=\"s3cr3t\" />\n  </appSettings>\n</configuration>\n", "configuration.appSettings.DbPassword", "s3cr3t", 4},
+		{"settings.xml", "<settings>\n  <server>\n    <password>\n      s3cr3t\n    </password>\n  </server>\n</settings>\n", "settings.server.password", "s3cr3t", 4},
+		{"application.properties", "# comment\nspring.datasource.url=jdbc:postgresql://db/app\nspring.datasource.password = s3cr\\\n    3t\n", "spring.datasource.password", "s3cr3t", 3},
+		{"application.properties", "db.password: \\u0073ecret\n", "db.password", "secret", 1},
+		{"config.toml", "title = \"x\"\n\n[database]\nuser = \"app\"\npassword = \"s3cr3t\"\n", "database.password", "s3cr3t", 5},
+		{"config.toml", "[[servers]]\nname = \"a\"\n[[servers]]\nauth = { token = \"s3cr3t\" }\n", "servers[1].auth.token", "s3cr3t", 4},
+		{".env", "# comment\nexport DB_PASSWORD='s3cr3t' # quoted\nAPI_KEY=abc\n", "DB_PASSWORD", "s3cr3t", 2},
+		{"prod.env", "KEY=\"line1\\nline2\"\nTOKEN=s3cr3t # comment\n", "TOKEN", "s3cr3t", 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fileName+" "+tt.path, func(t *testing.T) {
+			parser := configParserForFile(tt.fileName)
+			if parser == nil {
+				t.Fatalf("no parser for %s", tt.fileName)
+			}
+			values, err := parser([]byte(tt.content))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, value := range values {
+				if value.path == tt.path {
+					if value.value != tt.value || value.lineNumber != tt.line {
+						t.Errorf("%s = %q on line %d, want %q on line %d", tt.path, value.value, value.lineNumber, tt.value, tt.line)
+					}
+					return
+				}
+			}
+			t.Errorf("%s not found in %+v", tt.path, values)
+		})
+	}
+}
+
+func TestExtractFromConfig(t *testing.T) {
+	fs, err := NewScanner()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		fileName string
+		content  string
+		username string
+		password string
+		line     int
+	}{
+		{
+			name:     "nested yaml",
+			fileName: "application.yaml",
+			content:  "spring:\n  datasource:\n    username: app\n    password:\n      hunter22\n",
+			username: "app",
+			password: "hunter22",
+			line:     5,
+		},
+		{
+			name:     "connection string",
+			fileName: "web.config",
+			content:  "<configuration>\n  <connectionStrings>\n    <add name=\"Default\" connectionString=\"Server=db;User Id=sa;Password=hunter22;\" />\n  </connectionStrings>\n</configuration>\n",
+			username: "sa",
+			password: "hunter22",
+			line:     3,
+		},
+		{
+			name:     "placeholder",
+			fileName: "application.properties",
+			content:  "spring.datasource.password=${DB_PASSWORD}\nspring.datasource.password-file=/run/secrets/db\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := fs.ExtractFromReader(context.Background(), tt.fileName, strings.NewReader(tt.content))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var found *ExtractResult
+			for i := range results {
+				if results[i].DetectorID == configSecretDetectorID {
+					found = &results[i]
+				}
+			}
+			if tt.password == "" {
+				if found != nil {
+					t.Errorf("found %v, want no config secret", found)
+				}
+				return
+			}
+			if found == nil {
+				t.Fatalf("no config secret in %v", results)
+			}
+			if found.Username != tt.username || found.Password != tt.password || found.LineNumber != tt.line {
+				t.Errorf("got %s:%s on line %d, want %s:%s on line %d", found.Username, found.Password, found.LineNumber, tt.username, tt.password, tt.line)
+			}
+		})
+	}
+}
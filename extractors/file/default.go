@@ -2,10 +2,8 @@ package file
 
 import (
 	"bufio"
-	"encoding/base64"
-	"fmt"
 	"math"
-	"regexp"
+	"slices"
 	"strings"
 )
 
@@ -14,70 +12,56 @@ const defaultProbabilityIncreaseMultiplier = 2.0
 const defaultEntropyThresholdMidpoint = 40
 const defaultLogisticGrowthRate = 0.2
 
-func (fs *FileScanner) getSecretTypes() []secretType {
-	calculateProbabilityCommonWithMultiplier := func(multiplier float64) func(string, string) float64 {
-		return func(line string, match string) float64 {
-			entropy := shannonEntropy(match)
-			probability := 1.0 / (1.0 + math.Exp(-fs.options.logisticGrowthRate*float64(entropy-fs.options.entropyThresholdMidpoint)))
+func (fs *FileScanner) calculateProbabilityCommonWithMultiplier(multiplier float64) func(string, string) float64 {
+	return func(line string, match string) float64 {
+		entropy := shannonEntropy(match)
+		probability := 1.0 / (1.0 + math.Exp(-fs.options.logisticGrowthRate*float64(entropy-fs.options.entropyThresholdMidpoint)))
 
-			scanner := bufio.NewScanner(strings.NewReader(strings.ToLower(match)))
-			scanner.Split(bufio.ScanWords)
+		scanner := bufio.NewScanner(strings.NewReader(strings.ToLower(match)))
+		scanner.Split(bufio.ScanWords)
 
-			for scanner.Scan() {
-				lower := strings.ToLower(scanner.Text())
-				if _, ok := fs.wordsIncreaseProbability[lower]; ok {
-					probability *= fs.options.probabilityDecreaseMultiplier
-				}
-				if _, ok := fs.wordsIncreaseProbability[lower]; ok {
-					probability *= fs.options.probabilityIncreaseMultiplier
-				}
+		for scanner.Scan() {
+			lower := strings.ToLower(scanner.Text())
+			if _, ok := fs.wordsIncreaseProbability[lower]; ok {
+				probability *= fs.options.probabilityDecreaseMultiplier
+			}
+			if _, ok := fs.wordsIncreaseProbability[lower]; ok {
+				probability *= fs.options.probabilityIncreaseMultiplier
 			}
-			return math.Min(probability*multiplier, 1.0)
 		}
+		return math.Min(probability*multiplier, 1.0)
 	}
+}
+
+// getSecretTypes builds the secret types from the enabled detectors of the
+// configured detector sets.
+func (fs *FileScanner) getSecretTypes() []secretType {
+	detectors, allowlists := mergeDetectorSets(fs.options.detectorSets)
 
-	return []secretType{
-		{
-			regex:       regexp.MustCompile(`(?i)(_|[a-zA-Z])*(password|passwd|pwd|pass)(_|[a-zA-Z])* *(=|:|:=) *(?P<password>[a-zA-Z_\-\.]+)`),
-			probability: calculateProbabilityCommonWithMultiplier(1),
-			name:        "Generic Password",
-		},
-		{
-			regex: regexp.MustCompile(`(?i)postgres:\/\/(?P<username>[^:]+)( *)(=|:)( *)(?P<password>[^@]+)@`),
-			name:  "Postgres Connection String",
-		},
-		{
-			regex:       regexp.MustCompile(`(?i)(?P<username>[a-zA-Z0-9\-\.]+)(=|:)(?P<password>[a-zA-Z0-9\-\.]+)@`),
-			name:        "Generic Connection String",
-			probability: calculateProbabilityCommonWithMultiplier(1),
-		},
-		{
-			regex: regexp.MustCompile(`(?i)mongodb:\/\/(?P<username>[^:]+)( *)(=|:)( *)(?P<password>[^@]+)@`),
-			name:  "MongoDB Connection String",
-		},
-		{
-			regex: regexp.MustCompile(`(?i)(?P<username>(?:\b|_)([a-zA-Z0-9_]*(?:api|key|token)[a-zA-Z0-9_]*))(=|:)("|')?(?P<password>[a-zA-Z0-9_\-\.]+)(?:\b|_)("|')?`),
-			name:  "Generic Environment Variable",
-		},
-		{
-			regex:       regexp.MustCompile(`(?:[A-Za-z0-9+\/]{4})*(?:[A-Za-z0-9+\/]{4}|[A-Za-z0-9+\/]{3}=|[A-Za-z0-9+\/]{2}={2})`),
-			probability: calculateProbabilityCommonWithMultiplier(2),
-			name:        "Generic Base64",
-			postProcessing: func(match string) (string, error) {
-				if len(match) < 7 {
-					return "", fmt.Errorf("too short")
-				}
-				str, err := base64.StdEncoding.DecodeString(match)
-				if err != nil {
-					return "", err
-				}
-				if !isASCII(str) {
-					return "", fmt.Errorf("not ascii")
-				}
-				return string(str), nil
-			},
-		},
+	secretTypes := make([]secretType, 0, len(detectors))
+	for _, detector := range detectors {
+		st := secretType{
+			id:          detector.ID,
+			name:        detector.Name,
+			regex:       detector.regex,
+			path:        detector.path,
+			secretGroup: detector.SecretGroup,
+			keywords:    detector.Keywords,
+			entropy:     detector.Entropy,
+			allowlists:  append(slices.Clone(detector.Allowlists), allowlists...),
+		}
+		if detector.ProbabilityMultiplier > 0 {
+			st.probability = fs.calculateProbabilityCommonWithMultiplier(detector.ProbabilityMultiplier)
+		}
+		if detector.PostProcessing != "" {
+			st.postProcessing = postProcessors[detector.PostProcessing]
+		}
+		if detector.Validator != "" {
+			st.validator = tokenValidators[detector.Validator]
+		}
+		secretTypes = append(secretTypes, st)
 	}
+	return secretTypes
 }
 
 var defaultWordsReduceProbability = []string{
@@ -0,0 +1,348 @@
+package file
+
+import (
+	_ "embed"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	DetectorSetFormatYAML     = "yaml"
+	DetectorSetFormatGitleaks = "gitleaks"
+)
+
+const (
+	AllowlistTargetSecret = "secret"
+	AllowlistTargetMatch  = "match"
+	AllowlistTargetLine   = "line"
+)
+
+var (
+	ErrInvalidDetector          = errors.New("invalid detector")
+	ErrUnknownDetectorSetFormat = errors.New("unknown detector set format")
+)
+
+//go:embed detectors/default.yaml
+var defaultDetectorSet []byte
+
+// Allowlist ignores the results of a detector. A result is ignored if its
+// secret, match or line (depending on RegexTarget) matches one of Regexes,
+// if the file matches one of Paths or if the secret contains one of
+// Stopwords.
+type Allowlist struct {
+	Regexes     []string `yaml:"regexes" json:"regexes"`
+	RegexTarget string   `yaml:"regex_target" json:"regex_target"`
+	Paths       []string `yaml:"paths" json:"paths"`
+	Stopwords   []string `yaml:"stopwords" json:"stopwords"`
+
+	regexes []*regexp.Regexp
+	paths   []*regexp.Regexp
+}
+
+// Detector finds secrets in a line. The username and password are taken from
+// the named groups username and password of Regex, or the password from
+// SecretGroup if there is no password group. Lines that contain none of the
+// Keywords are skipped without running the regex.
+type Detector struct {
+	ID          string   `yaml:"id" json:"id"`
+	Name        string   `yaml:"name" json:"name"`
+	Regex       string   `yaml:"regex" json:"regex"`
+	SecretGroup int      `yaml:"secret_group" json:"secret_group"`
+	Keywords    []string `yaml:"keywords" json:"keywords"`
+	// Path restricts the detector to the files that match it.
+	Path string `yaml:"path" json:"path"`
+	// Entropy is the minimum Shannon entropy in bits per character of the
+	// secret. Secrets with a lower or equal entropy are ignored.
+	Entropy float64 `yaml:"entropy" json:"entropy"`
+	// ProbabilityMultiplier enables the probability computed from the
+	// entropy and the words of the line, multiplied by this value. Results of
+	// detectors without it have a probability of 1, or the one given by the
+	// confidence of their Validator.
+	ProbabilityMultiplier float64 `yaml:"probability_multiplier" json:"probability_multiplier"`
+	PostProcessing        string  `yaml:"post_processing" json:"post_processing"`
+	// Validator checks the secret offline, such as the checksum of GitHub
+	// tokens, and drops the secrets that are not valid tokens.
+	Validator  string      `yaml:"validator" json:"validator"`
+	Allowlists []Allowlist `yaml:"allowlists" json:"allowlists"`
+	Disabled   bool        `yaml:"disabled" json:"disabled"`
+
+	regex *regexp.Regexp
+	path  *regexp.Regexp
+}
+
+// DetectorSet is a list of detectors loaded from a file or the database.
+// When several sets are used, detectors with an ID that already exists
+// replace the previous definition and the IDs in Disable are turned off. The
+// Allowlists of a set apply to the detectors of every set.
+type DetectorSet struct {
+	Name       string      `yaml:"name" json:"name"`
+	Disable    []string    `yaml:"disable" json:"disable"`
+	Allowlists []Allowlist `yaml:"allowlists" json:"allowlists"`
+	Detectors  []Detector  `yaml:"detectors" json:"detectors"`
+}
+
+// postProcessors change the match of a detector before it is reported. An
+// error drops the match.
+var postProcessors = map[string]func(string) (string, error){
+	"base64": func(match string) (string, error) {
+		if len(match) < 7 {
+			return "", fmt.Errorf("too short")
+		}
+		str, err := base64.StdEncoding.DecodeString(match)
+		if err != nil {
+			return "", err
+		}
+		if !isASCII(str) {
+			return "", fmt.Errorf("not ascii")
+		}
+		return string(str), nil
+	},
+}
+
+func (a *Allowlist) compile() error {
+	switch a.RegexTarget {
+	case "":
+		a.RegexTarget = AllowlistTargetSecret
+	case AllowlistTargetSecret, AllowlistTargetMatch, AllowlistTargetLine:
+	default:
+		return fmt.Errorf("unknown allowlist regex target %q", a.RegexTarget)
+	}
+
+	a.regexes = make([]*regexp.Regexp, len(a.Regexes))
+	for i, expr := range a.Regexes {
+		regex, err := regexp.Compile(expr)
+		if err != nil {
+			return fmt.Errorf("invalid allowlist regex: %w", err)
+		}
+		a.regexes[i] = regex
+	}
+	a.paths = make([]*regexp.Regexp, len(a.Paths))
+	for i, expr := range a.Paths {
+		regex, err := regexp.Compile(expr)
+		if err != nil {
+			return fmt.Errorf("invalid allowlist path: %w", err)
+		}
+		a.paths[i] = regex
+	}
+	for i := range a.Stopwords {
+		a.Stopwords[i] = strings.ToLower(a.Stopwords[i])
+	}
+	return nil
+}
+
+// allows returns true if the result is ignored by the allowlist.
+func (a *Allowlist) allows(fileName string, line string, match string, secret string) bool {
+	target := secret
+	switch a.RegexTarget {
+	case AllowlistTargetMatch:
+		target = match
+	case AllowlistTargetLine:
+		target = line
+	}
+	for _, regex := range a.regexes {
+		if regex.MatchString(target) {
+			return true
+		}
+	}
+	for _, regex := range a.paths {
+		if regex.MatchString(fileName) {
+			return true
+		}
+	}
+	lower := strings.ToLower(secret)
+	for _, stopword := range a.Stopwords {
+		if strings.Contains(lower, stopword) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Detector) compile() error {
+	if d.ID == "" {
+		return fmt.Errorf("%w: missing id", ErrInvalidDetector)
+	}
+	if d.Name == "" {
+		d.Name = d.ID
+	}
+
+	if d.Regex == "" {
+		return fmt.Errorf("%w: detector %s has no regex", ErrInvalidDetector, d.ID)
+	}
+	regex, err := regexp.Compile(d.Regex)
+	if err != nil {
+		return fmt.Errorf("%w: detector %s has an invalid regex: %w", ErrInvalidDetector, d.ID, err)
+	}
+	if d.SecretGroup < 0 || d.SecretGroup > regex.NumSubexp() {
+		return fmt.Errorf("%w: detector %s has no group %d", ErrInvalidDetector, d.ID, d.SecretGroup)
+	}
+	d.regex = regex
+
+	d.path = nil
+	if d.Path != "" {
+		path, err := regexp.Compile(d.Path)
+		if err != nil {
+			return fmt.Errorf("%w: detector %s has an invalid path: %w", ErrInvalidDetector, d.ID, err)
+		}
+		d.path = path
+	}
+
+	if d.PostProcessing != "" {
+		if _, ok := postProcessors[d.PostProcessing]; !ok {
+			return fmt.Errorf("%w: detector %s has unknown post processing %q", ErrInvalidDetector, d.ID, d.PostProcessing)
+		}
+	}
+
+	if d.Validator != "" {
+		if _, ok := tokenValidators[d.Validator]; !ok {
+			return fmt.Errorf("%w: detector %s has unknown validator %q", ErrInvalidDetector, d.ID, d.Validator)
+		}
+	}
+
+	for i := range d.Keywords {
+		d.Keywords[i] = strings.ToLower(d.Keywords[i])
+	}
+	for i := range d.Allowlists {
+		if err := d.Allowlists[i].compile(); err != nil {
+			return fmt.Errorf("%w: detector %s: %w", ErrInvalidDetector, d.ID, err)
+		}
+	}
+	return nil
+}
+
+func (set *DetectorSet) compile() error {
+	for i := range set.Detectors {
+		if err := set.Detectors[i].compile(); err != nil {
+			return err
+		}
+	}
+	for i := range set.Allowlists {
+		if err := set.Allowlists[i].compile(); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidDetector, err)
+		}
+	}
+	return nil
+}
+
+// ParseDetectorSet parses a detector set in YAML or JSON.
+func ParseDetectorSet(content []byte) (*DetectorSet, error) {
+	var set DetectorSet
+	if err := yaml.Unmarshal(content, &set); err != nil {
+		return nil, fmt.Errorf("could not parse detector set: %w", err)
+	}
+	if err := set.compile(); err != nil {
+		return nil, err
+	}
+	return &set, nil
+}
+
+// ParseDetectorSetFormat parses a detector set stored in the given format,
+// which is either DetectorSetFormatYAML or DetectorSetFormatGitleaks.
+func ParseDetectorSetFormat(format string, content []byte) (*DetectorSet, error) {
+	switch format {
+	case DetectorSetFormatYAML, "":
+		return ParseDetectorSet(content)
+	case DetectorSetFormatGitleaks:
+		return ParseGitleaksConfig(content)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownDetectorSetFormat, format)
+	}
+}
+
+// LoadDetectorSet reads a detector set from a file. Files ending in .toml
+// are imported as gitleaks configurations.
+func LoadDetectorSet(path string) (*DetectorSet, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read detector set: %w", err)
+	}
+	format := DetectorSetFormatYAML
+	if strings.ToLower(filepath.Ext(path)) == ".toml" {
+		format = DetectorSetFormatGitleaks
+	}
+	set, err := ParseDetectorSetFormat(format, content)
+	if err != nil {
+		return nil, fmt.Errorf("could not load detector set %s: %w", path, err)
+	}
+	return set, nil
+}
+
+// DefaultDetectors returns the builtin detector set.
+func DefaultDetectors() *DetectorSet {
+	set, err := ParseDetectorSet(defaultDetectorSet)
+	if err != nil {
+		panic(fmt.Errorf("could not load builtin detectors: %w", err))
+	}
+	return set
+}
+
+// mergeDetectorSets returns the enabled detectors of the sets, in the order
+// they were first defined, and the allowlists shared by all of them.
+func mergeDetectorSets(sets []*DetectorSet) ([]Detector, []Allowlist) {
+	detectors := map[string]Detector{}
+	order := []string{}
+	allowlists := []Allowlist{}
+	for _, set := range sets {
+		for _, detector := range set.Detectors {
+			if _, ok := detectors[detector.ID]; !ok {
+				order = append(order, detector.ID)
+			}
+			detectors[detector.ID] = detector
+		}
+		for _, id := range set.Disable {
+			if detector, ok := detectors[id]; ok {
+				detector.Disabled = true
+				detectors[id] = detector
+			}
+		}
+		allowlists = append(allowlists, set.Allowlists...)
+	}
+
+	result := []Detector{}
+	for _, id := range order {
+		if !detectors[id].Disabled {
+			result = append(result, detectors[id])
+		}
+	}
+	return result, allowlists
+}
+
+// matchesKeywords returns true if the lowercase line contains one of the
+// keywords, or if there are no keywords.
+func matchesKeywords(lowerLine string, keywords []string) bool {
+	if len(keywords) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(keywords, func(keyword string) bool {
+		return strings.Contains(lowerLine, keyword)
+	})
+}
+
+// entropyPerCharacter returns the Shannon entropy of value in bits per
+// character, the same measure that gitleaks uses for its thresholds.
+func entropyPerCharacter(value string) float64 {
+	if value == "" {
+		return 0
+	}
+	frequency := map[rune]float64{}
+	length := 0.0
+	for _, r := range value {
+		frequency[r]++
+		length++
+	}
+	var entropy float64
+	for _, count := range frequency {
+		f := count / length
+		entropy -= f * math.Log2(f)
+	}
+	return entropy
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"regexp"
 	"strings"
+	"time"
 
 	"log/slog"
 
@@ -17,10 +18,18 @@ const maxMatchesPerLine = 100
 const keepPreviousLines = 5
 
 type secretType struct {
+	id             string
 	regex          *regexp.Regexp
 	probability    func(line string, match string) float64
 	name           string
 	postProcessing func(string) (string, error)
+	validator      func(string, time.Time) (tokenValidation, bool)
+
+	path        *regexp.Regexp
+	secretGroup int
+	keywords    []string
+	entropy     float64
+	allowlists  []Allowlist
 }
 
 type FileScanner struct {
@@ -32,6 +41,7 @@ type FileScanner struct {
 	options options
 
 	secretTypes []secretType
+	allowlists  []Allowlist
 
 	initiated bool
 }
@@ -64,6 +74,7 @@ func NewScanner(opts ...Option) (*FileScanner, error) {
 	}
 
 	fs.secretTypes = fs.getSecretTypes()
+	_, fs.allowlists = mergeDetectorSets(o.detectorSets)
 	return fs, nil
 }
 
@@ -77,11 +88,14 @@ func init() {
 	}
 }
 
-func (fs *FileScanner) createResult(ctx context.Context, secretType secretType, match string, fileName string, lineNumber int, line string, previousLines string) (ExtractResult, bool, error) {
+func (fs *FileScanner) createResult(ctx context.Context, secretType secretType, submatches []string, fileName string, lineNumber int, line string, previousLines string) (ExtractResult, bool, error) {
+	match := submatches[0]
 	result := ExtractResult{
 		Name:          secretType.name,
+		DetectorID:    secretType.id,
 		Line:          line,
 		LineNumber:    lineNumber,
+		EndLineNumber: lineNumber,
 		PreviousLines: previousLines,
 		FileName:      fileName,
 	}
@@ -94,51 +108,97 @@ func (fs *FileScanner) createResult(ctx context.Context, secretType secretType,
 		match = postProcessed
 	}
 
-	if len(secretType.regex.SubexpNames()) > 0 {
-		for i, name := range secretType.regex.SubexpNames() {
-			if i != 0 && name != "" {
-				switch name {
-				case "username":
-					result.Username = secretType.regex.FindStringSubmatch(line)[i]
-				case "password":
-					result.Password = secretType.regex.FindStringSubmatch(line)[i]
-				}
+	for i, name := range secretType.regex.SubexpNames() {
+		if i != 0 && name != "" {
+			switch name {
+			case "username":
+				result.Username = submatches[i]
+			case "password":
+				result.Password = submatches[i]
 			}
 		}
 	}
+	if result.Password == "" && secretType.secretGroup > 0 {
+		result.Password = submatches[secretType.secretGroup]
+	}
 
 	result.Match = strings.TrimSpace(match)
 
+	secret := result.Password
+	if secret == "" {
+		secret = result.Match
+	}
+	if secretType.entropy > 0 && entropyPerCharacter(secret) <= secretType.entropy {
+		slog.DebugContext(ctx, "Entropy too low", "detector", secretType.id, "fileName", fileName, "lineNumber", lineNumber)
+		return ExtractResult{}, false, nil
+	}
+
+	if secretType.validator != nil {
+		validation, ok := secretType.validator(secret, time.Now())
+		if !ok {
+			slog.DebugContext(ctx, "Token failed validation", "detector", secretType.id, "fileName", fileName, "lineNumber", lineNumber)
+			return ExtractResult{}, false, nil
+		}
+		result.Provider = validation.provider
+		result.Confidence = validation.confidence
+		result.Claims = validation.claims
+		result.ExpiresAt = validation.expiresAt
+	}
+
 	if secretType.probability != nil {
 		if result.Password != "" {
 			result.Probability = secretType.probability(result.Line, result.Password)
 		} else {
 			result.Probability = secretType.probability(result.Line, result.Match)
 		}
+	} else if secretType.validator != nil {
+		result.Probability = confidenceProbability[result.Confidence]
 	} else {
 		result.Probability = 1.0
 	}
 
-	if result.Probability < fs.options.minimumProbability {
-		slog.DebugContext(ctx, "Probability too low", "probability", result.Probability, "fileName", fileName, "lineNumber", lineNumber)
+	if !fs.keepResult(ctx, secretType.allowlists, &result) {
 		return ExtractResult{}, false, nil
 	}
 
+	return result, true, nil
+}
+
+// keepResult returns false if the result is ignored by one of the
+// allowlists, has a too low probability or an ignored username or password.
+// The kept results have their fields filtered of unusual characters.
+func (fs *FileScanner) keepResult(ctx context.Context, allowlists []Allowlist, result *ExtractResult) bool {
+	secret := result.Password
+	if secret == "" {
+		secret = result.Match
+	}
+	for _, allowlist := range allowlists {
+		if allowlist.allows(result.FileName, result.Line, result.Match, secret) {
+			slog.DebugContext(ctx, "Result allowed by allowlist", "detector", result.DetectorID, "fileName", result.FileName, "lineNumber", result.LineNumber)
+			return false
+		}
+	}
+
+	if result.Probability < fs.options.minimumProbability {
+		slog.DebugContext(ctx, "Probability too low", "probability", result.Probability, "fileName", result.FileName, "lineNumber", result.LineNumber)
+		return false
+	}
+
 	if result.Password != "" {
 		if len(result.Password) < 4 {
-			slog.DebugContext(ctx, "Password too short", "password", result.Password, "fileName", fileName, "lineNumber", lineNumber)
-			return ExtractResult{}, false, nil
+			slog.DebugContext(ctx, "Password too short", "password", result.Password, "fileName", result.FileName, "lineNumber", result.LineNumber)
+			return false
 		}
 		if _, ok := fs.passwordsCompletelyIgnore[result.Password]; ok {
-			slog.DebugContext(ctx, "Password completely ignored", "password", result.Password, "fileName", fileName, "lineNumber", lineNumber)
-			return ExtractResult{}, false, nil
+			slog.DebugContext(ctx, "Password completely ignored", "password", result.Password, "fileName", result.FileName, "lineNumber", result.LineNumber)
+			return false
 		}
 	}
 
 	if result.Username != "" {
 		if _, ok := fs.usernamesCompletelyIgnore[result.Username]; ok {
-			slog.DebugContext(ctx, "Username completely ignored", "username", result.Username, "fileName", fileName, "lineNumber", lineNumber)
-			return ExtractResult{}, false, nil
+			slog.DebugContext(ctx, "Username completely ignored", "username", result.Username, "fileName", result.FileName, "lineNumber", result.LineNumber)
+			return false
 		}
 	}
 
@@ -147,8 +207,7 @@ func (fs *FileScanner) createResult(ctx context.Context, secretType secretType,
 	result.Line = filterAlphanumericCharacters.ReplaceAllString(result.Line, " ")
 	result.Match = filterAlphanumericCharacters.ReplaceAllString(result.Match, " ")
 	result.PreviousLines = filterAlphanumericCharacters.ReplaceAllString(result.PreviousLines, " ")
-
-	return result, true, nil
+	return true
 }
 
 func (fs *FileScanner) ExtractFromLine(ctx context.Context, fileName string, lineNumber int, line string, previousLines string) ([]ExtractResult, error) {
@@ -158,9 +217,16 @@ func (fs *FileScanner) ExtractFromLine(ctx context.Context, fileName string, lin
 
 	var results []ExtractResult
 
+	lowerLine := strings.ToLower(line)
 	for _, secretType := range fs.secretTypes {
-		for _, match := range secretType.regex.FindAllString(line, maxMatchesPerLine) {
-			result, ok, err := fs.createResult(ctx, secretType, match, fileName, lineNumber, line, previousLines)
+		if secretType.path != nil && !secretType.path.MatchString(fileName) {
+			continue
+		}
+		if !matchesKeywords(lowerLine, secretType.keywords) {
+			continue
+		}
+		for _, submatches := range secretType.regex.FindAllStringSubmatch(line, maxMatchesPerLine) {
+			result, ok, err := fs.createResult(ctx, secretType, submatches, fileName, lineNumber, line, previousLines)
 			if err != nil {
 				return nil, err
 			}
@@ -181,17 +247,63 @@ func (fs *FileScanner) ExtractFromLine(ctx context.Context, fileName string, lin
 	return results, nil
 }
 
-func (fs *FileScanner) ExtractFromReader(ctx context.Context, fileName string, rd io.Reader) ([]ExtractResult, error) {
+// SupportsStructuredParsing returns true if the file is a config format that
+// ExtractFromReader parses by key path in addition to the line by line scan.
+func (fs *FileScanner) SupportsStructuredParsing(fileName string) bool {
+	return fs.options.structuredParsing && configParserForFile(fileName) != nil
+}
+
+// ExtractBlocksFromLines runs only the multi-line detectors over consecutive
+// lines, the first of which is firstLine. It is used for diffs, where the
+// lines are otherwise scanned one at a time by ExtractFromLine.
+func (fs *FileScanner) ExtractBlocksFromLines(ctx context.Context, fileName string, firstLine int, lines []string) ([]ExtractResult, error) {
 	if !fs.initiated {
 		return nil, errors.New("FileScanner not initiated")
 	}
 
+	blocks := newBlockScanner(fileName)
 	var results []ExtractResult
-	var lineNumber int
+	for i, line := range lines {
+		results = append(results, fs.keepBlockResults(ctx, blocks.scanLine(firstLine+i, line))...)
+	}
+	return results, nil
+}
+
+// ExtractFromReader scans a file. Archives, compressed files and base64
+// blobs embedded in text files are unpacked recursively within the limits set
+// by WithArchiveLimits, and the results found inside them are named with
+// virtual paths such as app.jar!/BOOT-INF/classes/application.yml. Binary
+// files are scanned by their printable strings.
+func (fs *FileScanner) ExtractFromReader(ctx context.Context, fileName string, rd io.Reader) ([]ExtractResult, error) {
+	if !fs.initiated {
+		return nil, errors.New("FileScanner not initiated")
+	}
 
 	slog.DebugContext(ctx, "Extracting from reader", "fileName", fileName)
 
+	u := &unpacker{
+		fs:     fs,
+		budget: unpackBudget{remaining: fs.options.maxUnpackedSize},
+	}
+	results, err := u.scan(ctx, fileName, rd, 0)
+	if isUnpackLimit(err) {
+		slog.WarnContext(ctx, "Stopped unpacking file", "fileName", fileName, "error", err)
+		return results, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// extractFromLines scans a file line by line, and for the secrets that span
+// several lines. If onLine is set, it is called with every line of the file.
+func (fs *FileScanner) extractFromLines(ctx context.Context, fileName string, rd io.Reader, onLine func(lineNumber int, line string)) ([]ExtractResult, error) {
+	var results []ExtractResult
+	var lineNumber int
+
 	previousLines := []string{}
+	blocks := newBlockScanner(fileName)
 	scanner := bufio.NewScanner(rd)
 	for scanner.Scan() {
 		if lineNumber > keepPreviousLines {
@@ -199,6 +311,9 @@ func (fs *FileScanner) ExtractFromReader(ctx context.Context, fileName string, r
 		}
 		line := scanner.Text()
 		lineNumber++
+		if onLine != nil {
+			onLine(lineNumber, line)
+		}
 
 		extracted, err := fs.ExtractFromLine(ctx, fileName, lineNumber, line, strings.Join(previousLines, "\n"))
 		if err != nil {
@@ -206,6 +321,7 @@ func (fs *FileScanner) ExtractFromReader(ctx context.Context, fileName string, r
 		}
 
 		results = append(results, extracted...)
+		results = append(results, fs.keepBlockResults(ctx, blocks.scanLine(lineNumber, line))...)
 		previousLines = append(previousLines, line)
 
 		select {
@@ -214,5 +330,8 @@ func (fs *FileScanner) ExtractFromReader(ctx context.Context, fileName string, r
 		default:
 		}
 	}
+	if err := scanner.Err(); isUnpackLimit(err) {
+		return results, err
+	}
 	return results, nil
 }
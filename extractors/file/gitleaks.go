@@ -0,0 +1,97 @@
+package file
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+type gitleaksAllowlist struct {
+	RegexTarget string   `toml:"regexTarget"`
+	Regexes     []string `toml:"regexes"`
+	Paths       []string `toml:"paths"`
+	Stopwords   []string `toml:"stopwords"`
+}
+
+type gitleaksRule struct {
+	ID          string              `toml:"id"`
+	Description string              `toml:"description"`
+	Regex       string              `toml:"regex"`
+	SecretGroup int                 `toml:"secretGroup"`
+	Entropy     float64             `toml:"entropy"`
+	Keywords    []string            `toml:"keywords"`
+	Path        string              `toml:"path"`
+	Allowlist   *gitleaksAllowlist  `toml:"allowlist"`
+	Allowlists  []gitleaksAllowlist `toml:"allowlists"`
+}
+
+type gitleaksConfig struct {
+	Title      string              `toml:"title"`
+	Allowlist  *gitleaksAllowlist  `toml:"allowlist"`
+	Allowlists []gitleaksAllowlist `toml:"allowlists"`
+	Rules      []gitleaksRule      `toml:"rules"`
+}
+
+func (a gitleaksAllowlist) toAllowlist() Allowlist {
+	return Allowlist{
+		Regexes:     a.Regexes,
+		RegexTarget: a.RegexTarget,
+		Paths:       a.Paths,
+		Stopwords:   a.Stopwords,
+	}
+}
+
+func gitleaksAllowlists(single *gitleaksAllowlist, multiple []gitleaksAllowlist) []Allowlist {
+	allowlists := []Allowlist{}
+	if single != nil {
+		allowlists = append(allowlists, single.toAllowlist())
+	}
+	for _, allowlist := range multiple {
+		allowlists = append(allowlists, allowlist.toAllowlist())
+	}
+	return allowlists
+}
+
+// ParseGitleaksConfig imports the rules of a gitleaks TOML configuration.
+// The secret of a rule is reported as the password of the result. Rules that
+// only match file paths are skipped, since the file scanner reports lines.
+func ParseGitleaksConfig(content []byte) (*DetectorSet, error) {
+	var config gitleaksConfig
+	if err := toml.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("could not parse gitleaks config: %w", err)
+	}
+
+	set := &DetectorSet{
+		Name:       config.Title,
+		Allowlists: gitleaksAllowlists(config.Allowlist, config.Allowlists),
+	}
+	for _, rule := range config.Rules {
+		if rule.Regex == "" {
+			continue
+		}
+		detector := Detector{
+			ID:          rule.ID,
+			Name:        rule.Description,
+			Regex:       rule.Regex,
+			SecretGroup: rule.SecretGroup,
+			Entropy:     rule.Entropy,
+			Keywords:    rule.Keywords,
+			Path:        rule.Path,
+			Allowlists:  gitleaksAllowlists(rule.Allowlist, rule.Allowlists),
+		}
+		// gitleaks uses the first group as the secret if the rule does not
+		// set one.
+		if detector.SecretGroup == 0 {
+			if regex, err := regexp.Compile(rule.Regex); err == nil && regex.NumSubexp() > 0 {
+				detector.SecretGroup = 1
+			}
+		}
+		set.Detectors = append(set.Detectors, detector)
+	}
+
+	if err := set.compile(); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
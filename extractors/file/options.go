@@ -1,5 +1,7 @@
 package file
 
+import "fmt"
+
 type Option func(*options) error
 
 type options struct {
@@ -13,6 +15,14 @@ type options struct {
 	logisticGrowthRate            float64
 
 	minimumProbability float64
+
+	detectorSets []*DetectorSet
+
+	structuredParsing bool
+
+	maxArchiveDepth     int
+	maxUnpackedSize     int64
+	maxCompressionRatio float64
 }
 
 func WithWordsReduceProbability(useDefault bool, names ...string) Option {
@@ -90,6 +100,50 @@ func WithMinimumProbability(minimumProbability float64) Option {
 	}
 }
 
+// WithDetectorSets sets the detectors used to find secrets. If useDefault is
+// true, the sets are added on top of the builtin detectors, otherwise they
+// replace them.
+func WithDetectorSets(useDefault bool, sets ...*DetectorSet) Option {
+	return func(o *options) error {
+		o.detectorSets = []*DetectorSet{}
+		if useDefault {
+			o.detectorSets = append(o.detectorSets, DefaultDetectors())
+		}
+		for _, set := range sets {
+			if err := set.compile(); err != nil {
+				return err
+			}
+		}
+		o.detectorSets = append(o.detectorSets, sets...)
+		return nil
+	}
+}
+
+// WithStructuredParsing enables parsing YAML, JSON, XML, Java properties,
+// TOML and .env files by key path, in addition to the line by line scan.
+func WithStructuredParsing(structuredParsing bool) Option {
+	return func(o *options) error {
+		o.structuredParsing = structuredParsing
+		return nil
+	}
+}
+
+// WithArchiveLimits sets how archives and compressed files are unpacked:
+// how deep they can be nested, how many bytes can be unpacked from a scanned
+// file and how many times larger than its compressed size a file can be. A
+// depth of 0 disables unpacking.
+func WithArchiveLimits(maxDepth int, maxUnpackedSize int64, maxCompressionRatio float64) Option {
+	return func(o *options) error {
+		if maxDepth < 0 || maxUnpackedSize <= 0 || maxCompressionRatio <= 0 {
+			return fmt.Errorf("invalid archive limits: depth %d, size %d, ratio %f", maxDepth, maxUnpackedSize, maxCompressionRatio)
+		}
+		o.maxArchiveDepth = maxDepth
+		o.maxUnpackedSize = maxUnpackedSize
+		o.maxCompressionRatio = maxCompressionRatio
+		return nil
+	}
+}
+
 func makeOptions(opts ...Option) (*options, error) {
 	o := &options{
 		wordsReduceProbability:        defaultWordsReduceProbability[:],
@@ -101,6 +155,11 @@ func makeOptions(opts ...Option) (*options, error) {
 		entropyThresholdMidpoint:      defaultEntropyThresholdMidpoint,
 		logisticGrowthRate:            defaultLogisticGrowthRate,
 		minimumProbability:            0.7,
+		detectorSets:                  []*DetectorSet{DefaultDetectors()},
+		structuredParsing:             true,
+		maxArchiveDepth:               defaultMaxArchiveDepth,
+		maxUnpackedSize:               defaultMaxUnpackedSize,
+		maxCompressionRatio:           defaultMaxCompressionRatio,
 	}
 	for _, opt := range opts {
 		if err := opt(o); err != nil {
@@ -0,0 +1,184 @@
+package file
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"hash/crc32"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Confidence is how sure a validator is that a token is real and usable.
+type Confidence string
+
+const (
+	// ConfidenceHigh is given to tokens with a valid checksum.
+	ConfidenceHigh Confidence = "high"
+	// ConfidenceMedium is given to tokens that match the exact layout of
+	// their provider.
+	ConfidenceMedium Confidence = "medium"
+	// ConfidenceLow is given to tokens that are well-formed but probably not
+	// usable, such as test keys or expired JWTs.
+	ConfidenceLow Confidence = "low"
+)
+
+// confidenceProbability is the probability of the results of detectors that
+// have a validator and no probability multiplier.
+var confidenceProbability = map[Confidence]float64{
+	ConfidenceHigh:   1,
+	ConfidenceMedium: 0.9,
+	ConfidenceLow:    0.75,
+}
+
+// tokenValidation is what a validator learned about a token.
+type tokenValidation struct {
+	provider   string
+	confidence Confidence
+	claims     map[string]any
+	expiresAt  time.Time
+}
+
+// tokenValidators check a token offline, without calling the provider. A
+// token that fails the check is dropped.
+var tokenValidators = map[string]func(token string, now time.Time) (tokenValidation, bool){
+	"github": validateGitHubToken,
+	"aws":    validateAWSAccessKeyID,
+	"slack":  validateSlackToken,
+	"stripe": validateStripeKey,
+	"gitlab": validateGitLabToken,
+	"jwt":    validateJWT,
+}
+
+const base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+func base62Encode(n uint32, width int) string {
+	encoded := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		encoded[i] = base62Alphabet[n%62]
+		n /= 62
+	}
+	return string(encoded)
+}
+
+var githubTokenRegex = regexp.MustCompile(`^gh[pousr]_[0-9A-Za-z]{36}$`)
+var githubFineGrainedTokenRegex = regexp.MustCompile(`^github_pat_[0-9A-Za-z]{22}_[0-9A-Za-z]{59}$`)
+
+// validateGitHubToken checks the CRC32 of the classic tokens, which is stored
+// in base62 in the last 6 characters. Fine-grained tokens have no public
+// checksum, so only their layout is checked.
+func validateGitHubToken(token string, now time.Time) (tokenValidation, bool) {
+	if githubFineGrainedTokenRegex.MatchString(token) {
+		return tokenValidation{provider: "github", confidence: ConfidenceMedium}, true
+	}
+	if !githubTokenRegex.MatchString(token) {
+		return tokenValidation{}, false
+	}
+	random, checksum := token[4:34], token[34:]
+	if base62Encode(crc32.ChecksumIEEE([]byte(random)), 6) != checksum {
+		return tokenValidation{}, false
+	}
+	return tokenValidation{provider: "github", confidence: ConfidenceHigh}, true
+}
+
+var awsAccessKeyIDRegex = regexp.MustCompile(`^(AKIA|ASIA|ABIA|ACCA)[A-Z2-7]{16}$`)
+
+// validateAWSAccessKeyID checks the prefix, length and base32 alphabet of an
+// access key ID. The keys used in the AWS documentation end in EXAMPLE.
+func validateAWSAccessKeyID(token string, now time.Time) (tokenValidation, bool) {
+	if !awsAccessKeyIDRegex.MatchString(token) || strings.HasSuffix(token, "EXAMPLE") {
+		return tokenValidation{}, false
+	}
+	return tokenValidation{provider: "aws", confidence: ConfidenceMedium}, true
+}
+
+var slackTokenRegexes = []*regexp.Regexp{
+	regexp.MustCompile(`^xoxb-[0-9]{10,13}-[0-9]{10,13}-[0-9A-Za-z]{24}$`),
+	regexp.MustCompile(`^xoxp-[0-9]{10,13}-[0-9]{10,13}-[0-9]{10,13}-[0-9a-f]{32}$`),
+	regexp.MustCompile(`^xapp-1-[0-9A-Z]{9,11}-[0-9]{10,13}-[0-9a-f]{64}$`),
+	regexp.MustCompile(`^https://hooks\.slack\.com/services/T[0-9A-Z]{8,10}/B[0-9A-Z]{8,10}/[0-9A-Za-z]{24}$`),
+}
+
+func validateSlackToken(token string, now time.Time) (tokenValidation, bool) {
+	for _, regex := range slackTokenRegexes {
+		if regex.MatchString(token) {
+			return tokenValidation{provider: "slack", confidence: ConfidenceMedium}, true
+		}
+	}
+	return tokenValidation{}, false
+}
+
+var stripeKeyRegex = regexp.MustCompile(`^(?:sk|rk)_(live|test)_[0-9A-Za-z]{24,247}$`)
+
+// validateStripeKey accepts secret and restricted keys. Test mode keys cannot
+// move money, so they have a low confidence.
+func validateStripeKey(token string, now time.Time) (tokenValidation, bool) {
+	submatches := stripeKeyRegex.FindStringSubmatch(token)
+	if submatches == nil {
+		return tokenValidation{}, false
+	}
+	if submatches[1] == "test" {
+		return tokenValidation{provider: "stripe", confidence: ConfidenceLow}, true
+	}
+	return tokenValidation{provider: "stripe", confidence: ConfidenceMedium}, true
+}
+
+var gitlabTokenRegexes = []*regexp.Regexp{
+	regexp.MustCompile(`^(?:glpat|gldt|glrt)-[0-9A-Za-z_-]{20}$`),
+	regexp.MustCompile(`^glptt-[0-9a-f]{40}$`),
+	regexp.MustCompile(`^GR1348941[0-9A-Za-z_-]{20}$`),
+}
+
+func validateGitLabToken(token string, now time.Time) (tokenValidation, bool) {
+	for _, regex := range gitlabTokenRegexes {
+		if regex.MatchString(token) {
+			return tokenValidation{provider: "gitlab", confidence: ConfidenceMedium}, true
+		}
+	}
+	return tokenValidation{}, false
+}
+
+func decodeJWTPart(part string, value any) bool {
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(part, "="))
+	if err != nil {
+		return false
+	}
+	decoder := json.NewDecoder(strings.NewReader(string(decoded)))
+	decoder.UseNumber()
+	return decoder.Decode(value) == nil
+}
+
+// validateJWT decodes the header and the claims of a JWT without checking its
+// signature. Unsigned and expired tokens have a low confidence.
+func validateJWT(token string, now time.Time) (tokenValidation, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return tokenValidation{}, false
+	}
+	var header struct {
+		Algorithm string `json:"alg"`
+	}
+	if !decodeJWTPart(parts[0], &header) || header.Algorithm == "" {
+		return tokenValidation{}, false
+	}
+	var claims map[string]any
+	if !decodeJWTPart(parts[1], &claims) || claims == nil {
+		return tokenValidation{}, false
+	}
+
+	validation := tokenValidation{provider: "jwt", confidence: ConfidenceMedium, claims: claims}
+	if exp, ok := claims["exp"].(json.Number); ok {
+		seconds, err := exp.Float64()
+		if err != nil {
+			return tokenValidation{}, false
+		}
+		validation.expiresAt = time.Unix(int64(seconds), 0)
+	}
+	if strings.EqualFold(header.Algorithm, "none") || parts[2] == "" {
+		validation.confidence = ConfidenceLow
+	}
+	if !validation.expiresAt.IsZero() && validation.expiresAt.Before(now) {
+		validation.confidence = ConfidenceLow
+	}
+	return validation, true
+}
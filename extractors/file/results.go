@@ -4,18 +4,38 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"time"
 )
 
 type ExtractResult struct {
-	Name          string
-	Line          string
+	Name string
+	// DetectorID is the ID of the detector that found the result.
+	DetectorID string
+	Line       string
+	// LineNumber is the first line of the result and EndLineNumber the last
+	// one, which differ for secrets that span several lines such as private
+	// keys.
 	LineNumber    int
+	EndLineNumber int
 	Match         string
 	Probability   float64
 	Username      string
 	Password      string
 	FileName      string
 	PreviousLines string
+	// Provider and Confidence are set by the detectors that validate their
+	// tokens offline, such as the checksum of GitHub tokens.
+	Provider   string
+	Confidence Confidence
+	// Claims are the decoded claims of a JWT and ExpiresAt its expiry, zero
+	// if the token does not expire.
+	Claims    map[string]any
+	ExpiresAt time.Time
+}
+
+// Expired returns true if the result is a token that has expired.
+func (e *ExtractResult) Expired() bool {
+	return !e.ExpiresAt.IsZero() && e.ExpiresAt.Before(time.Now())
 }
 
 func (e *ExtractResult) Hash() string {
@@ -28,5 +48,5 @@ func (e *ExtractResult) Hash() string {
 }
 
 func (e *ExtractResult) String() string {
-	return fmt.Sprintf("ExtractResult{Name: %s, Line: %s, LineNumber: %d, Match: %s, Probability: %f, Username: %s, Password: %s, FileName: %s}", e.Name, e.Line, e.LineNumber, e.Match, e.Probability, e.Username, e.Password, e.FileName)
+	return fmt.Sprintf("ExtractResult{Name: %s, Line: %s, LineNumber: %d, EndLineNumber: %d, Match: %s, Probability: %f, Username: %s, Password: %s, FileName: %s}", e.Name, e.Line, e.LineNumber, e.EndLineNumber, e.Match, e.Probability, e.Username, e.Password, e.FileName)
 }
@@ -14,6 +14,8 @@ import (
 type FileScanner interface {
 	ExtractFromReader(ctx context.Context, fileName string, rd io.Reader) ([]file.ExtractResult, error)
 	ExtractFromLine(ctx context.Context, fileName string, lineNumber int, line string, previousLines string) ([]file.ExtractResult, error)
+	SupportsStructuredParsing(fileName string) bool
+	ExtractBlocksFromLines(ctx context.Context, fileName string, firstLine int, lines []string) ([]file.ExtractResult, error)
 }
 
 type GitResult struct {
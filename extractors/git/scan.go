@@ -56,17 +56,25 @@ func (scanner *GitScan) inspectTextFile(ctx context.Context, filePatch diff.File
 		case diff.Equal:
 			lineNumber += strings.Count(chunk.Content(), "\n")
 		case diff.Add:
+			_, toFile := filePatch.Files()
+			firstLine := lineNumber + 1
+			var lines []string
 			var sc = bufio.NewScanner(strings.NewReader(chunk.Content()))
 			for sc.Scan() {
 				lineNumber++
 				line := sc.Text()
-				_, toFile := filePatch.Files()
+				lines = append(lines, line)
 				fileResults, err := scanner.fileScanner.ExtractFromLine(ctx, toFile.Path(), lineNumber, line, strings.Join(previousLines, "\n"))
 				if err != nil {
 					return nil, fmt.Errorf("inspectTextFile: cannot extract from line: %w", err)
 				}
 				results = append(results, fileResults...)
 			}
+			blockResults, err := scanner.fileScanner.ExtractBlocksFromLines(ctx, toFile.Path(), firstLine, lines)
+			if err != nil {
+				return nil, fmt.Errorf("inspectTextFile: cannot extract blocks: %w", err)
+			}
+			results = append(results, blockResults...)
 		}
 
 		previousLines = append(previousLines, strings.Split(chunk.Content(), "\n")...)
@@ -77,6 +85,44 @@ func (scanner *GitScan) inspectTextFile(ctx context.Context, filePatch diff.File
 	return results, nil
 }
 
+// inspectConfigFile scans the whole config file at the commit, so its values
+// are found by key path, and keeps only the results on the lines added by the
+// patch. A result spanning several lines is kept if any of them was added.
+func (scanner *GitScan) inspectConfigFile(ctx context.Context, commit *object.Commit, filePatch diff.FilePatch, to diff.File) ([]file.ExtractResult, error) {
+	addedLines := map[int]struct{}{}
+	lineNumber := 0
+	for _, chunk := range filePatch.Chunks() {
+		switch chunk.Type() {
+		case diff.Equal:
+			lineNumber += strings.Count(chunk.Content(), "\n")
+		case diff.Add:
+			var sc = bufio.NewScanner(strings.NewReader(chunk.Content()))
+			for sc.Scan() {
+				lineNumber++
+				addedLines[lineNumber] = struct{}{}
+			}
+		}
+	}
+	if len(addedLines) == 0 {
+		return nil, nil
+	}
+
+	fileResults, err := scanner.inspectBinaryFile(ctx, commit, to)
+	if err != nil {
+		return nil, fmt.Errorf("inspectConfigFile: cannot inspect file: %w", err)
+	}
+	var results []file.ExtractResult
+	for _, result := range fileResults {
+		for line := result.LineNumber; line <= max(result.LineNumber, result.EndLineNumber); line++ {
+			if _, ok := addedLines[line]; ok {
+				results = append(results, result)
+				break
+			}
+		}
+	}
+	return results, nil
+}
+
 func (scanner *GitScan) inspectFilePatch(ctx context.Context, commit *object.Commit, filePatch diff.FilePatch) error {
 	var results []file.ExtractResult
 	var err error
@@ -86,6 +132,8 @@ func (scanner *GitScan) inspectFilePatch(ctx context.Context, commit *object.Com
 	}
 	if filePatch.IsBinary() {
 		results, err = scanner.inspectBinaryFile(ctx, commit, to)
+	} else if scanner.fileScanner.SupportsStructuredParsing(to.Path()) {
+		results, err = scanner.inspectConfigFile(ctx, commit, filePatch, to)
 	} else {
 		results, err = scanner.inspectTextFile(ctx, filePatch)
 	}
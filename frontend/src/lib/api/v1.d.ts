@@ -2592,6 +2592,11 @@ export interface components {
       name: string;
       line: string;
       line_number: number;
+      end_line_number: number;
+      provider: string;
+      /** @enum {string} */
+      confidence: "" | "low" | "medium" | "high";
+      expires_at?: string;
       previous_lines: string;
       match: string;
       probability: number;
@@ -2617,6 +2622,11 @@ export interface components {
       name: string;
       line: string;
       line_number: number;
+      end_line_number: number;
+      provider: string;
+      /** @enum {string} */
+      confidence: "" | "low" | "medium" | "high";
+      expires_at?: string;
       match: string;
       probability: number;
       username: string;
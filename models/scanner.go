@@ -8,6 +8,16 @@ const (
 	SCAN_CHECKING_PUBLIC_ACCESS
 )
 
+// The status of a shard of a distributed bruteforce. The queries in
+// bruteforce_shards.sql use the same values.
+const (
+	BRUTEFORCE_SHARD_PENDING int32 = iota
+	BRUTEFORCE_SHARD_RUNNING
+	BRUTEFORCE_SHARD_FINISHED
+	BRUTEFORCE_SHARD_CANCELLED
+	BRUTEFORCE_SHARD_FAILED
+)
+
 const (
 	SCAN_POSTGRES = 1
 	SCAN_MYSQL    = 2
@@ -15,6 +25,10 @@ const (
 	SCAN_DOCKER   = 4
 	SCAN_REDIS    = 5
 	SCAN_MONGODB  = 6
+
+	SCAN_ELASTICSEARCH = 7
+	SCAN_MSSQL         = 8
+	SCAN_ETCD          = 9
 )
 
 const AUTOMATIC_SCAN_USER_ID = -1
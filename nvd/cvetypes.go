@@ -67,6 +67,28 @@ func (c *NvdCveCve) Score() (float64, error) {
 	return value, nil
 }
 
+// Vector returns the CVSS vector of the metric with the highest base score,
+// preferring the newer CVSS versions when the scores are equal.
+func (c *NvdCveCve) Vector() string {
+	value, vector := 0.0, ""
+	for _, metric := range c.Metrics.CvssMetricV31 {
+		if metric.CvssData.BaseScore > value {
+			value, vector = metric.CvssData.BaseScore, metric.CvssData.VectorString
+		}
+	}
+	for _, metric := range c.Metrics.CvssMetricV30 {
+		if metric.CvssData.BaseScore > value {
+			value, vector = metric.CvssData.BaseScore, metric.CvssData.VectorString
+		}
+	}
+	for _, metric := range c.Metrics.CvssMetricV2 {
+		if metric.CvssData.BaseScore > value {
+			value, vector = metric.CvssData.BaseScore, metric.CvssData.VectorString
+		}
+	}
+	return vector
+}
+
 type NvdCveConfiguration struct {
 	Nodes    []NvdCveNode `json:"nodes"`
 	Operator *string      `json:"operator,omitempty"`
@@ -83,6 +105,7 @@ type NvdCveCpeMatch struct {
 	Criteria              string  `json:"criteria"`
 	MatchCriteriaID       string  `json:"matchCriteriaId"`
 	VersionStartIncluding *string `json:"versionStartIncluding,omitempty"`
+	VersionStartExcluding *string `json:"versionStartExcluding,omitempty"`
 	VersionEndExcluding   *string `json:"versionEndExcluding,omitempty"`
 	VersionEndIncluding   *string `json:"versionEndIncluding,omitempty"`
 }
@@ -1,5 +1,7 @@
 package nvd
 
+// ignoredCVEs are false positives that are ignored for every project, in
+// addition to the CVEs each project ignores.
 var ignoredCVEs = []string{
 	"CVE-2009-2943",
 	"CVE-2010-3781",
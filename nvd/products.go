@@ -10,6 +10,10 @@ const (
 	MYSQL
 	REDIS
 	MONGODB
+	ELASTICSEARCH
+	OPENSEARCH
+	MSSQL
+	ETCD
 )
 
 func GetNvdProductType(name string) Product {
@@ -22,6 +26,14 @@ func GetNvdProductType(name string) Product {
 		return REDIS
 	case "mongodb":
 		return MONGODB
+	case "elasticsearch":
+		return ELASTICSEARCH
+	case "opensearch":
+		return OPENSEARCH
+	case "mssql":
+		return MSSQL
+	case "etcd":
+		return ETCD
 	default:
 		return PRODUCT_UNKNOWN
 	}
@@ -37,6 +49,14 @@ func GetNvdProductName(t Product) string {
 		return "redis"
 	case MONGODB:
 		return "mongodb"
+	case ELASTICSEARCH:
+		return "elasticsearch"
+	case OPENSEARCH:
+		return "opensearch"
+	case MSSQL:
+		return "mssql"
+	case ETCD:
+		return "etcd"
 	default:
 		return "unknown"
 	}
@@ -52,6 +72,14 @@ func GetNvdCpeForProduct(t Product) (string, error) {
 		return "cpe:2.3:a:redis:redis", nil
 	case MONGODB:
 		return "cpe:2.3:a:mongodb:mongodb", nil
+	case ELASTICSEARCH:
+		return "cpe:2.3:a:elastic:elasticsearch", nil
+	case OPENSEARCH:
+		return "cpe:2.3:a:amazon:opensearch", nil
+	case MSSQL:
+		return "cpe:2.3:a:microsoft:sql_server", nil
+	case ETCD:
+		return "cpe:2.3:a:etcd:etcd", nil
 	default:
 		return "", errors.New("Product does not exist")
 	}
@@ -1,6 +1,26 @@
 package nvd
 
-import "errors"
+import (
+	"errors"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var ErrNoVersion = errors.New("no version found")
+
+var cpeVersionRegex = regexp.MustCompile(`^[0-9]+(\.[0-9]+)*([.-]?[a-zA-Z0-9]+)*$`)
+
+// VersionRange is a vulnerable range from the configurations of a CVE. Exact
+// is set when the criteria names a single version instead of a range.
+type VersionRange struct {
+	Criteria       string
+	Exact          string
+	StartIncluding string
+	StartExcluding string
+	EndIncluding   string
+	EndExcluding   string
+}
 
 func extractCpeSemverVersion(titles []NvdCpeTitle) (string, error) {
 	for _, title := range titles {
@@ -9,14 +29,196 @@ func extractCpeSemverVersion(titles []NvdCpeTitle) (string, error) {
 			return extract[0], nil
 		}
 	}
-	return "", errors.New("no version found")
+	return "", ErrNoVersion
+}
+
+// splitCpe splits a CPE 2.3 name into its components, keeping escaped colons.
+func splitCpe(cpe string) []string {
+	var parts []string
+	var current strings.Builder
+	for i := 0; i < len(cpe); i++ {
+		switch {
+		case cpe[i] == '\\' && i+1 < len(cpe):
+			current.WriteByte(cpe[i+1])
+			i++
+		case cpe[i] == ':':
+			parts = append(parts, current.String())
+			current.Reset()
+		default:
+			current.WriteByte(cpe[i])
+		}
+	}
+	return append(parts, current.String())
+}
+
+// extractCpeNameVersion returns the version and update components of a CPE
+// name, for example 6.2.0-rc1 for cpe:2.3:a:redis:redis:6.2.0:rc1:*:*:*:*:*:*.
+func extractCpeNameVersion(cpe string) (string, error) {
+	parts := splitCpe(cpe)
+	if len(parts) < 7 || parts[0] != "cpe" {
+		return "", ErrNoVersion
+	}
+	version, update := parts[5], parts[6]
+	if version == "*" || version == "-" || version == "" {
+		return "", ErrNoVersion
+	}
+	if update != "*" && update != "-" && update != "" {
+		version += "-" + update
+	}
+	if !cpeVersionRegex.MatchString(version) {
+		return "", ErrNoVersion
+	}
+	return version, nil
+}
+
+// extractCpeVersion prefers the version from the CPE name and falls back to
+// the first version in the titles.
+func extractCpeVersion(cpe NvdCpeCpe) (string, error) {
+	if version, err := extractCpeNameVersion(cpe.CpeName); err == nil {
+		return version, nil
+	}
+	return extractCpeSemverVersion(cpe.Titles)
 }
 
-func ExtractCpeVersionProduct(product Product, titles []NvdCpeTitle) (string, error) {
+func ExtractCpeVersionProduct(product Product, cpe NvdCpeCpe) (string, error) {
 	switch product {
-	case POSTGRESQL, MYSQL:
-		return extractCpeSemverVersion(titles)
+	case POSTGRESQL, MYSQL, REDIS, MONGODB, ELASTICSEARCH, OPENSEARCH, MSSQL, ETCD:
+		return extractCpeVersion(cpe)
 	default:
 		return "", errors.New("Product does not exist")
 	}
 }
+
+// mssqlReleases maps the releases of SQL Server, which NVD uses as the
+// version of the sql_server CPEs or in the name of the sql_server_20xx ones,
+// to the first build of their major version, which is what the server reports
+// as its ProductVersion. They are sorted by build.
+var mssqlReleases = []struct {
+	release string
+	build   string
+}{
+	{"2000", "8.0"},
+	{"2005", "9.0"},
+	{"2008", "10.0"},
+	{"2008-r2", "10.50"},
+	{"2012", "11.0"},
+	{"2014", "12.0"},
+	{"2016", "13.0"},
+	{"2017", "14.0"},
+	{"2019", "15.0"},
+	{"2022", "16.0"},
+	{"2025", "17.0"},
+}
+
+// mssqlReleaseBuilds returns the builds of a SQL Server release such as 2019,
+// from the first build of the release to the first one of the next release.
+// Service packs are not mapped, so 2014-sp2 covers every build of 2014.
+func mssqlReleaseBuilds(release string) (start string, end string, ok bool) {
+	release = strings.ToLower(strings.ReplaceAll(release, "_", "-"))
+	for i, r := range mssqlReleases {
+		// 2008-sp4 is a build of 2008, but 2008-r2 is a release of its own.
+		if release != r.release && (!strings.HasPrefix(release, r.release+"-") || strings.HasPrefix(release, r.release+"-r2")) {
+			continue
+		}
+		if i+1 < len(mssqlReleases) {
+			end = mssqlReleases[i+1].build
+		}
+		return r.build, end, true
+	}
+	return "", "", false
+}
+
+// mssqlVersionRange turns a range of a SQL Server CVE into builds. The
+// sql_server CPEs name a release, such as cpe:2.3:a:microsoft:sql_server:2019,
+// and the sql_server_20xx ones either have ranges of builds or name the whole
+// release with no version.
+func mssqlVersionRange(r VersionRange) (VersionRange, bool) {
+	parts := splitCpe(r.Criteria)
+	if len(parts) < 7 {
+		return VersionRange{}, false
+	}
+	product, version, update := parts[4], parts[5], parts[6]
+
+	release, found := strings.CutPrefix(product, "sql_server_")
+	if !found {
+		if r.Exact == "" {
+			// Ranges of releases are not used by NVD for sql_server.
+			return VersionRange{}, false
+		}
+		release = version
+		if update != "*" && update != "-" && update != "" {
+			release += "-" + update
+		}
+	}
+
+	// Other products such as sql_server_management_studio are not releases.
+	start, end, ok := mssqlReleaseBuilds(release)
+	if !ok {
+		return VersionRange{}, false
+	}
+	if found && (r.Exact != "" || r.StartIncluding != "" || r.StartExcluding != "" || r.EndIncluding != "" || r.EndExcluding != "") {
+		return r, true
+	}
+	return VersionRange{Criteria: r.Criteria, StartIncluding: start, EndExcluding: end}, true
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// ExtractVersionRanges returns the vulnerable ranges of the CVE that apply to
+// the product.
+func ExtractVersionRanges(product Product, cve NvdCveCve) ([]VersionRange, error) {
+	prefix, err := GetNvdCpeForProduct(product)
+	if err != nil {
+		return nil, err
+	}
+	prefixes := []string{prefix + ":"}
+	if product == MSSQL {
+		prefixes = append(prefixes, prefix+"_")
+	}
+
+	var ranges []VersionRange
+	for _, configuration := range cve.Configurations {
+		for _, node := range configuration.Nodes {
+			if node.Negate {
+				continue
+			}
+			for _, match := range node.CpeMatch {
+				if !match.Vulnerable || !slices.ContainsFunc(prefixes, func(prefix string) bool {
+					return strings.HasPrefix(match.Criteria, prefix)
+				}) {
+					continue
+				}
+				r := VersionRange{
+					Criteria:       match.Criteria,
+					StartIncluding: derefString(match.VersionStartIncluding),
+					StartExcluding: derefString(match.VersionStartExcluding),
+					EndIncluding:   derefString(match.VersionEndIncluding),
+					EndExcluding:   derefString(match.VersionEndExcluding),
+				}
+				if r.StartIncluding == "" && r.StartExcluding == "" && r.EndIncluding == "" && r.EndExcluding == "" {
+					version, err := extractCpeNameVersion(match.Criteria)
+					if err == nil {
+						r.Exact = version
+					} else if product != MSSQL {
+						// A criteria without a version and without bounds matches
+						// every version, which is almost always a mistake in the data.
+						continue
+					}
+				}
+				if product == MSSQL {
+					var ok bool
+					if r, ok = mssqlVersionRange(r); !ok {
+						continue
+					}
+				}
+				ranges = append(ranges, r)
+			}
+		}
+	}
+	return ranges, nil
+}
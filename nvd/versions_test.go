@@ -0,0 +1,169 @@
+package nvd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestExtractCpeVersionProduct(t *testing.T) {
+	tests := []struct {
+		name    string
+		product Product
+		cpe     NvdCpeCpe
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "postgres",
+			product: POSTGRESQL,
+			cpe:     NvdCpeCpe{CpeName: "cpe:2.3:a:postgresql:postgresql:9.6.1:*:*:*:*:*:*:*"},
+			want:    "9.6.1",
+		},
+		{
+			name:    "mysql",
+			product: MYSQL,
+			cpe:     NvdCpeCpe{CpeName: "cpe:2.3:a:oracle:mysql:8.0.36:*:*:*:*:*:*:*"},
+			want:    "8.0.36",
+		},
+		{
+			name:    "redis release candidate",
+			product: REDIS,
+			cpe:     NvdCpeCpe{CpeName: "cpe:2.3:a:redis:redis:6.2.0:rc1:*:*:*:*:*:*"},
+			want:    "6.2.0-rc1",
+		},
+		{
+			name:    "mongodb",
+			product: MONGODB,
+			cpe:     NvdCpeCpe{CpeName: "cpe:2.3:a:mongodb:mongodb:4.4.1:-:*:*:*:*:*:*"},
+			want:    "4.4.1",
+		},
+		{
+			name:    "version from title",
+			product: MONGODB,
+			cpe: NvdCpeCpe{
+				CpeName: "cpe:2.3:a:mongodb:mongodb:*:*:*:*:*:*:*:*",
+				Titles:  []NvdCpeTitle{{Title: "MongoDB 3.6.2", Lang: NvdCpeEn}},
+			},
+			want: "3.6.2",
+		},
+		{
+			name:    "no version",
+			product: REDIS,
+			cpe:     NvdCpeCpe{CpeName: "cpe:2.3:a:redis:redis:-:*:*:*:*:*:*:*"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown product",
+			product: PRODUCT_UNKNOWN,
+			cpe:     NvdCpeCpe{CpeName: "cpe:2.3:a:redis:redis:7.0.0:*:*:*:*:*:*:*"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExtractCpeVersionProduct(tt.product, tt.cpe)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExtractCpeVersionProduct() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ExtractCpeVersionProduct() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractVersionRanges(t *testing.T) {
+	cve := NvdCveCve{
+		ID: "CVE-2023-0001",
+		Configurations: []NvdCveConfiguration{{
+			Nodes: []NvdCveNode{
+				{
+					Operator: Or,
+					CpeMatch: []NvdCveCpeMatch{
+						{
+							Vulnerable:            true,
+							Criteria:              "cpe:2.3:a:redis:redis:*:*:*:*:*:*:*:*",
+							VersionStartIncluding: strPtr("7.0.0"),
+							VersionEndExcluding:   strPtr("7.0.12"),
+						},
+						{
+							Vulnerable:            true,
+							Criteria:              "cpe:2.3:a:redis:redis:*:*:*:*:*:*:*:*",
+							VersionStartExcluding: strPtr("6.0"),
+							VersionEndIncluding:   strPtr("6.2.13"),
+						},
+						{Vulnerable: true, Criteria: "cpe:2.3:a:redis:redis:7.2.0:rc1:*:*:*:*:*:*"},
+						{Vulnerable: true, Criteria: "cpe:2.3:a:redis:redis:*:*:*:*:*:*:*:*"},
+						{Vulnerable: false, Criteria: "cpe:2.3:a:redis:redis:5.0.0:*:*:*:*:*:*:*"},
+						{Vulnerable: true, Criteria: "cpe:2.3:a:redislabs:redis_enterprise:6.0.0:*:*:*:*:*:*:*"},
+					},
+				},
+				{
+					Negate:   true,
+					CpeMatch: []NvdCveCpeMatch{{Vulnerable: true, Criteria: "cpe:2.3:a:redis:redis:7.0.5:*:*:*:*:*:*:*"}},
+				},
+			},
+		}},
+	}
+
+	got, err := ExtractVersionRanges(REDIS, cve)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []VersionRange{
+		{Criteria: "cpe:2.3:a:redis:redis:*:*:*:*:*:*:*:*", StartIncluding: "7.0.0", EndExcluding: "7.0.12"},
+		{Criteria: "cpe:2.3:a:redis:redis:*:*:*:*:*:*:*:*", StartExcluding: "6.0", EndIncluding: "6.2.13"},
+		{Criteria: "cpe:2.3:a:redis:redis:7.2.0:rc1:*:*:*:*:*:*", Exact: "7.2.0-rc1"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExtractVersionRanges() = %+v, want %+v", got, want)
+	}
+
+	if ranges, _ := ExtractVersionRanges(MONGODB, cve); len(ranges) != 0 {
+		t.Errorf("ExtractVersionRanges() for another product = %+v, want none", ranges)
+	}
+}
+
+func TestExtractVersionRangesMssql(t *testing.T) {
+	// Criteria from CVE-2019-1068 and CVE-2023-21528.
+	cve := NvdCveCve{
+		ID: "CVE-2023-21528",
+		Configurations: []NvdCveConfiguration{{
+			Nodes: []NvdCveNode{{
+				Operator: Or,
+				CpeMatch: []NvdCveCpeMatch{
+					{Vulnerable: true, Criteria: "cpe:2.3:a:microsoft:sql_server:2014:sp2:*:*:*:*:x64:*"},
+					{Vulnerable: true, Criteria: "cpe:2.3:a:microsoft:sql_server:2017:*:*:*:*:*:x64:*"},
+					{Vulnerable: true, Criteria: "cpe:2.3:a:microsoft:sql_server:2008:r2:*:*:*:*:*:*"},
+					{
+						Vulnerable:            true,
+						Criteria:              "cpe:2.3:a:microsoft:sql_server_2022:*:*:*:*:*:*:x64:*",
+						VersionStartIncluding: strPtr("16.0.0"),
+						VersionEndExcluding:   strPtr("16.0.1050.5"),
+					},
+					{Vulnerable: true, Criteria: "cpe:2.3:a:microsoft:sql_server_2019:-:*:*:*:*:*:x64:*"},
+					{Vulnerable: true, Criteria: "cpe:2.3:a:microsoft:sql_server_management_studio:18.0:*:*:*:*:*:*:*"},
+				},
+			}},
+		}},
+	}
+
+	got, err := ExtractVersionRanges(MSSQL, cve)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []VersionRange{
+		{Criteria: "cpe:2.3:a:microsoft:sql_server:2014:sp2:*:*:*:*:x64:*", StartIncluding: "12.0", EndExcluding: "13.0"},
+		{Criteria: "cpe:2.3:a:microsoft:sql_server:2017:*:*:*:*:*:x64:*", StartIncluding: "14.0", EndExcluding: "15.0"},
+		{Criteria: "cpe:2.3:a:microsoft:sql_server:2008:r2:*:*:*:*:*:*", StartIncluding: "10.50", EndExcluding: "11.0"},
+		{Criteria: "cpe:2.3:a:microsoft:sql_server_2022:*:*:*:*:*:*:x64:*", StartIncluding: "16.0.0", EndExcluding: "16.0.1050.5"},
+		{Criteria: "cpe:2.3:a:microsoft:sql_server_2019:-:*:*:*:*:*:x64:*", StartIncluding: "15.0", EndExcluding: "16.0"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExtractVersionRanges() = %+v, want %+v", got, want)
+	}
+}
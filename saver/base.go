@@ -13,6 +13,7 @@ import (
 	"github.com/tedyst/licenta/models"
 	"github.com/tedyst/licenta/nvd"
 	"github.com/tedyst/licenta/scanner"
+	"github.com/tedyst/licenta/scanner/tlsprobe"
 )
 
 type BaseQuerier interface {
@@ -20,10 +21,13 @@ type BaseQuerier interface {
 	GetScan(ctx context.Context, id int64) (*queries.GetScanRow, error)
 	UpdateScanStatus(ctx context.Context, params queries.UpdateScanStatusParams) error
 	CreateScanResult(ctx context.Context, params queries.CreateScanResultParams) (*queries.ScanResult, error)
+	CreateScanCveResult(ctx context.Context, params queries.CreateScanCveResultParams) (*queries.ScanCveResult, error)
+	CreateScanFindingResult(ctx context.Context, params queries.CreateScanFindingResultParams) (*queries.ScanResultFinding, error)
 	CreateScanBruteforceResult(ctx context.Context, arg queries.CreateScanBruteforceResultParams) (*queries.ScanBruteforceResult, error)
 	UpdateScanBruteforceResult(ctx context.Context, params queries.UpdateScanBruteforceResultParams) error
 
 	GetCvesByProductAndVersion(ctx context.Context, arg queries.GetCvesByProductAndVersionParams) ([]*queries.GetCvesByProductAndVersionRow, error)
+	GetProjectIgnoredCves(ctx context.Context, projectID int64) ([]*queries.ProjectIgnoredCfe, error)
 }
 
 type baseSaver struct {
@@ -40,22 +44,64 @@ type baseSaver struct {
 	runAfterScan func(ctx context.Context) error
 
 	projectIsRemote bool
+
+	tlsTarget *tlsTarget
+}
+
+// tlsTarget is the endpoint of the database that is probed for its TLS
+// configuration.
+type tlsTarget struct {
+	host     string
+	port     int32
+	protocol tlsprobe.Protocol
 }
 
 func (saver *baseSaver) insertResults(ctx context.Context, results []scanner.ScanResult) error {
 	for _, result := range results {
-		if _, err := saver.queries.CreateScanResult(ctx, queries.CreateScanResultParams{
-			ScanID:     saver.scan.ID,
-			Severity:   int32(result.Severity()),
-			Message:    result.Detail(),
-			ScanSource: int32(saver.scanner.GetScannerID()),
-		}); err != nil {
-			return fmt.Errorf("could not insert scan result: %w", err)
+		finding := result.Finding()
+		if finding.RuleID == "" {
+			if _, err := saver.queries.CreateScanResult(ctx, queries.CreateScanResultParams{
+				ScanID:     saver.scan.ID,
+				Severity:   int32(result.Severity()),
+				Message:    result.Detail(),
+				ScanSource: int32(saver.scanner.GetScannerID()),
+			}); err != nil {
+				return fmt.Errorf("could not insert scan result: %w", err)
+			}
+			continue
+		}
+
+		if err := saver.insertFinding(ctx, result.Severity(), result.Detail(), finding); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+func (saver *baseSaver) insertFinding(ctx context.Context, severity scanner.Severity, message string, finding scanner.Finding) error {
+	references := finding.References
+	if references == nil {
+		references = []string{}
+	}
+	if _, err := saver.queries.CreateScanFindingResult(ctx, queries.CreateScanFindingResultParams{
+		ScanID:        saver.scan.ID,
+		Severity:      int32(severity),
+		Message:       message,
+		ScanSource:    int32(saver.scanner.GetScannerID()),
+		RuleID:        finding.RuleID,
+		Title:         finding.Title,
+		Description:   finding.Description,
+		Remediation:   finding.Remediation,
+		ReferenceUrls: references,
+		ObjectType:    string(finding.AffectedObject.Type),
+		ObjectName:    finding.AffectedObject.Name,
+		Evidence:      finding.Evidence,
+	}); err != nil {
+		return fmt.Errorf("could not insert scan result: %w", err)
+	}
+	return nil
+}
+
 func (saver *baseSaver) bruteforceUpdateStatus(ctx context.Context) func(status map[scanner.User]bruteforce.BruteforceUserStatus) error {
 	return func(status map[scanner.User]bruteforce.BruteforceUserStatus) error {
 		for user, entry := range status {
@@ -198,13 +244,27 @@ func (runner *baseSaver) runScanner(ctx context.Context) error {
 
 	runner.logger.DebugContext(ctx, "Scanned config")
 
-	_, err = runner.scanner.GetUsers(ctx)
+	if err := runner.probeTLS(ctx); err != nil {
+		return fmt.Errorf("could not insert TLS results: %w", err)
+	}
+
+	users, err := runner.scanner.GetUsers(ctx)
 	if err != nil && err != scanner.ErrGetUsersNotSupported {
 		return fmt.Errorf("could not get users: %w", err)
 	}
 
 	runner.logger.DebugContext(ctx, "Got users")
 
+	results, err = analyzeUsers(users)
+	if err != nil {
+		return fmt.Errorf("could not analyze users: %w", err)
+	}
+	if err := runner.insertResults(ctx, results); err != nil {
+		return fmt.Errorf("could not insert user results: %w", err)
+	}
+
+	runner.logger.DebugContext(ctx, "Analyzed users")
+
 	version, err := runner.scanner.GetVersion(ctx)
 	if err != nil && err != scanner.ErrVersionNotSupported {
 		return fmt.Errorf("could not get version: %w", err)
@@ -213,27 +273,8 @@ func (runner *baseSaver) runScanner(ctx context.Context) error {
 	if err != scanner.ErrVersionNotSupported && runner.scanner.GetNvdProductType() != nvd.PRODUCT_UNKNOWN {
 		runner.logger.DebugContext(ctx, "Got version")
 
-		cves, err := runner.queries.GetCvesByProductAndVersion(ctx, queries.GetCvesByProductAndVersionParams{
-			DatabaseType: int32(runner.scanner.GetNvdProductType()),
-			Version:      version,
-		})
-		if err != nil {
-			return fmt.Errorf("could not get cves: %w", err)
-		}
-
-		for _, cve := range cves {
-			if nvd.IsCVEIgnored(cve.NvdCfe.CveID) {
-				slog.DebugContext(ctx, "Ignoring CVE", "cveId", cve.NvdCfe.CveID)
-				continue
-			}
-			if _, err := runner.queries.CreateScanResult(ctx, queries.CreateScanResultParams{
-				ScanID:     runner.scan.ID,
-				Severity:   int32(scanner.SEVERITY_HIGH),
-				Message:    fmt.Sprintf("Vulnerability %s found. Please update to the latest version", cve.NvdCfe.CveID),
-				ScanSource: int32(runner.scanner.GetScannerID()),
-			}); err != nil {
-				return fmt.Errorf("could not insert scan result: %w", err)
-			}
+		if err := runner.insertCves(ctx, version); err != nil {
+			return err
 		}
 
 		runner.logger.DebugContext(ctx, "Verified version for CVEs")
@@ -246,6 +287,80 @@ func (runner *baseSaver) runScanner(ctx context.Context) error {
 	return nil
 }
 
+// probeTLS inspects the TLS configuration of the database. A failed probe is
+// only logged, since the database was already reachable for the other checks.
+func (runner *baseSaver) probeTLS(ctx context.Context) error {
+	if runner.tlsTarget == nil {
+		return nil
+	}
+
+	report, err := tlsprobe.Probe(ctx, runner.tlsTarget.host, runner.tlsTarget.port, runner.tlsTarget.protocol)
+	if err != nil {
+		runner.logger.WarnContext(ctx, "Could not probe TLS", "error", err)
+		return nil
+	}
+	if err := runner.insertResults(ctx, report.Results()); err != nil {
+		return err
+	}
+
+	runner.logger.DebugContext(ctx, "Probed TLS")
+	return nil
+}
+
+func (runner *baseSaver) insertCves(ctx context.Context, version string) error {
+	cves, err := runner.queries.GetCvesByProductAndVersion(ctx, queries.GetCvesByProductAndVersionParams{
+		DatabaseType: int32(runner.scanner.GetNvdProductType()),
+		Version:      version,
+	})
+	if err != nil {
+		return fmt.Errorf("could not get cves: %w", err)
+	}
+
+	scangroup, err := runner.queries.GetScanGroup(ctx, runner.scan.ScanGroupID)
+	if err != nil {
+		return fmt.Errorf("could not get scan group: %w", err)
+	}
+	ignoredCves, err := runner.queries.GetProjectIgnoredCves(ctx, scangroup.ProjectID)
+	if err != nil {
+		return fmt.Errorf("could not get ignored cves: %w", err)
+	}
+	ignored := map[string]struct{}{}
+	for _, cve := range ignoredCves {
+		ignored[cve.CveID] = struct{}{}
+	}
+
+	for _, cve := range cves {
+		if _, ok := ignored[cve.NvdCfe.CveID]; ok || nvd.IsCVEIgnored(cve.NvdCfe.CveID) {
+			runner.logger.DebugContext(ctx, "Ignoring CVE", "cveId", cve.NvdCfe.CveID)
+			continue
+		}
+
+		message := fmt.Sprintf("Vulnerability %s (CVSS %.1f) found in version %s.", cve.NvdCfe.CveID, cve.NvdCfe.Score, version)
+		if cve.FixedIn != "" {
+			message += fmt.Sprintf(" Please update to version %s or later.", cve.FixedIn)
+		} else {
+			message += " Please update to the latest version."
+		}
+
+		if _, err := runner.queries.CreateScanCveResult(ctx, queries.CreateScanCveResultParams{
+			ScanID:      runner.scan.ID,
+			Severity:    int32(scanner.SeverityFromCVSS(cve.NvdCfe.Score, cve.NvdCfe.Vector)),
+			Message:     message,
+			ScanSource:  int32(runner.scanner.GetScannerID()),
+			CveID:       cve.NvdCfe.CveID,
+			Score:       cve.NvdCfe.Score,
+			Vector:      cve.NvdCfe.Vector,
+			Description: cve.NvdCfe.Description,
+			Published:   cve.NvdCfe.Published,
+			FixedIn:     cve.FixedIn,
+		}); err != nil {
+			return fmt.Errorf("could not insert cve result: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (r *baseSaver) bruteforce(ctx context.Context) error {
 	r.logger.DebugContext(ctx, "Bruteforcing passwords for all users")
 
@@ -253,7 +368,7 @@ func (r *baseSaver) bruteforce(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("could not get scan group: %w", err)
 	}
-	bruteforcer, err := r.bruteforceProvider.NewBruteforcer(ctx, r.scanner, r.bruteforceUpdateStatus(ctx), scangroup.ProjectID)
+	bruteforcer, err := r.bruteforceProvider.NewBruteforcer(ctx, r.scanner, r.bruteforceUpdateStatus(ctx), scangroup.ProjectID, bruteforce.WithScan(r.scan.ID))
 	if err != nil {
 		return fmt.Errorf("could not create bruteforcer: %w", err)
 	}
@@ -306,13 +421,14 @@ func (runner *baseSaver) ScanForPublicAccessOnly(ctx context.Context) error {
 		severity = scanner.SEVERITY_HIGH
 	}
 
-	if _, err := runner.queries.CreateScanResult(ctx, queries.CreateScanResultParams{
-		ScanID:     runner.scan.ID,
-		Severity:   int32(severity),
-		Message:    "Database is accessible from public internet",
-		ScanSource: int32(runner.scanner.GetScannerID()),
+	if err := runner.insertFinding(ctx, severity, "Database is accessible from public internet", scanner.Finding{
+		RuleID:         "public-access",
+		Title:          "Database is accessible from public internet",
+		Description:    "The database accepted a connection from a worker outside of the project network.",
+		Remediation:    "Restrict access to the database with a firewall or bind it to a private address.",
+		AffectedObject: scanner.AffectedObject{Type: scanner.OBJECT_SERVER, Name: runner.scanner.GetScannerName()},
 	}); err != nil {
-		return fmt.Errorf("could not insert scan result: %w", err)
+		return err
 	}
 
 	if _, err := runner.queries.CreateScanResult(ctx, queries.CreateScanResultParams{
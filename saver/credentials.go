@@ -0,0 +1,133 @@
+package saver
+
+import (
+	"fmt"
+
+	"github.com/tedyst/licenta/scanner"
+)
+
+// minimumWorkFactors are the lowest iteration counts, or bcrypt costs, that
+// are not reported. The SCRAM ones are the minimum recommended by RFC 7677
+// and the bcrypt one is the default cost of etcd.
+var minimumWorkFactors = map[string]int{
+	"SCRAM-SHA-256": 4096,
+	"SCRAM-SHA-1":   4096,
+	"bcrypt":        10,
+}
+
+// weakHashAlgorithms are the password hashes that are reported when every
+// hash of a user uses one of them. mysql_native_password is not one of them,
+// since the MySQL scanner reports every account using it.
+var weakHashAlgorithms = map[string]struct {
+	severity    scanner.Severity
+	reason      string
+	remediation string
+}{
+	"md5": {
+		severity:    scanner.SEVERITY_MEDIUM,
+		reason:      "MD5 hashes are fast to crack, are only salted with the username and can be used to log in without knowing the password.",
+		remediation: "Set password_encryption to scram-sha-256 and set the password of the user again.",
+	},
+	"SCRAM-SHA-1": {
+		severity:    scanner.SEVERITY_WARNING,
+		reason:      "SCRAM-SHA-1 credentials are derived from an MD5 digest of the password and SHA1, which is deprecated.",
+		remediation: "Set the password of the user again with the SCRAM-SHA-256 mechanism and remove SCRAM-SHA-1 from authenticationMechanisms.",
+	},
+}
+
+// analyzeUsers reports users without a password, passwords stored in
+// plaintext and weak password hashes. A user can be returned more than once
+// by a scanner, once for every password hash, so the hashing algorithm is
+// only reported if all of the hashes of the user are weak.
+func analyzeUsers(users []scanner.User) ([]scanner.ScanResult, error) {
+	results := []scanner.ScanResult{}
+	add := func(severity scanner.Severity, username string, ruleID string, title string, description string, remediation string, evidence string) {
+		results = append(results, scanner.NewResultWithDetail(severity, fmt.Sprintf("User %s: %s", username, description), scanner.Finding{
+			RuleID:         ruleID,
+			Title:          title + " for user " + username,
+			Description:    description,
+			Remediation:    remediation,
+			AffectedObject: scanner.AffectedObject{Type: scanner.OBJECT_USER, Name: username},
+			Evidence:       evidence,
+		}))
+	}
+
+	var usernames []string
+	algorithms := map[string][]string{}
+	for _, user := range users {
+		username, err := user.GetUsername()
+		if err != nil {
+			return nil, fmt.Errorf("could not get username: %w", err)
+		}
+		privileged, err := user.IsPrivileged()
+		if err != nil {
+			return nil, fmt.Errorf("could not check if user is privileged: %w", err)
+		}
+
+		hasPassword, err := user.HasPassword()
+		if err != nil {
+			return nil, fmt.Errorf("could not check if user has a password: %w", err)
+		}
+		if !hasPassword {
+			severity := scanner.SEVERITY_MEDIUM
+			if privileged {
+				severity = scanner.SEVERITY_HIGH
+			}
+			add(severity, username, "credential-no-password", "No password",
+				"The user can log in without a password.",
+				"Set a password for the user or disable it.", "")
+			continue
+		}
+
+		if password, ok, err := user.GetRawPassword(); err != nil {
+			return nil, fmt.Errorf("could not get raw password: %w", err)
+		} else if ok && password != "" {
+			add(scanner.SEVERITY_HIGH, username, "credential-plaintext-password", "Plaintext password",
+				"The password of the user is stored in plaintext, so anyone who can read the user table or a backup can log in as the user.",
+				"Hash the stored passwords and set the password of the user again.", "")
+			continue
+		}
+
+		hashUser, ok := user.(scanner.PasswordHashUser)
+		if !ok {
+			continue
+		}
+		hash, err := hashUser.GetPasswordHash()
+		if err != nil {
+			return nil, fmt.Errorf("could not get password hash of user %s: %w", username, err)
+		}
+		if hash.Algorithm == "" {
+			continue
+		}
+		if _, ok := algorithms[username]; !ok {
+			usernames = append(usernames, username)
+		}
+		algorithms[username] = append(algorithms[username], hash.Algorithm)
+
+		if minimum, ok := minimumWorkFactors[hash.Algorithm]; ok && hash.WorkFactor < minimum {
+			add(scanner.SEVERITY_WARNING, username, "credential-low-work-factor", "Low password hash work factor",
+				fmt.Sprintf("The %s hash of the password uses a work factor of %d, which is below %d and makes it faster to crack.", hash.Algorithm, hash.WorkFactor, minimum),
+				"Increase the iteration count or cost of the server and set the password of the user again.",
+				fmt.Sprintf("%s %d", hash.Algorithm, hash.WorkFactor))
+		}
+	}
+
+	for _, username := range usernames {
+		allWeak := true
+		for _, algorithm := range algorithms[username] {
+			if _, ok := weakHashAlgorithms[algorithm]; !ok {
+				allWeak = false
+				break
+			}
+		}
+		if !allWeak {
+			continue
+		}
+		weak := weakHashAlgorithms[algorithms[username][0]]
+		add(weak.severity, username, "credential-weak-hash", "Weak password hash",
+			fmt.Sprintf("The password of the user is hashed with %s. %s", algorithms[username][0], weak.reason),
+			weak.remediation, algorithms[username][0])
+	}
+
+	return results, nil
+}
@@ -0,0 +1,90 @@
+package saver
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/tedyst/licenta/scanner"
+)
+
+type testUser struct {
+	name       string
+	noPassword bool
+	plaintext  string
+	hash       scanner.PasswordHash
+	privileged bool
+}
+
+func (u *testUser) GetUsername() (string, error)                   { return u.name, nil }
+func (u *testUser) HasPassword() (bool, error)                     { return !u.noPassword, nil }
+func (u *testUser) VerifyPassword(string) (bool, error)            { return false, nil }
+func (u *testUser) IsPrivileged() (bool, error)                    { return u.privileged, nil }
+func (u *testUser) GetHashedPassword() (string, error)             { return "", nil }
+func (u *testUser) GetPasswordHash() (scanner.PasswordHash, error) { return u.hash, nil }
+func (u *testUser) GetRawPassword() (string, bool, error) {
+	return u.plaintext, u.plaintext != "", nil
+}
+
+func TestAnalyzeUsers(t *testing.T) {
+	tests := []struct {
+		name  string
+		users []scanner.User
+		want  []string
+	}{
+		{
+			name: "strong hashes",
+			users: []scanner.User{
+				&testUser{name: "postgres", hash: scanner.PasswordHash{Algorithm: "SCRAM-SHA-256", WorkFactor: 4096}},
+				&testUser{name: "root", hash: scanner.PasswordHash{Algorithm: "bcrypt", WorkFactor: 10}},
+				&testUser{name: "app", hash: scanner.PasswordHash{Algorithm: "caching_sha2_password", WorkFactor: 5000}},
+			},
+		},
+		{
+			name: "weak hashes",
+			users: []scanner.User{
+				&testUser{name: "postgres", hash: scanner.PasswordHash{Algorithm: "md5"}},
+				&testUser{name: "localhost:root", hash: scanner.PasswordHash{Algorithm: "mysql_native_password"}},
+				&testUser{name: "etcd", hash: scanner.PasswordHash{Algorithm: "bcrypt", WorkFactor: 4}},
+				&testUser{name: "scram", hash: scanner.PasswordHash{Algorithm: "SCRAM-SHA-256", WorkFactor: 1000}},
+			},
+			want: []string{
+				"credential-low-work-factor etcd",
+				"credential-low-work-factor scram",
+				"credential-weak-hash postgres",
+			},
+		},
+		{
+			name: "scram-sha-1 only when there is no other credential",
+			users: []scanner.User{
+				&testUser{name: "old", hash: scanner.PasswordHash{Algorithm: "SCRAM-SHA-1", WorkFactor: 10000}},
+				&testUser{name: "both", hash: scanner.PasswordHash{Algorithm: "SCRAM-SHA-1", WorkFactor: 10000}},
+				&testUser{name: "both", hash: scanner.PasswordHash{Algorithm: "SCRAM-SHA-256", WorkFactor: 15000}},
+			},
+			want: []string{"credential-weak-hash old"},
+		},
+		{
+			name: "no password and plaintext",
+			users: []scanner.User{
+				&testUser{name: "default", noPassword: true, privileged: true},
+				&testUser{name: "legacy", plaintext: "hunter2"},
+			},
+			want: []string{"credential-no-password default", "credential-plaintext-password legacy"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := analyzeUsers(tt.users)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, result := range results {
+				finding := result.Finding()
+				got = append(got, finding.RuleID+" "+finding.AffectedObject.Name)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("analyzeUsers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
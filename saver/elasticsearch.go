@@ -0,0 +1,171 @@
+package saver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/spf13/viper"
+
+	"github.com/tedyst/licenta/bruteforce"
+	"github.com/tedyst/licenta/db"
+	"github.com/tedyst/licenta/db/queries"
+	"github.com/tedyst/licenta/scanner/elasticsearch"
+	"github.com/tedyst/licenta/scanner/tlsprobe"
+)
+
+type ElasticsearchQuerier interface {
+	BaseQuerier
+
+	GetElasticsearchScanByScanID(ctx context.Context, scanID int64) (*queries.ElasticsearchScan, error)
+	GetElasticsearchDatabase(context.Context, queries.GetElasticsearchDatabaseParams) (*queries.GetElasticsearchDatabaseRow, error)
+	UpdateElasticsearchVersion(ctx context.Context, params queries.UpdateElasticsearchVersionParams) error
+}
+
+func NewElasticsearchSaver(ctx context.Context, baseQuerier BaseQuerier, bruteforceProvider bruteforce.BruteforceProvider, scan *queries.Scan, projectIsRemote bool, saltKey string) (Saver, error) {
+	q, ok := baseQuerier.(ElasticsearchQuerier)
+	if !ok {
+		return nil, errors.Join(ErrSaverNotNeeded, fmt.Errorf("queries is not a ElasticsearchQuerier"))
+	}
+
+	elasticsearchScan, err := q.GetElasticsearchScanByScanID(ctx, scan.ID)
+	if err == pgx.ErrNoRows {
+		return nil, errors.Join(ErrSaverNotNeeded, fmt.Errorf("could not get elasticsearch scan: %w", err))
+	}
+	if err != nil {
+		return nil, errors.Join(ErrSaverNotNeeded, fmt.Errorf("could not get elasticsearch scan: %w", err))
+	}
+
+	db, err := q.GetElasticsearchDatabase(ctx, queries.GetElasticsearchDatabaseParams{
+		ID:      elasticsearchScan.DatabaseID,
+		SaltKey: saltKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get database: %w", err)
+	}
+
+	url, err := elasticsearch.ResolveURL(ctx, db.Host, db.Port)
+	if err != nil {
+		// Ping fails with the same error when the scan starts, which marks
+		// the scan as failed.
+		url = fmt.Sprintf("http://%s:%d", db.Host, db.Port)
+	}
+
+	sc, err := elasticsearch.NewScanner(ctx, url, db.Username, db.Password)
+	if err != nil {
+		return nil, fmt.Errorf("could not create scanner: %w", err)
+	}
+
+	logger := slog.With(
+		"scan", scan.ID,
+		"elasticsearch_scan", elasticsearchScan.ID,
+		"elasticsearch_database_id", elasticsearchScan.DatabaseID,
+	)
+
+	saver := &elasticsearchSaver{
+		queries:           q,
+		baseSaver:         *createBaseSaver(q, bruteforceProvider, logger, scan, sc, projectIsRemote),
+		elasticsearchScan: elasticsearchScan,
+		database: &queries.ElasticsearchDatabase{
+			ID:        db.ID,
+			ProjectID: db.ProjectID,
+			Host:      db.Host,
+			Port:      db.Port,
+			Username:  db.Username,
+			Password:  db.Password,
+			Version:   db.Version,
+			CreatedAt: db.CreatedAt,
+		},
+	}
+	saver.runAfterScan = saver.hookAfterScan
+	if strings.HasPrefix(url, "https://") {
+		saver.tlsTarget = &tlsTarget{host: db.Host, port: db.Port, protocol: tlsprobe.PROTOCOL_DIRECT}
+	}
+	return saver, nil
+}
+
+func (saver *elasticsearchSaver) hookAfterScan(ctx context.Context) error {
+	version, err := saver.scanner.GetVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get version: %w", err)
+	}
+	if err := saver.queries.UpdateElasticsearchVersion(ctx, queries.UpdateElasticsearchVersionParams{
+		ID:      saver.elasticsearchScan.DatabaseID,
+		Version: sql.NullString{String: version, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("could not update version: %w", err)
+	}
+
+	return nil
+}
+
+type elasticsearchSaver struct {
+	queries ElasticsearchQuerier
+
+	elasticsearchScan *queries.ElasticsearchScan
+	database          *queries.ElasticsearchDatabase
+
+	baseSaver
+}
+
+func init() {
+	savers["elasticsearch"] = NewElasticsearchSaver
+	creaters["elasticsearch"] = CreateElasticsearchScan
+}
+
+type CreateElasticsearchScanQuerier interface {
+	BaseCreater
+	GetElasticsearchDatabasesForProject(context.Context, queries.GetElasticsearchDatabasesForProjectParams) ([]*queries.GetElasticsearchDatabasesForProjectRow, error)
+	CreateElasticsearchScan(ctx context.Context, params queries.CreateElasticsearchScanParams) (*queries.ElasticsearchScan, error)
+}
+
+var CreateElasticsearchScan = CreateBaseScan(
+	func(q BaseCreater) (func(context.Context, int64) ([]*queries.ElasticsearchDatabase, error), error) {
+		mq, ok := q.(CreateElasticsearchScanQuerier)
+		if !ok {
+			return nil, errors.New("querier is not a CreateElasticsearchScanQuerier")
+		}
+		return func(ctx context.Context, projectID int64) ([]*queries.ElasticsearchDatabase, error) {
+			rows, err := mq.GetElasticsearchDatabasesForProject(ctx, queries.GetElasticsearchDatabasesForProjectParams{
+				ProjectID: projectID,
+				SaltKey:   viper.GetString("db-encryption-salt"),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("could not get databases: %w", err)
+			}
+
+			dbs := make([]*queries.ElasticsearchDatabase, 0, len(rows))
+			for _, row := range rows {
+				dbs = append(dbs, &queries.ElasticsearchDatabase{
+					ID:        row.ID,
+					ProjectID: row.ProjectID,
+					Host:      row.Host,
+					Port:      row.Port,
+					Username:  row.Username,
+					Password:  row.Password,
+					Version:   row.Version,
+					CreatedAt: row.CreatedAt,
+				})
+			}
+			return dbs, nil
+		}, nil
+	},
+	func(ctx context.Context, q BaseCreater, scanID int64, db *queries.ElasticsearchDatabase) (any, error) {
+		mq, ok := q.(CreateElasticsearchScanQuerier)
+		if !ok {
+			return nil, errors.New("querier is not a CreateElasticsearchScanQuerier")
+		}
+		return mq.CreateElasticsearchScan(ctx, queries.CreateElasticsearchScanParams{
+			ScanID:     scanID,
+			DatabaseID: db.ID,
+		})
+	},
+	elasticsearch.GetScannerID(),
+)
+
+var _ ElasticsearchQuerier = (db.TransactionQuerier)(nil)
+var _ CreateElasticsearchScanQuerier = (db.TransactionQuerier)(nil)
@@ -0,0 +1,171 @@
+package saver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/spf13/viper"
+
+	"github.com/tedyst/licenta/bruteforce"
+	"github.com/tedyst/licenta/db"
+	"github.com/tedyst/licenta/db/queries"
+	"github.com/tedyst/licenta/scanner/etcd"
+	"github.com/tedyst/licenta/scanner/tlsprobe"
+)
+
+type EtcdQuerier interface {
+	BaseQuerier
+
+	GetEtcdScanByScanID(ctx context.Context, scanID int64) (*queries.EtcdScan, error)
+	GetEtcdDatabase(context.Context, queries.GetEtcdDatabaseParams) (*queries.GetEtcdDatabaseRow, error)
+	UpdateEtcdVersion(ctx context.Context, params queries.UpdateEtcdVersionParams) error
+}
+
+func NewEtcdSaver(ctx context.Context, baseQuerier BaseQuerier, bruteforceProvider bruteforce.BruteforceProvider, scan *queries.Scan, projectIsRemote bool, saltKey string) (Saver, error) {
+	q, ok := baseQuerier.(EtcdQuerier)
+	if !ok {
+		return nil, errors.Join(ErrSaverNotNeeded, fmt.Errorf("queries is not a EtcdQuerier"))
+	}
+
+	etcdScan, err := q.GetEtcdScanByScanID(ctx, scan.ID)
+	if err == pgx.ErrNoRows {
+		return nil, errors.Join(ErrSaverNotNeeded, fmt.Errorf("could not get etcd scan: %w", err))
+	}
+	if err != nil {
+		return nil, errors.Join(ErrSaverNotNeeded, fmt.Errorf("could not get etcd scan: %w", err))
+	}
+
+	db, err := q.GetEtcdDatabase(ctx, queries.GetEtcdDatabaseParams{
+		ID:      etcdScan.DatabaseID,
+		SaltKey: saltKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get database: %w", err)
+	}
+
+	url, err := etcd.ResolveURL(ctx, db.Host, db.Port)
+	if err != nil {
+		// Ping fails with the same error when the scan starts, which marks
+		// the scan as failed.
+		url = fmt.Sprintf("http://%s:%d", db.Host, db.Port)
+	}
+
+	sc, err := etcd.NewScanner(ctx, url, db.Username, db.Password)
+	if err != nil {
+		return nil, fmt.Errorf("could not create scanner: %w", err)
+	}
+
+	logger := slog.With(
+		"scan", scan.ID,
+		"etcd_scan", etcdScan.ID,
+		"etcd_database_id", etcdScan.DatabaseID,
+	)
+
+	saver := &etcdSaver{
+		queries:   q,
+		baseSaver: *createBaseSaver(q, bruteforceProvider, logger, scan, sc, projectIsRemote),
+		etcdScan:  etcdScan,
+		database: &queries.EtcdDatabase{
+			ID:        db.ID,
+			ProjectID: db.ProjectID,
+			Host:      db.Host,
+			Port:      db.Port,
+			Username:  db.Username,
+			Password:  db.Password,
+			Version:   db.Version,
+			CreatedAt: db.CreatedAt,
+		},
+	}
+	saver.runAfterScan = saver.hookAfterScan
+	if strings.HasPrefix(url, "https://") {
+		saver.tlsTarget = &tlsTarget{host: db.Host, port: db.Port, protocol: tlsprobe.PROTOCOL_DIRECT}
+	}
+	return saver, nil
+}
+
+func (saver *etcdSaver) hookAfterScan(ctx context.Context) error {
+	version, err := saver.scanner.GetVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get version: %w", err)
+	}
+	if err := saver.queries.UpdateEtcdVersion(ctx, queries.UpdateEtcdVersionParams{
+		ID:      saver.etcdScan.DatabaseID,
+		Version: sql.NullString{String: version, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("could not update version: %w", err)
+	}
+
+	return nil
+}
+
+type etcdSaver struct {
+	queries EtcdQuerier
+
+	etcdScan *queries.EtcdScan
+	database *queries.EtcdDatabase
+
+	baseSaver
+}
+
+func init() {
+	savers["etcd"] = NewEtcdSaver
+	creaters["etcd"] = CreateEtcdScan
+}
+
+type CreateEtcdScanQuerier interface {
+	BaseCreater
+	GetEtcdDatabasesForProject(context.Context, queries.GetEtcdDatabasesForProjectParams) ([]*queries.GetEtcdDatabasesForProjectRow, error)
+	CreateEtcdScan(ctx context.Context, params queries.CreateEtcdScanParams) (*queries.EtcdScan, error)
+}
+
+var CreateEtcdScan = CreateBaseScan(
+	func(q BaseCreater) (func(context.Context, int64) ([]*queries.EtcdDatabase, error), error) {
+		mq, ok := q.(CreateEtcdScanQuerier)
+		if !ok {
+			return nil, errors.New("querier is not a CreateEtcdScanQuerier")
+		}
+		return func(ctx context.Context, projectID int64) ([]*queries.EtcdDatabase, error) {
+			rows, err := mq.GetEtcdDatabasesForProject(ctx, queries.GetEtcdDatabasesForProjectParams{
+				ProjectID: projectID,
+				SaltKey:   viper.GetString("db-encryption-salt"),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("could not get databases: %w", err)
+			}
+
+			dbs := make([]*queries.EtcdDatabase, 0, len(rows))
+			for _, row := range rows {
+				dbs = append(dbs, &queries.EtcdDatabase{
+					ID:        row.ID,
+					ProjectID: row.ProjectID,
+					Host:      row.Host,
+					Port:      row.Port,
+					Username:  row.Username,
+					Password:  row.Password,
+					Version:   row.Version,
+					CreatedAt: row.CreatedAt,
+				})
+			}
+			return dbs, nil
+		}, nil
+	},
+	func(ctx context.Context, q BaseCreater, scanID int64, db *queries.EtcdDatabase) (any, error) {
+		mq, ok := q.(CreateEtcdScanQuerier)
+		if !ok {
+			return nil, errors.New("querier is not a CreateEtcdScanQuerier")
+		}
+		return mq.CreateEtcdScan(ctx, queries.CreateEtcdScanParams{
+			ScanID:     scanID,
+			DatabaseID: db.ID,
+		})
+	},
+	etcd.GetScannerID(),
+)
+
+var _ EtcdQuerier = (db.TransactionQuerier)(nil)
+var _ CreateEtcdScanQuerier = (db.TransactionQuerier)(nil)
@@ -16,6 +16,7 @@ import (
 	"github.com/tedyst/licenta/db"
 	"github.com/tedyst/licenta/db/queries"
 	"github.com/tedyst/licenta/scanner/mongodb"
+	"github.com/tedyst/licenta/scanner/tlsprobe"
 	m "go.mongodb.org/mongo-driver/mongo"
 )
 
@@ -74,7 +75,12 @@ func NewMongoSaver(ctx context.Context, baseQuerier BaseQuerier, bruteforceProvi
 		return nil, fmt.Errorf("cannot create database connection: %w", err)
 	}
 
-	sc, err := mongodb.NewScanner(ctx, conn)
+	engine, err := loadRuleEngine(ctx, q, db.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("could not load rule packs: %w", err)
+	}
+
+	sc, err := mongodb.NewScanner(ctx, conn, mongodb.WithRuleEngine(engine))
 	if err != nil {
 		return nil, fmt.Errorf("could not create scanner: %w", err)
 	}
@@ -103,6 +109,7 @@ func NewMongoSaver(ctx context.Context, baseQuerier BaseQuerier, bruteforceProvi
 		connection: conn,
 	}
 	saver.runAfterScan = saver.hookAfterScan
+	saver.tlsTarget = &tlsTarget{host: db.Host, port: db.Port, protocol: tlsprobe.PROTOCOL_DIRECT}
 	return saver, nil
 }
 
@@ -0,0 +1,205 @@
+package saver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	_ "github.com/microsoft/go-mssqldb"
+	"github.com/spf13/viper"
+
+	"github.com/tedyst/licenta/bruteforce"
+	"github.com/tedyst/licenta/db"
+	"github.com/tedyst/licenta/db/queries"
+	"github.com/tedyst/licenta/scanner/mssql"
+)
+
+type MssqlQuerier interface {
+	BaseQuerier
+
+	GetMssqlScanByScanID(ctx context.Context, scanID int64) (*queries.MssqlScan, error)
+	GetMssqlDatabase(context.Context, queries.GetMssqlDatabaseParams) (*queries.GetMssqlDatabaseRow, error)
+	UpdateMssqlVersion(ctx context.Context, params queries.UpdateMssqlVersionParams) error
+}
+
+// mssqlDriverName is the database/sql driver used for SQL Server, which is
+// registered by github.com/microsoft/go-mssqldb.
+const mssqlDriverName = "sqlserver"
+
+func getMssqlConnectString(db *queries.MssqlDatabase) string {
+	query := url.Values{}
+	query.Set("database", db.DatabaseName)
+	u := &url.URL{
+		Scheme:   "sqlserver",
+		User:     url.UserPassword(db.Username, db.Password),
+		Host:     db.Host + ":" + strconv.Itoa(int(db.Port)),
+		RawQuery: query.Encode(),
+	}
+	return u.String()
+}
+
+func NewMssqlSaver(ctx context.Context, baseQuerier BaseQuerier, bruteforceProvider bruteforce.BruteforceProvider, scan *queries.Scan, projectIsRemote bool, saltKey string) (Saver, error) {
+	q, ok := baseQuerier.(MssqlQuerier)
+	if !ok {
+		return nil, errors.Join(ErrSaverNotNeeded, fmt.Errorf("queries is not a MssqlQuerier"))
+	}
+
+	mssqlScan, err := q.GetMssqlScanByScanID(ctx, scan.ID)
+	if err == pgx.ErrNoRows {
+		return nil, errors.Join(ErrSaverNotNeeded, fmt.Errorf("could not get mssql scan: %w", err))
+	}
+	if err != nil {
+		return nil, errors.Join(ErrSaverNotNeeded, fmt.Errorf("could not get mssql scan: %w", err))
+	}
+
+	db, err := q.GetMssqlDatabase(ctx, queries.GetMssqlDatabaseParams{
+		ID:      mssqlScan.DatabaseID,
+		SaltKey: saltKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get database: %w", err)
+	}
+
+	conn, err := sql.Open(mssqlDriverName, getMssqlConnectString(&queries.MssqlDatabase{
+		ID:           db.ID,
+		ProjectID:    db.ProjectID,
+		Host:         db.Host,
+		Port:         db.Port,
+		DatabaseName: db.DatabaseName,
+		Username:     db.Username,
+		Password:     db.Password,
+		Version:      db.Version,
+		CreatedAt:    db.CreatedAt,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("cannot create database connection: %w", err)
+	}
+
+	engine, err := loadRuleEngine(ctx, q, db.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("could not load rule packs: %w", err)
+	}
+
+	sc, err := mssql.NewScanner(ctx, conn, mssql.WithRuleEngine(engine))
+	if err != nil {
+		return nil, fmt.Errorf("could not create scanner: %w", err)
+	}
+
+	logger := slog.With(
+		"scan", scan.ID,
+		"mssql_scan", mssqlScan.ID,
+		"mssql_database_id", mssqlScan.DatabaseID,
+	)
+
+	saver := &mssqlSaver{
+		queries:   q,
+		baseSaver: *createBaseSaver(q, bruteforceProvider, logger, scan, sc, projectIsRemote),
+		mssqlScan: mssqlScan,
+		database: &queries.MssqlDatabase{
+			ID:           db.ID,
+			ProjectID:    db.ProjectID,
+			Host:         db.Host,
+			Port:         db.Port,
+			DatabaseName: db.DatabaseName,
+			Username:     db.Username,
+			Password:     db.Password,
+			Version:      db.Version,
+			CreatedAt:    db.CreatedAt,
+		},
+		connection: conn,
+	}
+	saver.runAfterScan = saver.hookAfterScan
+	// SQL Server negotiates TLS inside TDS pre-login packets, which tlsprobe
+	// does not speak, so there is no tlsTarget.
+	return saver, nil
+}
+
+func (saver *mssqlSaver) hookAfterScan(ctx context.Context) error {
+	version, err := saver.scanner.GetVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get version: %w", err)
+	}
+	if err := saver.queries.UpdateMssqlVersion(ctx, queries.UpdateMssqlVersionParams{
+		ID:      saver.mssqlScan.DatabaseID,
+		Version: sql.NullString{String: version, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("could not update version: %w", err)
+	}
+
+	return saver.connection.Close()
+}
+
+type mssqlSaver struct {
+	queries MssqlQuerier
+
+	connection *sql.DB
+
+	mssqlScan *queries.MssqlScan
+	database  *queries.MssqlDatabase
+
+	baseSaver
+}
+
+func init() {
+	savers["mssql"] = NewMssqlSaver
+	creaters["mssql"] = CreateMssqlScan
+}
+
+type CreateMssqlScanQuerier interface {
+	BaseCreater
+	GetMssqlDatabasesForProject(context.Context, queries.GetMssqlDatabasesForProjectParams) ([]*queries.GetMssqlDatabasesForProjectRow, error)
+	CreateMssqlScan(ctx context.Context, params queries.CreateMssqlScanParams) (*queries.MssqlScan, error)
+}
+
+var CreateMssqlScan = CreateBaseScan(
+	func(q BaseCreater) (func(context.Context, int64) ([]*queries.MssqlDatabase, error), error) {
+		mq, ok := q.(CreateMssqlScanQuerier)
+		if !ok {
+			return nil, errors.New("querier is not a CreateMssqlScanQuerier")
+		}
+		return func(ctx context.Context, projectID int64) ([]*queries.MssqlDatabase, error) {
+			rows, err := mq.GetMssqlDatabasesForProject(ctx, queries.GetMssqlDatabasesForProjectParams{
+				ProjectID: projectID,
+				SaltKey:   viper.GetString("db-encryption-salt"),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("could not get databases: %w", err)
+			}
+
+			dbs := make([]*queries.MssqlDatabase, 0, len(rows))
+			for _, row := range rows {
+				dbs = append(dbs, &queries.MssqlDatabase{
+					ID:           row.ID,
+					ProjectID:    row.ProjectID,
+					Host:         row.Host,
+					Port:         row.Port,
+					DatabaseName: row.DatabaseName,
+					Username:     row.Username,
+					Password:     row.Password,
+					Version:      row.Version,
+					CreatedAt:    row.CreatedAt,
+				})
+			}
+			return dbs, nil
+		}, nil
+	},
+	func(ctx context.Context, q BaseCreater, scanID int64, db *queries.MssqlDatabase) (any, error) {
+		mq, ok := q.(CreateMssqlScanQuerier)
+		if !ok {
+			return nil, errors.New("querier is not a CreateMssqlScanQuerier")
+		}
+		return mq.CreateMssqlScan(ctx, queries.CreateMssqlScanParams{
+			ScanID:     scanID,
+			DatabaseID: db.ID,
+		})
+	},
+	mssql.GetScannerID(),
+)
+
+var _ MssqlQuerier = (db.TransactionQuerier)(nil)
+var _ CreateMssqlScanQuerier = (db.TransactionQuerier)(nil)
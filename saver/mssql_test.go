@@ -0,0 +1,24 @@
+package saver
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/tedyst/licenta/db/queries"
+)
+
+func TestMssqlDriver(t *testing.T) {
+	conn, err := sql.Open(mssqlDriverName, getMssqlConnectString(&queries.MssqlDatabase{
+		Host:         "localhost",
+		Port:         1433,
+		DatabaseName: "master",
+		Username:     "sa",
+		Password:     "p@ss;word",
+	}))
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+	if err := conn.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
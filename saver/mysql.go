@@ -15,6 +15,7 @@ import (
 	"github.com/tedyst/licenta/db"
 	"github.com/tedyst/licenta/db/queries"
 	"github.com/tedyst/licenta/scanner/mysql"
+	"github.com/tedyst/licenta/scanner/tlsprobe"
 )
 
 type MysqlQuerier interface {
@@ -66,7 +67,12 @@ func NewMysqlSaver(ctx context.Context, baseQuerier BaseQuerier, bruteforceProvi
 		return nil, fmt.Errorf("cannot create database connection: %w", err)
 	}
 
-	sc, err := mysql.NewScanner(ctx, conn)
+	engine, err := loadRuleEngine(ctx, q, db.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("could not load rule packs: %w", err)
+	}
+
+	sc, err := mysql.NewScanner(ctx, conn, mysql.WithRuleEngine(engine))
 	if err != nil {
 		return nil, fmt.Errorf("could not create scanner: %w", err)
 	}
@@ -95,6 +101,7 @@ func NewMysqlSaver(ctx context.Context, baseQuerier BaseQuerier, bruteforceProvi
 		connection: conn,
 	}
 	saver.runAfterScan = saver.hookAfterScan
+	saver.tlsTarget = &tlsTarget{host: db.Host, port: db.Port, protocol: tlsprobe.PROTOCOL_MYSQL}
 	return saver, nil
 }
 
@@ -13,6 +13,7 @@ import (
 	"github.com/tedyst/licenta/db"
 	"github.com/tedyst/licenta/db/queries"
 	"github.com/tedyst/licenta/scanner/postgres"
+	"github.com/tedyst/licenta/scanner/tlsprobe"
 )
 
 func getPostgresConnectString(db *queries.PostgresDatabase) string {
@@ -65,7 +66,12 @@ func NewPostgresSaver(ctx context.Context, baseQuerier BaseQuerier, bruteforcePr
 		conn = nil
 	}
 
-	sc, err := postgres.NewScanner(ctx, conn)
+	engine, err := loadRuleEngine(ctx, q, db.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("could not load rule packs: %w", err)
+	}
+
+	sc, err := postgres.NewScanner(ctx, conn, postgres.WithRuleEngine(engine))
 	if err != nil {
 		return nil, fmt.Errorf("could not create scanner: %w", err)
 	}
@@ -94,6 +100,7 @@ func NewPostgresSaver(ctx context.Context, baseQuerier BaseQuerier, bruteforcePr
 		connection: conn,
 	}
 	saver.runAfterScan = saver.hookAfterScan
+	saver.tlsTarget = &tlsTarget{host: db.Host, port: db.Port, protocol: tlsprobe.PROTOCOL_POSTGRES}
 	return saver, nil
 }
 
@@ -16,6 +16,7 @@ import (
 	"github.com/tedyst/licenta/db"
 	"github.com/tedyst/licenta/db/queries"
 	"github.com/tedyst/licenta/scanner/redis"
+	"github.com/tedyst/licenta/scanner/tlsprobe"
 )
 
 type RedisQuerier interface {
@@ -60,7 +61,12 @@ func NewRedisSaver(ctx context.Context, baseQuerier BaseQuerier, bruteforceProvi
 		PoolTimeout:           5 * time.Second,
 	})
 
-	sc, err := redis.NewScanner(ctx, conn)
+	engine, err := loadRuleEngine(ctx, q, db.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("could not load rule packs: %w", err)
+	}
+
+	sc, err := redis.NewScanner(ctx, conn, redis.WithRuleEngine(engine))
 	if err != nil {
 		return nil, fmt.Errorf("could not create scanner: %w", err)
 	}
@@ -88,6 +94,7 @@ func NewRedisSaver(ctx context.Context, baseQuerier BaseQuerier, bruteforceProvi
 		connection: conn,
 	}
 	saver.runAfterScan = saver.hookAfterScan
+	saver.tlsTarget = &tlsTarget{host: db.Host, port: db.Port, protocol: tlsprobe.PROTOCOL_DIRECT}
 	return saver, nil
 }
 
@@ -0,0 +1,48 @@
+package saver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/viper"
+	"github.com/tedyst/licenta/db"
+	"github.com/tedyst/licenta/db/queries"
+	"github.com/tedyst/licenta/scanner/rules"
+)
+
+type RulePackQuerier interface {
+	GetRulePacksForProject(ctx context.Context, id int64) ([]*queries.RulePack, error)
+}
+
+// loadRuleEngine builds the rule engine used for configuration checks. The
+// builtin packs are loaded first, then the packs configured on disk and
+// finally the packs stored for the project's organization, so later packs can
+// override or disable earlier rules.
+func loadRuleEngine(ctx context.Context, q BaseQuerier, projectID int64) (*rules.Engine, error) {
+	engine := rules.Default()
+
+	for _, path := range viper.GetStringSlice("rule-packs") {
+		if err := engine.LoadPath(path); err != nil {
+			return nil, err
+		}
+	}
+
+	rq, ok := q.(RulePackQuerier)
+	if !ok {
+		return engine, nil
+	}
+
+	packs, err := rq.GetRulePacksForProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get rule packs: %w", err)
+	}
+	for _, pack := range packs {
+		if err := engine.LoadPack([]byte(pack.Content)); err != nil {
+			return nil, fmt.Errorf("could not load rule pack %s: %w", pack.Name, err)
+		}
+	}
+
+	return engine, nil
+}
+
+var _ RulePackQuerier = (db.TransactionQuerier)(nil)
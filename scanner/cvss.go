@@ -0,0 +1,33 @@
+package scanner
+
+import "strings"
+
+// SeverityFromCVSS maps a CVSS base score to a Severity using the CVSS v3
+// qualitative ratings. Vulnerabilities that can only be exploited with local
+// or physical access to the server are lowered by one level, since the
+// scanned databases are reached over the network.
+func SeverityFromCVSS(score float64, vector string) Severity {
+	var severity Severity
+	switch {
+	case score >= 7.0:
+		severity = SEVERITY_HIGH
+	case score >= 4.0:
+		severity = SEVERITY_MEDIUM
+	case score > 0:
+		severity = SEVERITY_WARNING
+	default:
+		return SEVERITY_INFORMATIONAL
+	}
+
+	for _, metric := range strings.Split(vector, "/") {
+		if metric == "AV:L" || metric == "AV:P" {
+			severity--
+			break
+		}
+	}
+
+	if severity < SEVERITY_WARNING {
+		return SEVERITY_WARNING
+	}
+	return severity
+}
@@ -0,0 +1,28 @@
+package scanner
+
+import "testing"
+
+func TestSeverityFromCVSS(t *testing.T) {
+	tests := []struct {
+		name   string
+		score  float64
+		vector string
+		want   Severity
+	}{
+		{"critical network", 9.8, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", SEVERITY_HIGH},
+		{"high local", 7.8, "CVSS:3.1/AV:L/AC:L/PR:L/UI:N/S:U/C:H/I:H/A:H", SEVERITY_MEDIUM},
+		{"medium network", 6.5, "CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:U/C:N/I:N/A:H", SEVERITY_MEDIUM},
+		{"medium physical", 4.6, "CVSS:3.1/AV:P/AC:L/PR:N/UI:N/S:U/C:H/I:N/A:N", SEVERITY_WARNING},
+		{"low local", 2.5, "CVSS:3.1/AV:L/AC:H/PR:H/UI:N/S:U/C:L/I:N/A:N", SEVERITY_WARNING},
+		{"cvss v2", 7.5, "AV:N/AC:L/Au:N/C:P/I:P/A:P", SEVERITY_HIGH},
+		{"no vector", 5.0, "", SEVERITY_MEDIUM},
+		{"no score", 0, "", SEVERITY_INFORMATIONAL},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SeverityFromCVSS(tt.score, tt.vector); got != tt.want {
+				t.Errorf("SeverityFromCVSS() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
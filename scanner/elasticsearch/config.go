@@ -0,0 +1,108 @@
+package elasticsearch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/tedyst/licenta/scanner"
+)
+
+// corsProbeOrigin is sent as the Origin of the CORS check. A cluster that
+// allows it would also allow any website to read its data from a browser.
+const corsProbeOrigin = "https://cors-probe.invalid"
+
+// securityEnabled reports whether authentication is enabled on the cluster.
+// known is false when the cluster did not answer in a way that can be
+// interpreted, for example because the user can not read the feature list.
+func (sc *elasticsearchScanner) securityEnabled(ctx context.Context) (enabled bool, known bool, err error) {
+	if sc.isOpenSearch() {
+		response, _, err := sc.do(ctx, "/_plugins/_security/health", true, nil)
+		if err != nil {
+			return false, false, err
+		}
+		switch response.StatusCode {
+		case http.StatusOK:
+			return true, true, nil
+		case http.StatusNotFound, http.StatusBadRequest:
+			return false, true, nil
+		default:
+			return false, false, nil
+		}
+	}
+
+	var features struct {
+		Features struct {
+			Security struct {
+				Available bool `json:"available"`
+				Enabled   bool `json:"enabled"`
+			} `json:"security"`
+		} `json:"features"`
+	}
+	status, err := sc.getJSON(ctx, "/_xpack?categories=features", &features)
+	if err != nil {
+		return false, false, err
+	}
+	if status != http.StatusOK {
+		return false, false, nil
+	}
+	return features.Features.Security.Enabled, true, nil
+}
+
+func (sc *elasticsearchScanner) ScanConfig(ctx context.Context) ([]scanner.ScanResult, error) {
+	results := []scanner.ScanResult{}
+	server := scanner.AffectedObject{Type: scanner.OBJECT_SERVER, Name: sc.url}
+	add := func(ruleID string, severity scanner.Severity, message string, detail string, remediation string, evidence string) {
+		results = append(results, scanner.NewResult(severity, scanner.Finding{
+			RuleID:         ruleID,
+			Title:          message,
+			Description:    detail,
+			Remediation:    remediation,
+			AffectedObject: server,
+			Evidence:       evidence,
+		}))
+	}
+
+	if strings.HasPrefix(sc.url, "http://") {
+		add("elasticsearch-http-without-tls", scanner.SEVERITY_HIGH, "The HTTP API is served without TLS.",
+			"The HTTP API does not use TLS, so credentials and documents are sent in clear text.",
+			"Set xpack.security.http.ssl.enabled or plugins.security.ssl.http.enabled to true.", sc.url)
+	}
+
+	enabled, known, err := sc.securityEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if known && !enabled {
+		add("elasticsearch-security-disabled", scanner.SEVERITY_HIGH, "Security is disabled.",
+			"Security is disabled, so anyone that can reach the cluster can read, change and delete every index.",
+			"Enable security with xpack.security.enabled or install the security plugin.", "")
+	} else {
+		response, body, err := sc.do(ctx, "/_cat/indices?format=json", false, nil)
+		if err != nil {
+			return nil, err
+		}
+		if response.StatusCode == http.StatusOK {
+			add("elasticsearch-anonymous-access", scanner.SEVERITY_HIGH, "Anonymous users can list the indices.",
+				"Requests without credentials are accepted and can list the indices of the cluster.",
+				"Remove the anonymous user or limit its roles.", fmt.Sprintf("GET /_cat/indices returned %d bytes without credentials", len(body)))
+		}
+	}
+
+	response, _, err := sc.do(ctx, "/", true, http.Header{"Origin": []string{corsProbeOrigin}})
+	if err != nil {
+		return nil, err
+	}
+	if origin := response.Header.Get("Access-Control-Allow-Origin"); origin == "*" || origin == corsProbeOrigin {
+		severity := scanner.SEVERITY_MEDIUM
+		if response.Header.Get("Access-Control-Allow-Credentials") == "true" {
+			severity = scanner.SEVERITY_HIGH
+		}
+		add("elasticsearch-open-cors", severity, "CORS allows any origin.",
+			"CORS allows requests from any origin, so any website visited by a user that can reach the cluster can read its data.",
+			"Set http.cors.allow-origin to the origins that need access or disable http.cors.enabled.", "Access-Control-Allow-Origin: "+origin)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,188 @@
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/tedyst/licenta/models"
+	"github.com/tedyst/licenta/nvd"
+	"github.com/tedyst/licenta/scanner"
+)
+
+const distributionOpenSearch = "opensearch"
+
+// clusterInfo is the response of GET /.
+type clusterInfo struct {
+	Name        string `json:"name"`
+	ClusterName string `json:"cluster_name"`
+	Version     struct {
+		Number       string `json:"number"`
+		Distribution string `json:"distribution"`
+	} `json:"version"`
+}
+
+type elasticsearchScanner struct {
+	url      string
+	username string
+	password string
+
+	info *clusterInfo
+
+	options *options
+}
+
+var _ scanner.Scanner = (*elasticsearchScanner)(nil)
+
+func (sc *elasticsearchScanner) GetScannerName() string {
+	return "elasticsearch"
+}
+func (sc *elasticsearchScanner) GetScannerID() int32 {
+	return models.SCAN_ELASTICSEARCH
+}
+func GetScannerID() int32 {
+	return models.SCAN_ELASTICSEARCH
+}
+
+// GetNvdProductType returns OpenSearch for clusters that reported the
+// opensearch distribution in Ping, and Elasticsearch otherwise.
+func (sc *elasticsearchScanner) GetNvdProductType() nvd.Product {
+	if sc.isOpenSearch() {
+		return nvd.OPENSEARCH
+	}
+	return nvd.ELASTICSEARCH
+}
+func (sc *elasticsearchScanner) ShouldNotBePublic() bool {
+	return true
+}
+
+func (sc *elasticsearchScanner) isOpenSearch() bool {
+	return sc.info != nil && sc.info.Version.Distribution == distributionOpenSearch
+}
+
+// do sends a GET request to the cluster. The credentials are only sent when
+// authenticated is set, which is used to check for anonymous access.
+func (sc *elasticsearchScanner) do(ctx context.Context, path string, authenticated bool, header http.Header) (*http.Response, []byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, sc.url+path, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create request: %w", err)
+	}
+	for key, values := range header {
+		request.Header[key] = values
+	}
+	if authenticated && (sc.username != "" || sc.password != "") {
+		request.SetBasicAuth(sc.username, sc.password)
+	}
+
+	response, err := sc.options.client.Do(request)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not send request to %s: %w", path, err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, 32<<20))
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not read response from %s: %w", path, err)
+	}
+	return response, body, nil
+}
+
+// getJSON sends an authenticated GET request and decodes the response if the
+// status is 200. The status is returned in every other case.
+func (sc *elasticsearchScanner) getJSON(ctx context.Context, path string, v any) (int, error) {
+	response, body, err := sc.do(ctx, path, true, nil)
+	if err != nil {
+		return 0, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return response.StatusCode, nil
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return response.StatusCode, fmt.Errorf("could not decode response from %s: %w", path, err)
+	}
+	return response.StatusCode, nil
+}
+
+func (sc *elasticsearchScanner) Ping(ctx context.Context) error {
+	info := &clusterInfo{}
+	status, err := sc.getJSON(ctx, "/", info)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from /", status)
+	}
+	sc.info = info
+	return nil
+}
+
+func (sc *elasticsearchScanner) CheckPermissions(ctx context.Context) error {
+	var health map[string]any
+	status, err := sc.getJSON(ctx, "/_cluster/health", &health)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("could not read /_cluster/health: status %d", status)
+	}
+	return nil
+}
+
+func (sc *elasticsearchScanner) GetVersion(ctx context.Context) (string, error) {
+	if sc.info == nil {
+		if err := sc.Ping(ctx); err != nil {
+			return "", err
+		}
+	}
+	if sc.info.Version.Number == "" {
+		return "", errors.New("could not find version")
+	}
+	return sc.info.Version.Number, nil
+}
+
+// ResolveURL returns the base URL of the cluster on host and port, preferring
+// HTTPS. Any HTTP response, including an authentication error, means that the
+// scheme is correct.
+func ResolveURL(ctx context.Context, host string, port int32, opts ...Option) (string, error) {
+	o, err := makeOptions(opts...)
+	if err != nil {
+		return "", err
+	}
+
+	var errs []error
+	for _, scheme := range []string{"https", "http"} {
+		url := fmt.Sprintf("%s://%s:%d", scheme, host, port)
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, url+"/", nil)
+		if err != nil {
+			return "", fmt.Errorf("could not create request: %w", err)
+		}
+		response, err := o.client.Do(request)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		response.Body.Close()
+		return url, nil
+	}
+	return "", fmt.Errorf("could not connect to %s:%d: %w", host, port, errors.Join(errs...))
+}
+
+func NewScanner(ctx context.Context, url string, username string, password string, opts ...Option) (scanner.Scanner, error) {
+	o, err := makeOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	sc := &elasticsearchScanner{
+		url:      strings.TrimSuffix(url, "/"),
+		username: username,
+		password: password,
+		options:  o,
+	}
+
+	return sc, nil
+}
@@ -0,0 +1,228 @@
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/tedyst/licenta/nvd"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type fakeCluster struct {
+	distribution    string
+	securityEnabled bool
+	anonymous       bool
+	cors            string
+	users           []map[string]any
+}
+
+func (c *fakeCluster) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if c.cors != "" {
+		w.Header().Set("Access-Control-Allow-Origin", c.cors)
+	}
+
+	username, password, ok := r.BasicAuth()
+	authenticated := ok && username == "elastic" && password == "changeme"
+	if c.securityEnabled && !authenticated && !(c.anonymous && r.URL.Path == "/_cat/indices") {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var response any
+	switch r.URL.Path {
+	case "/":
+		response = map[string]any{
+			"name":         "node-1",
+			"cluster_name": "test",
+			"version":      map[string]any{"number": "8.11.1", "distribution": c.distribution},
+		}
+	case "/_cluster/health":
+		response = map[string]any{"status": "green"}
+	case "/_xpack":
+		if c.distribution == distributionOpenSearch {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		response = map[string]any{"features": map[string]any{"security": map[string]any{"available": true, "enabled": c.securityEnabled}}}
+	case "/_plugins/_security/health":
+		if c.distribution != distributionOpenSearch || !c.securityEnabled {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		response = map[string]any{"status": "UP"}
+	case "/_cat/indices":
+		response = []map[string]any{{"index": "customers"}}
+	case "/.security/_search":
+		hits := []map[string]any{}
+		for _, user := range c.users {
+			hits = append(hits, map[string]any{"_source": user})
+		}
+		response = map[string]any{"hits": map[string]any{"hits": hits}}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func newTestScanner(t *testing.T, server *httptest.Server) *elasticsearchScanner {
+	t.Helper()
+	sc, err := NewScanner(context.Background(), server.URL, "elastic", "changeme", WithHTTPClient(server.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sc.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+	return sc.(*elasticsearchScanner)
+}
+
+func TestElasticsearchScanner_ScanConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster fakeCluster
+		tls     bool
+		want    []string
+	}{
+		{
+			name:    "secure",
+			cluster: fakeCluster{securityEnabled: true},
+			tls:     true,
+			want:    []string{},
+		},
+		{
+			name:    "security disabled over http",
+			cluster: fakeCluster{cors: "*"},
+			want:    []string{"elasticsearch-http-without-tls", "elasticsearch-security-disabled", "elasticsearch-open-cors"},
+		},
+		{
+			name:    "anonymous access",
+			cluster: fakeCluster{securityEnabled: true, anonymous: true},
+			tls:     true,
+			want:    []string{"elasticsearch-anonymous-access"},
+		},
+		{
+			name:    "opensearch without security plugin",
+			cluster: fakeCluster{distribution: distributionOpenSearch},
+			tls:     true,
+			want:    []string{"elasticsearch-security-disabled"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var server *httptest.Server
+			if tt.tls {
+				server = httptest.NewTLSServer(&tt.cluster)
+			} else {
+				server = httptest.NewServer(&tt.cluster)
+			}
+			defer server.Close()
+
+			sc := newTestScanner(t, server)
+			results, err := sc.ScanConfig(context.Background())
+			if err != nil {
+				t.Fatalf("ScanConfig() error = %v", err)
+			}
+			got := []string{}
+			for _, result := range results {
+				got = append(got, result.Finding().RuleID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ScanConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestElasticsearchScanner_GetVersion(t *testing.T) {
+	for _, tt := range []struct {
+		distribution string
+		product      nvd.Product
+	}{
+		{"", nvd.ELASTICSEARCH},
+		{distributionOpenSearch, nvd.OPENSEARCH},
+	} {
+		server := httptest.NewServer(&fakeCluster{distribution: tt.distribution})
+		sc := newTestScanner(t, server)
+		version, err := sc.GetVersion(context.Background())
+		if err != nil || version != "8.11.1" {
+			t.Errorf("GetVersion() = %q, %v, want 8.11.1", version, err)
+		}
+		if product := sc.GetNvdProductType(); product != tt.product {
+			t.Errorf("GetNvdProductType() = %v, want %v", product, tt.product)
+		}
+		server.Close()
+	}
+}
+
+func TestElasticsearchScanner_GetUsers(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("hunter2"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cluster := &fakeCluster{
+		securityEnabled: true,
+		users: []map[string]any{
+			{"username": "admin", "password": string(hash), "roles": []string{"superuser"}, "enabled": true},
+			{"username": "reader", "password": string(hash), "roles": []string{"viewer"}, "enabled": true},
+			{"username": "disabled", "password": string(hash), "roles": []string{"superuser"}, "enabled": false},
+			{"username": "pbkdf2", "password": "{PBKDF2}10000$abc$def", "roles": []string{"viewer"}, "enabled": true},
+		},
+	}
+	server := httptest.NewTLSServer(cluster)
+	defer server.Close()
+
+	users, err := newTestScanner(t, server).GetUsers(context.Background())
+	if err != nil {
+		t.Fatalf("GetUsers() error = %v", err)
+	}
+	if len(users) != 2 {
+		t.Fatalf("GetUsers() returned %d users, want 2", len(users))
+	}
+
+	for i, want := range []struct {
+		name       string
+		privileged bool
+	}{{"admin", true}, {"reader", false}} {
+		name, _ := users[i].GetUsername()
+		privileged, _ := users[i].IsPrivileged()
+		if name != want.name || privileged != want.privileged {
+			t.Errorf("user %d = (%s, %v), want (%s, %v)", i, name, privileged, want.name, want.privileged)
+		}
+		if ok, err := users[i].VerifyPassword("hunter2"); !ok || err != nil {
+			t.Errorf("VerifyPassword(hunter2) = %v, %v, want true", ok, err)
+		}
+		if ok, err := users[i].VerifyPassword("wrong"); ok || err != nil {
+			t.Errorf("VerifyPassword(wrong) = %v, %v, want false", ok, err)
+		}
+	}
+}
+
+func TestResolveURL(t *testing.T) {
+	for _, newServer := range []func(http.Handler) *httptest.Server{httptest.NewServer, httptest.NewTLSServer} {
+		server := newServer(&fakeCluster{securityEnabled: true})
+		host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		portNumber, err := strconv.Atoi(port)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		url, err := ResolveURL(context.Background(), host, int32(portNumber), WithHTTPClient(server.Client()))
+		if err != nil {
+			t.Fatalf("ResolveURL() error = %v", err)
+		}
+		if url != server.URL {
+			t.Errorf("ResolveURL() = %s, want %s", url, server.URL)
+		}
+		server.Close()
+	}
+}
@@ -0,0 +1,45 @@
+package elasticsearch
+
+import (
+	"crypto/tls"
+	"net/http"
+	"time"
+)
+
+type Option func(*options) error
+
+type options struct {
+	client *http.Client
+}
+
+// WithHTTPClient sets the client used for all requests. The default client
+// does not verify certificates, since the certificate itself is checked by
+// the TLS probe.
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *options) error {
+		o.client = client
+		return nil
+	}
+}
+
+func defaultHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+}
+
+func makeOptions(opts ...Option) (*options, error) {
+	o := &options{}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+	if o.client == nil {
+		o.client = defaultHTTPClient()
+	}
+	return o, nil
+}
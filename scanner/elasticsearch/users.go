@@ -0,0 +1,118 @@
+package elasticsearch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/tedyst/licenta/scanner"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type elasticsearchUser struct {
+	name       string
+	hash       string
+	privileged bool
+}
+
+var _ scanner.User = (*elasticsearchUser)(nil)
+
+// privilegedRoles are the builtin roles that can manage security or read
+// every index.
+var privilegedRoles = map[string]struct{}{
+	"superuser":        {},
+	"all_access":       {},
+	"security_manager": {},
+}
+
+func (u *elasticsearchUser) VerifyPassword(password string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(u.hash), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (u *elasticsearchUser) GetRawPassword() (string, bool, error) {
+	return "", false, nil
+}
+
+func (u *elasticsearchUser) IsPrivileged() (bool, error) {
+	return u.privileged, nil
+}
+
+func (u *elasticsearchUser) HasPassword() (bool, error) {
+	return u.hash != "", nil
+}
+
+func (u *elasticsearchUser) GetUsername() (string, error) {
+	return u.name, nil
+}
+
+func (u *elasticsearchUser) GetHashedPassword() (string, error) {
+	return u.hash, nil
+}
+
+func isBcryptHash(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
+
+// GetUsers reads the native realm users from the security index. Only
+// superusers can read it, so a cluster where the scanning user is not allowed
+// returns no users instead of an error. Users hashed with anything other than
+// bcrypt are skipped.
+func (sc *elasticsearchScanner) GetUsers(ctx context.Context) ([]scanner.User, error) {
+	if sc.isOpenSearch() {
+		// The internal users of OpenSearch can only be read with the admin
+		// certificate.
+		return nil, scanner.ErrGetUsersNotSupported
+	}
+
+	var response struct {
+		Hits struct {
+			Hits []struct {
+				Source struct {
+					Username string   `json:"username"`
+					Password string   `json:"password"`
+					Roles    []string `json:"roles"`
+					Enabled  bool     `json:"enabled"`
+				} `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	status, err := sc.getJSON(ctx, "/.security/_search?q=type:user&size=10000", &response)
+	if err != nil {
+		return nil, err
+	}
+	switch status {
+	case http.StatusOK:
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+		return []scanner.User{}, nil
+	default:
+		return nil, errors.New("could not read the security index")
+	}
+
+	users := []scanner.User{}
+	for _, hit := range response.Hits.Hits {
+		user := hit.Source
+		if !user.Enabled || !isBcryptHash(user.Password) {
+			continue
+		}
+		privileged := false
+		for _, role := range user.Roles {
+			if _, ok := privilegedRoles[role]; ok {
+				privileged = true
+			}
+		}
+		users = append(users, &elasticsearchUser{
+			name:       user.Username,
+			hash:       user.Password,
+			privileged: privileged,
+		})
+	}
+	return users, nil
+}
@@ -0,0 +1,311 @@
+package etcd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	permissionRead      = "READ"
+	permissionWrite     = "WRITE"
+	permissionReadWrite = "READWRITE"
+
+	rootUser = "root"
+	rootRole = "root"
+)
+
+// Names of the bbolt buckets that store the auth data in the snapshot.
+const (
+	bucketAuthUsers = "authUsers"
+	bucketAuthRoles = "authRoles"
+)
+
+type etcdPermission struct {
+	permType string
+	key      []byte
+	rangeEnd []byte
+}
+
+// coversKeyspace reports whether the permission applies to every key, which
+// is how `etcdctl role grant-permission --prefix role readwrite ""` is stored.
+func (p etcdPermission) coversKeyspace() bool {
+	return (len(p.key) == 0 || bytes.Equal(p.key, []byte{0})) && bytes.Equal(p.rangeEnd, []byte{0})
+}
+
+type etcdRole struct {
+	name        string
+	permissions []etcdPermission
+}
+
+// hasFullReadWrite reports whether the role can read and write every key.
+func (role *etcdRole) hasFullReadWrite() bool {
+	if role.name == rootRole {
+		return true
+	}
+	for _, permission := range role.permissions {
+		if permission.permType == permissionReadWrite && permission.coversKeyspace() {
+			return true
+		}
+	}
+	return false
+}
+
+type etcdAuthUser struct {
+	name       string
+	password   string
+	roles      []string
+	noPassword bool
+}
+
+func (sc *etcdScanner) authEnabled(ctx context.Context) (bool, error) {
+	var response struct {
+		Enabled bool `json:"enabled"`
+	}
+	if err := sc.call(ctx, "/auth/status", struct{}{}, &response); err != nil {
+		return false, fmt.Errorf("could not get auth status: %w", err)
+	}
+	return response.Enabled, nil
+}
+
+// getAuth reads the users and their roles through the auth API. The password
+// hashes are not available through it.
+func (sc *etcdScanner) getAuth(ctx context.Context) ([]*etcdAuthUser, map[string]*etcdRole, error) {
+	var userList struct {
+		Users []string `json:"users"`
+	}
+	if err := sc.call(ctx, "/auth/user/list", struct{}{}, &userList); err != nil {
+		return nil, nil, fmt.Errorf("could not list users: %w", err)
+	}
+	sort.Strings(userList.Users)
+
+	users := make([]*etcdAuthUser, 0, len(userList.Users))
+	for _, name := range userList.Users {
+		var user struct {
+			Roles []string `json:"roles"`
+		}
+		if err := sc.call(ctx, "/auth/user/get", map[string]string{"name": name}, &user); err != nil {
+			return nil, nil, fmt.Errorf("could not get user %s: %w", name, err)
+		}
+		users = append(users, &etcdAuthUser{name: name, roles: user.Roles})
+	}
+
+	var roleList struct {
+		Roles []string `json:"roles"`
+	}
+	if err := sc.call(ctx, "/auth/role/list", struct{}{}, &roleList); err != nil {
+		return nil, nil, fmt.Errorf("could not list roles: %w", err)
+	}
+
+	roles := map[string]*etcdRole{}
+	for _, name := range roleList.Roles {
+		var role struct {
+			Perm []struct {
+				PermType string `json:"permType"`
+				Key      []byte `json:"key"`
+				RangeEnd []byte `json:"range_end"`
+			} `json:"perm"`
+		}
+		if err := sc.call(ctx, "/auth/role/get", map[string]string{"role": name}, &role); err != nil {
+			return nil, nil, fmt.Errorf("could not get role %s: %w", name, err)
+		}
+		r := &etcdRole{name: name}
+		for _, perm := range role.Perm {
+			permType := perm.PermType
+			if permType == "" {
+				// READ is the zero value, so the gateway omits it.
+				permType = permissionRead
+			}
+			r.permissions = append(r.permissions, etcdPermission{permType: permType, key: perm.Key, rangeEnd: perm.RangeEnd})
+		}
+		roles[name] = r
+	}
+
+	return users, roles, nil
+}
+
+// getSnapshot downloads a snapshot through the maintenance API. The gateway
+// streams it as one JSON object per chunk.
+func (sc *etcdScanner) getSnapshot(ctx context.Context) ([]byte, error) {
+	response, err := sc.send(ctx, "/maintenance/snapshot", struct{}{}, true)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden {
+		return nil, errPermissionDenied
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d from /maintenance/snapshot", response.StatusCode)
+	}
+
+	var snapshot []byte
+	decoder := json.NewDecoder(response.Body)
+	for {
+		var chunk struct {
+			Result *struct {
+				Blob []byte `json:"blob"`
+			} `json:"result"`
+			Error *gatewayError `json:"error"`
+		}
+		err := decoder.Decode(&chunk)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not decode snapshot: %w", err)
+		}
+		if chunk.Error != nil {
+			if chunk.Error.Code == grpcPermissionDenied || chunk.Error.Code == grpcUnauthenticated {
+				return nil, fmt.Errorf("%w: %s", errPermissionDenied, chunk.Error.Message)
+			}
+			return nil, fmt.Errorf("could not get snapshot: %s", chunk.Error.Message)
+		}
+		if chunk.Result == nil {
+			continue
+		}
+		if int64(len(snapshot)+len(chunk.Result.Blob)) > sc.options.maxSnapshotSize {
+			return nil, fmt.Errorf("snapshot is larger than %d bytes", sc.options.maxSnapshotSize)
+		}
+		snapshot = append(snapshot, chunk.Result.Blob...)
+	}
+	if len(snapshot) == 0 {
+		return nil, errors.New("empty snapshot")
+	}
+	return snapshot, nil
+}
+
+// getSnapshotAuth reads the users, including their password hashes, and the
+// roles from a snapshot.
+func (sc *etcdScanner) getSnapshotAuth(ctx context.Context) ([]*etcdAuthUser, map[string]*etcdRole, error) {
+	snapshot, err := sc.getSnapshot(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return parseSnapshotAuth(snapshot)
+}
+
+func parseSnapshotAuth(snapshot []byte) ([]*etcdAuthUser, map[string]*etcdRole, error) {
+	buckets, err := readBoltBuckets(snapshot, bucketAuthUsers, bucketAuthRoles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	users := []*etcdAuthUser{}
+	for _, value := range buckets[bucketAuthUsers] {
+		user, err := unmarshalUser(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		users = append(users, user)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].name < users[j].name })
+
+	roles := map[string]*etcdRole{}
+	for _, value := range buckets[bucketAuthRoles] {
+		role, err := unmarshalRole(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		roles[role.name] = role
+	}
+	return users, roles, nil
+}
+
+// protoFields calls fn for every field of a protobuf message.
+func protoFields(b []byte, fn func(num protowire.Number, typ protowire.Type, value []byte, varint uint64)) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return fmt.Errorf("%w: %w", ErrInvalidSnapshot, protowire.ParseError(n))
+		}
+		b = b[n:]
+
+		var value []byte
+		var varint uint64
+		switch typ {
+		case protowire.BytesType:
+			value, n = protowire.ConsumeBytes(b)
+		case protowire.VarintType:
+			varint, n = protowire.ConsumeVarint(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return fmt.Errorf("%w: %w", ErrInvalidSnapshot, protowire.ParseError(n))
+		}
+		b = b[n:]
+		fn(num, typ, value, varint)
+	}
+	return nil
+}
+
+// unmarshalUser decodes an authpb.User.
+func unmarshalUser(b []byte) (*etcdAuthUser, error) {
+	user := &etcdAuthUser{}
+	err := protoFields(b, func(num protowire.Number, typ protowire.Type, value []byte, _ uint64) {
+		if typ != protowire.BytesType {
+			return
+		}
+		switch num {
+		case 1:
+			user.name = string(value)
+		case 2:
+			user.password = string(value)
+		case 3:
+			user.roles = append(user.roles, string(value))
+		case 4:
+			_ = protoFields(value, func(num protowire.Number, typ protowire.Type, _ []byte, varint uint64) {
+				if num == 1 && typ == protowire.VarintType {
+					user.noPassword = varint != 0
+				}
+			})
+		}
+	})
+	return user, err
+}
+
+var permissionTypes = map[uint64]string{
+	0: permissionRead,
+	1: permissionWrite,
+	2: permissionReadWrite,
+}
+
+// unmarshalRole decodes an authpb.Role.
+func unmarshalRole(b []byte) (*etcdRole, error) {
+	role := &etcdRole{}
+	var errs []error
+	err := protoFields(b, func(num protowire.Number, typ protowire.Type, value []byte, _ uint64) {
+		if typ != protowire.BytesType {
+			return
+		}
+		switch num {
+		case 1:
+			role.name = string(value)
+		case 2:
+			permission := etcdPermission{permType: permissionRead}
+			errs = append(errs, protoFields(value, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) {
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					permission.permType = permissionTypes[varint]
+				case num == 2 && typ == protowire.BytesType:
+					permission.key = value
+				case num == 3 && typ == protowire.BytesType:
+					permission.rangeEnd = value
+				}
+			}))
+			role.permissions = append(role.permissions, permission)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return role, errors.Join(errs...)
+}
@@ -0,0 +1,176 @@
+package etcd
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// The snapshot returned by the maintenance API is the bbolt database of the
+// member. Only the parts of the format needed to read a few top level buckets
+// are implemented here, so that the scanner does not depend on bbolt.
+const (
+	boltMagic   = 0xED0CDAED
+	boltVersion = 2
+
+	boltPageHeaderSize  = 16
+	boltElementSize     = 16
+	boltBucketHeaderLen = 16
+
+	boltBranchPageFlag = 0x01
+	boltLeafPageFlag   = 0x02
+	boltBucketLeafFlag = 0x01
+
+	// boltMaxDepth limits the recursion on corrupted files with page cycles.
+	boltMaxDepth = 64
+)
+
+var ErrInvalidSnapshot = errors.New("invalid snapshot")
+
+type boltDB struct {
+	data     []byte
+	pageSize uint64
+}
+
+type boltMeta struct {
+	root uint64
+	txid uint64
+}
+
+func readBoltMeta(data []byte, offset uint64) (*boltMeta, uint64, bool) {
+	if uint64(len(data)) < offset+boltPageHeaderSize+64 {
+		return nil, 0, false
+	}
+	meta := data[offset+boltPageHeaderSize:]
+	if binary.LittleEndian.Uint32(meta[0:]) != boltMagic || binary.LittleEndian.Uint32(meta[4:]) != boltVersion {
+		return nil, 0, false
+	}
+	return &boltMeta{
+		root: binary.LittleEndian.Uint64(meta[16:]),
+		txid: binary.LittleEndian.Uint64(meta[48:]),
+	}, uint64(binary.LittleEndian.Uint32(meta[8:])), true
+}
+
+// readBoltBuckets returns the key/value pairs of the named top level buckets.
+// Buckets that do not exist are missing from the result. Nested buckets are
+// skipped.
+func readBoltBuckets(data []byte, names ...string) (map[string]map[string][]byte, error) {
+	meta, pageSize, ok := readBoltMeta(data, 0)
+	if !ok || pageSize < boltPageHeaderSize+boltElementSize {
+		return nil, fmt.Errorf("%w: bad meta page", ErrInvalidSnapshot)
+	}
+	// The meta page with the highest transaction is the current one.
+	if other, otherPageSize, ok := readBoltMeta(data, pageSize); ok && otherPageSize == pageSize && other.txid > meta.txid {
+		meta = other
+	}
+
+	db := &boltDB{data: data, pageSize: pageSize}
+	root, err := db.page(meta.root)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := map[string]struct{}{}
+	for _, name := range names {
+		wanted[name] = struct{}{}
+	}
+
+	result := map[string]map[string][]byte{}
+	err = db.walk(root, 0, func(key []byte, value []byte, flags uint32) error {
+		if _, ok := wanted[string(key)]; !ok || flags&boltBucketLeafFlag == 0 {
+			return nil
+		}
+		bucket, err := db.readBucket(value)
+		if err != nil {
+			return fmt.Errorf("could not read bucket %s: %w", key, err)
+		}
+		result[string(key)] = bucket
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (db *boltDB) page(id uint64) ([]byte, error) {
+	start := id * db.pageSize
+	if id == 0 || start/db.pageSize != id || start+boltPageHeaderSize > uint64(len(db.data)) {
+		return nil, fmt.Errorf("%w: page %d is out of range", ErrInvalidSnapshot, id)
+	}
+	overflow := uint64(binary.LittleEndian.Uint32(db.data[start+12:]))
+	end := start + (overflow+1)*db.pageSize
+	if end > uint64(len(db.data)) || end < start {
+		end = uint64(len(db.data))
+	}
+	return db.data[start:end], nil
+}
+
+func (db *boltDB) readBucket(value []byte) (map[string][]byte, error) {
+	if len(value) < boltBucketHeaderLen {
+		return nil, fmt.Errorf("%w: short bucket header", ErrInvalidSnapshot)
+	}
+	var root []byte
+	if id := binary.LittleEndian.Uint64(value); id == 0 {
+		// Small buckets are stored inline, right after the bucket header.
+		root = value[boltBucketHeaderLen:]
+	} else {
+		var err error
+		if root, err = db.page(id); err != nil {
+			return nil, err
+		}
+	}
+
+	bucket := map[string][]byte{}
+	err := db.walk(root, 0, func(key []byte, value []byte, flags uint32) error {
+		if flags&boltBucketLeafFlag == 0 {
+			bucket[string(key)] = value
+		}
+		return nil
+	})
+	return bucket, err
+}
+
+// walk calls fn for every leaf element reachable from the page.
+func (db *boltDB) walk(page []byte, depth int, fn func(key []byte, value []byte, flags uint32) error) error {
+	if depth > boltMaxDepth {
+		return fmt.Errorf("%w: tree is too deep", ErrInvalidSnapshot)
+	}
+	if len(page) < boltPageHeaderSize {
+		return fmt.Errorf("%w: short page", ErrInvalidSnapshot)
+	}
+	flags := binary.LittleEndian.Uint16(page[8:])
+	count := int(binary.LittleEndian.Uint16(page[10:]))
+	if boltPageHeaderSize+count*boltElementSize > len(page) {
+		return fmt.Errorf("%w: too many elements in page", ErrInvalidSnapshot)
+	}
+
+	for i := 0; i < count; i++ {
+		offset := boltPageHeaderSize + i*boltElementSize
+		element := page[offset : offset+boltElementSize]
+		switch {
+		case flags&boltLeafPageFlag != 0:
+			elementFlags := binary.LittleEndian.Uint32(element[0:])
+			pos := uint64(offset) + uint64(binary.LittleEndian.Uint32(element[4:]))
+			keySize := uint64(binary.LittleEndian.Uint32(element[8:]))
+			valueSize := uint64(binary.LittleEndian.Uint32(element[12:]))
+			if pos+keySize+valueSize > uint64(len(page)) {
+				return fmt.Errorf("%w: leaf element out of range", ErrInvalidSnapshot)
+			}
+			if err := fn(page[pos:pos+keySize], page[pos+keySize:pos+keySize+valueSize], elementFlags); err != nil {
+				return err
+			}
+		case flags&boltBranchPageFlag != 0:
+			child, err := db.page(binary.LittleEndian.Uint64(element[8:]))
+			if err != nil {
+				return err
+			}
+			if err := db.walk(child, depth+1, fn); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%w: unexpected page flags %#x", ErrInvalidSnapshot, flags)
+		}
+	}
+	return nil
+}
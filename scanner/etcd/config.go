@@ -0,0 +1,144 @@
+package etcd
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/tedyst/licenta/scanner"
+)
+
+// clientCertRequired reports whether the member rejects TLS clients without a
+// certificate, which is what --client-cert-auth does.
+func (sc *etcdScanner) clientCertRequired(ctx context.Context) (bool, error) {
+	transport := &http.Transport{}
+	if t, ok := sc.options.client.Transport.(*http.Transport); ok {
+		transport = t.Clone()
+	}
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	transport.TLSClientConfig.Certificates = nil
+	transport.TLSClientConfig.GetClientCertificate = nil
+	defer transport.CloseIdleConnections()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, sc.url+"/version", nil)
+	if err != nil {
+		return false, fmt.Errorf("could not create request: %w", err)
+	}
+	response, err := (&http.Client{Transport: transport, Timeout: sc.options.client.Timeout}).Do(request)
+	if err != nil {
+		if strings.Contains(err.Error(), "remote error: tls:") {
+			return true, nil
+		}
+		return false, fmt.Errorf("could not send request without a client certificate: %w", err)
+	}
+	response.Body.Close()
+	return false, nil
+}
+
+func (sc *etcdScanner) ScanConfig(ctx context.Context) ([]scanner.ScanResult, error) {
+	results := []scanner.ScanResult{}
+	server := scanner.AffectedObject{Type: scanner.OBJECT_SERVER, Name: sc.url}
+	add := func(ruleID string, severity scanner.Severity, message string, detail string, remediation string, evidence string) {
+		results = append(results, scanner.NewResult(severity, scanner.Finding{
+			RuleID:         ruleID,
+			Title:          message,
+			Description:    detail,
+			Remediation:    remediation,
+			AffectedObject: server,
+			Evidence:       evidence,
+		}))
+	}
+
+	certAuth := false
+	if strings.HasPrefix(sc.url, "http://") {
+		add("etcd-client-without-tls", scanner.SEVERITY_HIGH, "The client API is served without TLS.",
+			"The client API does not use TLS, so credentials and keys are sent in clear text.",
+			"Set --cert-file and --key-file and use https in --listen-client-urls.", sc.url)
+	} else {
+		var err error
+		certAuth, err = sc.clientCertRequired(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !certAuth {
+			add("etcd-client-cert-auth-disabled", scanner.SEVERITY_MEDIUM, "Client certificate authentication is disabled.",
+				"Clients can connect without a certificate signed by the trusted CA.",
+				"Set --client-cert-auth and --trusted-ca-file.", "")
+		}
+	}
+
+	enabled, err := sc.authEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		severity := scanner.SEVERITY_HIGH
+		if certAuth {
+			severity = scanner.SEVERITY_MEDIUM
+		}
+		add("etcd-auth-disabled", severity, "Authentication is disabled.",
+			"Authentication is disabled, so every client that can connect can read and change every key, including the Kubernetes secrets.",
+			"Create the root user and run etcdctl auth enable.", "")
+		return results, nil
+	}
+
+	// The snapshot also has the password hashes, which are needed for the
+	// root user check. Without it, only the role bindings are checked.
+	var users []*etcdAuthUser
+	var roles map[string]*etcdRole
+	if sc.options.snapshotPasswordHashes {
+		users, roles, err = sc.getSnapshotAuth(ctx)
+	}
+	if !sc.options.snapshotPasswordHashes || err != nil {
+		if users, roles, err = sc.getAuth(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return append(results, analyzeAuth(users, roles)...), nil
+}
+
+func analyzeAuth(users []*etcdAuthUser, roles map[string]*etcdRole) []scanner.ScanResult {
+	results := []scanner.ScanResult{}
+	add := func(user *etcdAuthUser, ruleID string, severity scanner.Severity, message string, detail string, remediation string, evidence string) {
+		results = append(results, scanner.NewResult(severity, scanner.Finding{
+			RuleID:         ruleID,
+			Title:          fmt.Sprintf("User %s: %s", user.name, message),
+			Description:    fmt.Sprintf("User %s: %s", user.name, detail),
+			Remediation:    remediation,
+			AffectedObject: scanner.AffectedObject{Type: scanner.OBJECT_USER, Name: user.name},
+			Evidence:       evidence,
+		}))
+	}
+
+	for _, user := range users {
+		if user.name == rootUser && user.password != "" && !user.noPassword {
+			add(user, "etcd-root-password", scanner.SEVERITY_MEDIUM, "root can log in with a password.",
+				"the root user has a password, so it can be guessed or brute forced.",
+				"Recreate root with --no-password and authenticate it with a client certificate.", "")
+		}
+
+		for _, name := range user.roles {
+			if name == rootRole {
+				if user.name != rootUser {
+					add(user, "etcd-root-role", scanner.SEVERITY_HIGH, "has the root role.",
+						"the user has the root role, so it can read and write every key and manage the cluster and its users.",
+						"Revoke the root role and grant a role limited to the keys the user needs.", "role "+name)
+				}
+				continue
+			}
+			role, ok := roles[name]
+			if !ok || !role.hasFullReadWrite() {
+				continue
+			}
+			add(user, "etcd-full-keyspace-readwrite", scanner.SEVERITY_HIGH, fmt.Sprintf("role %s can read and write every key.", name),
+				fmt.Sprintf("the user has the role %s, which grants readwrite on the whole keyspace.", name),
+				"Limit the permissions of the role to the prefixes the user needs.", `READWRITE ["\0", "\0")`)
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,238 @@
+package etcd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/tedyst/licenta/models"
+	"github.com/tedyst/licenta/nvd"
+	"github.com/tedyst/licenta/scanner"
+)
+
+var (
+	errPermissionDenied = errors.New("permission denied")
+	errAuthNotEnabled   = errors.New("authentication is not enabled")
+)
+
+// gRPC status codes returned by the gateway for authentication errors.
+const (
+	grpcPermissionDenied   = 7
+	grpcFailedPrecondition = 9
+	grpcUnauthenticated    = 16
+)
+
+// versionInfo is the response of GET /version.
+type versionInfo struct {
+	Server  string `json:"etcdserver"`
+	Cluster string `json:"etcdcluster"`
+}
+
+// gatewayError is the error body of the gRPC gateway.
+type gatewayError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Error   string `json:"error"`
+}
+
+type etcdScanner struct {
+	url      string
+	username string
+	password string
+
+	token string
+	info  *versionInfo
+
+	options *options
+}
+
+var _ scanner.Scanner = (*etcdScanner)(nil)
+
+func (sc *etcdScanner) GetScannerName() string {
+	return "etcd"
+}
+func (sc *etcdScanner) GetScannerID() int32 {
+	return models.SCAN_ETCD
+}
+func GetScannerID() int32 {
+	return models.SCAN_ETCD
+}
+func (sc *etcdScanner) GetNvdProductType() nvd.Product {
+	return nvd.ETCD
+}
+func (sc *etcdScanner) ShouldNotBePublic() bool {
+	return true
+}
+
+// send posts a request to the gRPC gateway under /v3. The auth token is only
+// sent when authenticated is set.
+func (sc *etcdScanner) send(ctx context.Context, path string, body any, authenticated bool) (*http.Response, error) {
+	content, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode request: %w", err)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, sc.url+"/v3"+path, bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("could not create request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if authenticated && sc.token != "" {
+		request.Header.Set("Authorization", sc.token)
+	}
+
+	response, err := sc.options.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("could not send request to %s: %w", path, err)
+	}
+	return response, nil
+}
+
+// call posts an authenticated request and decodes the response. Authentication
+// errors are returned as errPermissionDenied, and the error of a cluster
+// without auth as errAuthNotEnabled.
+func (sc *etcdScanner) call(ctx context.Context, path string, request any, response any) error {
+	httpResponse, err := sc.send(ctx, path, request, true)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(httpResponse.Body, 32<<20))
+	if err != nil {
+		return fmt.Errorf("could not read response from %s: %w", path, err)
+	}
+	if httpResponse.StatusCode != http.StatusOK {
+		var gwErr gatewayError
+		_ = json.Unmarshal(body, &gwErr)
+		if httpResponse.StatusCode == http.StatusUnauthorized || httpResponse.StatusCode == http.StatusForbidden ||
+			gwErr.Code == grpcPermissionDenied || gwErr.Code == grpcUnauthenticated {
+			return fmt.Errorf("%w: %s", errPermissionDenied, gwErr.Message)
+		}
+		// etcdserver: authentication is not enabled
+		if gwErr.Code == grpcFailedPrecondition && strings.Contains(gwErr.Message, errAuthNotEnabled.Error()) {
+			return fmt.Errorf("%w: %s", errAuthNotEnabled, gwErr.Message)
+		}
+		return fmt.Errorf("unexpected status %d from %s: %s", httpResponse.StatusCode, path, gwErr.Message)
+	}
+	if err := json.Unmarshal(body, response); err != nil {
+		return fmt.Errorf("could not decode response from %s: %w", path, err)
+	}
+	return nil
+}
+
+// authenticate gets a token for the configured user. A cluster without auth
+// rejects the request with errAuthNotEnabled, in which case no token is used.
+func (sc *etcdScanner) authenticate(ctx context.Context) error {
+	if sc.username == "" || sc.token != "" {
+		return nil
+	}
+
+	var response struct {
+		Token string `json:"token"`
+	}
+	err := sc.call(ctx, "/auth/authenticate", map[string]string{"name": sc.username, "password": sc.password}, &response)
+	if errors.Is(err, errAuthNotEnabled) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not authenticate as %s: %w", sc.username, err)
+	}
+	sc.token = response.Token
+	return nil
+}
+
+func (sc *etcdScanner) Ping(ctx context.Context) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, sc.url+"/version", nil)
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+	response, err := sc.options.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("could not send request to /version: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from /version", response.StatusCode)
+	}
+
+	info := &versionInfo{}
+	if err := json.NewDecoder(io.LimitReader(response.Body, 1<<20)).Decode(info); err != nil {
+		return fmt.Errorf("could not decode response from /version: %w", err)
+	}
+	sc.info = info
+
+	return sc.authenticate(ctx)
+}
+
+func (sc *etcdScanner) CheckPermissions(ctx context.Context) error {
+	var response struct {
+		Users []string `json:"users"`
+	}
+	if err := sc.call(ctx, "/auth/user/list", struct{}{}, &response); err != nil {
+		return fmt.Errorf("could not list users: %w", err)
+	}
+	return nil
+}
+
+func (sc *etcdScanner) GetVersion(ctx context.Context) (string, error) {
+	if sc.info == nil {
+		if err := sc.Ping(ctx); err != nil {
+			return "", err
+		}
+	}
+	if sc.info.Server == "" {
+		return "", errors.New("could not find version")
+	}
+	return sc.info.Server, nil
+}
+
+// ResolveURL returns the base URL of the member on host and port, preferring
+// HTTPS. Any HTTP response means that the scheme is correct.
+func ResolveURL(ctx context.Context, host string, port int32, opts ...Option) (string, error) {
+	o, err := makeOptions(opts...)
+	if err != nil {
+		return "", err
+	}
+
+	var errs []error
+	for _, scheme := range []string{"https", "http"} {
+		url := fmt.Sprintf("%s://%s:%d", scheme, host, port)
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, url+"/version", nil)
+		if err != nil {
+			return "", fmt.Errorf("could not create request: %w", err)
+		}
+		response, err := o.client.Do(request)
+		if err != nil && scheme == "https" && strings.Contains(err.Error(), "remote error: tls:") {
+			// The member speaks TLS, but requires a client certificate.
+			return url, nil
+		}
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		response.Body.Close()
+		return url, nil
+	}
+	return "", fmt.Errorf("could not connect to %s:%d: %w", host, port, errors.Join(errs...))
+}
+
+func NewScanner(ctx context.Context, url string, username string, password string, opts ...Option) (scanner.Scanner, error) {
+	o, err := makeOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	sc := &etcdScanner{
+		url:      strings.TrimSuffix(url, "/"),
+		username: username,
+		password: password,
+		options:  o,
+	}
+
+	return sc, nil
+}
@@ -0,0 +1,456 @@
+package etcd
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/tedyst/licenta/scanner"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const testPageSize = 4096
+
+type boltEntry struct {
+	key   string
+	value []byte
+	flags uint32
+}
+
+func boltLeafPage(id uint64, entries []boltEntry) []byte {
+	sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+	page := make([]byte, boltPageHeaderSize+len(entries)*boltElementSize)
+	binary.LittleEndian.PutUint64(page[0:], id)
+	binary.LittleEndian.PutUint16(page[8:], boltLeafPageFlag)
+	binary.LittleEndian.PutUint16(page[10:], uint16(len(entries)))
+	for i, entry := range entries {
+		offset := boltPageHeaderSize + i*boltElementSize
+		binary.LittleEndian.PutUint32(page[offset:], entry.flags)
+		binary.LittleEndian.PutUint32(page[offset+4:], uint32(len(page)-offset))
+		binary.LittleEndian.PutUint32(page[offset+8:], uint32(len(entry.key)))
+		binary.LittleEndian.PutUint32(page[offset+12:], uint32(len(entry.value)))
+		page = append(page, entry.key...)
+		page = append(page, entry.value...)
+	}
+	return page
+}
+
+func boltMetaPage(id uint64, root uint64, txid uint64) []byte {
+	page := make([]byte, boltPageHeaderSize+64)
+	binary.LittleEndian.PutUint64(page[0:], id)
+	binary.LittleEndian.PutUint16(page[8:], 0x04)
+	meta := page[boltPageHeaderSize:]
+	binary.LittleEndian.PutUint32(meta[0:], boltMagic)
+	binary.LittleEndian.PutUint32(meta[4:], boltVersion)
+	binary.LittleEndian.PutUint32(meta[8:], testPageSize)
+	binary.LittleEndian.PutUint64(meta[16:], root)
+	binary.LittleEndian.PutUint64(meta[48:], txid)
+	return page
+}
+
+// buildSnapshot writes a bbolt file with the roles in an inline bucket and
+// the users in a bucket behind a branch page.
+func buildSnapshot(users []boltEntry, roles []boltEntry) []byte {
+	branch := make([]byte, boltPageHeaderSize+boltElementSize)
+	binary.LittleEndian.PutUint64(branch[0:], 3)
+	binary.LittleEndian.PutUint16(branch[8:], boltBranchPageFlag)
+	binary.LittleEndian.PutUint16(branch[10:], 1)
+	binary.LittleEndian.PutUint64(branch[boltPageHeaderSize+8:], 4)
+
+	usersBucket := binary.LittleEndian.AppendUint64(nil, 3)
+	usersBucket = binary.LittleEndian.AppendUint64(usersBucket, 0)
+	rolesBucket := append(make([]byte, boltBucketHeaderLen), boltLeafPage(0, roles)...)
+
+	pages := [][]byte{
+		// The first meta page is stale and points to a page that does not exist.
+		boltMetaPage(0, 7, 1),
+		boltMetaPage(1, 2, 2),
+		boltLeafPage(2, []boltEntry{
+			{key: bucketAuthUsers, value: usersBucket, flags: boltBucketLeafFlag},
+			{key: bucketAuthRoles, value: rolesBucket, flags: boltBucketLeafFlag},
+			{key: "key", value: make([]byte, boltBucketHeaderLen+boltPageHeaderSize), flags: boltBucketLeafFlag},
+		}),
+		branch,
+		boltLeafPage(4, users),
+	}
+	data := make([]byte, len(pages)*testPageSize)
+	for i, page := range pages {
+		copy(data[i*testPageSize:], page)
+	}
+	return data
+}
+
+func protoUser(name string, password string, noPassword bool, roles ...string) boltEntry {
+	b := protowire.AppendTag(nil, 1, protowire.BytesType)
+	b = protowire.AppendString(b, name)
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	b = protowire.AppendString(b, password)
+	for _, role := range roles {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		b = protowire.AppendString(b, role)
+	}
+	if noPassword {
+		options := protowire.AppendTag(nil, 1, protowire.VarintType)
+		options = protowire.AppendVarint(options, 1)
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		b = protowire.AppendBytes(b, options)
+	}
+	return boltEntry{key: name, value: b}
+}
+
+func protoRole(name string, permType uint64, key string, rangeEnd string) boltEntry {
+	permission := protowire.AppendTag(nil, 1, protowire.VarintType)
+	permission = protowire.AppendVarint(permission, permType)
+	permission = protowire.AppendTag(permission, 2, protowire.BytesType)
+	permission = protowire.AppendString(permission, key)
+	permission = protowire.AppendTag(permission, 3, protowire.BytesType)
+	permission = protowire.AppendString(permission, rangeEnd)
+
+	b := protowire.AppendTag(nil, 1, protowire.BytesType)
+	b = protowire.AppendString(b, name)
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	b = protowire.AppendBytes(b, permission)
+	return boltEntry{key: name, value: b}
+}
+
+type fakeMember struct {
+	authEnabled    bool
+	snapshotDenied bool
+	users          map[string][]string
+	roles          map[string][]map[string]any
+	snapshot       []byte
+}
+
+func (m *fakeMember) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	writeError := func(status int, code int, message string) {
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(map[string]any{"code": code, "message": message, "error": message})
+	}
+
+	if r.URL.Path == "/version" {
+		_ = json.NewEncoder(w).Encode(map[string]string{"etcdserver": "3.5.9", "etcdcluster": "3.5.0"})
+		return
+	}
+
+	var request map[string]string
+	_ = json.NewDecoder(r.Body).Decode(&request)
+
+	if r.URL.Path == "/v3/auth/authenticate" {
+		if !m.authEnabled {
+			writeError(http.StatusBadRequest, 9, "etcdserver: authentication is not enabled")
+			return
+		}
+		if request["name"] != "root" || request["password"] != "changeme" {
+			writeError(http.StatusBadRequest, 3, "etcdserver: authentication failed, invalid user ID or password")
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"token": "token"})
+		return
+	}
+	if m.authEnabled && r.Header.Get("Authorization") != "token" && r.URL.Path != "/v3/auth/status" {
+		writeError(http.StatusUnauthorized, grpcUnauthenticated, "etcdserver: user name is empty")
+		return
+	}
+
+	var response any
+	switch r.URL.Path {
+	case "/v3/auth/status":
+		response = map[string]any{"enabled": m.authEnabled}
+	case "/v3/auth/user/list":
+		names := []string{}
+		for name := range m.users {
+			names = append(names, name)
+		}
+		response = map[string]any{"users": names}
+	case "/v3/auth/user/get":
+		response = map[string]any{"roles": m.users[request["name"]]}
+	case "/v3/auth/role/list":
+		names := []string{}
+		for name := range m.roles {
+			names = append(names, name)
+		}
+		response = map[string]any{"roles": names}
+	case "/v3/auth/role/get":
+		response = map[string]any{"perm": m.roles[request["role"]]}
+	case "/v3/maintenance/snapshot":
+		if m.snapshotDenied {
+			_ = json.NewEncoder(w).Encode(map[string]any{"error": map[string]any{"code": grpcPermissionDenied, "message": "etcdserver: permission denied"}})
+			return
+		}
+		// Send the snapshot in two chunks, like the gateway streams it.
+		half := len(m.snapshot) / 2
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": map[string]any{"blob": m.snapshot[:half]}})
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": map[string]any{"blob": m.snapshot[half:]}})
+		return
+	default:
+		writeError(http.StatusNotFound, 5, "not found")
+		return
+	}
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func newTestScanner(t *testing.T, url string, client *http.Client, opts ...Option) *etcdScanner {
+	t.Helper()
+	sc, err := NewScanner(context.Background(), url, "root", "changeme", append(opts, WithHTTPClient(client))...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sc.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+	return sc.(*etcdScanner)
+}
+
+func testSnapshot(t *testing.T) []byte {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte("hunter2"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buildSnapshot(
+		[]boltEntry{
+			protoUser("root", string(hash), false, "root"),
+			protoUser("apiserver", string(hash), false, "kube"),
+			protoUser("reader", string(hash), false, "read"),
+			protoUser("cert-only", "", true, "kube"),
+		},
+		[]boltEntry{
+			protoRole("kube", 2, "\x00", "\x00"),
+			protoRole("read", 0, "/registry/", "/registry0"),
+		},
+	)
+}
+
+func TestEtcdScanner_Ping(t *testing.T) {
+	tests := []struct {
+		name      string
+		handler   http.Handler
+		password  string
+		wantToken string
+		wantErr   bool
+	}{
+		{
+			name:     "auth not enabled",
+			handler:  &fakeMember{},
+			password: "changeme",
+		},
+		{
+			name:      "authenticated",
+			handler:   &fakeMember{authEnabled: true},
+			password:  "changeme",
+			wantToken: "token",
+		},
+		{
+			name:     "wrong password",
+			handler:  &fakeMember{authEnabled: true},
+			password: "wrong",
+			wantErr:  true,
+		},
+		{
+			name: "server error",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/version" {
+					_ = json.NewEncoder(w).Encode(map[string]string{"etcdserver": "3.5.9"})
+					return
+				}
+				w.WriteHeader(http.StatusServiceUnavailable)
+				_ = json.NewEncoder(w).Encode(map[string]any{"code": 14, "message": "etcdserver: request timed out"})
+			}),
+			password: "changeme",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+
+			sc, err := NewScanner(context.Background(), server.URL, "root", tt.password, WithHTTPClient(server.Client()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = sc.Ping(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Ping() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if token := sc.(*etcdScanner).token; token != tt.wantToken {
+				t.Errorf("token = %q, want %q", token, tt.wantToken)
+			}
+		})
+	}
+}
+
+func TestEtcdScanner_ScanConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		member   fakeMember
+		tls      bool
+		certAuth bool
+		opts     []Option
+		want     []string
+	}{
+		{
+			name:   "auth disabled over http",
+			member: fakeMember{},
+			want:   []string{"etcd-client-without-tls", "etcd-auth-disabled"},
+		},
+		{
+			name:     "client certificates and a broad role",
+			member:   fakeMember{authEnabled: true},
+			tls:      true,
+			certAuth: true,
+			opts:     []Option{WithSnapshotPasswordHashes(true)},
+			want:     []string{"etcd-full-keyspace-readwrite", "etcd-full-keyspace-readwrite", "etcd-root-password"},
+		},
+		{
+			name: "snapshot denied",
+			member: fakeMember{
+				authEnabled:    true,
+				snapshotDenied: true,
+				users:          map[string][]string{"root": {"root"}, "admin": {"root"}, "app": {"app"}},
+				roles:          map[string][]map[string]any{"root": {}, "app": {{"key": []byte("/app/"), "range_end": []byte("/app0")}}},
+			},
+			tls:  true,
+			opts: []Option{WithSnapshotPasswordHashes(true)},
+			want: []string{"etcd-client-cert-auth-disabled", "etcd-root-role"},
+		},
+		{
+			name: "snapshot not enabled",
+			member: fakeMember{
+				authEnabled: true,
+				users:       map[string][]string{"root": {"root"}, "admin": {"root"}},
+				roles:       map[string][]map[string]any{"root": {}},
+			},
+			want: []string{"etcd-client-without-tls", "etcd-root-role"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.member.snapshot = testSnapshot(t)
+
+			var server *httptest.Server
+			client := http.DefaultClient
+			if tt.tls {
+				server = httptest.NewUnstartedServer(&tt.member)
+				if tt.certAuth {
+					server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+					server.Config.ErrorLog = log.New(io.Discard, "", 0)
+				}
+				server.StartTLS()
+				client = server.Client()
+				if tt.certAuth {
+					transport := client.Transport.(*http.Transport).Clone()
+					transport.TLSClientConfig.Certificates = server.TLS.Certificates
+					client = &http.Client{Transport: transport}
+				}
+			} else {
+				server = httptest.NewServer(&tt.member)
+			}
+			defer server.Close()
+
+			results, err := newTestScanner(t, server.URL, client, tt.opts...).ScanConfig(context.Background())
+			if err != nil {
+				t.Fatalf("ScanConfig() error = %v", err)
+			}
+			got := []string{}
+			for _, result := range results {
+				got = append(got, result.Finding().RuleID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ScanConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEtcdScanner_GetUsers(t *testing.T) {
+	server := httptest.NewServer(&fakeMember{
+		authEnabled: true,
+		users:       map[string][]string{"root": {"root"}, "app": {"app"}},
+		roles:       map[string][]map[string]any{"root": {}, "app": {{"key": []byte("/app/"), "range_end": []byte("/app0")}}},
+		snapshot:    testSnapshot(t),
+	})
+	defer server.Close()
+
+	users, err := newTestScanner(t, server.URL, server.Client()).GetUsers(context.Background())
+	if err != nil {
+		t.Fatalf("GetUsers() error = %v", err)
+	}
+	if len(users) != 2 {
+		t.Fatalf("GetUsers() returned %d users, want 2", len(users))
+	}
+	for i, want := range []struct {
+		name       string
+		privileged bool
+	}{{"app", false}, {"root", true}} {
+		name, _ := users[i].GetUsername()
+		privileged, _ := users[i].IsPrivileged()
+		if name != want.name || privileged != want.privileged {
+			t.Errorf("user %d = (%s, %v), want (%s, %v)", i, name, privileged, want.name, want.privileged)
+		}
+		if ok, _ := users[i].HasPassword(); !ok {
+			t.Errorf("HasPassword() = false, want true")
+		}
+		if _, err := users[i].GetHashedPassword(); !errors.Is(err, scanner.ErrPasswordHashUnavailable) {
+			t.Errorf("GetHashedPassword() error = %v, want %v", err, scanner.ErrPasswordHashUnavailable)
+		}
+	}
+}
+
+func TestEtcdScanner_GetUsersSnapshot(t *testing.T) {
+	server := httptest.NewServer(&fakeMember{authEnabled: true, snapshot: testSnapshot(t)})
+	defer server.Close()
+
+	users, err := newTestScanner(t, server.URL, server.Client(), WithSnapshotPasswordHashes(true)).GetUsers(context.Background())
+	if err != nil {
+		t.Fatalf("GetUsers() error = %v", err)
+	}
+	if len(users) != 3 {
+		t.Fatalf("GetUsers() returned %d users, want 3", len(users))
+	}
+
+	for i, want := range []struct {
+		name       string
+		privileged bool
+	}{{"apiserver", true}, {"reader", false}, {"root", true}} {
+		name, _ := users[i].GetUsername()
+		privileged, _ := users[i].IsPrivileged()
+		if name != want.name || privileged != want.privileged {
+			t.Errorf("user %d = (%s, %v), want (%s, %v)", i, name, privileged, want.name, want.privileged)
+		}
+		if ok, err := users[i].VerifyPassword("hunter2"); !ok || err != nil {
+			t.Errorf("VerifyPassword(hunter2) = %v, %v, want true", ok, err)
+		}
+		if ok, err := users[i].VerifyPassword("wrong"); ok || err != nil {
+			t.Errorf("VerifyPassword(wrong) = %v, %v, want false", ok, err)
+		}
+	}
+}
+
+func Test_readBoltBuckets(t *testing.T) {
+	snapshot := testSnapshot(t)
+	if _, err := readBoltBuckets(snapshot[:3*testPageSize], bucketAuthUsers); !errors.Is(err, ErrInvalidSnapshot) {
+		t.Errorf("readBoltBuckets() on a truncated snapshot error = %v, want %v", err, ErrInvalidSnapshot)
+	}
+	if _, err := readBoltBuckets(make([]byte, testPageSize), bucketAuthUsers); !errors.Is(err, ErrInvalidSnapshot) {
+		t.Errorf("readBoltBuckets() on an empty file error = %v, want %v", err, ErrInvalidSnapshot)
+	}
+
+	buckets, err := readBoltBuckets(snapshot, bucketAuthRoles, "missing")
+	if err != nil {
+		t.Fatalf("readBoltBuckets() error = %v", err)
+	}
+	if len(buckets) != 1 || len(buckets[bucketAuthRoles]) != 2 {
+		t.Errorf("readBoltBuckets() = %v, want only the two roles", buckets)
+	}
+}
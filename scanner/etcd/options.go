@@ -0,0 +1,73 @@
+package etcd
+
+import (
+	"crypto/tls"
+	"net/http"
+	"time"
+)
+
+type Option func(*options) error
+
+type options struct {
+	client                 *http.Client
+	maxSnapshotSize        int64
+	snapshotPasswordHashes bool
+}
+
+// WithHTTPClient sets the client used for all requests. The default client
+// does not verify certificates, since the certificate itself is checked by
+// the TLS probe. Client certificates set on its transport are used for the
+// requests, but never for the client certificate authentication check.
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *options) error {
+		o.client = client
+		return nil
+	}
+}
+
+// WithSnapshotPasswordHashes makes GetUsers and ScanConfig read the bcrypt
+// password hashes from a snapshot of the member, since the auth API never
+// returns them. The snapshot is a copy of the whole keyspace, including every
+// Kubernetes Secret, and is downloaded into memory. Only the authUsers and
+// authRoles buckets are read from it. Without this option, the users are read
+// through the auth API and cannot be bruteforced.
+func WithSnapshotPasswordHashes(enabled bool) Option {
+	return func(o *options) error {
+		o.snapshotPasswordHashes = enabled
+		return nil
+	}
+}
+
+// WithMaxSnapshotSize limits the size of the snapshot downloaded to read the
+// password hashes. Larger snapshots make GetUsers fail.
+func WithMaxSnapshotSize(size int64) Option {
+	return func(o *options) error {
+		o.maxSnapshotSize = size
+		return nil
+	}
+}
+
+func defaultHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+}
+
+func makeOptions(opts ...Option) (*options, error) {
+	o := &options{}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+	if o.client == nil {
+		o.client = defaultHTTPClient()
+	}
+	if o.maxSnapshotSize == 0 {
+		o.maxSnapshotSize = 256 << 20
+	}
+	return o, nil
+}
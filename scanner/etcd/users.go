@@ -0,0 +1,146 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tedyst/licenta/scanner"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type etcdUser struct {
+	name       string
+	hash       string
+	privileged bool
+	// hashUnavailable is set for the users read through the auth API, which
+	// have a password that is not known.
+	hashUnavailable bool
+}
+
+var _ scanner.PasswordHashUser = (*etcdUser)(nil)
+
+func (u *etcdUser) VerifyPassword(password string) (bool, error) {
+	if u.hashUnavailable {
+		return false, scanner.ErrPasswordHashUnavailable
+	}
+	err := bcrypt.CompareHashAndPassword([]byte(u.hash), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (u *etcdUser) GetRawPassword() (string, bool, error) {
+	return "", false, nil
+}
+
+func (u *etcdUser) IsPrivileged() (bool, error) {
+	return u.privileged, nil
+}
+
+func (u *etcdUser) HasPassword() (bool, error) {
+	return u.hashUnavailable || u.hash != "", nil
+}
+
+func (u *etcdUser) GetUsername() (string, error) {
+	return u.name, nil
+}
+
+func (u *etcdUser) GetHashedPassword() (string, error) {
+	if u.hashUnavailable {
+		return "", scanner.ErrPasswordHashUnavailable
+	}
+	return u.hash, nil
+}
+
+func (u *etcdUser) GetPasswordHash() (scanner.PasswordHash, error) {
+	if !isBcryptHash(u.hash) {
+		return scanner.PasswordHash{}, nil
+	}
+	cost, err := bcrypt.Cost([]byte(u.hash))
+	if err != nil {
+		return scanner.PasswordHash{}, fmt.Errorf("could not get bcrypt cost: %w", err)
+	}
+	return scanner.PasswordHash{Algorithm: "bcrypt", WorkFactor: cost}, nil
+}
+
+func isBcryptHash(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
+
+// GetUsers reads the users and their roles through the auth API. The API
+// never returns the password hashes, so the users cannot be bruteforced
+// unless WithSnapshotPasswordHashes is set, in which case the users and their
+// bcrypt hashes are read from a snapshot instead. Only root can list the users
+// or take a snapshot when auth is enabled, so a member where the scanning
+// user is not allowed returns no users instead of an error.
+func (sc *etcdScanner) GetUsers(ctx context.Context) ([]scanner.User, error) {
+	if sc.options.snapshotPasswordHashes {
+		return sc.getSnapshotUsers(ctx)
+	}
+
+	authUsers, roles, err := sc.getAuth(ctx)
+	if errors.Is(err, errPermissionDenied) {
+		return []scanner.User{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	users := []scanner.User{}
+	for _, user := range authUsers {
+		users = append(users, &etcdUser{
+			name:            user.name,
+			privileged:      isPrivileged(user, roles),
+			hashUnavailable: true,
+		})
+	}
+	return users, nil
+}
+
+func (sc *etcdScanner) getSnapshotUsers(ctx context.Context) ([]scanner.User, error) {
+	authUsers, roles, err := sc.getSnapshotAuth(ctx)
+	if errors.Is(err, errPermissionDenied) {
+		return []scanner.User{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	users := []scanner.User{}
+	for _, user := range authUsers {
+		if user.noPassword || !isBcryptHash(user.password) {
+			continue
+		}
+		users = append(users, &etcdUser{
+			name:       user.name,
+			hash:       user.password,
+			privileged: isPrivileged(user, roles),
+		})
+	}
+	return users, nil
+}
+
+func isPrivileged(user *etcdAuthUser, roles map[string]*etcdRole) bool {
+	for _, name := range user.roles {
+		if role, ok := roles[name]; name == rootRole || (ok && role.hasFullReadWrite()) {
+			return true
+		}
+	}
+	return false
+}
+
+// UserFromHash returns a user that only has the bcrypt password hash of an
+// etcd user, so that it can be bruteforced without a connection to the
+// cluster.
+func UserFromHash(username string, hash string) (scanner.User, error) {
+	if !isBcryptHash(hash) {
+		return nil, fmt.Errorf("%w: %q", scanner.ErrUnsupportedHash, username)
+	}
+	return &etcdUser{name: username, hash: hash}, nil
+}
@@ -0,0 +1,76 @@
+package scanner
+
+// ObjectType is the kind of object a finding is about.
+type ObjectType string
+
+const (
+	OBJECT_NONE     ObjectType = ""
+	OBJECT_USER     ObjectType = "user"
+	OBJECT_SETTING  ObjectType = "setting"
+	OBJECT_CVE      ObjectType = "cve"
+	OBJECT_SCHEMA   ObjectType = "schema"
+	OBJECT_TABLE    ObjectType = "table"
+	OBJECT_FUNCTION ObjectType = "function"
+	OBJECT_SERVER   ObjectType = "server"
+)
+
+// AffectedObject identifies the object inside the database that a finding is
+// about, for example the user with a weak password.
+type AffectedObject struct {
+	Type ObjectType
+	Name string
+}
+
+// Finding is the structured form of a ScanResult. RuleID is stable between
+// scans, so it can be used to deduplicate findings and to suppress a rule.
+type Finding struct {
+	RuleID         string
+	Title          string
+	Description    string
+	Remediation    string
+	References     []string
+	AffectedObject AffectedObject
+	Evidence       string
+}
+
+// Result is the ScanResult of the checks that the scanners run themselves,
+// outside of the rule engine.
+type Result struct {
+	severity Severity
+	detail   string
+	finding  Finding
+}
+
+var _ ScanResult = (*Result)(nil)
+
+// NewResult returns a result whose detail is the description of the finding
+// followed by its remediation.
+func NewResult(severity Severity, finding Finding) *Result {
+	detail := finding.Description
+	if finding.Remediation != "" {
+		detail += " " + finding.Remediation
+	}
+	return NewResultWithDetail(severity, detail, finding)
+}
+
+// NewResultWithDetail returns a result with a detail that is not derived from
+// the finding.
+func NewResultWithDetail(severity Severity, detail string, finding Finding) *Result {
+	return &Result{
+		severity: severity,
+		detail:   detail,
+		finding:  finding,
+	}
+}
+
+func (result *Result) Severity() Severity {
+	return result.severity
+}
+
+func (result *Result) Detail() string {
+	return result.detail
+}
+
+func (result *Result) Finding() Finding {
+	return result.finding
+}
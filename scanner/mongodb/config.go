@@ -2,10 +2,195 @@ package mongodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
 
 	"github.com/tedyst/licenta/scanner"
+	"github.com/tedyst/licenta/scanner/rules"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
+// defaultSettings contains the values mongod uses when an option is not set
+// in the configuration file or on the command line, so that rules can check the
+// effective configuration instead of only the explicit one.
+var defaultSettings = map[string]string{
+	"security.authorization":     "disabled",
+	"security.javascriptEnabled": "true",
+	"net.bindIp":                 "localhost",
+	"net.tls.mode":               "disabled",
+}
+
+func (sc *mongodbScanner) runAdminCommand(ctx context.Context, command bson.D) (bson.M, error) {
+	var result bson.M
+	err := sc.db.Database("admin").RunCommand(ctx, command).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// errorCodeUnauthorized is returned for commands the user has no privilege
+// to run.
+const errorCodeUnauthorized = 13
+
+// isUnauthorized returns true if a command failed because the user lacks the
+// privilege to run it.
+func isUnauthorized(err error) bool {
+	var commandError mongo.CommandError
+	return errors.As(err, &commandError) && commandError.HasErrorCode(errorCodeUnauthorized)
+}
+
+// ScanConfig reads the settings with getCmdLineOpts, getParameter and
+// serverStatus, which need the clusterMonitor role. A command the user is not
+// allowed to run is reported as a missing privilege, and the rules for the
+// settings it returns are skipped instead of failing the scan.
 func (sc *mongodbScanner) ScanConfig(ctx context.Context) ([]scanner.ScanResult, error) {
-	return nil, nil
+	results := []scanner.ScanResult{}
+	skipped := map[string]bool{}
+	run := func(name string, command bson.D) (bson.M, error) {
+		result, err := sc.runAdminCommand(ctx, command)
+		if isUnauthorized(err) {
+			slog.WarnContext(ctx, "Not allowed to run command, skipping its checks", "command", name, "error", err)
+			skipped[name] = true
+			results = append(results, missingPrivilegeResult(name, err))
+			return bson.M{}, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not run %s: %w", name, err)
+		}
+		return result, nil
+	}
+
+	cmdLine, err := run("getCmdLineOpts", bson.D{{Key: "getCmdLineOpts", Value: 1}})
+	if err != nil {
+		return nil, err
+	}
+
+	parameters, err := run("getParameter", bson.D{{Key: "getParameter", Value: "*"}})
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := run("serverStatus", bson.D{{Key: "serverStatus", Value: 1}})
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, _ := cmdLine["parsed"].(bson.M)
+
+	settings := collectSettings(parsed, parameters, status)
+	return append(results, evaluateSettings(sc.options.ruleEngine, settings, skipped)...), nil
+}
+
+func missingPrivilegeResult(command string, err error) *scanner.Result {
+	return scanner.NewResult(scanner.SEVERITY_INFORMATIONAL, scanner.Finding{
+		RuleID:         "mongodb-missing-privilege",
+		Title:          fmt.Sprintf("Not allowed to run %s", command),
+		Description:    fmt.Sprintf("The scanning user is not allowed to run %s, so the settings it returns were not checked.", command),
+		Remediation:    "Grant the clusterMonitor role on the admin database to the scanning user.",
+		AffectedObject: scanner.AffectedObject{Type: scanner.OBJECT_SERVER, Name: command},
+		Evidence:       err.Error(),
+	})
+}
+
+// settingCommand returns the command a setting from collectSettings is read
+// from.
+func settingCommand(setting string) string {
+	switch {
+	case strings.HasPrefix(setting, "parameter."):
+		return "getParameter"
+	case strings.HasPrefix(setting, "serverStatus."):
+		return "serverStatus"
+	default:
+		return "getCmdLineOpts"
+	}
+}
+
+// evaluateSettings runs the MongoDB rules, except the ones for settings that
+// are read from a skipped command. Those settings would otherwise be missing
+// or have their default value, and be reported wrongly.
+func evaluateSettings(engine *rules.Engine, settings map[string]string, skipped map[string]bool) []scanner.ScanResult {
+	results := []scanner.ScanResult{}
+	for _, result := range engine.Evaluate(rules.PRODUCT_MONGODB, settings) {
+		if r, ok := result.(*rules.Result); ok && skipped[settingCommand(r.Rule().Setting)] {
+			continue
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// collectSettings flattens the output of getCmdLineOpts, getParameter and
+// serverStatus into a single map. Command line options keep their dotted
+// configuration file names (net.bindIp), parameters are prefixed with
+// "parameter." and server status fields with "serverStatus.".
+func collectSettings(parsed bson.M, parameters bson.M, status bson.M) map[string]string {
+	settings := map[string]string{}
+	flatten(settings, "", parsed)
+	flatten(settings, "parameter.", parameters)
+	flatten(settings, "serverStatus.", status)
+
+	// Older versions only know about the net.ssl options.
+	if _, ok := settings["net.tls.mode"]; !ok {
+		if mode, ok := settings["net.ssl.mode"]; ok {
+			settings["net.tls.mode"] = strings.Replace(mode, "SSL", "TLS", 1)
+		}
+	}
+
+	// A keyFile or x509 cluster authentication implicitly enables authorization.
+	if _, ok := settings["security.authorization"]; !ok {
+		if _, ok := settings["security.keyFile"]; ok {
+			settings["security.authorization"] = "enabled"
+		} else if _, ok := settings["security.clusterAuthMode"]; ok {
+			settings["security.authorization"] = "enabled"
+		}
+	}
+
+	for key, value := range defaultSettings {
+		if _, ok := settings[key]; !ok {
+			settings[key] = value
+		}
+	}
+
+	return settings
+}
+
+func flatten(settings map[string]string, prefix string, document bson.M) {
+	for key, value := range document {
+		switch v := value.(type) {
+		case bson.M:
+			flatten(settings, prefix+key+".", v)
+		default:
+			settings[prefix+key] = formatValue(v)
+		}
+	}
+}
+
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bson.A:
+		values := make([]string, len(v))
+		for i, item := range v {
+			values[i] = formatValue(item)
+		}
+		return strings.Join(values, ",")
+	default:
+		return fmt.Sprint(v)
+	}
 }
@@ -0,0 +1,116 @@
+package mongodb
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/tedyst/licenta/scanner/rules"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func Test_collectSettings(t *testing.T) {
+	tests := []struct {
+		name       string
+		parsed     bson.M
+		parameters bson.M
+		status     bson.M
+		want       []string
+	}{
+		{
+			name:   "defaults",
+			parsed: bson.M{},
+			want: []string{
+				"mongodb-authorization-disabled",
+				"mongodb-tls-not-required",
+				"mongodb-javascript-enabled",
+				"mongodb-audit-log-missing",
+			},
+		},
+		{
+			name: "hardened",
+			parsed: bson.M{
+				"net":      bson.M{"bindIp": "127.0.0.1", "tls": bson.M{"mode": "requireTLS"}},
+				"security": bson.M{"keyFile": "/etc/mongo.key", "javascriptEnabled": false},
+				"auditLog": bson.M{"destination": "file"},
+			},
+			parameters: bson.M{"scramIterationCount": int32(10000), "scramSHA256IterationCount": int32(15000)},
+			status:     bson.M{"transportSecurity": bson.M{"1.0": int64(0), "1.2": int64(5)}},
+			want:       []string{},
+		},
+		{
+			name: "exposed",
+			parsed: bson.M{
+				"net":      bson.M{"bindIp": "localhost,0.0.0.0", "ssl": bson.M{"mode": "preferSSL"}, "http": bson.M{"enabled": true, "RESTInterfaceEnabled": true}},
+				"security": bson.M{"authorization": "enabled", "javascriptEnabled": false},
+				"auditLog": bson.M{"destination": "syslog"},
+			},
+			parameters: bson.M{"scramIterationCount": int32(5000), "scramSHA256IterationCount": int32(15000)},
+			status:     bson.M{"transportSecurity": bson.M{"1.0": int64(3)}},
+			want: []string{
+				"mongodb-bind-all-interfaces",
+				"mongodb-tls-not-required",
+				"mongodb-http-interface-enabled",
+				"mongodb-rest-interface-enabled",
+				"mongodb-scram-sha1-iterations",
+				"mongodb-tls10-connections",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := collectSettings(tt.parsed, tt.parameters, tt.status)
+			results := rules.Default().Evaluate(rules.PRODUCT_MONGODB, settings)
+			if len(results) != len(tt.want) {
+				t.Fatalf("got %d results, want %d", len(results), len(tt.want))
+			}
+			for i, result := range results {
+				if id := result.(*rules.Result).Rule().ID; id != tt.want[i] {
+					t.Errorf("result %d = %s, want %s", i, id, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func Test_evaluateSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		skipped map[string]bool
+		want    []string
+	}{
+		{
+			name: "nothing skipped",
+			want: []string{"mongodb-authorization-disabled", "mongodb-tls-not-required", "mongodb-javascript-enabled", "mongodb-audit-log-missing", "mongodb-scram-sha1-iterations"},
+		},
+		{
+			name:    "no clusterMonitor role",
+			skipped: map[string]bool{"getCmdLineOpts": true, "serverStatus": true},
+			want:    []string{"mongodb-scram-sha1-iterations"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := collectSettings(bson.M{}, bson.M{"scramIterationCount": int32(5000)}, bson.M{})
+			results := evaluateSettings(rules.Default(), settings, tt.skipped)
+			got := []string{}
+			for _, result := range results {
+				got = append(got, result.Finding().RuleID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("evaluateSettings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_isUnauthorized(t *testing.T) {
+	unauthorized := mongo.CommandError{Code: errorCodeUnauthorized, Name: "Unauthorized", Message: "not authorized on admin to execute command { getCmdLineOpts: 1 }"}
+	if !isUnauthorized(fmt.Errorf("could not run: %w", unauthorized)) {
+		t.Error("isUnauthorized() = false for an Unauthorized error, want true")
+	}
+	if isUnauthorized(mongo.CommandError{Code: 59, Name: "CommandNotFound"}) {
+		t.Error("isUnauthorized() = true for CommandNotFound, want false")
+	}
+}
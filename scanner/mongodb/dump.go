@@ -0,0 +1,80 @@
+package mongodb
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/tedyst/licenta/scanner"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var ErrInvalidDump = errors.New("invalid system.users dump")
+
+// ParseUserDump reads the users of a mongoexport of admin.system.users
+// without a connection to the server. Both the default export, with one
+// Extended JSON document per line, and --jsonArray exports are accepted.
+func ParseUserDump(r io.Reader) ([]scanner.User, error) {
+	reader := bufio.NewReader(r)
+	decoder := json.NewDecoder(reader)
+
+	var documents []json.RawMessage
+	first, err := peekNonSpace(reader)
+	if err != nil {
+		return nil, fmt.Errorf("could not read dump: %w", err)
+	}
+	if first == '[' {
+		if err := decoder.Decode(&documents); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidDump, err)
+		}
+	} else {
+		for {
+			var document json.RawMessage
+			err := decoder.Decode(&document)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidDump, err)
+			}
+			documents = append(documents, document)
+		}
+	}
+
+	users := []scanner.User{}
+	for _, document := range documents {
+		var userMap bson.M
+		if err := bson.UnmarshalExtJSON(document, false, &userMap); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidDump, err)
+		}
+		credentials, err := parseUserCredentials(userMap)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidDump, err)
+		}
+		users = append(users, credentials...)
+	}
+	return users, nil
+}
+
+// peekNonSpace returns the first byte of r that is not whitespace, without
+// consuming it. It returns 0 for an empty reader.
+func peekNonSpace(r *bufio.Reader) (byte, error) {
+	for {
+		b, err := r.Peek(1)
+		if err == io.EOF {
+			return 0, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		if !bytes.ContainsAny(b, " \t\r\n") {
+			return b[0], nil
+		}
+		if _, err := r.Discard(1); err != nil {
+			return 0, err
+		}
+	}
+}
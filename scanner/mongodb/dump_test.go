@@ -0,0 +1,70 @@
+package mongodb
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/xdg-go/scram"
+)
+
+// scramCredential returns the SCRAM-SHA-256 credential MongoDB stores for the
+// password, as Extended JSON.
+func scramCredential(t *testing.T, username, password string) string {
+	t.Helper()
+	client, err := scram.SHA256.NewClient(username, password, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	salt := []byte("0123456789abcdef")
+	key := client.GetStoredCredentials(scram.KeyFactors{Salt: string(salt), Iters: 15000})
+	return fmt.Sprintf(`{"iterationCount":15000,"salt":%q,"storedKey":%q,"serverKey":%q}`,
+		base64.StdEncoding.EncodeToString(salt),
+		base64.StdEncoding.EncodeToString(key.StoredKey),
+		base64.StdEncoding.EncodeToString(key.ServerKey))
+}
+
+func TestParseUserDump(t *testing.T) {
+	root := `{"_id":"admin.root","userId":{"$binary":{"base64":"AAAAAAAAAAAAAAAAAAAAAA==","subType":"04"}},"user":"root","db":"admin","credentials":{"SCRAM-SHA-256":` + scramCredential(t, "root", "secret") + `},"roles":[{"role":"root","db":"admin"}]}`
+	app := `{"_id":"app.web","user":"web","db":"app","credentials":{"SCRAM-SHA-256":` + scramCredential(t, "web", "secret") + `},"roles":[{"role":"readWrite","db":"app"}]}`
+
+	tests := []struct {
+		name           string
+		dump           string
+		wantUsers      []string
+		wantPrivileged []bool
+		wantErr        error
+	}{
+		{"lines", root + "\n" + app + "\n", []string{"root", "web"}, []bool{true, false}, nil},
+		{"array", "[" + root + ",\n" + app + "]", []string{"root", "web"}, []bool{true, false}, nil},
+		{"empty", "\n", nil, nil, nil},
+		{"missing credentials", `{"user":"root","db":"admin"}`, nil, nil, ErrInvalidDump},
+		{"invalid json", `{"user":`, nil, nil, ErrInvalidDump},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users, err := ParseUserDump(strings.NewReader(tt.dump))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseUserDump() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(users) != len(tt.wantUsers) {
+				t.Fatalf("ParseUserDump() returned %d users, want %d", len(users), len(tt.wantUsers))
+			}
+			for i, user := range users {
+				name, _ := user.GetUsername()
+				privileged, _ := user.IsPrivileged()
+				if name != tt.wantUsers[i] || privileged != tt.wantPrivileged[i] {
+					t.Errorf("user %d = %q, privileged %v, want %q, privileged %v", i, name, privileged, tt.wantUsers[i], tt.wantPrivileged[i])
+				}
+				for _, password := range []string{"secret", "secret1"} {
+					ok, err := user.VerifyPassword(password)
+					if err != nil || ok != (password == "secret") {
+						t.Errorf("user %q VerifyPassword(%q) = %v, %v", name, password, ok, err)
+					}
+				}
+			}
+		})
+	}
+}
@@ -11,22 +11,10 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
-type mongodbScanResult struct {
-	severity scanner.Severity
-	message  string
-	detail   string
-}
-
-func (result *mongodbScanResult) Severity() scanner.Severity {
-	return result.severity
-}
-
-func (result *mongodbScanResult) Detail() string {
-	return result.detail
-}
-
 type mongodbScanner struct {
 	db *mongo.Client
+
+	options *options
 }
 
 func (sc *mongodbScanner) GetScannerName() string {
@@ -65,9 +53,15 @@ func (sc *mongodbScanner) GetVersion(ctx context.Context) (string, error) {
 	return version, nil
 }
 
-func NewScanner(ctx context.Context, db *mongo.Client) (scanner.Scanner, error) {
+func NewScanner(ctx context.Context, db *mongo.Client, opts ...Option) (scanner.Scanner, error) {
+	o, err := makeOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
 	sc := &mongodbScanner{
-		db: db,
+		db:      db,
+		options: o,
 	}
 
 	return sc, nil
@@ -7,7 +7,6 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/tedyst/licenta/scanner"
 	"github.com/xdg-go/scram"
@@ -22,9 +21,52 @@ type mongodbUser struct {
 	storedKey      []byte
 	serverKey      []byte
 	salt           []byte
+
+	privileged bool
+}
+
+// privilegedRoles are the builtin roles that grant access to every database,
+// user management or cluster administration. Roles that are only privileged
+// when granted on the admin database are listed in privilegedAdminRoles.
+var privilegedRoles = map[string]struct{}{
+	"root":                 {},
+	"__system":             {},
+	"userAdminAnyDatabase": {},
+	"dbAdminAnyDatabase":   {},
+	"readWriteAnyDatabase": {},
+	"clusterAdmin":         {},
+	"clusterManager":       {},
+	"hostManager":          {},
+	"restore":              {},
+	"backup":               {},
 }
 
-var _ scanner.User = (*mongodbUser)(nil)
+var privilegedAdminRoles = map[string]struct{}{
+	"userAdmin": {},
+	"dbOwner":   {},
+	"readWrite": {},
+	"dbAdmin":   {},
+}
+
+func hasPrivilegedRole(roles bson.A) bool {
+	for _, r := range roles {
+		roleMap, ok := r.(bson.M)
+		if !ok {
+			continue
+		}
+		name, _ := roleMap["role"].(string)
+		db, _ := roleMap["db"].(string)
+		if _, ok := privilegedRoles[name]; ok {
+			return true
+		}
+		if _, ok := privilegedAdminRoles[name]; ok && db == "admin" {
+			return true
+		}
+	}
+	return false
+}
+
+var _ scanner.PasswordHashUser = (*mongodbUser)(nil)
 
 func (u *mongodbUser) VerifyPassword(password string) (bool, error) {
 	switch u.algorithm {
@@ -55,18 +97,14 @@ func (u *mongodbUser) VerifyPassword(password string) (bool, error) {
 	}
 }
 
+// GetRawPassword never returns a password, because MongoDB only stores the
+// SCRAM credentials.
 func (u *mongodbUser) GetRawPassword() (string, bool, error) {
-	if strings.HasPrefix(u.password, "SCRAM-SHA-256") {
-		return "", false, nil
-	}
-	if strings.HasPrefix(u.password, "md5") {
-		return "", false, nil
-	}
-	return u.password, true, nil
+	return "", false, nil
 }
 
 func (u *mongodbUser) IsPrivileged() (bool, error) {
-	return true, nil
+	return u.privileged, nil
 }
 
 func (u *mongodbUser) HasPassword() (bool, error) {
@@ -81,6 +119,10 @@ func (u *mongodbUser) GetHashedPassword() (string, error) {
 	return u.password, nil
 }
 
+func (u *mongodbUser) GetPasswordHash() (scanner.PasswordHash, error) {
+	return scanner.PasswordHash{Algorithm: u.algorithm, WorkFactor: u.iterationCount}, nil
+}
+
 func (sc *mongodbScanner) GetUsers(ctx context.Context) ([]scanner.User, error) {
 	var result bson.M
 	err := sc.db.Database("admin").RunCommand(ctx, bson.D{
@@ -103,66 +145,83 @@ func (sc *mongodbScanner) GetUsers(ctx context.Context) ([]scanner.User, error)
 			return nil, errors.New("Could not find user")
 		}
 
-		username, ok := userMap["user"].(string)
+		credentials, err := parseUserCredentials(userMap)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, credentials...)
+	}
+
+	return res, nil
+}
+
+// parseUserCredentials returns one user for every credential of a user
+// document, as returned by usersInfo or stored in admin.system.users.
+func parseUserCredentials(userMap bson.M) ([]scanner.User, error) {
+	username, ok := userMap["user"].(string)
+	if !ok {
+		return nil, errors.New("Could not find username")
+	}
+
+	roles, _ := userMap["roles"].(bson.A)
+	privileged := hasPrivilegedRole(roles)
+
+	credentials, ok := userMap["credentials"].(bson.M)
+	if !ok {
+		return nil, errors.New("Could not find credentials")
+	}
+
+	var res []scanner.User
+	for t, credential := range credentials {
+		credentialMap, ok := credential.(bson.M)
+		if !ok {
+			return nil, errors.New("Could not find credential")
+		}
+
+		iterationCount, ok := credentialMap["iterationCount"].(int32)
 		if !ok {
-			return nil, errors.New("Could not find username")
+			return nil, errors.New("Could not find iterationCount")
 		}
 
-		credentials, ok := userMap["credentials"].(bson.M)
+		storedKey, ok := credentialMap["storedKey"].(string)
 		if !ok {
-			return nil, errors.New("Could not find credentials")
+			return nil, errors.New("Could not find storedKey")
 		}
 
-		for t, credential := range credentials {
-			credentialMap, ok := credential.(bson.M)
-			if !ok {
-				return nil, errors.New("Could not find credential")
-			}
-
-			iterationCount, ok := credentialMap["iterationCount"].(int32)
-			if !ok {
-				return nil, errors.New("Could not find iterationCount")
-			}
-
-			storedKey, ok := credentialMap["storedKey"].(string)
-			if !ok {
-				return nil, errors.New("Could not find storedKey")
-			}
-
-			decodedStoredKey, err := base64.StdEncoding.DecodeString(storedKey)
-			if err != nil {
-				return nil, fmt.Errorf("could not decode storedKey: %w", err)
-			}
-
-			serverKey, ok := credentialMap["serverKey"].(string)
-			if !ok {
-				return nil, errors.New("Could not find serverKey")
-			}
-
-			decodedServerKey, err := base64.StdEncoding.DecodeString(serverKey)
-			if err != nil {
-				return nil, fmt.Errorf("could not decode serverKey: %w", err)
-			}
-
-			salt, ok := credentialMap["salt"].(string)
-			if !ok {
-				return nil, errors.New("Could not find salt")
-			}
-
-			decodedSalt, err := base64.StdEncoding.DecodeString(salt)
-			if err != nil {
-				return nil, fmt.Errorf("could not decode salt: %w", err)
-			}
-
-			res = append(res, &mongodbUser{
-				name:           username,
-				algorithm:      t,
-				storedKey:      decodedStoredKey,
-				serverKey:      decodedServerKey,
-				iterationCount: int(iterationCount),
-				salt:           decodedSalt,
-			})
+		decodedStoredKey, err := base64.StdEncoding.DecodeString(storedKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode storedKey: %w", err)
 		}
+
+		serverKey, ok := credentialMap["serverKey"].(string)
+		if !ok {
+			return nil, errors.New("Could not find serverKey")
+		}
+
+		decodedServerKey, err := base64.StdEncoding.DecodeString(serverKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode serverKey: %w", err)
+		}
+
+		salt, ok := credentialMap["salt"].(string)
+		if !ok {
+			return nil, errors.New("Could not find salt")
+		}
+
+		decodedSalt, err := base64.StdEncoding.DecodeString(salt)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode salt: %w", err)
+		}
+
+		res = append(res, &mongodbUser{
+			name:           username,
+			algorithm:      t,
+			storedKey:      decodedStoredKey,
+			serverKey:      decodedServerKey,
+			iterationCount: int(iterationCount),
+			salt:           decodedSalt,
+			privileged:     privileged,
+		})
 	}
 
 	return res, nil
@@ -0,0 +1,52 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tedyst/licenta/scanner"
+	"github.com/tedyst/licenta/scanner/rules"
+)
+
+func (sc *mssqlScanner) ScanConfig(ctx context.Context) ([]scanner.ScanResult, error) {
+	rows, err := sc.db.QueryContext(ctx, "SELECT name, CAST(value_in_use AS nvarchar(64)) FROM sys.configurations")
+	if err != nil {
+		return nil, fmt.Errorf("could not see view sys.configurations: %w", err)
+	}
+	defer rows.Close()
+
+	settings := map[string]string{}
+	for rows.Next() {
+		var name string
+		var setting string
+
+		err := rows.Scan(&name, &setting)
+		if err != nil {
+			return nil, fmt.Errorf("could not scan row: %w", err)
+		}
+
+		settings[name] = setting
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read sys.configurations: %w", err)
+	}
+
+	// The authentication mode is a server property, not a configuration
+	// option, so it is added to the settings for the rule engine.
+	var integratedSecurityOnly string
+	err = sc.db.QueryRowContext(ctx, "SELECT CAST(SERVERPROPERTY('IsIntegratedSecurityOnly') AS nvarchar(8))").Scan(&integratedSecurityOnly)
+	if err != nil {
+		return nil, fmt.Errorf("could not get IsIntegratedSecurityOnly: %w", err)
+	}
+	settings["IsIntegratedSecurityOnly"] = integratedSecurityOnly
+
+	results := sc.options.ruleEngine.Evaluate(rules.PRODUCT_MSSQL, settings)
+
+	logins, err := sc.scanLogins(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results = append(results, logins...)
+
+	return results, nil
+}
@@ -0,0 +1,66 @@
+package mssql
+
+import (
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	passwordHashVersion2 = 0x0200
+	passwordSaltLen      = 4
+)
+
+var ErrUnsupportedHash = errors.New("unsupported password hash format")
+
+// decodePasswordHash parses a hash in the 0x0200... hex form that SQL Server
+// and hashcat use.
+func decodePasswordHash(hash string) ([]byte, error) {
+	hash = strings.TrimPrefix(strings.TrimPrefix(hash, "0x"), "0X")
+	decoded, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, errors.Join(ErrUnsupportedHash, err)
+	}
+	return decoded, nil
+}
+
+func encodePasswordHash(hash []byte) string {
+	return "0x" + strings.ToUpper(hex.EncodeToString(hash))
+}
+
+func utf16le(s string) []byte {
+	encoded := utf16.Encode([]rune(s))
+	result := make([]byte, 2*len(encoded))
+	for i, c := range encoded {
+		binary.LittleEndian.PutUint16(result[2*i:], c)
+	}
+	return result
+}
+
+// hashPassword computes a SQL Server 2012+ password hash: the 0x0200
+// version, a 4 byte salt and SHA-512 over the UTF-16LE password followed by
+// the salt.
+func hashPassword(password string, salt []byte) []byte {
+	digest := sha512.Sum512(append(utf16le(password), salt...))
+
+	result := make([]byte, 0, 2+len(salt)+len(digest))
+	result = binary.BigEndian.AppendUint16(result, passwordHashVersion2)
+	result = append(result, salt...)
+	return append(result, digest[:]...)
+}
+
+// verifyPasswordHash checks a password against a password_hash value from
+// sys.sql_logins. Only the 0x0200 format is supported, older servers use
+// SHA-1 hashes which are not read by the scanner.
+func verifyPasswordHash(hash []byte, password string) (bool, error) {
+	if len(hash) != 2+passwordSaltLen+sha512.Size || binary.BigEndian.Uint16(hash) != passwordHashVersion2 {
+		return false, ErrUnsupportedHash
+	}
+	salt := hash[2 : 2+passwordSaltLen]
+
+	return subtle.ConstantTimeCompare(hashPassword(password, salt), hash) == 1, nil
+}
@@ -0,0 +1,87 @@
+package mssql
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_verifyPasswordHash(t *testing.T) {
+	tests := []struct {
+		name     string
+		hash     string
+		password string
+		want     bool
+		wantErr  error
+	}{
+		{
+			name:     "hashcat example",
+			hash:     "0x02000102030434ea1b17802fd95ea6316bd61d2c94622ca3812793e8fb1672487b5c904a45a31b2ab4a78890d563d2fcf5663e46fe797d71550494be50cf4915d3f4d55ec375",
+			password: "hashcat",
+			want:     true,
+		},
+		{
+			name:     "wrong password",
+			hash:     "0x02000102030434ea1b17802fd95ea6316bd61d2c94622ca3812793e8fb1672487b5c904a45a31b2ab4a78890d563d2fcf5663e46fe797d71550494be50cf4915d3f4d55ec375",
+			password: "Hashcat",
+			want:     false,
+		},
+		{
+			name:     "uppercase hex",
+			hash:     "0x0200A1B2C3D4EF66911D0AEE2EFBE9CE1C0B2241FD74CE3820C39CF5C67F71F7F98BDDB2951812137E4527A6D88309C37A032E6BC1700FB6DD1194F0BB4030851C1541F139A3",
+			password: "Password123!",
+			want:     true,
+		},
+		{
+			name:     "empty password",
+			hash:     "0x020000000000EC2D57691D9B2D40182AC565032054B7D784BA96B18BCB5BE0BB4E70E3FB041EFF582C8AF66EE50256539F2181D7F9E53627C0189DA7E75A4D5EF10EA93B20B3",
+			password: "",
+			want:     true,
+		},
+		{
+			name:     "non ascii password",
+			hash:     "0x0200DEADBEEF981681025C9ACEF0619B458CC8306B7A542FD0DB76A9B5931F855BB307275567961E02408A4F30707AF1B781EE6E7B3485925FC5BB328A994EF6434E7143DBB1",
+			password: "pässwörd",
+			want:     true,
+		},
+		{
+			name:     "sha1 hash",
+			hash:     "0x01004086ceb60c0d4a7b2ce5d2e9c4b98d2c8e2b1f3b2c1d7e8f9a0b1c2d3e4f5a6b7c8d",
+			password: "password",
+			wantErr:  ErrUnsupportedHash,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := decodePasswordHash(tt.hash)
+			if err != nil {
+				t.Fatalf("decodePasswordHash() error = %v", err)
+			}
+			got, err := verifyPasswordHash(hash, tt.password)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("verifyPasswordHash() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("verifyPasswordHash() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_hashPasswordRoundTrip(t *testing.T) {
+	hash := hashPassword("correct horse", []byte{0x12, 0x34, 0x56, 0x78})
+
+	decoded, err := decodePasswordHash(encodePasswordHash(hash))
+	if err != nil {
+		t.Fatalf("decodePasswordHash() error = %v", err)
+	}
+	user := &mssqlUser{name: "app", passwordHash: decoded}
+
+	ok, err := user.VerifyPassword("correct horse")
+	if err != nil || !ok {
+		t.Errorf("VerifyPassword() = %v, %v, want true", ok, err)
+	}
+	ok, err = user.VerifyPassword("correct horse battery")
+	if err != nil || ok {
+		t.Errorf("VerifyPassword() = %v, %v, want false", ok, err)
+	}
+}
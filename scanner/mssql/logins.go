@@ -0,0 +1,97 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tedyst/licenta/scanner"
+)
+
+// saPrincipalID is the principal_id of the sa login, which stays the same
+// when the login is renamed.
+const saPrincipalID = 1
+
+type mssqlLogin struct {
+	name              string
+	principalID       int64
+	disabled          bool
+	policyChecked     bool
+	expirationChecked bool
+	sysadmin          bool
+}
+
+func (sc *mssqlScanner) getLogins(ctx context.Context) ([]*mssqlLogin, error) {
+	rows, err := sc.db.QueryContext(ctx, "SELECT name, principal_id, is_disabled, is_policy_checked, is_expiration_checked, COALESCE(IS_SRVROLEMEMBER('sysadmin', name), 0) FROM sys.sql_logins ORDER BY name")
+	if err != nil {
+		return nil, fmt.Errorf("could not see view sys.sql_logins: %w", err)
+	}
+	defer rows.Close()
+
+	logins := []*mssqlLogin{}
+	for rows.Next() {
+		var login mssqlLogin
+		if err := rows.Scan(&login.name, &login.principalID, &login.disabled, &login.policyChecked, &login.expirationChecked, &login.sysadmin); err != nil {
+			return nil, fmt.Errorf("could not scan row: %w", err)
+		}
+		logins = append(logins, &login)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read sys.sql_logins: %w", err)
+	}
+
+	return logins, nil
+}
+
+func analyzeLogins(logins []*mssqlLogin) []scanner.ScanResult {
+	results := []scanner.ScanResult{}
+	add := func(login *mssqlLogin, ruleID string, severity scanner.Severity, message string, detail string, remediation string) {
+		results = append(results, scanner.NewResult(severity, scanner.Finding{
+			RuleID:         ruleID,
+			Title:          fmt.Sprintf("Login %s: %s", login.name, message),
+			Description:    fmt.Sprintf("Login %s: %s", login.name, detail),
+			Remediation:    remediation,
+			AffectedObject: scanner.AffectedObject{Type: scanner.OBJECT_USER, Name: login.name},
+		}))
+	}
+
+	for _, login := range logins {
+		// Disabled logins, including the ##MS_...## certificate logins, can not be used to log in.
+		if login.disabled || strings.HasPrefix(login.name, "##") {
+			continue
+		}
+
+		if login.principalID == saPrincipalID {
+			add(login, "mssql-sa-enabled", scanner.SEVERITY_HIGH, "sa is enabled.",
+				"the sa login is enabled. It is a sysadmin that can not be dropped and is the first target of password attacks.",
+				"Disable the login with ALTER LOGIN ... DISABLE and use named administrator logins.")
+		}
+
+		if !login.policyChecked {
+			add(login, "mssql-login-policy-not-checked", scanner.SEVERITY_MEDIUM, "is_policy_checked is off.",
+				"the Windows password policy is not enforced, so the login can have a weak password and is never locked out.",
+				"Run ALTER LOGIN ... WITH CHECK_POLICY = ON.")
+		}
+
+		if !login.expirationChecked {
+			severity := scanner.SEVERITY_WARNING
+			if login.sysadmin {
+				severity = scanner.SEVERITY_MEDIUM
+			}
+			add(login, "mssql-login-expiration-not-checked", severity, "is_expiration_checked is off.",
+				"the password of the login never expires.",
+				"Run ALTER LOGIN ... WITH CHECK_EXPIRATION = ON.")
+		}
+	}
+
+	return results
+}
+
+func (sc *mssqlScanner) scanLogins(ctx context.Context) ([]scanner.ScanResult, error) {
+	logins, err := sc.getLogins(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return analyzeLogins(logins), nil
+}
@@ -0,0 +1,54 @@
+package mssql
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_analyzeLogins(t *testing.T) {
+	tests := []struct {
+		name  string
+		login mssqlLogin
+		want  []string
+	}{
+		{
+			name:  "disabled sa",
+			login: mssqlLogin{name: "sa", principalID: 1, disabled: true, sysadmin: true},
+			want:  []string{},
+		},
+		{
+			name:  "certificate login",
+			login: mssqlLogin{name: "##MS_PolicyEventProcessingLogin##", principalID: 256},
+			want:  []string{},
+		},
+		{
+			name:  "hardened application login",
+			login: mssqlLogin{name: "app", principalID: 267, policyChecked: true, expirationChecked: true},
+			want:  []string{},
+		},
+		{
+			name:  "renamed sa",
+			login: mssqlLogin{name: "admin", principalID: 1, policyChecked: true, sysadmin: true},
+			want:  []string{"sa is enabled.", "is_expiration_checked is off."},
+		},
+		{
+			name:  "no password policy",
+			login: mssqlLogin{name: "legacy", principalID: 270},
+			want:  []string{"is_policy_checked is off.", "is_expiration_checked is off."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := analyzeLogins([]*mssqlLogin{&tt.login})
+			if len(results) != len(tt.want) {
+				t.Fatalf("analyzeLogins() returned %d results, want %d", len(results), len(tt.want))
+			}
+			for i, result := range results {
+				message := result.Finding().Title
+				if !strings.HasSuffix(message, tt.want[i]) {
+					t.Errorf("result %d = %q, want suffix %q", i, message, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/tedyst/licenta/models"
+	"github.com/tedyst/licenta/nvd"
+	"github.com/tedyst/licenta/scanner"
+)
+
+type mssqlScanner struct {
+	db *sql.DB
+
+	options *options
+}
+
+func (sc *mssqlScanner) GetScannerName() string {
+	return "MSSQL"
+}
+func (sc *mssqlScanner) GetScannerID() int32 {
+	return models.SCAN_MSSQL
+}
+func GetScannerID() int32 {
+	return models.SCAN_MSSQL
+}
+func (sc *mssqlScanner) GetNvdProductType() nvd.Product {
+	return nvd.MSSQL
+}
+func (sc *mssqlScanner) ShouldNotBePublic() bool {
+	return true
+}
+func (sc *mssqlScanner) Ping(ctx context.Context) error {
+	return sc.db.PingContext(ctx)
+}
+func (sc *mssqlScanner) CheckPermissions(ctx context.Context) error {
+	// password_hash in sys.sql_logins is NULL unless the login has CONTROL SERVER.
+	var controlServer sql.NullInt64
+	err := sc.db.QueryRowContext(ctx, "SELECT HAS_PERMS_BY_NAME(NULL, NULL, 'CONTROL SERVER')").Scan(&controlServer)
+	if err != nil {
+		return fmt.Errorf("could not check CONTROL SERVER permission: %w", err)
+	}
+	if controlServer.Int64 != 1 {
+		return errors.New("the login does not have the CONTROL SERVER permission")
+	}
+
+	rows, err := sc.db.QueryContext(ctx, "SELECT TOP 1 name FROM sys.sql_logins")
+	if err != nil {
+		return fmt.Errorf("could not see view sys.sql_logins: %w", err)
+	}
+	rows.Close()
+
+	rows, err = sc.db.QueryContext(ctx, "SELECT TOP 1 name FROM sys.configurations")
+	if err != nil {
+		return fmt.Errorf("could not see view sys.configurations: %w", err)
+	}
+	rows.Close()
+
+	return nil
+}
+
+// GetVersion returns the build, such as 16.0.4135.4. The NVD ranges of SQL
+// Server, which name releases such as 2022, are converted to builds when they
+// are imported.
+func (sc *mssqlScanner) GetVersion(ctx context.Context) (string, error) {
+	var version string
+	err := sc.db.QueryRowContext(ctx, "SELECT CAST(SERVERPROPERTY('ProductVersion') AS nvarchar(128))").Scan(&version)
+	if err != nil {
+		return "", fmt.Errorf("could not get version: %w", err)
+	}
+
+	return version, nil
+}
+
+func NewScanner(ctx context.Context, db *sql.DB, opts ...Option) (scanner.Scanner, error) {
+	o, err := makeOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	sc := &mssqlScanner{
+		db:      db,
+		options: o,
+	}
+
+	return sc, nil
+}
@@ -0,0 +1,29 @@
+package mssql
+
+import "github.com/tedyst/licenta/scanner/rules"
+
+type Option func(*options) error
+
+type options struct {
+	ruleEngine *rules.Engine
+}
+
+func WithRuleEngine(engine *rules.Engine) Option {
+	return func(o *options) error {
+		o.ruleEngine = engine
+		return nil
+	}
+}
+
+func makeOptions(opts ...Option) (*options, error) {
+	o := &options{}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+	if o.ruleEngine == nil {
+		o.ruleEngine = rules.Default()
+	}
+	return o, nil
+}
@@ -0,0 +1,64 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tedyst/licenta/scanner"
+)
+
+type mssqlUser struct {
+	name         string
+	passwordHash []byte
+
+	privileged bool
+}
+
+var _ scanner.User = (*mssqlUser)(nil)
+
+func (u *mssqlUser) VerifyPassword(password string) (bool, error) {
+	return verifyPasswordHash(u.passwordHash, password)
+}
+
+func (u *mssqlUser) GetRawPassword() (string, bool, error) {
+	return "", false, nil
+}
+
+func (u *mssqlUser) IsPrivileged() (bool, error) {
+	return u.privileged, nil
+}
+
+func (u *mssqlUser) HasPassword() (bool, error) {
+	return len(u.passwordHash) > 0, nil
+}
+
+func (u *mssqlUser) GetUsername() (string, error) {
+	return u.name, nil
+}
+
+func (u *mssqlUser) GetHashedPassword() (string, error) {
+	return encodePasswordHash(u.passwordHash), nil
+}
+
+func (sc *mssqlScanner) GetUsers(ctx context.Context) ([]scanner.User, error) {
+	rows, err := sc.db.QueryContext(ctx, "SELECT name, password_hash, COALESCE(IS_SRVROLEMEMBER('sysadmin', name), 0) FROM sys.sql_logins WHERE is_disabled = 0 AND password_hash IS NOT NULL")
+	if err != nil {
+		return nil, fmt.Errorf("could not see view sys.sql_logins: %w", err)
+	}
+	defer rows.Close()
+
+	var users = make([]scanner.User, 0)
+	for rows.Next() {
+		var user mssqlUser
+		err = rows.Scan(&user.name, &user.passwordHash, &user.privileged)
+		if err != nil {
+			return nil, fmt.Errorf("could not scan row: %w", err)
+		}
+		users = append(users, &user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read sys.sql_logins: %w", err)
+	}
+
+	return users, nil
+}
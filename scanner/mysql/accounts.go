@@ -0,0 +1,270 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tedyst/licenta/scanner"
+)
+
+type mysqlAccount struct {
+	user             string
+	host             string
+	plugin           string
+	hasPassword      bool
+	passwordLifetime sql.NullInt64
+	locked           bool
+
+	privileges []string
+	grantable  bool
+	grants     []string
+}
+
+func (account *mysqlAccount) String() string {
+	return fmt.Sprintf("'%s'@'%s'", account.user, account.host)
+}
+
+var dangerousPrivileges = []struct {
+	privilege string
+	severity  scanner.Severity
+	reason    string
+}{
+	{"FILE", scanner.SEVERITY_HIGH, "it can read and write files on the server with the privileges of the MySQL process"},
+	{"SUPER", scanner.SEVERITY_HIGH, "it can change the server configuration, kill other sessions and bypass read_only"},
+	{"PROCESS", scanner.SEVERITY_MEDIUM, "it can see the queries of all other sessions, including passwords sent in them"},
+	{"SHUTDOWN", scanner.SEVERITY_MEDIUM, "it can stop the server"},
+}
+
+// privilegedPrivileges are the global privileges that make an account
+// privileged for IsPrivileged, besides GRANT OPTION.
+var privilegedPrivileges = map[string]struct{}{
+	"SUPER":       {},
+	"FILE":        {},
+	"PROCESS":     {},
+	"SHUTDOWN":    {},
+	"RELOAD":      {},
+	"CREATE USER": {},
+	"SYSTEM_USER": {},
+	"INSERT":      {},
+	"UPDATE":      {},
+	"DELETE":      {},
+	"DROP":        {},
+	"ALTER":       {},
+}
+
+// passwordlessPlugins authenticate using the operating system, so an empty
+// authentication_string is expected for them.
+var passwordlessPlugins = map[string]struct{}{
+	"auth_socket":              {},
+	"unix_socket":              {},
+	"authentication_ldap_sasl": {},
+}
+
+func (account *mysqlAccount) isPrivileged() bool {
+	if account.grantable {
+		return true
+	}
+	for _, privilege := range account.privileges {
+		if _, ok := privilegedPrivileges[privilege]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (account *mysqlAccount) hasPrivilege(privilege string) bool {
+	for _, p := range account.privileges {
+		if p == privilege {
+			return true
+		}
+	}
+	return false
+}
+
+// grantFor returns the SHOW GRANTS line that gives the privilege.
+func (account *mysqlAccount) grantFor(privilege string) string {
+	for _, grant := range account.grants {
+		if !strings.Contains(grant, " ON *.* ") {
+			continue
+		}
+		if strings.Contains(grant, privilege) || strings.HasPrefix(grant, "GRANT ALL PRIVILEGES") {
+			return grant
+		}
+	}
+	return ""
+}
+
+func quoteString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
+func (sc *mysqlScanner) getAccounts(ctx context.Context) ([]*mysqlAccount, error) {
+	rows, err := sc.db.QueryContext(ctx, "SELECT user, host, plugin, COALESCE(authentication_string, '') <> '', password_lifetime, account_locked = 'Y' FROM mysql.user")
+	if err != nil {
+		return nil, fmt.Errorf("could not see table mysql.user: %w", err)
+	}
+	defer rows.Close()
+
+	accounts := map[string]*mysqlAccount{}
+	for rows.Next() {
+		var account mysqlAccount
+		if err := rows.Scan(&account.user, &account.host, &account.plugin, &account.hasPassword, &account.passwordLifetime, &account.locked); err != nil {
+			return nil, fmt.Errorf("could not scan row: %w", err)
+		}
+		accounts[account.String()] = &account
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read mysql.user: %w", err)
+	}
+
+	privileges, err := sc.db.QueryContext(ctx, "SELECT GRANTEE, PRIVILEGE_TYPE, IS_GRANTABLE = 'YES' FROM information_schema.USER_PRIVILEGES")
+	if err != nil {
+		return nil, fmt.Errorf("could not see table information_schema.USER_PRIVILEGES: %w", err)
+	}
+	defer privileges.Close()
+
+	for privileges.Next() {
+		var grantee, privilege string
+		var grantable bool
+		if err := privileges.Scan(&grantee, &privilege, &grantable); err != nil {
+			return nil, fmt.Errorf("could not scan row: %w", err)
+		}
+		account, ok := accounts[grantee]
+		if !ok {
+			continue
+		}
+		if privilege != "USAGE" {
+			account.privileges = append(account.privileges, privilege)
+		}
+		account.grantable = account.grantable || grantable
+	}
+	if err := privileges.Err(); err != nil {
+		return nil, fmt.Errorf("could not read information_schema.USER_PRIVILEGES: %w", err)
+	}
+
+	result := make([]*mysqlAccount, 0, len(accounts))
+	for _, account := range accounts {
+		result = append(result, account)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].String() < result[j].String() })
+
+	for _, account := range result {
+		if account.locked || (len(account.privileges) == 0 && !account.grantable) {
+			continue
+		}
+		grants, err := sc.db.QueryContext(ctx, "SHOW GRANTS FOR "+quoteString(account.user)+"@"+quoteString(account.host))
+		if err != nil {
+			return nil, fmt.Errorf("could not run SHOW GRANTS for %s: %w", account, err)
+		}
+		for grants.Next() {
+			var grant string
+			if err := grants.Scan(&grant); err != nil {
+				grants.Close()
+				return nil, fmt.Errorf("could not scan row: %w", err)
+			}
+			account.grants = append(account.grants, grant)
+		}
+		grants.Close()
+	}
+
+	return result, nil
+}
+
+func (sc *mysqlScanner) getDefaultPasswordLifetime(ctx context.Context) (int64, error) {
+	var lifetime sql.NullInt64
+	if err := sc.db.QueryRowContext(ctx, "SELECT @@default_password_lifetime").Scan(&lifetime); err != nil {
+		return 0, fmt.Errorf("could not get default_password_lifetime: %w", err)
+	}
+	return lifetime.Int64, nil
+}
+
+func analyzeAccounts(accounts []*mysqlAccount, defaultPasswordLifetime int64) []scanner.ScanResult {
+	results := []scanner.ScanResult{}
+	add := func(account *mysqlAccount, ruleID string, severity scanner.Severity, message string, detail string, remediation string) {
+		results = append(results, scanner.NewResult(severity, scanner.Finding{
+			RuleID:         ruleID,
+			Title:          fmt.Sprintf("Account %s: %s", account, message),
+			Description:    fmt.Sprintf("Account %s: %s", account, detail),
+			Remediation:    remediation,
+			AffectedObject: scanner.AffectedObject{Type: scanner.OBJECT_USER, Name: account.String()},
+		}))
+	}
+
+	for _, account := range accounts {
+		// Locked accounts, like mysql.sys and mysql.session, can not be used to log in.
+		if account.locked {
+			continue
+		}
+
+		if account.user == "" {
+			add(account, "mysql-anonymous-user", scanner.SEVERITY_HIGH, "anonymous user.",
+				"anonymous user. Anyone can connect without knowing a username.", "Drop the account.")
+		}
+
+		if account.host == "%" || account.host == "" {
+			add(account, "mysql-any-host", scanner.SEVERITY_MEDIUM, "host is %.",
+				"the account can connect from any host.", "Restrict the host to the networks that need access.")
+		}
+
+		_, passwordless := passwordlessPlugins[account.plugin]
+		if !account.hasPassword && !passwordless {
+			add(account, "mysql-empty-password", scanner.SEVERITY_HIGH, "empty authentication_string.",
+				fmt.Sprintf("the account uses %s with an empty authentication_string, so it can log in without a password.", account.plugin),
+				"Set a password with ALTER USER.")
+		}
+
+		if account.plugin == "mysql_native_password" {
+			add(account, "mysql-native-password", scanner.SEVERITY_MEDIUM, "uses mysql_native_password.",
+				"the account uses mysql_native_password, which stores unsalted SHA1 hashes.", "Use caching_sha2_password instead.")
+		}
+
+		lifetime := defaultPasswordLifetime
+		if account.passwordLifetime.Valid {
+			lifetime = account.passwordLifetime.Int64
+		}
+		if lifetime == 0 && !passwordless {
+			add(account, "mysql-password-never-expires", scanner.SEVERITY_WARNING, "password never expires.",
+				"the password never expires.", "Set PASSWORD EXPIRE INTERVAL on the account or default_password_lifetime.")
+		}
+
+		for _, privilege := range dangerousPrivileges {
+			if !account.hasPrivilege(privilege.privilege) {
+				continue
+			}
+			detail := fmt.Sprintf("has the global %s privilege, so %s.", privilege.privilege, privilege.reason)
+			if grant := account.grantFor(privilege.privilege); grant != "" {
+				detail += " Granted by: " + grant
+			}
+			add(account, "mysql-privilege-"+strings.ToLower(strings.ReplaceAll(privilege.privilege, " ", "-")), privilege.severity,
+				"has "+privilege.privilege+".", detail, "Revoke the privilege if the account does not need it.")
+		}
+
+		if account.grantable {
+			detail := "has GRANT OPTION, so it can give its privileges to other accounts."
+			if grant := account.grantFor("WITH GRANT OPTION"); grant != "" {
+				detail += " Granted by: " + grant
+			}
+			add(account, "mysql-grant-option", scanner.SEVERITY_MEDIUM, "has GRANT OPTION.", detail,
+				"Revoke GRANT OPTION if the account does not manage other accounts.")
+		}
+	}
+
+	return results
+}
+
+func (sc *mysqlScanner) scanAccounts(ctx context.Context) ([]scanner.ScanResult, error) {
+	accounts, err := sc.getAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lifetime, err := sc.getDefaultPasswordLifetime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return analyzeAccounts(accounts, lifetime), nil
+}
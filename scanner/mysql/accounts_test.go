@@ -0,0 +1,68 @@
+package mysql
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+)
+
+func Test_analyzeAccounts(t *testing.T) {
+	tests := []struct {
+		name     string
+		account  mysqlAccount
+		lifetime int64
+		want     []string
+	}{
+		{
+			name:     "locked system account",
+			account:  mysqlAccount{user: "mysql.sys", host: "localhost", plugin: "caching_sha2_password", locked: true, privileges: []string{"SUPER"}},
+			lifetime: 0,
+			want:     []string{},
+		},
+		{
+			name:     "hardened application account",
+			account:  mysqlAccount{user: "app", host: "10.0.0.%", plugin: "caching_sha2_password", hasPassword: true, privileges: []string{"SELECT"}},
+			lifetime: 90,
+			want:     []string{},
+		},
+		{
+			name:     "anonymous from anywhere",
+			account:  mysqlAccount{user: "", host: "%", plugin: "mysql_native_password", passwordLifetime: sql.NullInt64{Int64: 30, Valid: true}},
+			lifetime: 0,
+			want:     []string{"anonymous user.", "host is %.", "empty authentication_string.", "uses mysql_native_password."},
+		},
+		{
+			name: "privileged account",
+			account: mysqlAccount{
+				user: "admin", host: "localhost", plugin: "caching_sha2_password", hasPassword: true,
+				privileges: []string{"SELECT", "FILE", "PROCESS"}, grantable: true,
+				grants: []string{"GRANT SELECT, PROCESS, FILE ON *.* TO `admin`@`localhost` WITH GRANT OPTION"},
+			},
+			lifetime: 0,
+			want:     []string{"password never expires.", "has FILE.", "has PROCESS.", "has GRANT OPTION."},
+		},
+		{
+			name:     "socket authentication",
+			account:  mysqlAccount{user: "root", host: "localhost", plugin: "auth_socket"},
+			lifetime: 0,
+			want:     []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := analyzeAccounts([]*mysqlAccount{&tt.account}, tt.lifetime)
+			if len(results) != len(tt.want) {
+				t.Fatalf("analyzeAccounts() returned %d results, want %d", len(results), len(tt.want))
+			}
+			for i, result := range results {
+				message := result.Finding().Title
+				if !strings.HasSuffix(message, tt.want[i]) {
+					t.Errorf("result %d = %q, want suffix %q", i, message, tt.want[i])
+				}
+				if strings.HasPrefix(tt.want[i], "has ") && !strings.Contains(result.Detail(), "Granted by: GRANT") {
+					t.Errorf("result %d does not mention the grant: %q", i, result.Detail())
+				}
+			}
+		})
+	}
+}
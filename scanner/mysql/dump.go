@@ -0,0 +1,207 @@
+package mysql
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/tedyst/licenta/scanner"
+)
+
+var ErrInvalidDump = errors.New("invalid mysql.user dump")
+
+// sha2SaltLength is the length of the salt of a caching_sha2_password hash,
+// which is stored between "$A$005$" and the hash itself.
+const sha2SaltLength = 20
+
+// ParseUserDump reads the users of a mysql.user export without a connection
+// to the server. It accepts the tab separated output of mysql --batch and CSV
+// files with a header row that has at least the user, host, plugin and
+// authentication_string columns. The authentication_string column can also
+// be exported with HEX(). Global privilege columns, such as Super_priv, are
+// used to decide if an account is privileged when they are present.
+//
+// Only accounts using caching_sha2_password or mysql_native_password are
+// returned.
+func ParseUserDump(r io.Reader) ([]scanner.User, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("could not read dump: %w", err)
+	}
+
+	var records [][]string
+	header, _, _ := bytes.Cut(data, []byte("\n"))
+	if bytes.Contains(header, []byte("\t")) {
+		records, err = parseBatchOutput(data)
+	} else {
+		reader := csv.NewReader(bytes.NewReader(data))
+		reader.FieldsPerRecord = -1
+		records, err = reader.ReadAll()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidDump, err)
+	}
+	if len(records) == 0 {
+		return []scanner.User{}, nil
+	}
+
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	hexPassword := false
+	passwordColumn, ok := columns["authentication_string"]
+	if !ok {
+		passwordColumn, ok = columns["hex(authentication_string)"]
+		hexPassword = true
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: missing authentication_string column", ErrInvalidDump)
+	}
+	for _, name := range []string{"user", "host", "plugin"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("%w: missing %s column", ErrInvalidDump, name)
+		}
+	}
+
+	users := []scanner.User{}
+	for _, record := range records[1:] {
+		if len(record) < len(records[0]) {
+			continue
+		}
+		account := &mysqlAccount{
+			user:   record[columns["user"]],
+			host:   record[columns["host"]],
+			plugin: record[columns["plugin"]],
+		}
+		for name, i := range columns {
+			privilege, ok := strings.CutSuffix(name, "_priv")
+			if !ok || !strings.EqualFold(record[i], "Y") {
+				continue
+			}
+			if privilege == "grant" {
+				account.grantable = true
+				continue
+			}
+			account.privileges = append(account.privileges, strings.ToUpper(strings.ReplaceAll(privilege, "_", " ")))
+		}
+
+		password, err := dumpPasswordHash(account.plugin, record[passwordColumn], hexPassword)
+		if err != nil {
+			return nil, fmt.Errorf("%w: account %s: %w", ErrInvalidDump, account, err)
+		}
+		if password == "" {
+			continue
+		}
+		users = append(users, &mysqlUser{
+			name:        account.host + ":" + account.user,
+			password:    password,
+			auth_plugin: account.plugin,
+			privileged:  account.isPrivileged(),
+		})
+	}
+	return users, nil
+}
+
+// dumpPasswordHash converts an authentication_string to the format expected
+// by VerifyPassword. It returns an empty string for accounts that can not be
+// bruteforced.
+func dumpPasswordHash(plugin string, value string, isHex bool) (string, error) {
+	if isHex || strings.HasPrefix(value, "0x") || strings.HasPrefix(value, "0X") {
+		decoded, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(value, "0x"), "0X"))
+		if err != nil {
+			return "", fmt.Errorf("could not decode authentication_string: %w", err)
+		}
+		value = string(decoded)
+	}
+	if value == "" || value == "NULL" || strings.Contains(value, "INVALIDSALTANDPASSWORD") {
+		return "", nil
+	}
+
+	switch plugin {
+	case "caching_sha2_password":
+		if strings.HasPrefix(value, "$mysql$") {
+			return value, nil
+		}
+		// The same conversion as the query in GetUsers.
+		if len(value) <= 7+sha2SaltLength || !strings.HasPrefix(value, "$A$") || value[6] != '$' {
+			return "", errors.New("invalid caching_sha2_password hash")
+		}
+		salt, hash := value[7:7+sha2SaltLength], value[7+sha2SaltLength:]
+		return "$mysql" + value[:6] + "$" + strings.ToUpper(hex.EncodeToString([]byte(salt))) + "$" + strings.ToUpper(hex.EncodeToString([]byte(hash))), nil
+	case "mysql_native_password":
+		if len(value) != 41 || value[0] != '*' {
+			return "", errors.New("invalid mysql_native_password hash")
+		}
+		return value, nil
+	default:
+		return "", nil
+	}
+}
+
+// parseBatchOutput splits the output of mysql --batch into fields and
+// reverses the escaping it does for special characters.
+func parseBatchOutput(data []byte) ([][]string, error) {
+	var records [][]string
+	lines := bufio.NewScanner(bytes.NewReader(data))
+	lines.Buffer(nil, 1024*1024)
+	for lines.Scan() {
+		line := strings.TrimSuffix(lines.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		for i, field := range fields {
+			fields[i] = unescapeBatchField(field)
+		}
+		records = append(records, fields)
+	}
+	return records, lines.Err()
+}
+
+var batchEscapes = map[byte]byte{
+	'0':  0,
+	'b':  '\b',
+	'n':  '\n',
+	'r':  '\r',
+	't':  '\t',
+	'Z':  0x1a,
+	'\\': '\\',
+}
+
+func unescapeBatchField(field string) string {
+	if !strings.Contains(field, `\`) {
+		return field
+	}
+	var value strings.Builder
+	for i := 0; i < len(field); i++ {
+		if field[i] == '\\' && i+1 < len(field) {
+			if c, ok := batchEscapes[field[i+1]]; ok {
+				value.WriteByte(c)
+				i++
+				continue
+			}
+		}
+		value.WriteByte(field[i])
+	}
+	return value.String()
+}
+
+// UserFromHash returns a user that only has the password hash of an account,
+// in the format returned by GetHashedPassword, so that it can be bruteforced
+// without a connection to the server.
+func UserFromHash(username string, hash string) (scanner.User, error) {
+	switch {
+	case strings.HasPrefix(hash, "$mysql$A$"):
+		return &mysqlUser{name: username, password: hash, auth_plugin: "caching_sha2_password"}, nil
+	case strings.HasPrefix(hash, "*") && len(hash) == 41:
+		return &mysqlUser{name: username, password: hash, auth_plugin: "mysql_native_password"}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", scanner.ErrUnsupportedHash, username)
+	}
+}
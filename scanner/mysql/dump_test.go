@@ -0,0 +1,80 @@
+package mysql
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+const nativeSecret = "*14E65567ABDB5135D0CFD9A70B3032C179A49EE7"
+
+func sha2Secret() string {
+	salt := "abcdefghij\tlmnopqrst"
+	return "$A$005$" + salt + string(myCryptGenhash([]byte("secret"), []byte(salt), 5000))
+}
+
+func TestParseUserDump(t *testing.T) {
+	batchEscaped := strings.NewReplacer(`\`, `\\`, "\t", `\t`, "\n", `\n`).Replace(sha2Secret())
+
+	tests := []struct {
+		name           string
+		dump           string
+		wantUsers      []string
+		wantPrivileged []bool
+		wantErr        error
+	}{
+		{
+			name: "batch",
+			dump: "user\thost\tplugin\tauthentication_string\tSuper_priv\n" +
+				"root\tlocalhost\tcaching_sha2_password\t" + batchEscaped + "\tY\n" +
+				"app\t%\tmysql_native_password\t" + nativeSecret + "\tN\n" +
+				"mysql.sys\tlocalhost\tcaching_sha2_password\t$A$005$THISISACOMBINATIONOFINVALIDSALTANDPASSWORDTHATMUSTNEVERBRBEUSED\tN\n" +
+				"socket\tlocalhost\tauth_socket\t\tN\n",
+			wantUsers:      []string{"localhost:root", "%:app"},
+			wantPrivileged: []bool{true, false},
+		},
+		{
+			name: "csv with hex",
+			dump: "User,Host,plugin,HEX(authentication_string),Grant_priv\n" +
+				"admin,%,caching_sha2_password," + hex.EncodeToString([]byte(sha2Secret())) + ",Y\n" +
+				"empty,%,mysql_native_password,,N\n",
+			wantUsers:      []string{"%:admin"},
+			wantPrivileged: []bool{true},
+		},
+		{
+			name:    "missing plugin column",
+			dump:    "user,host,authentication_string\nroot,localhost," + nativeSecret + "\n",
+			wantErr: ErrInvalidDump,
+		},
+		{
+			name:    "invalid hash",
+			dump:    "user,host,plugin,authentication_string\nroot,localhost,mysql_native_password,secret\n",
+			wantErr: ErrInvalidDump,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users, err := ParseUserDump(strings.NewReader(tt.dump))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseUserDump() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(users) != len(tt.wantUsers) {
+				t.Fatalf("ParseUserDump() returned %d users, want %d", len(users), len(tt.wantUsers))
+			}
+			for i, user := range users {
+				name, _ := user.GetUsername()
+				privileged, _ := user.IsPrivileged()
+				if name != tt.wantUsers[i] || privileged != tt.wantPrivileged[i] {
+					t.Errorf("user %d = %q, privileged %v, want %q, privileged %v", i, name, privileged, tt.wantUsers[i], tt.wantPrivileged[i])
+				}
+				for _, password := range []string{"secret", "secret1"} {
+					ok, err := user.VerifyPassword(password)
+					if err != nil || ok != (password == "secret") {
+						t.Errorf("user %q VerifyPassword(%q) = %v, %v", name, password, ok, err)
+					}
+				}
+			}
+		})
+	}
+}
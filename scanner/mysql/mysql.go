@@ -10,22 +10,10 @@ import (
 	"github.com/tedyst/licenta/scanner"
 )
 
-type mysqlScanResult struct {
-	severity scanner.Severity
-	message  string
-	detail   string
-}
-
-func (result *mysqlScanResult) Severity() scanner.Severity {
-	return result.severity
-}
-
-func (result *mysqlScanResult) Detail() string {
-	return result.detail
-}
-
 type mysqlScanner struct {
 	db *sql.DB
+
+	options *options
 }
 
 func (sc *mysqlScanner) GetScannerName() string {
@@ -47,20 +35,29 @@ func (sc *mysqlScanner) Ping(context.Context) error {
 	return sc.db.Ping()
 }
 func (sc *mysqlScanner) CheckPermissions(ctx context.Context) error {
-	_, err := sc.db.QueryContext(ctx, "SELECT * FROM mysql.user")
+	rows, err := sc.db.QueryContext(ctx, "SELECT * FROM mysql.user")
 	if err != nil {
 		return fmt.Errorf("could not see table mysql.user: %w", err)
 	}
+	rows.Close()
 
-	_, err = sc.db.QueryContext(ctx, "SHOW VARIABLES")
+	rows, err = sc.db.QueryContext(ctx, "SELECT * FROM information_schema.USER_PRIVILEGES")
+	if err != nil {
+		return fmt.Errorf("could not see table information_schema.USER_PRIVILEGES: %w", err)
+	}
+	rows.Close()
+
+	rows, err = sc.db.QueryContext(ctx, "SHOW VARIABLES")
 	if err != nil {
 		return fmt.Errorf("could not run SHOW VARIABLES: %w", err)
 	}
+	rows.Close()
 
-	_, err = sc.db.QueryContext(ctx, "SELECT VERSION()")
+	rows, err = sc.db.QueryContext(ctx, "SELECT VERSION()")
 	if err != nil {
 		return fmt.Errorf("could not run SELECT VERSION(): %w", err)
 	}
+	rows.Close()
 
 	return nil
 }
@@ -74,9 +71,15 @@ func (sc *mysqlScanner) GetVersion(ctx context.Context) (string, error) {
 	return version, nil
 }
 
-func NewScanner(ctx context.Context, db *sql.DB) (scanner.Scanner, error) {
+func NewScanner(ctx context.Context, db *sql.DB, opts ...Option) (scanner.Scanner, error) {
+	o, err := makeOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
 	sc := &mysqlScanner{
-		db: db,
+		db:      db,
+		options: o,
 	}
 
 	return sc, nil
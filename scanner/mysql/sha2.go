@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"strconv"
 )
@@ -152,6 +153,9 @@ func myCryptGenhash(plaintext []byte, switchsalt []byte, numRounds int) []byte {
 
 func verifySHA2Password(hashedPassword string, password string) (bool, error) {
 	parts := bytes.Split([]byte(hashedPassword), []byte("$"))
+	if len(parts) != 6 {
+		return false, errors.New("invalid caching_sha2_password hash")
+	}
 
 	rounds := ROUNDS_DEFAULT
 	r, err := strconv.Atoi(string(parts[3]))
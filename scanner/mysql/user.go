@@ -2,8 +2,11 @@ package mysql
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	_ "unsafe"
@@ -15,31 +18,39 @@ type mysqlUser struct {
 	name        string
 	password    string
 	auth_plugin string
+
+	privileged bool
 }
 
-var _ scanner.User = (*mysqlUser)(nil)
+var _ scanner.PasswordHashUser = (*mysqlUser)(nil)
 
 func (u *mysqlUser) VerifyPassword(password string) (bool, error) {
 	switch u.auth_plugin {
 	case "caching_sha2_password":
 		return verifySHA2Password(u.password, password)
+	case "mysql_native_password":
+		return verifyNativePassword(u.password, password), nil
 	default:
 		return false, errors.New("invalid auth plugin")
 	}
 }
 
+// verifyNativePassword checks a mysql_native_password hash, which is an
+// asterisk followed by SHA1(SHA1(password)) in uppercase hex.
+func verifyNativePassword(hashedPassword string, password string) bool {
+	first := sha1.Sum([]byte(password))
+	second := sha1.Sum(first[:])
+	return strings.EqualFold(hashedPassword, "*"+hex.EncodeToString(second[:]))
+}
+
+// GetRawPassword never returns a password, because MySQL does not store
+// them in plaintext.
 func (u *mysqlUser) GetRawPassword() (string, bool, error) {
-	if strings.HasPrefix(u.password, "SCRAM-SHA-256") {
-		return "", false, nil
-	}
-	if strings.HasPrefix(u.password, "md5") {
-		return "", false, nil
-	}
-	return u.password, true, nil
+	return "", false, nil
 }
 
 func (u *mysqlUser) IsPrivileged() (bool, error) {
-	return true, nil
+	return u.privileged, nil
 }
 
 func (u *mysqlUser) HasPassword() (bool, error) {
@@ -54,8 +65,34 @@ func (u *mysqlUser) GetHashedPassword() (string, error) {
 	return u.password, nil
 }
 
+func (u *mysqlUser) GetPasswordHash() (scanner.PasswordHash, error) {
+	hash := scanner.PasswordHash{Algorithm: u.auth_plugin}
+	if u.auth_plugin == "caching_sha2_password" {
+		// $mysql$A$005$, where 005 is the number of rounds in thousands.
+		parts := strings.Split(u.password, "$")
+		if len(parts) < 4 {
+			return scanner.PasswordHash{}, errors.New("invalid caching_sha2_password hash")
+		}
+		rounds, err := strconv.Atoi(parts[3])
+		if err != nil {
+			return scanner.PasswordHash{}, fmt.Errorf("could not convert rounds to int: %w", err)
+		}
+		hash.WorkFactor = rounds * ROUNDS_MULTIPLIER
+	}
+	return hash, nil
+}
+
 func (sc *mysqlScanner) GetUsers(ctx context.Context) ([]scanner.User, error) {
-	rows, err := sc.db.QueryContext(ctx, "SELECT CONCAT(host, ':', user), plugin, CONCAT('$mysql',LEFT(authentication_string,6),'$',INSERT(HEX(SUBSTR(authentication_string,8)),41,0,'$')) AS hash FROM mysql.user WHERE plugin = 'caching_sha2_password' AND authentication_string NOT LIKE '%INVALIDSALTANDPASSWORD%';")
+	accounts, err := sc.getAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	privileged := map[string]bool{}
+	for _, account := range accounts {
+		privileged[account.host+":"+account.user] = account.isPrivileged()
+	}
+
+	rows, err := sc.db.QueryContext(ctx, "SELECT CONCAT(host, ':', user), plugin, IF(plugin = 'caching_sha2_password', CONCAT('$mysql',LEFT(authentication_string,6),'$',INSERT(HEX(SUBSTR(authentication_string,8)),41,0,'$')), authentication_string) AS hash FROM mysql.user WHERE plugin IN ('caching_sha2_password', 'mysql_native_password') AND authentication_string != '' AND authentication_string NOT LIKE '%INVALIDSALTANDPASSWORD%';")
 	if err != nil {
 		return nil, fmt.Errorf("could not see table mysql.user: %w", err)
 	}
@@ -68,6 +105,7 @@ func (sc *mysqlScanner) GetUsers(ctx context.Context) ([]scanner.User, error) {
 		if err != nil {
 			return nil, fmt.Errorf("could not scan row: %w", err)
 		}
+		user.privileged = privileged[user.name]
 		users = append(users, &user)
 	}
 
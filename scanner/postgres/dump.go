@@ -0,0 +1,175 @@
+package postgres
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/tedyst/licenta/scanner"
+)
+
+var ErrInvalidDump = errors.New("invalid pg_authid dump")
+
+// alterRoleRegex matches the ALTER ROLE statements written by
+// pg_dumpall --roles-only.
+var alterRoleRegex = regexp.MustCompile(`^ALTER ROLE ("(?:[^"]|"")+"|[^\s"]+) WITH (.*);$`)
+
+// ParseUserDump reads the users of a pg_authid export without a connection
+// to the server. It accepts the output of pg_dumpall --roles-only and CSV
+// exports of pg_authid with a header row that has at least the rolname and
+// rolpassword columns. Roles that can not log in or have no password are
+// skipped.
+func ParseUserDump(r io.Reader) ([]scanner.User, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("could not read dump: %w", err)
+	}
+	if bytes.Contains(data, []byte("ALTER ROLE ")) {
+		return parseDumpallRoles(data)
+	}
+	return parseAuthidCSV(data)
+}
+
+func parseDumpallRoles(data []byte) ([]scanner.User, error) {
+	users := []scanner.User{}
+	lines := bufio.NewScanner(bytes.NewReader(data))
+	lines.Buffer(nil, 1024*1024)
+	for lines.Scan() {
+		match := alterRoleRegex.FindStringSubmatch(strings.TrimSpace(lines.Text()))
+		if match == nil {
+			continue
+		}
+		name := match[1]
+		if strings.HasPrefix(name, `"`) {
+			name = strings.ReplaceAll(name[1:len(name)-1], `""`, `"`)
+		}
+
+		user := &postgresUser{name: name}
+		var attributes roleAttribute
+		login := false
+		options := match[2]
+		for options != "" {
+			var option string
+			option, options, _ = strings.Cut(strings.TrimSpace(options), " ")
+			switch option {
+			case "SUPERUSER":
+				user.super = true
+				attributes |= attributeSuperuser
+			case "CREATEROLE":
+				attributes |= attributeCreateRole
+			case "REPLICATION":
+				attributes |= attributeReplication
+			case "BYPASSRLS":
+				attributes |= attributeBypassRLS
+			case "LOGIN":
+				login = true
+			case "PASSWORD":
+				if !strings.HasPrefix(strings.TrimSpace(options), "'") {
+					continue
+				}
+				password, rest, err := parseSQLString(strings.TrimSpace(options))
+				if err != nil {
+					return nil, fmt.Errorf("%w: role %s: %w", ErrInvalidDump, name, err)
+				}
+				user.password, options = password, rest
+			}
+		}
+		user.privileged = attributes&privilegedAttributes != 0
+
+		if login && user.password != "" {
+			users = append(users, user)
+		}
+	}
+	if err := lines.Err(); err != nil {
+		return nil, fmt.Errorf("could not read dump: %w", err)
+	}
+	return users, nil
+}
+
+// parseSQLString parses a single quoted SQL string at the start of s and
+// returns it together with the rest of s.
+func parseSQLString(s string) (string, string, error) {
+	if !strings.HasPrefix(s, "'") {
+		return "", "", errors.New("expected a quoted string")
+	}
+	var value strings.Builder
+	for i := 1; i < len(s); i++ {
+		if s[i] != '\'' {
+			value.WriteByte(s[i])
+			continue
+		}
+		if i+1 < len(s) && s[i+1] == '\'' {
+			value.WriteByte('\'')
+			i++
+			continue
+		}
+		return value.String(), s[i+1:], nil
+	}
+	return "", "", errors.New("unterminated string")
+}
+
+func parseAuthidCSV(data []byte) ([]scanner.User, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidDump, err)
+	}
+	if len(records) == 0 {
+		return []scanner.User{}, nil
+	}
+
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+	nameColumn, ok := columns["rolname"]
+	if !ok {
+		return nil, fmt.Errorf("%w: missing rolname column", ErrInvalidDump)
+	}
+	passwordColumn, ok := columns["rolpassword"]
+	if !ok {
+		return nil, fmt.Errorf("%w: missing rolpassword column", ErrInvalidDump)
+	}
+	column := func(record []string, name string) bool {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return false
+		}
+		value := strings.ToLower(record[i])
+		return value == "t" || value == "true"
+	}
+
+	users := []scanner.User{}
+	for _, record := range records[1:] {
+		if nameColumn >= len(record) || passwordColumn >= len(record) || record[passwordColumn] == "" {
+			continue
+		}
+		if _, ok := columns["rolcanlogin"]; ok && !column(record, "rolcanlogin") {
+			continue
+		}
+		user := &postgresUser{
+			name:     record[nameColumn],
+			password: record[passwordColumn],
+			super:    column(record, "rolsuper"),
+		}
+		user.privileged = user.super || column(record, "rolcreaterole") || column(record, "rolreplication") || column(record, "rolbypassrls")
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+// UserFromHash returns a user that only has the rolpassword hash of a role,
+// so that it can be bruteforced without a connection to the server. Only
+// SCRAM-SHA-256 and MD5 hashes are accepted.
+func UserFromHash(username string, hash string) (scanner.User, error) {
+	if !strings.HasPrefix(hash, "SCRAM-SHA-256$") && !strings.HasPrefix(hash, "md5") {
+		return nil, fmt.Errorf("%w: %q", scanner.ErrUnsupportedHash, username)
+	}
+	return &postgresUser{name: username, password: hash}, nil
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const scramPostgres = "SCRAM-SHA-256$4096:x1Y7a1TyFE4fFwUOMyvX8Q==$WvMDOS/ZDzXzaHjpPqzkqXrd1ntcDIi7P2jQwYgI0e4=:Wf431GYj+SeayVQ6zOijoV5xQwzZKyGoVU7IAbXTO7U="
+
+func TestParseUserDump(t *testing.T) {
+	tests := []struct {
+		name           string
+		dump           string
+		wantUsers      []string
+		wantPrivileged []bool
+		wantErr        error
+	}{
+		{
+			name: "pg_dumpall",
+			dump: `--
+-- Roles
+--
+
+CREATE ROLE postgres;
+ALTER ROLE postgres WITH SUPERUSER INHERIT CREATEROLE CREATEDB LOGIN REPLICATION BYPASSRLS PASSWORD '` + scramPostgres + `';
+CREATE ROLE "app ""web""";
+ALTER ROLE "app ""web""" WITH NOSUPERUSER INHERIT NOCREATEROLE NOCREATEDB LOGIN NOREPLICATION NOBYPASSRLS PASSWORD 'md5a3556571e93b0d20722ba62be61e8c2d';
+ALTER ROLE readers WITH NOSUPERUSER INHERIT NOLOGIN;
+ALTER ROLE nopassword WITH LOGIN;
+`,
+			wantUsers:      []string{"postgres", `app "web"`},
+			wantPrivileged: []bool{true, false},
+		},
+		{
+			name: "pg_authid csv",
+			dump: `rolname,rolsuper,rolcreaterole,rolcanlogin,rolpassword
+postgres,t,t,t,` + scramPostgres + `
+replicator,f,f,true,md5a3556571e93b0d20722ba62be61e8c2d
+group,f,f,f,
+web,f,f,t,
+`,
+			wantUsers:      []string{"postgres", "replicator"},
+			wantPrivileged: []bool{true, false},
+		},
+		{
+			name:    "csv without password column",
+			dump:    "rolname,rolsuper\npostgres,t\n",
+			wantErr: ErrInvalidDump,
+		},
+		{
+			name:    "unterminated password",
+			dump:    "ALTER ROLE postgres WITH LOGIN PASSWORD 'abc;\n",
+			wantErr: ErrInvalidDump,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users, err := ParseUserDump(strings.NewReader(tt.dump))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseUserDump() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(users) != len(tt.wantUsers) {
+				t.Fatalf("ParseUserDump() returned %d users, want %d", len(users), len(tt.wantUsers))
+			}
+			for i, user := range users {
+				name, _ := user.GetUsername()
+				privileged, _ := user.IsPrivileged()
+				if name != tt.wantUsers[i] || privileged != tt.wantPrivileged[i] {
+					t.Errorf("user %d = %q, privileged %v, want %q, privileged %v", i, name, privileged, tt.wantUsers[i], tt.wantPrivileged[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseUserDump_VerifyPassword(t *testing.T) {
+	users, err := ParseUserDump(strings.NewReader("ALTER ROLE postgres WITH LOGIN PASSWORD '" + scramPostgres + "';\n"))
+	if err != nil || len(users) != 1 {
+		t.Fatalf("ParseUserDump() = %v, %v", users, err)
+	}
+	ok, err := users[0].VerifyPassword("postgres")
+	if err != nil || !ok {
+		t.Errorf("VerifyPassword(postgres) = %v, %v, want true", ok, err)
+	}
+}
@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/tedyst/licenta/scanner"
+)
+
+type hbaRule struct {
+	lineNumber int32
+	typ        string
+	database   []string
+	userName   []string
+	address    string
+	netmask    string
+	authMethod string
+	err        string
+}
+
+func (rule *hbaRule) String() string {
+	fields := []string{rule.typ, strings.Join(rule.database, ","), strings.Join(rule.userName, ",")}
+	if rule.address != "" {
+		address := rule.address
+		if rule.netmask != "" {
+			if ones, ok := rule.prefixLength(); ok {
+				address = fmt.Sprintf("%s/%d", address, ones)
+			}
+		}
+		fields = append(fields, address)
+	}
+	fields = append(fields, rule.authMethod)
+	return strings.Join(fields, " ")
+}
+
+func (rule *hbaRule) isRemote() bool {
+	return strings.HasPrefix(rule.typ, "host")
+}
+
+// prefixLength returns the CIDR prefix length of the rule. Hostnames and the
+// samehost/samenet keywords do not have one.
+func (rule *hbaRule) prefixLength() (int, bool) {
+	if rule.address == "all" {
+		return 0, true
+	}
+	if net.ParseIP(rule.address) == nil {
+		return 0, false
+	}
+	mask := net.ParseIP(rule.netmask)
+	if mask == nil {
+		return 0, false
+	}
+	var ones int
+	if v4 := mask.To4(); v4 != nil && net.ParseIP(rule.address).To4() != nil {
+		ones, _ = net.IPMask(v4).Size()
+	} else {
+		ones, _ = net.IPMask(mask.To16()).Size()
+	}
+	return ones, true
+}
+
+func (rule *hbaRule) isIPv4() bool {
+	ip := net.ParseIP(rule.address)
+	return ip != nil && ip.To4() != nil
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func (sc *postgresScanner) getHbaRules(ctx context.Context) ([]hbaRule, error) {
+	rows, err := sc.db.Query(ctx, "SELECT line_number, type, database, user_name, address, netmask, auth_method, error FROM pg_hba_file_rules;")
+	if err != nil {
+		return nil, fmt.Errorf("could not see table pg_hba_file_rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []hbaRule
+	for rows.Next() {
+		var rule hbaRule
+		var typ, address, netmask, authMethod, ruleErr *string
+		var lineNumber *int32
+
+		if err := rows.Scan(&lineNumber, &typ, &rule.database, &rule.userName, &address, &netmask, &authMethod, &ruleErr); err != nil {
+			return nil, fmt.Errorf("could not scan row: %w", err)
+		}
+
+		rule.typ = derefString(typ)
+		rule.address = derefString(address)
+		rule.netmask = derefString(netmask)
+		rule.authMethod = derefString(authMethod)
+		rule.err = derefString(ruleErr)
+		if lineNumber != nil {
+			rule.lineNumber = *lineNumber
+		}
+
+		rules = append(rules, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read pg_hba_file_rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+func analyzeHbaRules(rules []hbaRule) []scanner.ScanResult {
+	results := []scanner.ScanResult{}
+	add := func(rule *hbaRule, ruleID string, severity scanner.Severity, message string, detail string, remediation string) {
+		results = append(results, scanner.NewResult(severity, scanner.Finding{
+			RuleID:         ruleID,
+			Title:          fmt.Sprintf("pg_hba.conf line %d: %s", rule.lineNumber, message),
+			Description:    fmt.Sprintf("pg_hba.conf line %d (%s): %s", rule.lineNumber, rule.String(), detail),
+			Remediation:    remediation,
+			AffectedObject: scanner.AffectedObject{Type: scanner.OBJECT_SETTING, Name: fmt.Sprintf("pg_hba.conf:%d", rule.lineNumber)},
+			Evidence:       rule.String(),
+		}))
+	}
+
+	for i := range rules {
+		rule := &rules[i]
+
+		if rule.err != "" {
+			add(rule, "postgres-hba-invalid-rule", scanner.SEVERITY_WARNING, "rule could not be parsed.",
+				"the rule could not be parsed and is ignored by the server: "+rule.err+".", "Fix or remove the rule.")
+			continue
+		}
+
+		switch rule.authMethod {
+		case "trust":
+			severity := scanner.SEVERITY_HIGH
+			if !rule.isRemote() {
+				severity = scanner.SEVERITY_MEDIUM
+			}
+			add(rule, "postgres-hba-trust", severity, "trust authentication is used.",
+				"trust authentication allows anyone matching this rule to connect without a password.", "Use scram-sha-256 instead.")
+		case "password":
+			severity := scanner.SEVERITY_HIGH
+			if rule.typ == "hostssl" || !rule.isRemote() {
+				severity = scanner.SEVERITY_WARNING
+			}
+			add(rule, "postgres-hba-password", severity, "password authentication is used.",
+				"password authentication sends the password in clear text.", "Use scram-sha-256 instead.")
+		case "md5":
+			add(rule, "postgres-hba-md5", scanner.SEVERITY_MEDIUM, "md5 authentication is used.",
+				"md5 authentication is vulnerable to replay attacks and md5 hashes are easy to bruteforce.", "Use scram-sha-256 instead.")
+		}
+
+		if rule.typ == "hostnossl" && rule.authMethod != "reject" {
+			add(rule, "postgres-hba-hostnossl", scanner.SEVERITY_MEDIUM, "hostnossl allows connections without SSL.",
+				"hostnossl explicitly allows connections that are not encrypted.", "Use hostssl instead.")
+		}
+
+		if !rule.isRemote() || rule.authMethod == "reject" {
+			continue
+		}
+		ones, ok := rule.prefixLength()
+		if !ok {
+			continue
+		}
+		switch {
+		case ones == 0:
+			add(rule, "postgres-hba-any-address", scanner.SEVERITY_HIGH, "rule matches any address.",
+				"the rule allows connections from any address.", "Restrict it to the networks that need access.")
+		case rule.isIPv4() && ones < 16, !rule.isIPv4() && ones < 48:
+			add(rule, "postgres-hba-wide-range", scanner.SEVERITY_WARNING, "rule matches a wide address range.",
+				fmt.Sprintf("the rule allows connections from a /%d network.", ones), "Restrict it to the networks that need access.")
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/tedyst/licenta/scanner"
+)
+
+func Test_analyzeHbaRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  hbaRule
+		wants []scanner.Severity
+	}{
+		{
+			name:  "local peer",
+			rule:  hbaRule{typ: "local", database: []string{"all"}, userName: []string{"postgres"}, authMethod: "peer"},
+			wants: []scanner.Severity{},
+		},
+		{
+			name:  "local trust",
+			rule:  hbaRule{typ: "local", database: []string{"all"}, userName: []string{"all"}, authMethod: "trust"},
+			wants: []scanner.Severity{scanner.SEVERITY_MEDIUM},
+		},
+		{
+			name:  "host trust from anywhere",
+			rule:  hbaRule{typ: "host", database: []string{"all"}, userName: []string{"all"}, address: "0.0.0.0", netmask: "0.0.0.0", authMethod: "trust"},
+			wants: []scanner.Severity{scanner.SEVERITY_HIGH, scanner.SEVERITY_HIGH},
+		},
+		{
+			name:  "host all keyword",
+			rule:  hbaRule{typ: "host", database: []string{"all"}, userName: []string{"all"}, address: "all", authMethod: "scram-sha-256"},
+			wants: []scanner.Severity{scanner.SEVERITY_HIGH},
+		},
+		{
+			name:  "md5 over a wide ipv6 network",
+			rule:  hbaRule{typ: "hostssl", database: []string{"app"}, userName: []string{"app"}, address: "2001:db8::", netmask: "ffff:ffff::", authMethod: "md5"},
+			wants: []scanner.Severity{scanner.SEVERITY_MEDIUM, scanner.SEVERITY_WARNING},
+		},
+		{
+			name:  "cleartext password without ssl",
+			rule:  hbaRule{typ: "hostnossl", database: []string{"app"}, userName: []string{"app"}, address: "10.0.0.0", netmask: "255.255.255.0", authMethod: "password"},
+			wants: []scanner.Severity{scanner.SEVERITY_HIGH, scanner.SEVERITY_MEDIUM},
+		},
+		{
+			name:  "reject from anywhere",
+			rule:  hbaRule{typ: "host", database: []string{"all"}, userName: []string{"all"}, address: "0.0.0.0", netmask: "0.0.0.0", authMethod: "reject"},
+			wants: []scanner.Severity{},
+		},
+		{
+			name:  "parse error",
+			rule:  hbaRule{lineNumber: 42, err: `invalid authentication method "foo"`},
+			wants: []scanner.Severity{scanner.SEVERITY_WARNING},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := analyzeHbaRules([]hbaRule{tt.rule})
+			if len(results) != len(tt.wants) {
+				t.Fatalf("analyzeHbaRules() returned %d results, want %d", len(results), len(tt.wants))
+			}
+			for i, result := range results {
+				if result.Severity() != tt.wants[i] {
+					t.Errorf("result %d severity = %d, want %d (%s)", i, result.Severity(), tt.wants[i], result.Detail())
+				}
+			}
+		})
+	}
+}
+
+func Test_isInsufficientPrivilege(t *testing.T) {
+	denied := &pgconn.PgError{Code: errorCodeInsufficientPrivilege, Message: "permission denied for view pg_hba_file_rules"}
+	if !isInsufficientPrivilege(fmt.Errorf("could not see table pg_hba_file_rules: %w", denied)) {
+		t.Error("isInsufficientPrivilege() = false for insufficient_privilege, want true")
+	}
+	if isInsufficientPrivilege(&pgconn.PgError{Code: "42P01", Message: "relation does not exist"}) {
+		t.Error("isInsufficientPrivilege() = true for undefined_table, want false")
+	}
+}
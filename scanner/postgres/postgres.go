@@ -13,24 +13,11 @@ import (
 
 type postgresScanner struct {
 	db *pgx.Conn
-}
-
-var _ scanner.Scanner = (*postgresScanner)(nil)
-var _ scanner.ScanResult = (*postgresScanResult)(nil)
-
-type postgresScanResult struct {
-	severity scanner.Severity
-	message  string
-	detail   string
-}
 
-func (result *postgresScanResult) Severity() scanner.Severity {
-	return result.severity
+	options *options
 }
 
-func (result *postgresScanResult) Detail() string {
-	return result.detail
-}
+var _ scanner.Scanner = (*postgresScanner)(nil)
 
 func (sc *postgresScanner) Ping(ctx context.Context) error {
 	if sc.db == nil {
@@ -53,6 +40,11 @@ func (sc *postgresScanner) CheckPermissions(ctx context.Context) error {
 		return fmt.Errorf("could not see table pg_catalog.pg_roles: %w", err)
 	}
 	row.Close()
+	row, err = sc.db.Query(ctx, "SELECT * FROM pg_catalog.pg_auth_members;")
+	if err != nil {
+		return fmt.Errorf("could not see table pg_catalog.pg_auth_members: %w", err)
+	}
+	row.Close()
 	row, err = sc.db.Query(ctx, "SELECT * FROM pg_catalog.pg_user;")
 	if err != nil {
 		return fmt.Errorf("could not see table pg_catalog.pg_user: %w", err)
@@ -68,6 +60,11 @@ func (sc *postgresScanner) CheckPermissions(ctx context.Context) error {
 		return fmt.Errorf("could not see table pg_file_settings: %w", err)
 	}
 	row.Close()
+	row, err = sc.db.Query(ctx, "SELECT * FROM pg_hba_file_rules;")
+	if err != nil {
+		return fmt.Errorf("could not see table pg_hba_file_rules: %w", err)
+	}
+	row.Close()
 
 	return nil
 }
@@ -92,9 +89,15 @@ func (*postgresScanner) GetScannerName() string {
 	return "PostgreSQL"
 }
 
-func NewScanner(ctx context.Context, db *pgx.Conn) (scanner.Scanner, error) {
+func NewScanner(ctx context.Context, db *pgx.Conn, opts ...Option) (scanner.Scanner, error) {
+	o, err := makeOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
 	sc := &postgresScanner{
-		db: db,
+		db:      db,
+		options: o,
 	}
 
 	return sc, nil
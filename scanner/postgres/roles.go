@@ -0,0 +1,375 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/tedyst/licenta/scanner"
+)
+
+type roleAttribute int
+
+const (
+	attributeSuperuser roleAttribute = 1 << iota
+	attributeCreateRole
+	attributeCreateDB
+	attributeBypassRLS
+	attributeReplication
+)
+
+var roleAttributeNames = []struct {
+	attribute roleAttribute
+	name      string
+	severity  scanner.Severity
+}{
+	{attributeSuperuser, "SUPERUSER", scanner.SEVERITY_HIGH},
+	{attributeCreateRole, "CREATEROLE", scanner.SEVERITY_HIGH},
+	{attributeBypassRLS, "BYPASSRLS", scanner.SEVERITY_MEDIUM},
+	{attributeReplication, "REPLICATION", scanner.SEVERITY_MEDIUM},
+	{attributeCreateDB, "CREATEDB", scanner.SEVERITY_WARNING},
+}
+
+// privilegedAttributes are the attributes that make a role privileged for
+// IsPrivileged. CREATEDB alone does not give access to existing data.
+const privilegedAttributes = attributeSuperuser | attributeCreateRole | attributeBypassRLS | attributeReplication
+
+// bootstrapSuperuserOID is the OID of the role created by initdb.
+const bootstrapSuperuserOID = 10
+
+type role struct {
+	oid        uint32
+	name       string
+	canLogin   bool
+	attributes roleAttribute
+	memberOf   []uint32
+}
+
+type roleGraph struct {
+	roles map[uint32]*role
+}
+
+type effectiveAttribute struct {
+	attribute roleAttribute
+	// path is the chain of roles the attribute was obtained through, empty
+	// when the role has the attribute itself.
+	path []string
+}
+
+func (sc *postgresScanner) getRoleGraph(ctx context.Context) (*roleGraph, error) {
+	graph := &roleGraph{roles: map[uint32]*role{}}
+
+	rows, err := sc.db.Query(ctx, "SELECT oid, rolname, rolcanlogin, rolsuper, rolcreaterole, rolcreatedb, rolbypassrls, rolreplication FROM pg_catalog.pg_roles;")
+	if err != nil {
+		return nil, fmt.Errorf("could not see table pg_catalog.pg_roles: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var r role
+		var super, createRole, createDB, bypassRLS, replication bool
+		if err := rows.Scan(&r.oid, &r.name, &r.canLogin, &super, &createRole, &createDB, &bypassRLS, &replication); err != nil {
+			return nil, fmt.Errorf("could not scan row: %w", err)
+		}
+		if super {
+			r.attributes |= attributeSuperuser
+		}
+		if createRole {
+			r.attributes |= attributeCreateRole
+		}
+		if createDB {
+			r.attributes |= attributeCreateDB
+		}
+		if bypassRLS {
+			r.attributes |= attributeBypassRLS
+		}
+		if replication {
+			r.attributes |= attributeReplication
+		}
+		graph.roles[r.oid] = &r
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read pg_catalog.pg_roles: %w", err)
+	}
+
+	members, err := sc.db.Query(ctx, "SELECT roleid, member FROM pg_catalog.pg_auth_members;")
+	if err != nil {
+		return nil, fmt.Errorf("could not see table pg_catalog.pg_auth_members: %w", err)
+	}
+	defer members.Close()
+
+	for members.Next() {
+		var roleID, member uint32
+		if err := members.Scan(&roleID, &member); err != nil {
+			return nil, fmt.Errorf("could not scan row: %w", err)
+		}
+		if r, ok := graph.roles[member]; ok {
+			r.memberOf = append(r.memberOf, roleID)
+		}
+	}
+	if err := members.Err(); err != nil {
+		return nil, fmt.Errorf("could not read pg_catalog.pg_auth_members: %w", err)
+	}
+
+	return graph, nil
+}
+
+// effectiveAttributes walks the membership graph breadth first, so every
+// attribute is reported with the shortest chain of roles that grants it.
+func (graph *roleGraph) effectiveAttributes(oid uint32) []effectiveAttribute {
+	start, ok := graph.roles[oid]
+	if !ok {
+		return nil
+	}
+
+	type queued struct {
+		role *role
+		path []string
+	}
+	visited := map[uint32]bool{oid: true}
+	queue := []queued{{role: start}}
+	var found roleAttribute
+	var result []effectiveAttribute
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, attr := range roleAttributeNames {
+			if current.role.attributes&attr.attribute != 0 && found&attr.attribute == 0 {
+				found |= attr.attribute
+				result = append(result, effectiveAttribute{attribute: attr.attribute, path: current.path})
+			}
+		}
+
+		for _, parentOID := range current.role.memberOf {
+			if visited[parentOID] {
+				continue
+			}
+			visited[parentOID] = true
+			parent, ok := graph.roles[parentOID]
+			if !ok {
+				continue
+			}
+			path := make([]string, len(current.path), len(current.path)+1)
+			copy(path, current.path)
+			queue = append(queue, queued{role: parent, path: append(path, parent.name)})
+		}
+	}
+
+	return result
+}
+
+func (graph *roleGraph) effectiveAttributeMask(oid uint32) roleAttribute {
+	var mask roleAttribute
+	for _, attr := range graph.effectiveAttributes(oid) {
+		mask |= attr.attribute
+	}
+	return mask
+}
+
+func (graph *roleGraph) loginRoles() []*role {
+	var roles []*role
+	for _, r := range graph.roles {
+		if r.canLogin {
+			roles = append(roles, r)
+		}
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i].name < roles[j].name })
+	return roles
+}
+
+func analyzeRoleGraph(graph *roleGraph) []scanner.ScanResult {
+	results := []scanner.ScanResult{}
+
+	for _, r := range graph.loginRoles() {
+		attributes := graph.effectiveAttributes(r.oid)
+		if len(attributes) == 0 {
+			continue
+		}
+
+		severity := scanner.SEVERITY_INFORMATIONAL
+		var names, sources []string
+		for _, attr := range attributes {
+			for _, info := range roleAttributeNames {
+				if info.attribute != attr.attribute {
+					continue
+				}
+				names = append(names, info.name)
+				if info.severity > severity {
+					severity = info.severity
+				}
+				if len(attr.path) == 0 {
+					sources = append(sources, info.name+" directly")
+				} else {
+					sources = append(sources, info.name+" through "+strings.Join(attr.path, " -> "))
+				}
+			}
+		}
+
+		// The bootstrap superuser is expected to exist, only mention it.
+		if r.oid == bootstrapSuperuserOID {
+			severity = scanner.SEVERITY_INFORMATIONAL
+		}
+
+		results = append(results, scanner.NewResult(severity, scanner.Finding{
+			RuleID:         "postgres-privileged-login-role",
+			Title:          fmt.Sprintf("Login role %s has %s.", r.name, strings.Join(names, ", ")),
+			Description:    fmt.Sprintf("Login role %s has %s. This may allow privilege escalation if its password is compromised.", r.name, strings.Join(sources, ", ")),
+			AffectedObject: scanner.AffectedObject{Type: scanner.OBJECT_USER, Name: r.name},
+			Evidence:       strings.Join(sources, ", "),
+		}))
+	}
+
+	return results
+}
+
+func (sc *postgresScanner) getPublicGrants(ctx context.Context) ([]scanner.ScanResult, error) {
+	results := []scanner.ScanResult{}
+
+	rows, err := sc.db.Query(ctx, `SELECT n.nspname FROM pg_catalog.pg_namespace n, aclexplode(n.nspacl) a
+		WHERE a.grantee = 0 AND a.privilege_type = 'CREATE'
+		AND n.nspname NOT IN ('pg_catalog', 'information_schema') AND n.nspname NOT LIKE 'pg\_%';`)
+	if err != nil {
+		return nil, fmt.Errorf("could not see table pg_catalog.pg_namespace: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var schema string
+		if err := rows.Scan(&schema); err != nil {
+			return nil, fmt.Errorf("could not scan row: %w", err)
+		}
+		results = append(results, scanner.NewResult(scanner.SEVERITY_HIGH, scanner.Finding{
+			RuleID:         "postgres-public-schema-create",
+			Title:          fmt.Sprintf("PUBLIC can create objects in schema %s.", schema),
+			Description:    fmt.Sprintf("PUBLIC has CREATE on schema %s. Any user can create objects that other users may run, which allows privilege escalation.", schema),
+			Remediation:    fmt.Sprintf("Run REVOKE CREATE ON SCHEMA %s FROM PUBLIC.", schema),
+			AffectedObject: scanner.AffectedObject{Type: scanner.OBJECT_SCHEMA, Name: schema},
+		}))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read pg_catalog.pg_namespace: %w", err)
+	}
+
+	tables, err := sc.db.Query(ctx, `SELECT n.nspname, c.relname, array_agg(a.privilege_type ORDER BY a.privilege_type)
+		FROM pg_catalog.pg_class c JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace, aclexplode(c.relacl) a
+		WHERE a.grantee = 0 AND c.relkind IN ('r', 'v', 'm', 'p', 'f')
+		AND n.nspname NOT IN ('pg_catalog', 'information_schema') AND n.nspname NOT LIKE 'pg\_%'
+		GROUP BY n.nspname, c.relname ORDER BY n.nspname, c.relname;`)
+	if err != nil {
+		return nil, fmt.Errorf("could not see table pg_catalog.pg_class: %w", err)
+	}
+	defer tables.Close()
+
+	for tables.Next() {
+		var schema, table string
+		var privileges []string
+		if err := tables.Scan(&schema, &table, &privileges); err != nil {
+			return nil, fmt.Errorf("could not scan row: %w", err)
+		}
+		results = append(results, publicTableGrantResult(schema, table, privileges))
+	}
+	if err := tables.Err(); err != nil {
+		return nil, fmt.Errorf("could not read pg_catalog.pg_class: %w", err)
+	}
+
+	return results, nil
+}
+
+func publicTableGrantResult(schema string, table string, privileges []string) scanner.ScanResult {
+	severity := scanner.SEVERITY_MEDIUM
+	for _, privilege := range privileges {
+		switch privilege {
+		case "INSERT", "UPDATE", "DELETE", "TRUNCATE":
+			severity = scanner.SEVERITY_HIGH
+		}
+	}
+	return scanner.NewResult(severity, scanner.Finding{
+		RuleID:         "postgres-public-table-grant",
+		Title:          fmt.Sprintf("PUBLIC has %s on %s.%s.", strings.Join(privileges, ", "), schema, table),
+		Description:    fmt.Sprintf("PUBLIC has %s on %s.%s. Every role, including ones created later, can access it.", strings.Join(privileges, ", "), schema, table),
+		Remediation:    fmt.Sprintf("Run REVOKE %s ON %s.%s FROM PUBLIC and grant access to the roles that need it.", strings.Join(privileges, ", "), schema, table),
+		AffectedObject: scanner.AffectedObject{Type: scanner.OBJECT_TABLE, Name: schema + "." + table},
+		Evidence:       strings.Join(privileges, ", "),
+	})
+}
+
+func (sc *postgresScanner) getSecurityDefinerFunctions(ctx context.Context) ([]scanner.ScanResult, error) {
+	rows, err := sc.db.Query(ctx, `SELECT n.nspname, p.proname, pg_catalog.pg_get_function_identity_arguments(p.oid), r.rolname,
+		EXISTS (SELECT 1 FROM unnest(p.proconfig) c WHERE c LIKE 'search\_path=%')
+		FROM pg_catalog.pg_proc p
+		JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+		JOIN pg_catalog.pg_roles r ON r.oid = p.proowner
+		WHERE p.prosecdef AND r.rolsuper
+		AND n.nspname NOT IN ('pg_catalog', 'information_schema') AND n.nspname NOT LIKE 'pg\_%';`)
+	if err != nil {
+		return nil, fmt.Errorf("could not see table pg_catalog.pg_proc: %w", err)
+	}
+	defer rows.Close()
+
+	results := []scanner.ScanResult{}
+	for rows.Next() {
+		var schema, name, arguments, owner string
+		var hasSearchPath bool
+		if err := rows.Scan(&schema, &name, &arguments, &owner, &hasSearchPath); err != nil {
+			return nil, fmt.Errorf("could not scan row: %w", err)
+		}
+
+		severity := scanner.SEVERITY_MEDIUM
+		description := fmt.Sprintf("SECURITY DEFINER function %s.%s(%s) is owned by superuser %s and runs with superuser privileges for every caller.", schema, name, arguments, owner)
+		if !hasSearchPath {
+			severity = scanner.SEVERITY_HIGH
+			description += " It does not set search_path, so callers can hijack the objects it uses."
+		}
+		results = append(results, scanner.NewResult(severity, scanner.Finding{
+			RuleID:         "postgres-superuser-security-definer",
+			Title:          fmt.Sprintf("SECURITY DEFINER function %s.%s(%s) is owned by superuser %s.", schema, name, arguments, owner),
+			Description:    description,
+			Remediation:    "Change the owner to a role with only the privileges the function needs.",
+			AffectedObject: scanner.AffectedObject{Type: scanner.OBJECT_FUNCTION, Name: fmt.Sprintf("%s.%s(%s)", schema, name, arguments)},
+			Evidence:       "owner " + owner,
+		}))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read pg_catalog.pg_proc: %w", err)
+	}
+
+	return results, nil
+}
+
+// scanPrivileges checks the roles, the grants to PUBLIC and the SECURITY
+// DEFINER functions. A check whose catalogs the role is not allowed to read
+// is reported as a missing privilege, and the others still run.
+func (sc *postgresScanner) scanPrivileges(ctx context.Context) ([]scanner.ScanResult, error) {
+	results := []scanner.ScanResult{}
+	checks := []struct {
+		catalogs string
+		run      func(context.Context) ([]scanner.ScanResult, error)
+	}{
+		{"pg_catalog.pg_roles and pg_catalog.pg_auth_members", func(ctx context.Context) ([]scanner.ScanResult, error) {
+			graph, err := sc.getRoleGraph(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return analyzeRoleGraph(graph), nil
+		}},
+		{"pg_catalog.pg_namespace and pg_catalog.pg_class", sc.getPublicGrants},
+		{"pg_catalog.pg_proc", sc.getSecurityDefinerFunctions},
+	}
+	for _, check := range checks {
+		checkResults, err := check.run(ctx)
+		if isInsufficientPrivilege(err) {
+			slog.WarnContext(ctx, "Not allowed to read catalogs, skipping their checks", "catalogs", check.catalogs, "error", err)
+			results = append(results, missingPrivilegeResult(check.catalogs, fmt.Sprintf("Grant SELECT on %s to the scanning role.", check.catalogs), err))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, checkResults...)
+	}
+	return results, nil
+}
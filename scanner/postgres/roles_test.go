@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/tedyst/licenta/scanner"
+)
+
+func testRoleGraph() *roleGraph {
+	return &roleGraph{roles: map[uint32]*role{
+		10: {oid: 10, name: "postgres", canLogin: true, attributes: attributeSuperuser | attributeCreateRole | attributeCreateDB | attributeBypassRLS | attributeReplication},
+		20: {oid: 20, name: "admins", attributes: attributeCreateRole},
+		21: {oid: 21, name: "ops", memberOf: []uint32{20, 22}},
+		22: {oid: 22, name: "ops_legacy", memberOf: []uint32{21}, attributes: attributeReplication},
+		30: {oid: 30, name: "alice", canLogin: true, memberOf: []uint32{21}},
+		31: {oid: 31, name: "reader", canLogin: true},
+		32: {oid: 32, name: "builder", canLogin: true, attributes: attributeCreateDB},
+		33: {oid: 33, name: "escalated", canLogin: true, memberOf: []uint32{10}},
+	}}
+}
+
+func Test_roleGraph_effectiveAttributes(t *testing.T) {
+	graph := testRoleGraph()
+	tests := []struct {
+		name       string
+		oid        uint32
+		want       roleAttribute
+		privileged bool
+	}{
+		{name: "transitive through a cycle", oid: 30, want: attributeCreateRole | attributeReplication, privileged: true},
+		{name: "no attributes", oid: 31, want: 0, privileged: false},
+		{name: "createdb only", oid: 32, want: attributeCreateDB, privileged: false},
+		{name: "member of superuser", oid: 33, want: attributeSuperuser | attributeCreateRole | attributeCreateDB | attributeBypassRLS | attributeReplication, privileged: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := graph.effectiveAttributeMask(tt.oid)
+			if got != tt.want {
+				t.Errorf("effectiveAttributeMask() = %b, want %b", got, tt.want)
+			}
+			if privileged := got&privilegedAttributes != 0; privileged != tt.privileged {
+				t.Errorf("privileged = %v, want %v", privileged, tt.privileged)
+			}
+		})
+	}
+}
+
+func Test_analyzeRoleGraph(t *testing.T) {
+	results := analyzeRoleGraph(testRoleGraph())
+
+	want := []struct {
+		severity scanner.Severity
+		detail   string
+	}{
+		{scanner.SEVERITY_HIGH, "Login role alice has CREATEROLE through ops -> admins, REPLICATION through ops -> ops_legacy. This may allow privilege escalation if its password is compromised."},
+		{scanner.SEVERITY_WARNING, "Login role builder has CREATEDB directly. This may allow privilege escalation if its password is compromised."},
+		{scanner.SEVERITY_HIGH, "Login role escalated has SUPERUSER through postgres, CREATEROLE through postgres, BYPASSRLS through postgres, REPLICATION through postgres, CREATEDB through postgres. This may allow privilege escalation if its password is compromised."},
+		{scanner.SEVERITY_INFORMATIONAL, "Login role postgres has SUPERUSER directly, CREATEROLE directly, BYPASSRLS directly, REPLICATION directly, CREATEDB directly. This may allow privilege escalation if its password is compromised."},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("analyzeRoleGraph() returned %d results, want %d", len(results), len(want))
+	}
+	for i, result := range results {
+		if result.Severity() != want[i].severity || result.Detail() != want[i].detail {
+			t.Errorf("result %d = (%d, %q), want (%d, %q)", i, result.Severity(), result.Detail(), want[i].severity, want[i].detail)
+		}
+	}
+}
@@ -2,157 +2,52 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/tedyst/licenta/scanner"
+	"github.com/tedyst/licenta/scanner/rules"
 )
 
-var scanConfigLines = map[string]struct {
-	value      func(string) bool
-	diagnostic postgresScanResult
-}{
-	"fsync": {
-		value: func(s string) bool { return s == "off" },
-		diagnostic: postgresScanResult{
-			severity: scanner.SEVERITY_WARNING,
-			message:  "fsync is off. Loss of data in case of crash.",
-			detail: "fsync=off is a dangerous setting. " +
-				"Data loss is possible in case of a crash. " +
-				"See https://www.postgresql.org/docs/current/runtime-config-wal.html#GUC-FSYNC for more details.",
-		},
-	},
-	"data_directory_mode": {
-		value: func(s string) bool { return s != "0700" },
-		diagnostic: postgresScanResult{
-			severity: scanner.SEVERITY_HIGH,
-			message:  "data_directory_mode is not 0700.",
-			detail:   "data_directory_mode is not 0700. This may allow other users to read your data.",
-		},
-	},
-	"listen_addresses": {
-		value: func(s string) bool { return s != "localhost" },
-		diagnostic: postgresScanResult{
-			severity: scanner.SEVERITY_WARNING,
-			message:  "listen_addresses is not localhost.",
-			detail:   "listen_addresses is not localhost. This may allow other users to connect to your database.",
-		},
-	},
-	"full_page_writes": {
-		value: func(s string) bool { return s == "off" },
-		diagnostic: postgresScanResult{
-			severity: scanner.SEVERITY_WARNING,
-			message:  "full_page_writes is off. Loss of data in case of crash.",
-			detail: "full_page_writes=off is a dangerous setting. " +
-				"Data loss is possible in case of a crash. " +
-				"See https://www.postgresql.org/docs/current/runtime-config-wal.html#GUC-FULL-PAGE-WRITES for more details.",
-		},
-	},
-	"ssl": {
-		value: func(s string) bool { return s == "off" },
-		diagnostic: postgresScanResult{
-			severity: scanner.SEVERITY_HIGH,
-			message:  "ssl is off. Passwords are sent in clear text.",
-			detail:   "ssl=off is a dangerous setting. Passwords are sent in clear text.",
-		},
-	},
-	"idle_in_transaction_session_timeout": {
-		value: func(s string) bool { return s != "0" },
-		diagnostic: postgresScanResult{
-			severity: scanner.SEVERITY_WARNING,
-			message:  "idle_in_transaction_session_timeout is not 0.",
-			detail:   "idle_in_transaction_session_timeout is not 0. This may cause a denial of service.",
-		},
-	},
-	"ignore_invalid_pages": {
-		value: func(s string) bool { return s == "on" },
-		diagnostic: postgresScanResult{
-			severity: scanner.SEVERITY_WARNING,
-			message:  "ignore_invalid_pages is on.",
-			detail:   "ignore_invalid_pages is on. This may cause error writing data to disk.",
-		},
-	},
-	"local_preload_libraries": {
-		value: func(s string) bool { return s != "" },
-		diagnostic: postgresScanResult{
-			severity: scanner.SEVERITY_HIGH,
-			message:  "local_preload_libraries is not empty.",
-			detail:   "local_preload_libraries is not empty. This may allow privilege escalation.",
-		},
-	},
-	"log_connections": {
-		value: func(s string) bool { return s == "off" },
-		diagnostic: postgresScanResult{
-			severity: scanner.SEVERITY_WARNING,
-			message:  "log_connections is off.",
-			detail:   "log_connections is off. This may make it harder to diagnose problems.",
-		},
-	},
-	"log_disconnections": {
-		value: func(s string) bool { return s == "off" },
-		diagnostic: postgresScanResult{
-			severity: scanner.SEVERITY_WARNING,
-			message:  "log_disconnections is off.",
-			detail:   "log_disconnections is off. This may make it harder to diagnose problems.",
-		},
-	},
-	"log_file_mode": {
-		value: func(s string) bool { return s != "0600" },
-		diagnostic: postgresScanResult{
-			severity: scanner.SEVERITY_HIGH,
-			message:  "log_file_mode is not 0600.",
-			detail:   "log_file_mode is not 0600. This may allow other users to read your logs.",
-		},
-	},
-	"max_connections": {
-		value: func(s string) bool {
-			var maxConnections int
-			_, err := fmt.Sscanf(s, "%d", &maxConnections)
-			if err != nil {
-				return false
-			}
-			return maxConnections > 1500
-		},
-		diagnostic: postgresScanResult{
-			severity: scanner.SEVERITY_HIGH,
-			message:  "max_connections is >1500.",
-			detail:   "max_connections is >1500. This may cause a denial of service.",
-		},
-	},
-	"password_encryption": {
-		value: func(s string) bool { return s == "off" || s == "md5" },
-		diagnostic: postgresScanResult{
-			severity: scanner.SEVERITY_HIGH,
-			message:  "password_encryption is off or set to md5",
-			detail:   "password_encryption is off or set to md5. This may allow privilege escalation.",
-		},
-	},
-	"synchronous_commit": {
-		value: func(s string) bool { return s == "off" },
-		diagnostic: postgresScanResult{
-			severity: scanner.SEVERITY_WARNING,
-			message:  "synchronous_commit is off.",
-			detail:   "synchronous_commit is off. This may cause a loss of data in case of crash.",
-		},
-	},
-	"TimeZone": {
-		value: func(s string) bool { return s != "Etc/UTC" },
-		diagnostic: postgresScanResult{
-			severity: scanner.SEVERITY_WARNING,
-			message:  "TimeZone is not UTC.",
-			detail:   "TimeZone is not UTC. This may cause problems with timezones.",
-		},
-	},
+// errorCodeInsufficientPrivilege is the SQLSTATE of a query the role is not
+// allowed to run.
+const errorCodeInsufficientPrivilege = "42501"
+
+// isInsufficientPrivilege returns true if a query failed because the role
+// lacks the privilege to run it.
+func isInsufficientPrivilege(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == errorCodeInsufficientPrivilege
 }
 
-func (sc *postgresScanner) ScanConfig(ctx context.Context) ([]scanner.ScanResult, error) {
-	var results = make([]scanner.ScanResult, 0)
+// missingPrivilegeResult reports that the checks that read object were
+// skipped because the role is not allowed to.
+func missingPrivilegeResult(object string, remediation string, err error) *scanner.Result {
+	return scanner.NewResult(scanner.SEVERITY_INFORMATIONAL, scanner.Finding{
+		RuleID:         "postgres-missing-privilege",
+		Title:          fmt.Sprintf("Not allowed to read %s", object),
+		Description:    fmt.Sprintf("The scanning role is not allowed to read %s, so the checks that need it were skipped.", object),
+		Remediation:    remediation,
+		AffectedObject: scanner.AffectedObject{Type: scanner.OBJECT_SERVER, Name: object},
+		Evidence:       err.Error(),
+	})
+}
 
+// ScanConfig checks pg_settings with the rule engine, then the rules of
+// pg_hba.conf and the privileges of the roles. The hba checks are skipped with
+// a missing privilege finding if the role is not allowed to read
+// pg_hba_file_rules, which by default only superusers can, and so are the
+// privilege checks whose catalogs it cannot read.
+func (sc *postgresScanner) ScanConfig(ctx context.Context) ([]scanner.ScanResult, error) {
 	rows, err := sc.db.Query(ctx, "SELECT name, setting FROM pg_settings;")
 	if err != nil {
 		return nil, fmt.Errorf("could not see table pg_settings: %w", err)
 	}
 	defer rows.Close()
 
+	settings := map[string]string{}
 	for rows.Next() {
 		var name string
 		var setting string
@@ -162,13 +57,30 @@ func (sc *postgresScanner) ScanConfig(ctx context.Context) ([]scanner.ScanResult
 			return nil, fmt.Errorf("could not scan row: %w", err)
 		}
 
-		if line, ok := scanConfigLines[name]; ok {
-			if line.value(setting) {
-				diagnostic := line.diagnostic
-				results = append(results, &diagnostic)
-			}
-		}
+		settings[name] = setting
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read pg_settings: %w", err)
+	}
+
+	results := sc.options.ruleEngine.Evaluate(rules.PRODUCT_POSTGRES, settings)
+
+	hbaRules, err := sc.getHbaRules(ctx)
+	switch {
+	case isInsufficientPrivilege(err):
+		slog.WarnContext(ctx, "Not allowed to read pg_hba_file_rules, skipping its checks", "error", err)
+		results = append(results, missingPrivilegeResult("pg_hba_file_rules", "Grant SELECT on pg_hba_file_rules and EXECUTE on pg_hba_file_rules() to the scanning role.", err))
+	case err != nil:
+		return nil, err
+	default:
+		results = append(results, analyzeHbaRules(hbaRules)...)
+	}
+
+	privileges, err := sc.scanPrivileges(ctx)
+	if err != nil {
+		return nil, err
 	}
+	results = append(results, privileges...)
 
 	return results, nil
 }
@@ -22,9 +22,11 @@ type postgresUser struct {
 	super    bool
 	name     string
 	password string
+
+	privileged bool
 }
 
-var _ scanner.User = (*postgresUser)(nil)
+var _ scanner.PasswordHashUser = (*postgresUser)(nil)
 
 func isASCII(s string) bool {
 	for _, c := range s {
@@ -118,7 +120,7 @@ func (u *postgresUser) GetRawPassword() (string, bool, error) {
 }
 
 func (u *postgresUser) IsPrivileged() (bool, error) {
-	return u.super, nil
+	return u.super || u.privileged, nil
 }
 
 func (u *postgresUser) HasPassword() (bool, error) {
@@ -133,8 +135,34 @@ func (u *postgresUser) GetHashedPassword() (string, error) {
 	return u.password, nil
 }
 
+// GetPasswordHash returns an empty algorithm for passwords stored in
+// plaintext, which only servers older than PostgreSQL 10 allow.
+func (u *postgresUser) GetPasswordHash() (scanner.PasswordHash, error) {
+	switch {
+	case strings.HasPrefix(u.password, "SCRAM-SHA-256$"):
+		iterations, _, ok := strings.Cut(strings.TrimPrefix(u.password, "SCRAM-SHA-256$"), ":")
+		if !ok {
+			return scanner.PasswordHash{}, errors.New("invalid iteration and salt format")
+		}
+		workFactor, err := strconv.Atoi(iterations)
+		if err != nil {
+			return scanner.PasswordHash{}, fmt.Errorf("could not convert iterations to int: %w", err)
+		}
+		return scanner.PasswordHash{Algorithm: "SCRAM-SHA-256", WorkFactor: workFactor}, nil
+	case strings.HasPrefix(u.password, "md5"):
+		return scanner.PasswordHash{Algorithm: "md5"}, nil
+	default:
+		return scanner.PasswordHash{}, nil
+	}
+}
+
 func (sc *postgresScanner) GetUsers(ctx context.Context) ([]scanner.User, error) {
-	rows, err := sc.db.Query(ctx, "SELECT rolsuper, rolname, rolpassword FROM pg_catalog.pg_authid WHERE rolcanlogin=true;")
+	graph, err := sc.getRoleGraph(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := sc.db.Query(ctx, "SELECT oid, rolsuper, rolname, rolpassword FROM pg_catalog.pg_authid WHERE rolcanlogin=true;")
 	if err != nil {
 		return nil, fmt.Errorf("could not see table pg_catalog.pg_authid: %w", err)
 	}
@@ -143,10 +171,12 @@ func (sc *postgresScanner) GetUsers(ctx context.Context) ([]scanner.User, error)
 	var users = make([]scanner.User, 0)
 	for rows.Next() {
 		var user postgresUser
-		err = rows.Scan(&user.super, &user.name, &user.password)
+		var oid uint32
+		err = rows.Scan(&oid, &user.super, &user.name, &user.password)
 		if err != nil {
 			return nil, fmt.Errorf("could not scan row: %w", err)
 		}
+		user.privileged = graph.effectiveAttributeMask(oid)&privilegedAttributes != 0
 		users = append(users, &user)
 	}
 
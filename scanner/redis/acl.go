@@ -0,0 +1,308 @@
+package redis
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/tedyst/licenta/scanner"
+)
+
+var ErrInvalidACL = errors.New("invalid ACL rule")
+
+// aclSelector holds the permissions of the root rules of a user or of one of
+// its selectors. Command rules are kept in order because later rules override
+// earlier ones.
+type aclSelector struct {
+	commandRules    []string
+	keyPatterns     []string
+	channelPatterns []string
+}
+
+type aclUser struct {
+	name      string
+	enabled   bool
+	nopass    bool
+	passwords []string
+
+	aclSelector
+	selectors []aclSelector
+
+	// unknownRules are the rules that were skipped because they are not
+	// known, for example ones added by a newer version of Redis.
+	unknownRules []string
+}
+
+// dangerousCommands are checked one by one, together with the categories they
+// belong to. The categories match the ones reported by ACL CAT on Redis 7.
+var dangerousCommands = []struct {
+	command    string
+	display    string
+	categories []string
+}{
+	{"config", "CONFIG", []string{"admin", "slow", "dangerous"}},
+	{"flushall", "FLUSHALL", []string{"keyspace", "write", "slow", "dangerous"}},
+	{"debug", "DEBUG", []string{"admin", "slow", "dangerous"}},
+	{"module|load", "MODULE LOAD", []string{"admin", "slow", "dangerous"}},
+}
+
+func splitACLTokens(line string) ([]string, error) {
+	var tokens []string
+	var current strings.Builder
+	depth := 0
+	for _, c := range line {
+		switch {
+		case c == '(':
+			depth++
+			current.WriteRune(c)
+		case c == ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("%w: unbalanced selector in %q", ErrInvalidACL, line)
+			}
+			current.WriteRune(c)
+		case c == ' ' && depth == 0:
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(c)
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("%w: unbalanced selector in %q", ErrInvalidACL, line)
+	}
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+	return tokens, nil
+}
+
+func (selector *aclSelector) apply(token string) bool {
+	switch {
+	case token == "allkeys":
+		selector.keyPatterns = append(selector.keyPatterns, "*")
+	case token == "resetkeys":
+		selector.keyPatterns = nil
+	case strings.HasPrefix(token, "~"):
+		selector.keyPatterns = append(selector.keyPatterns, token[1:])
+	case strings.HasPrefix(token, "%"):
+		if i := strings.Index(token, "~"); i >= 0 {
+			selector.keyPatterns = append(selector.keyPatterns, token[i+1:])
+		}
+	case token == "allchannels":
+		selector.channelPatterns = append(selector.channelPatterns, "*")
+	case token == "resetchannels":
+		selector.channelPatterns = nil
+	case strings.HasPrefix(token, "&"):
+		selector.channelPatterns = append(selector.channelPatterns, token[1:])
+	case token == "allcommands":
+		selector.commandRules = append(selector.commandRules, "+@all")
+	case token == "nocommands":
+		selector.commandRules = append(selector.commandRules, "-@all")
+	case strings.HasPrefix(token, "+"), strings.HasPrefix(token, "-"):
+		selector.commandRules = append(selector.commandRules, strings.ToLower(token))
+	default:
+		return false
+	}
+	return true
+}
+
+// hashACLPassword returns the hash Redis stores for a cleartext password.
+func hashACLPassword(password string) string {
+	hash := sha256.Sum256([]byte(password))
+	return hex.EncodeToString(hash[:])
+}
+
+// parseACLUser parses one line of ACL LIST or of an ACL file, for example
+// "user default on nopass sanitize-payload ~* &* +@all". Unknown rules are
+// kept in unknownRules instead of failing, so a newer Redis can be scanned.
+func parseACLUser(line string) (*aclUser, error) {
+	tokens, err := splitACLTokens(strings.TrimSpace(line))
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) < 2 || tokens[0] != "user" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidACL, line)
+	}
+
+	user := &aclUser{name: tokens[1]}
+	for _, token := range tokens[2:] {
+		switch {
+		case token == "on":
+			user.enabled = true
+		case token == "off":
+			user.enabled = false
+		case token == "nopass":
+			user.nopass = true
+			user.passwords = nil
+		case token == "resetpass":
+			user.nopass = false
+			user.passwords = nil
+		case token == "reset":
+			*user = aclUser{name: user.name}
+		case token == "sanitize-payload", token == "skip-sanitize-payload":
+		case strings.HasPrefix(token, "#"):
+			user.passwords = append(user.passwords, strings.ToLower(token[1:]))
+			user.nopass = false
+		case strings.HasPrefix(token, ">"):
+			user.passwords = append(user.passwords, hashACLPassword(token[1:]))
+			user.nopass = false
+		case strings.HasPrefix(token, "!"), strings.HasPrefix(token, "<"):
+			hash := strings.ToLower(token[1:])
+			if strings.HasPrefix(token, "<") {
+				hash = hashACLPassword(token[1:])
+			}
+			passwords := user.passwords[:0]
+			for _, p := range user.passwords {
+				if p != hash {
+					passwords = append(passwords, p)
+				}
+			}
+			user.passwords = passwords
+		case strings.HasPrefix(token, "(") && strings.HasSuffix(token, ")"):
+			var selector aclSelector
+			for _, rule := range strings.Fields(token[1 : len(token)-1]) {
+				if !selector.apply(rule) {
+					user.unknownRules = append(user.unknownRules, rule)
+				}
+			}
+			user.selectors = append(user.selectors, selector)
+		default:
+			if !user.aclSelector.apply(token) {
+				user.unknownRules = append(user.unknownRules, token)
+			}
+		}
+	}
+
+	return user, nil
+}
+
+// allowsCategory reports whether the last rule that mentions the category, or
+// @all, allows it.
+func (selector *aclSelector) allowsCategory(category string) (bool, string) {
+	allowed, rule := false, ""
+	for _, r := range selector.commandRules {
+		switch r[1:] {
+		case "@all", "@" + category:
+			allowed, rule = r[0] == '+', r
+		}
+	}
+	return allowed, rule
+}
+
+// allowsCommand evaluates the command rules in order. A rule for the parent
+// command also applies to its subcommands.
+func (selector *aclSelector) allowsCommand(command string, categories []string) (bool, string) {
+	parent, _, _ := strings.Cut(command, "|")
+	allowed, rule := false, ""
+	for _, r := range selector.commandRules {
+		name := r[1:]
+		matches := name == "@all" || name == command || name == parent
+		for _, category := range categories {
+			matches = matches || name == "@"+category
+		}
+		if matches {
+			allowed, rule = r[0] == '+', r
+		}
+	}
+	return allowed, rule
+}
+
+func (user *aclUser) allSelectors() []aclSelector {
+	return append([]aclSelector{user.aclSelector}, user.selectors...)
+}
+
+func analyzeACLUsers(users []*aclUser) []scanner.ScanResult {
+	results := []scanner.ScanResult{}
+	add := func(user *aclUser, ruleID string, severity scanner.Severity, message string, detail string, remediation string, evidence string) {
+		results = append(results, scanner.NewResult(severity, scanner.Finding{
+			RuleID:         ruleID,
+			Title:          fmt.Sprintf("ACL user %s: %s", user.name, message),
+			Description:    fmt.Sprintf("ACL user %s: %s", user.name, detail),
+			Remediation:    remediation,
+			AffectedObject: scanner.AffectedObject{Type: scanner.OBJECT_USER, Name: user.name},
+			Evidence:       evidence,
+		}))
+	}
+
+	for _, user := range users {
+		if !user.enabled {
+			continue
+		}
+
+		if user.nopass {
+			add(user, "redis-acl-nopass", scanner.SEVERITY_HIGH, "nopass is set.",
+				"nopass is set, so any password is accepted for this user.",
+				"Remove nopass and set a password with ACL SETUSER.", "nopass")
+		}
+
+		if user.name == "default" {
+			severity := scanner.SEVERITY_MEDIUM
+			if user.nopass {
+				severity = scanner.SEVERITY_HIGH
+			}
+			add(user, "redis-acl-default-user", severity, "the default user is enabled.",
+				"the default user is enabled. Clients that do not authenticate run as this user.",
+				"Disable it with ACL SETUSER default off.", "on")
+		}
+
+		dangerousCategory := false
+		for _, selector := range user.allSelectors() {
+			if allowed, rule := selector.allowsCategory("dangerous"); allowed {
+				dangerousCategory = true
+				add(user, "redis-acl-dangerous-category", scanner.SEVERITY_HIGH, "+@dangerous is allowed.",
+					fmt.Sprintf("the user can run every command in the @dangerous category because of %s.", rule),
+					"Add -@dangerous to the user.", rule)
+				break
+			}
+		}
+
+		var commands, rules []string
+		for _, command := range dangerousCommands {
+			for _, selector := range user.allSelectors() {
+				if allowed, rule := selector.allowsCommand(command.command, command.categories); allowed {
+					commands = append(commands, command.display)
+					rules = append(rules, command.display+" by "+rule)
+					break
+				}
+			}
+		}
+		if len(commands) > 0 {
+			severity := scanner.SEVERITY_HIGH
+			if dangerousCategory {
+				severity = scanner.SEVERITY_MEDIUM
+			}
+			add(user, "redis-acl-dangerous-commands", severity, "can run "+strings.Join(commands, ", ")+".",
+				"the user can run "+strings.Join(rules, ", ")+".",
+				"Deny the commands the user does not need.", strings.Join(rules, ", "))
+		}
+	}
+
+	return results
+}
+
+func (sc *redisScanner) getACLUsers(ctx context.Context) ([]*aclUser, error) {
+	lines, err := sc.db.Do(ctx, "ACL", "LIST").StringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("could not run ACL LIST: %w", err)
+	}
+
+	users := make([]*aclUser, 0, len(lines))
+	for _, line := range lines {
+		user, err := parseACLUser(line)
+		if err != nil {
+			return nil, err
+		}
+		if len(user.unknownRules) > 0 {
+			slog.WarnContext(ctx, "Skipped unknown ACL rules", "user", user.name, "rules", user.unknownRules)
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
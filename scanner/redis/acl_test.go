@@ -0,0 +1,145 @@
+package redis
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_parseACLUser(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		want    *aclUser
+		wantErr bool
+	}{
+		{
+			name: "default user",
+			line: "user default on nopass sanitize-payload ~* &* +@all",
+			want: &aclUser{
+				name: "default", enabled: true, nopass: true,
+				aclSelector: aclSelector{keyPatterns: []string{"*"}, channelPatterns: []string{"*"}, commandRules: []string{"+@all"}},
+			},
+		},
+		{
+			name: "multiple passwords and selector",
+			line: "user app off #AB12 #cd34 resetchannels %R~cache:* -@all +get +CONFIG|get (~logs:* +@write)",
+			want: &aclUser{
+				name: "app", passwords: []string{"ab12", "cd34"},
+				aclSelector: aclSelector{keyPatterns: []string{"cache:*"}, commandRules: []string{"-@all", "+get", "+config|get"}},
+				selectors:   []aclSelector{{keyPatterns: []string{"logs:*"}, commandRules: []string{"+@write"}}},
+			},
+		},
+		{
+			name: "removed password",
+			line: "user app on #ab12 #cd34 !ab12 nocommands",
+			want: &aclUser{
+				name: "app", enabled: true, passwords: []string{"cd34"},
+				aclSelector: aclSelector{commandRules: []string{"-@all"}},
+			},
+		},
+		{
+			name: "unknown rules",
+			line: "user app on something ~* (+get newrule) +@read",
+			want: &aclUser{
+				name: "app", enabled: true,
+				aclSelector:  aclSelector{keyPatterns: []string{"*"}, commandRules: []string{"+@read"}},
+				selectors:    []aclSelector{{commandRules: []string{"+get"}}},
+				unknownRules: []string{"something", "newrule"},
+			},
+		},
+		{
+			name:    "unbalanced selector",
+			line:    "user app on (~* +get",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseACLUser(tt.line)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseACLUser() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidACL) {
+					t.Errorf("parseACLUser() error = %v, want ErrInvalidACL", err)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseACLUser() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_analyzeACLUsers(t *testing.T) {
+	tests := []struct {
+		name       string
+		line       string
+		want       []string
+		privileged bool
+	}{
+		{
+			name:       "default user without password",
+			line:       "user default on nopass ~* &* +@all",
+			want:       []string{"nopass is set.", "the default user is enabled.", "+@dangerous is allowed.", "can run CONFIG, FLUSHALL, DEBUG, MODULE LOAD."},
+			privileged: true,
+		},
+		{
+			name:       "disabled default user",
+			line:       "user default off nopass ~* &* +@all",
+			want:       []string{},
+			privileged: true,
+		},
+		{
+			name: "read only user",
+			line: "user app on #ab12 ~* -@all +@read",
+			want: []string{},
+		},
+		{
+			name: "all commands except dangerous",
+			line: "user app on #ab12 ~* +@all -@dangerous",
+			want: []string{},
+		},
+		{
+			name:       "single dangerous commands",
+			line:       "user app on #ab12 ~* -@all +@read +flushall +module|load",
+			want:       []string{"can run FLUSHALL, MODULE LOAD."},
+			privileged: true,
+		},
+		{
+			name:       "dangerous command in selector",
+			line:       "user app on #ab12 ~* -@all +get (~* +config)",
+			want:       []string{"can run CONFIG."},
+			privileged: true,
+		},
+		{
+			name: "subcommand is not the whole command",
+			line: "user app on #ab12 ~* -@all +config|get",
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := parseACLUser(tt.line)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if user.isPrivileged() != tt.privileged {
+				t.Errorf("isPrivileged() = %v, want %v", user.isPrivileged(), tt.privileged)
+			}
+			results := analyzeACLUsers([]*aclUser{user})
+			if len(results) != len(tt.want) {
+				t.Fatalf("analyzeACLUsers() returned %d results, want %d", len(results), len(tt.want))
+			}
+			for i, result := range results {
+				message := result.Finding().Title
+				if !strings.HasSuffix(message, tt.want[i]) {
+					t.Errorf("result %d = %q, want suffix %q", i, message, tt.want[i])
+				}
+			}
+		})
+	}
+}
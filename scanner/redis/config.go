@@ -2,75 +2,37 @@ package redis
 
 import (
 	"context"
+	"errors"
 
 	"github.com/tedyst/licenta/scanner"
+	"github.com/tedyst/licenta/scanner/rules"
 )
 
-var scanConfigLines = map[string]struct {
-	value      func(string) bool
-	diagnostic redisScanResult
-}{
-	"timeout": {
-		value: func(s string) bool { return s == "0" },
-		diagnostic: redisScanResult{
-			severity: scanner.SEVERITY_WARNING,
-			message:  "timeout is set to 0.",
-			detail:   "timeout is set to 0. This can cause the server to hang indefinitely",
-		},
-	},
-	"tls-cert-file": {
-		value: func(s string) bool { return s == "" },
-		diagnostic: redisScanResult{
-			severity: scanner.SEVERITY_HIGH,
-			message:  "tls-cert-file is empty. TLS is not configured.",
-			detail:   "tls-cert-file is empty. TLS is not configured.",
-		},
-	},
-	"ignore-warnings": {
-		value: func(s string) bool { return s != "" },
-		diagnostic: redisScanResult{
-			severity: scanner.SEVERITY_WARNING,
-			message:  "ignore-warnings is not empty. This can hide important warnings.",
-			detail:   "ignore-warnings is empty. This can hide important warnings.",
-		},
-	},
-	"enable-debug-command": {
-		value: func(s string) bool { return s != "no" },
-		diagnostic: redisScanResult{
-			severity: scanner.SEVERITY_HIGH,
-			message:  "enable-debug-command is not set to no.",
-			detail:   "enable-debug-command is not set to no.",
-		},
-	},
-	"requirepass": {
-		value: func(s string) bool { return s != "" },
-		diagnostic: redisScanResult{
-			severity: scanner.SEVERITY_MEDIUM,
-			message:  "requirepass is set. Please consider using ACLs instead.",
-			detail:   "requirepass is set. Please consider using ACLs instead.",
-		},
-	},
-	"aclfile": {
-		value: func(s string) bool { return s == "" },
-		diagnostic: redisScanResult{
-			severity: scanner.SEVERITY_HIGH,
-			message:  "aclfile is not set. Please consider using ACLs.",
-			detail:   "aclfile is not set. Please consider using ACLs.",
-		},
-	},
-}
-
 func (sc *redisScanner) ScanConfig(ctx context.Context) ([]scanner.ScanResult, error) {
-	var results = make([]scanner.ScanResult, 0)
-	config := sc.db.Do(ctx, "CONFIG", "GET", "*").Val().(map[interface{}]interface{})
+	config, ok := sc.db.Do(ctx, "CONFIG", "GET", "*").Val().(map[interface{}]interface{})
+	if !ok {
+		return nil, errors.New("could not read CONFIG GET *")
+	}
 
+	settings := map[string]string{}
 	for key, value := range config {
-		if line, ok := scanConfigLines[key.(string)]; ok {
-			if line.value(value.(string)) {
-				results = append(results, &line.diagnostic)
-			}
+		k, ok := key.(string)
+		if !ok {
+			continue
+		}
+		v, ok := value.(string)
+		if !ok {
+			continue
 		}
+		settings[k] = v
+	}
+
+	results := sc.options.ruleEngine.Evaluate(rules.PRODUCT_REDIS, settings)
+
+	users, err := sc.getACLUsers(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return results, nil
+	return append(results, analyzeACLUsers(users)...), nil
 }
@@ -12,22 +12,10 @@ import (
 	"github.com/tedyst/licenta/scanner"
 )
 
-type redisScanResult struct {
-	severity scanner.Severity
-	message  string
-	detail   string
-}
-
-func (result *redisScanResult) Severity() scanner.Severity {
-	return result.severity
-}
-
-func (result *redisScanResult) Detail() string {
-	return result.detail
-}
-
 type redisScanner struct {
 	db *r.Client
+
+	options *options
 }
 
 func (sc *redisScanner) GetScannerName() string {
@@ -68,9 +56,15 @@ func (sc *redisScanner) GetVersion(ctx context.Context) (string, error) {
 	return "", errors.New("Could not find version")
 }
 
-func NewScanner(ctx context.Context, db *r.Client) (scanner.Scanner, error) {
+func NewScanner(ctx context.Context, db *r.Client, opts ...Option) (scanner.Scanner, error) {
+	o, err := makeOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
 	sc := &redisScanner{
-		db: db,
+		db:      db,
+		options: o,
 	}
 
 	return sc, nil
@@ -1,21 +1,24 @@
 package redis
 
 import (
+	"bufio"
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"io"
 	"log/slog"
-	"regexp"
 	"strings"
 
 	"github.com/tedyst/licenta/scanner"
 )
 
-var extractRegex = regexp.MustCompile("user ([a-zA-Z0-9]*) on (.*) #([a-zA-Z0-9-]*) ")
-
+// redisUser is a single password hash of an ACL user. Users with more than one
+// password are returned once for every hash, so that all of them are
+// bruteforced.
 type redisUser struct {
-	name     string
-	password string
+	name       string
+	password   string
+	privileged bool
 }
 
 var _ scanner.User = (*redisUser)(nil)
@@ -36,11 +39,11 @@ func (u *redisUser) GetRawPassword() (string, bool, error) {
 }
 
 func (u *redisUser) IsPrivileged() (bool, error) {
-	return true, nil
+	return u.privileged, nil
 }
 
 func (u *redisUser) HasPassword() (bool, error) {
-	return true, nil
+	return u.password != "", nil
 }
 
 func (u *redisUser) GetUsername() (string, error) {
@@ -51,22 +54,84 @@ func (u *redisUser) GetHashedPassword() (string, error) {
 	return u.password, nil
 }
 
+// isPrivileged reports whether the user can run any of the dangerous commands
+// or administer other users.
+func (user *aclUser) isPrivileged() bool {
+	for _, selector := range user.allSelectors() {
+		if allowed, _ := selector.allowsCategory("dangerous"); allowed {
+			return true
+		}
+		if allowed, _ := selector.allowsCommand("acl|setuser", []string{"admin", "slow", "dangerous"}); allowed {
+			return true
+		}
+		for _, command := range dangerousCommands {
+			if allowed, _ := selector.allowsCommand(command.command, command.categories); allowed {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (sc *redisScanner) GetUsers(ctx context.Context) ([]scanner.User, error) {
-	u := sc.db.Do(ctx, "ACL", "LIST").Val().([]interface{})
+	aclUsers, err := sc.getACLUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return usersFromACL(aclUsers), nil
+}
 
+func usersFromACL(aclUsers []*aclUser) []scanner.User {
 	var users []scanner.User
-	for _, user := range u {
-		matches := extractRegex.FindStringSubmatch(user.(string))
-		if len(matches) != 4 {
-			slog.Error("unexpected user format: %s", user)
-			return nil, nil
+	for _, user := range aclUsers {
+		// A nopass user accepts any password, so there is nothing to
+		// bruteforce. The ACL scan already reports it with redis-acl-nopass.
+		privileged := user.isPrivileged()
+		for _, password := range user.passwords {
+			users = append(users, &redisUser{
+				name:       user.name,
+				password:   password,
+				privileged: privileged,
+			})
 		}
+	}
+	return users
+}
 
-		users = append(users, &redisUser{
-			name:     matches[1],
-			password: strings.TrimPrefix(matches[3], "#"),
-		})
+// ParseUserDump reads the users of a Redis ACL file, as written by ACL SAVE
+// or the output of ACL LIST, without a connection to the server. Empty lines
+// and comments are skipped.
+func ParseUserDump(r io.Reader) ([]scanner.User, error) {
+	lines := bufio.NewScanner(r)
+	lines.Buffer(nil, 1024*1024)
+
+	var aclUsers []*aclUser
+	for lines.Scan() {
+		line := strings.TrimSpace(lines.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		user, err := parseACLUser(line)
+		if err != nil {
+			return nil, err
+		}
+		if len(user.unknownRules) > 0 {
+			slog.Warn("Skipped unknown ACL rules", "user", user.name, "rules", user.unknownRules)
+		}
+		aclUsers = append(aclUsers, user)
+	}
+	if err := lines.Err(); err != nil {
+		return nil, fmt.Errorf("could not read ACL file: %w", err)
 	}
+	return usersFromACL(aclUsers), nil
+}
 
-	return users, nil
+// UserFromHash returns a user that only has one of the SHA-256 password
+// hashes of an ACL user, so that it can be bruteforced without a connection
+// to the server.
+func UserFromHash(username string, hash string) (scanner.User, error) {
+	if len(hash) != sha256.Size*2 {
+		return nil, fmt.Errorf("%w: %q", scanner.ErrUnsupportedHash, username)
+	}
+	return &redisUser{name: username, password: strings.ToLower(hash)}, nil
 }
@@ -0,0 +1,65 @@
+package redis
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseUserDump(t *testing.T) {
+	tests := []struct {
+		name           string
+		dump           string
+		wantUsers      []string
+		wantPrivileged []bool
+		wantErr        error
+	}{
+		{
+			name: "acl file",
+			dump: `# users.acl
+user default on nopass ~* &* +@all
+
+user admin on #f52fbd32b2b3b86ff88ef6c490628285f482af15ddcb29541f94bcf526a3f6c7 ~* &* +@all
+user app on >hunter2 >secret <secret ~cache:* -@all +get +set
+`,
+			wantUsers:      []string{"admin", "app"},
+			wantPrivileged: []bool{true, false},
+		},
+		{
+			name:           "unknown rule",
+			dump:           "user app on >hunter2 +get unknown\n",
+			wantUsers:      []string{"app"},
+			wantPrivileged: []bool{false},
+		},
+		{
+			name:    "unbalanced selector",
+			dump:    "user app on (+get\n",
+			wantErr: ErrInvalidACL,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users, err := ParseUserDump(strings.NewReader(tt.dump))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseUserDump() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(users) != len(tt.wantUsers) {
+				t.Fatalf("ParseUserDump() returned %d users, want %d", len(users), len(tt.wantUsers))
+			}
+			for i, user := range users {
+				name, _ := user.GetUsername()
+				privileged, _ := user.IsPrivileged()
+				if name != tt.wantUsers[i] || privileged != tt.wantPrivileged[i] {
+					t.Errorf("user %d = %q, privileged %v, want %q, privileged %v", i, name, privileged, tt.wantUsers[i], tt.wantPrivileged[i])
+				}
+				hasPassword, _ := user.HasPassword()
+				if hasPassword != (name != "default") {
+					t.Errorf("user %q HasPassword() = %v", name, hasPassword)
+				}
+				if ok, err := user.VerifyPassword("hunter2"); err != nil || ok != hasPassword {
+					t.Errorf("user %q VerifyPassword(hunter2) = %v, %v, want %v", name, ok, err, hasPassword)
+				}
+			}
+		})
+	}
+}
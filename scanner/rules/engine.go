@@ -0,0 +1,203 @@
+package rules
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/tedyst/licenta/scanner"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	PRODUCT_POSTGRES = "postgres"
+	PRODUCT_MYSQL    = "mysql"
+	PRODUCT_REDIS    = "redis"
+	PRODUCT_MONGODB  = "mongodb"
+	PRODUCT_MSSQL    = "mssql"
+)
+
+//go:embed packs/*.yaml
+var builtinPacks embed.FS
+
+type Engine struct {
+	rules map[string]Rule
+	order []string
+
+	lock sync.RWMutex
+}
+
+func NewEngine() *Engine {
+	return &Engine{
+		rules: map[string]Rule{},
+	}
+}
+
+var builtinEngine *Engine
+
+func init() {
+	builtinEngine = NewEngine()
+	err := fs.WalkDir(builtinPacks, "packs", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := builtinPacks.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return builtinEngine.LoadPack(content)
+	})
+	if err != nil {
+		panic(fmt.Errorf("could not load builtin rule packs: %w", err))
+	}
+}
+
+// Default returns a copy of the engine containing only the embedded rule
+// packs, so that callers can add their own packs on top of it.
+func Default() *Engine {
+	return builtinEngine.Clone()
+}
+
+func ParsePack(content []byte) (*Pack, error) {
+	var pack Pack
+	if err := yaml.Unmarshal(content, &pack); err != nil {
+		return nil, fmt.Errorf("could not parse rule pack: %w", err)
+	}
+
+	for i := range pack.Rules {
+		if pack.Rules[i].Product == "" {
+			pack.Rules[i].Product = pack.Product
+		}
+		if err := pack.Rules[i].compile(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pack, nil
+}
+
+// AddPack merges the pack into the engine. Rules with an ID that already
+// exists replace the previous definition and the IDs in Disable are turned off.
+func (e *Engine) AddPack(pack *Pack) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	for _, rule := range pack.Rules {
+		if _, ok := e.rules[rule.ID]; !ok {
+			e.order = append(e.order, rule.ID)
+		}
+		e.rules[rule.ID] = rule
+	}
+
+	for _, id := range pack.Disable {
+		if rule, ok := e.rules[id]; ok {
+			rule.Disabled = true
+			e.rules[id] = rule
+		}
+	}
+}
+
+func (e *Engine) LoadPack(content []byte) error {
+	pack, err := ParsePack(content)
+	if err != nil {
+		return err
+	}
+	e.AddPack(pack)
+	return nil
+}
+
+func isPackFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
+
+// LoadPath loads a single rule pack file or every pack file from a directory,
+// in lexical order.
+func (e *Engine) LoadPath(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("could not read rule pack path: %w", err)
+	}
+
+	files := []string{path}
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return fmt.Errorf("could not read rule pack directory: %w", err)
+		}
+		files = []string{}
+		for _, entry := range entries {
+			if entry.IsDir() || !isPackFile(entry.Name()) {
+				continue
+			}
+			files = append(files, filepath.Join(path, entry.Name()))
+		}
+		sort.Strings(files)
+	}
+
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("could not read rule pack %s: %w", file, err)
+		}
+		if err := e.LoadPack(content); err != nil {
+			return fmt.Errorf("could not load rule pack %s: %w", file, err)
+		}
+	}
+
+	return nil
+}
+
+func (e *Engine) Clone() *Engine {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	clone := &Engine{
+		rules: make(map[string]Rule, len(e.rules)),
+		order: make([]string, len(e.order)),
+	}
+	for id, rule := range e.rules {
+		clone.rules[id] = rule
+	}
+	copy(clone.order, e.order)
+	return clone
+}
+
+// Rules returns the enabled rules for a product, in the order they were loaded.
+func (e *Engine) Rules(product string) []Rule {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	result := []Rule{}
+	for _, id := range e.order {
+		rule := e.rules[id]
+		if rule.Disabled || rule.Product != product {
+			continue
+		}
+		result = append(result, rule)
+	}
+	return result
+}
+
+func (e *Engine) Evaluate(product string, settings map[string]string) []scanner.ScanResult {
+	results := []scanner.ScanResult{}
+	for _, rule := range e.Rules(product) {
+		value, present := settings[rule.Setting]
+		if rule.Matches(value, present) {
+			results = append(results, &Result{
+				rule:  rule,
+				value: value,
+			})
+		}
+	}
+	return results
+}
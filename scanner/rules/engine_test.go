@@ -0,0 +1,113 @@
+package rules
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tedyst/licenta/scanner"
+)
+
+func TestEngine_Evaluate(t *testing.T) {
+	const override = `
+name: baseline
+product: postgres
+disable:
+  - postgres-timezone
+rules:
+  - id: postgres-max-connections
+    setting: max_connections
+    operator: gt
+    value: "100"
+    severity: medium
+    message: max_connections is >100.
+  - id: postgres-shared-preload
+    setting: shared_preload_libraries
+    operator: matches
+    value: "(^|,)\\s*plpython"
+    severity: high
+    message: plpython is preloaded.
+`
+	tests := []struct {
+		name     string
+		packs    []string
+		settings map[string]string
+		want     []string
+	}{
+		{
+			name:     "builtin",
+			settings: map[string]string{"ssl": "off", "max_connections": "200", "TimeZone": "Europe/Bucharest"},
+			want:     []string{"postgres-ssl-off", "postgres-timezone"},
+		},
+		{
+			name:     "override and disable",
+			packs:    []string{override},
+			settings: map[string]string{"ssl": "on", "max_connections": "200", "TimeZone": "Europe/Bucharest", "shared_preload_libraries": "pg_stat_statements, plpython3u"},
+			want:     []string{"postgres-max-connections", "postgres-shared-preload"},
+		},
+		{
+			name:     "non numeric value does not match",
+			packs:    []string{override},
+			settings: map[string]string{"max_connections": "many"},
+			want:     []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := Default()
+			for _, pack := range tt.packs {
+				if err := engine.LoadPack([]byte(pack)); err != nil {
+					t.Fatalf("LoadPack() error = %v", err)
+				}
+			}
+
+			results := engine.Evaluate(PRODUCT_POSTGRES, tt.settings)
+			if len(results) != len(tt.want) {
+				t.Fatalf("Evaluate() returned %d results, want %d", len(results), len(tt.want))
+			}
+			for i, result := range results {
+				if id := result.(*Result).Rule().ID; id != tt.want[i] {
+					t.Errorf("Evaluate()[%d] = %s, want %s", i, id, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestResult_Finding(t *testing.T) {
+	results := Default().Evaluate(PRODUCT_POSTGRES, map[string]string{"ssl": "off"})
+	if len(results) != 1 {
+		t.Fatalf("Evaluate() returned %d results, want 1", len(results))
+	}
+
+	want := scanner.Finding{
+		RuleID:         "postgres-ssl-off",
+		Title:          "ssl is off. Passwords are sent in clear text.",
+		Description:    "ssl=off is a dangerous setting. Passwords are sent in clear text.",
+		Remediation:    "Configure ssl_cert_file and ssl_key_file and set ssl = on.",
+		References:     []string{"https://www.postgresql.org/docs/current/ssl-tcp.html"},
+		AffectedObject: scanner.AffectedObject{Type: scanner.OBJECT_SETTING, Name: "ssl"},
+		Evidence:       `ssl = "off"`,
+	}
+	if got := results[0].Finding(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Finding() = %+v, want %+v", got, want)
+	}
+}
+
+func TestParsePack_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "unknown operator", content: "rules: [{id: a, product: redis, setting: x, operator: nope, severity: high, message: m}]"},
+		{name: "bad severity", content: "rules: [{id: a, product: redis, setting: x, operator: eq, severity: extreme, message: m}]"},
+		{name: "non numeric value", content: "rules: [{id: a, product: redis, setting: x, operator: gt, value: abc, severity: high, message: m}]"},
+		{name: "missing product", content: "rules: [{id: a, setting: x, operator: eq, severity: high, message: m}]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParsePack([]byte(tt.content)); err == nil {
+				t.Errorf("ParsePack() expected an error")
+			}
+		})
+	}
+}
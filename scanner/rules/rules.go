@@ -0,0 +1,236 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tedyst/licenta/scanner"
+)
+
+type Operator string
+
+const (
+	OperatorEquals         Operator = "eq"
+	OperatorNotEquals      Operator = "ne"
+	OperatorLessThan       Operator = "lt"
+	OperatorLessOrEqual    Operator = "le"
+	OperatorGreaterThan    Operator = "gt"
+	OperatorGreaterOrEqual Operator = "ge"
+	OperatorIn             Operator = "in"
+	OperatorNotIn          Operator = "not_in"
+	OperatorEmpty          Operator = "empty"
+	OperatorNotEmpty       Operator = "not_empty"
+	OperatorMatches        Operator = "matches"
+	OperatorMissing        Operator = "missing"
+)
+
+var (
+	ErrInvalidRule     = errors.New("invalid rule")
+	ErrInvalidSeverity = errors.New("invalid severity")
+)
+
+type Rule struct {
+	ID          string   `yaml:"id" json:"id"`
+	Product     string   `yaml:"product" json:"product"`
+	Setting     string   `yaml:"setting" json:"setting"`
+	Operator    Operator `yaml:"operator" json:"operator"`
+	Value       string   `yaml:"value" json:"value"`
+	Values      []string `yaml:"values" json:"values"`
+	Severity    string   `yaml:"severity" json:"severity"`
+	Message     string   `yaml:"message" json:"message"`
+	Detail      string   `yaml:"detail" json:"detail"`
+	Remediation string   `yaml:"remediation" json:"remediation"`
+	References  []string `yaml:"references" json:"references"`
+	Disabled    bool     `yaml:"disabled" json:"disabled"`
+
+	severity scanner.Severity
+	regex    *regexp.Regexp
+}
+
+type Pack struct {
+	Name    string   `yaml:"name" json:"name"`
+	Product string   `yaml:"product" json:"product"`
+	Disable []string `yaml:"disable" json:"disable"`
+	Rules   []Rule   `yaml:"rules" json:"rules"`
+}
+
+func ParseSeverity(severity string) (scanner.Severity, error) {
+	switch strings.ToLower(severity) {
+	case "informational", "info":
+		return scanner.SEVERITY_INFORMATIONAL, nil
+	case "warning", "low":
+		return scanner.SEVERITY_WARNING, nil
+	case "medium":
+		return scanner.SEVERITY_MEDIUM, nil
+	case "high", "critical":
+		return scanner.SEVERITY_HIGH, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSeverity, severity)
+	}
+}
+
+func (r *Rule) compile() error {
+	if r.ID == "" {
+		return fmt.Errorf("%w: missing id", ErrInvalidRule)
+	}
+	if r.Product == "" {
+		return fmt.Errorf("%w: rule %s has no product", ErrInvalidRule, r.ID)
+	}
+	if r.Setting == "" {
+		return fmt.Errorf("%w: rule %s has no setting", ErrInvalidRule, r.ID)
+	}
+	if r.Message == "" {
+		return fmt.Errorf("%w: rule %s has no message", ErrInvalidRule, r.ID)
+	}
+
+	severity, err := ParseSeverity(r.Severity)
+	if err != nil {
+		return fmt.Errorf("%w: rule %s: %w", ErrInvalidRule, r.ID, err)
+	}
+	r.severity = severity
+
+	switch r.Operator {
+	case OperatorEquals, OperatorNotEquals, OperatorEmpty, OperatorNotEmpty, OperatorMissing:
+	case OperatorLessThan, OperatorLessOrEqual, OperatorGreaterThan, OperatorGreaterOrEqual:
+		if _, err := strconv.ParseFloat(r.Value, 64); err != nil {
+			return fmt.Errorf("%w: rule %s needs a numeric value for operator %s", ErrInvalidRule, r.ID, r.Operator)
+		}
+	case OperatorIn, OperatorNotIn:
+		if len(r.Values) == 0 {
+			return fmt.Errorf("%w: rule %s needs values for operator %s", ErrInvalidRule, r.ID, r.Operator)
+		}
+	case OperatorMatches:
+		regex, err := regexp.Compile(r.Value)
+		if err != nil {
+			return fmt.Errorf("%w: rule %s has an invalid regex: %w", ErrInvalidRule, r.ID, err)
+		}
+		r.regex = regex
+	default:
+		return fmt.Errorf("%w: rule %s has unknown operator %q", ErrInvalidRule, r.ID, r.Operator)
+	}
+
+	return nil
+}
+
+func compareNumbers(actual string, expected string) (int, bool) {
+	a, err := strconv.ParseFloat(strings.TrimSpace(actual), 64)
+	if err != nil {
+		return 0, false
+	}
+	b, err := strconv.ParseFloat(expected, 64)
+	if err != nil {
+		return 0, false
+	}
+	switch {
+	case a < b:
+		return -1, true
+	case a > b:
+		return 1, true
+	default:
+		return 0, true
+	}
+}
+
+// Matches reports whether the rule fires for the given setting value. The
+// present flag is false when the scanned database did not report the setting.
+func (r *Rule) Matches(value string, present bool) bool {
+	if r.Operator == OperatorMissing {
+		return !present
+	}
+	if !present {
+		return false
+	}
+
+	switch r.Operator {
+	case OperatorEquals:
+		return value == r.Value
+	case OperatorNotEquals:
+		return value != r.Value
+	case OperatorEmpty:
+		return value == ""
+	case OperatorNotEmpty:
+		return value != ""
+	case OperatorIn:
+		for _, v := range r.Values {
+			if value == v {
+				return true
+			}
+		}
+		return false
+	case OperatorNotIn:
+		for _, v := range r.Values {
+			if value == v {
+				return false
+			}
+		}
+		return true
+	case OperatorMatches:
+		return r.regex.MatchString(value)
+	}
+
+	cmp, ok := compareNumbers(value, r.Value)
+	if !ok {
+		return false
+	}
+	switch r.Operator {
+	case OperatorLessThan:
+		return cmp < 0
+	case OperatorLessOrEqual:
+		return cmp <= 0
+	case OperatorGreaterThan:
+		return cmp > 0
+	case OperatorGreaterOrEqual:
+		return cmp >= 0
+	default:
+		return false
+	}
+}
+
+type Result struct {
+	rule  Rule
+	value string
+}
+
+var _ scanner.ScanResult = (*Result)(nil)
+
+func (result *Result) Severity() scanner.Severity {
+	return result.rule.severity
+}
+
+func (result *Result) Detail() string {
+	detail := result.rule.Detail
+	if detail == "" {
+		detail = result.rule.Message
+	}
+	if len(result.rule.References) > 0 {
+		detail += " See " + strings.Join(result.rule.References, ", ") + " for more details."
+	}
+	return detail
+}
+
+func (result *Result) Finding() scanner.Finding {
+	description := result.rule.Detail
+	if description == "" {
+		description = result.rule.Message
+	}
+	return scanner.Finding{
+		RuleID:         result.rule.ID,
+		Title:          result.rule.Message,
+		Description:    description,
+		Remediation:    result.rule.Remediation,
+		References:     result.rule.References,
+		AffectedObject: scanner.AffectedObject{Type: scanner.OBJECT_SETTING, Name: result.rule.Setting},
+		Evidence:       fmt.Sprintf("%s = %q", result.rule.Setting, result.value),
+	}
+}
+
+func (result *Result) Rule() Rule {
+	return result.rule
+}
+
+func (result *Result) Value() string {
+	return result.value
+}
@@ -22,6 +22,11 @@ var (
 	ErrScanConfigNotSupported       = errors.New("scan config not supported")
 	ErrGetUsersNotSupported         = errors.New("get users not supported")
 	ErrVersionNotSupported          = errors.New("version not supported")
+	ErrUnsupportedHash              = errors.New("unsupported password hash")
+	// ErrPasswordHashUnavailable is returned by GetHashedPassword for users
+	// that have a password the scanner could not read, so they cannot be
+	// bruteforced.
+	ErrPasswordHashUnavailable = errors.New("password hash not available")
 )
 
 type Scanner interface {
@@ -41,6 +46,7 @@ type Scanner interface {
 type ScanResult interface {
 	Severity() Severity
 	Detail() string
+	Finding() Finding
 }
 
 type User interface {
@@ -51,3 +57,20 @@ type User interface {
 	IsPrivileged() (bool, error)
 	GetHashedPassword() (string, error)
 }
+
+// PasswordHash describes how the password of a user is stored.
+type PasswordHash struct {
+	// Algorithm is the name the database uses for the hash, for example
+	// "md5" or "SCRAM-SHA-256".
+	Algorithm string
+	// WorkFactor is the iteration count of the hash, or the cost for bcrypt.
+	// It is 0 for algorithms without one.
+	WorkFactor int
+}
+
+// PasswordHashUser is implemented by the users that can describe their
+// password hash, so that weak hashing can be reported without cracking it.
+type PasswordHashUser interface {
+	User
+	GetPasswordHash() (PasswordHash, error)
+}
@@ -0,0 +1,151 @@
+package tlsprobe
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+// Protocol selects how the connection is upgraded to TLS before the handshake.
+type Protocol int
+
+const (
+	// PROTOCOL_DIRECT starts the TLS handshake as soon as the connection is
+	// open, like Redis and MongoDB do.
+	PROTOCOL_DIRECT Protocol = iota
+	// PROTOCOL_POSTGRES sends an SSLRequest message first.
+	PROTOCOL_POSTGRES
+	// PROTOCOL_MYSQL reads the server greeting, checks the CLIENT_SSL
+	// capability flag and sends an SSL request packet.
+	PROTOCOL_MYSQL
+)
+
+var ErrTLSNotSupported = errors.New("server does not support TLS")
+
+const (
+	postgresSSLRequestCode = 80877103
+
+	mysqlClientProtocol41       = 0x00000200
+	mysqlClientSSL              = 0x00000800
+	mysqlClientSecureConnection = 0x00008000
+	mysqlMaxPacketSize          = 1 << 24
+	mysqlCharsetUTF8            = 33
+)
+
+// startTLS prepares an open connection for the TLS handshake.
+func startTLS(conn net.Conn, protocol Protocol) error {
+	switch protocol {
+	case PROTOCOL_DIRECT:
+		return nil
+	case PROTOCOL_POSTGRES:
+		return postgresStartTLS(conn)
+	case PROTOCOL_MYSQL:
+		return mysqlStartTLS(conn)
+	default:
+		return fmt.Errorf("unknown protocol %d", protocol)
+	}
+}
+
+func postgresStartTLS(conn net.Conn) error {
+	request := make([]byte, 8)
+	binary.BigEndian.PutUint32(request[0:4], 8)
+	binary.BigEndian.PutUint32(request[4:8], postgresSSLRequestCode)
+	if _, err := conn.Write(request); err != nil {
+		return fmt.Errorf("could not send SSLRequest: %w", err)
+	}
+
+	response := make([]byte, 1)
+	if _, err := io.ReadFull(conn, response); err != nil {
+		return fmt.Errorf("could not read SSLRequest response: %w", err)
+	}
+	switch response[0] {
+	case 'S':
+		return nil
+	case 'N':
+		return ErrTLSNotSupported
+	default:
+		return fmt.Errorf("unexpected SSLRequest response %q", response[0])
+	}
+}
+
+func readMysqlPacket(conn net.Conn) ([]byte, byte, error) {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return nil, 0, err
+	}
+	length := int(header[0]) | int(header[1])<<8 | int(header[2])<<16
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(conn, payload); err != nil {
+		return nil, 0, err
+	}
+	return payload, header[3], nil
+}
+
+// mysqlCapabilities extracts the capability flags from the initial handshake
+// packet (protocol version 10).
+func mysqlCapabilities(greeting []byte) (uint32, error) {
+	if len(greeting) == 0 || greeting[0] != 10 {
+		return 0, errors.New("unexpected handshake packet")
+	}
+	end := 1
+	for end < len(greeting) && greeting[end] != 0 {
+		end++
+	}
+	// server version, NUL, connection id, auth-plugin-data-part-1 and a filler byte
+	pos := end + 1 + 4 + 8 + 1
+	if len(greeting) < pos+2 {
+		return 0, errors.New("handshake packet is too short")
+	}
+	capabilities := uint32(binary.LittleEndian.Uint16(greeting[pos:]))
+	// The upper capability bytes come after the character set and status flags.
+	if len(greeting) >= pos+2+1+2+2 {
+		capabilities |= uint32(binary.LittleEndian.Uint16(greeting[pos+5:])) << 16
+	}
+	return capabilities, nil
+}
+
+func mysqlStartTLS(conn net.Conn) error {
+	greeting, sequence, err := readMysqlPacket(conn)
+	if err != nil {
+		return fmt.Errorf("could not read handshake packet: %w", err)
+	}
+	if len(greeting) > 0 && greeting[0] == 0xff {
+		return errors.New("server refused the connection")
+	}
+	capabilities, err := mysqlCapabilities(greeting)
+	if err != nil {
+		return err
+	}
+	if capabilities&mysqlClientSSL == 0 {
+		return ErrTLSNotSupported
+	}
+
+	packet := make([]byte, 4+32)
+	packet[0] = 32
+	packet[3] = sequence + 1
+	binary.LittleEndian.PutUint32(packet[4:], mysqlClientProtocol41|mysqlClientSSL|mysqlClientSecureConnection)
+	binary.LittleEndian.PutUint32(packet[8:], mysqlMaxPacketSize)
+	packet[12] = mysqlCharsetUTF8
+	if _, err := conn.Write(packet); err != nil {
+		return fmt.Errorf("could not send SSL request: %w", err)
+	}
+	return nil
+}
+
+func (p *prober) dial(ctx context.Context) (net.Conn, error) {
+	dialer := net.Dialer{Timeout: p.options.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", p.address)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to %s: %w", p.address, err)
+	}
+	conn.SetDeadline(time.Now().Add(p.options.timeout))
+	if err := startTLS(conn, p.protocol); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
@@ -0,0 +1,64 @@
+package tlsprobe
+
+import (
+	"crypto/x509"
+	"time"
+)
+
+type Option func(*options) error
+
+type options struct {
+	timeout         time.Duration
+	rootCAs         *x509.CertPool
+	serverName      string
+	expiryThreshold time.Duration
+	now             func() time.Time
+}
+
+// WithTimeout sets the timeout used for every connection made by the probe.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) error {
+		o.timeout = timeout
+		return nil
+	}
+}
+
+// WithRootCAs sets the certificate pool used to verify the server chain. The
+// system pool is used by default.
+func WithRootCAs(pool *x509.CertPool) Option {
+	return func(o *options) error {
+		o.rootCAs = pool
+		return nil
+	}
+}
+
+// WithServerName overrides the name that the certificate is checked against.
+// The host that is probed is used by default.
+func WithServerName(name string) Option {
+	return func(o *options) error {
+		o.serverName = name
+		return nil
+	}
+}
+
+// WithExpiryThreshold sets how soon before expiring a certificate is reported.
+func WithExpiryThreshold(threshold time.Duration) Option {
+	return func(o *options) error {
+		o.expiryThreshold = threshold
+		return nil
+	}
+}
+
+func makeOptions(opts ...Option) (*options, error) {
+	o := &options{
+		timeout:         5 * time.Second,
+		expiryThreshold: 30 * 24 * time.Hour,
+		now:             time.Now,
+	}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+	return o, nil
+}
@@ -0,0 +1,205 @@
+package tlsprobe
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// versions are tried from the newest to the oldest, so the certificate is
+// taken from the best handshake the server supports.
+var versions = []uint16{tls.VersionTLS13, tls.VersionTLS12, tls.VersionTLS11, tls.VersionTLS10}
+
+// Report is what the probe learned about the TLS configuration of a server.
+type Report struct {
+	Address      string
+	ServerName   string
+	TLSSupported bool
+
+	Versions         []uint16
+	WeakCipherSuites []uint16
+
+	Certificates []*x509.Certificate
+	NotAfter     time.Time
+	Expired      bool
+	ExpiresSoon  bool
+	SelfSigned   bool
+	ChainError   error
+	HostnameErr  error
+}
+
+type prober struct {
+	address  string
+	protocol Protocol
+	options  *options
+}
+
+func allCipherSuites() []uint16 {
+	var suites []uint16
+	for _, suite := range tls.CipherSuites() {
+		suites = append(suites, suite.ID)
+	}
+	for _, suite := range tls.InsecureCipherSuites() {
+		suites = append(suites, suite.ID)
+	}
+	return suites
+}
+
+func (p *prober) handshake(ctx context.Context, version uint16, suites []uint16) (*tls.ConnectionState, error) {
+	conn, err := p.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := tls.Client(conn, &tls.Config{
+		// The chain is verified separately, so that the problems can be told apart.
+		InsecureSkipVerify: true,
+		MinVersion:         version,
+		MaxVersion:         version,
+		CipherSuites:       suites,
+	})
+	if err := client.HandshakeContext(ctx); err != nil {
+		return nil, err
+	}
+	state := client.ConnectionState()
+	return &state, nil
+}
+
+// weakCipherSuites offers only insecure cipher suites and removes every suite
+// the server picks until the handshake fails.
+func (p *prober) weakCipherSuites(ctx context.Context, version uint16) []uint16 {
+	var offered []uint16
+	for _, suite := range tls.InsecureCipherSuites() {
+		if slices.Contains(suite.SupportedVersions, version) {
+			offered = append(offered, suite.ID)
+		}
+	}
+
+	var accepted []uint16
+	for len(offered) > 0 {
+		state, err := p.handshake(ctx, version, offered)
+		if err != nil {
+			break
+		}
+		accepted = append(accepted, state.CipherSuite)
+		offered = slices.DeleteFunc(offered, func(id uint16) bool { return id == state.CipherSuite })
+	}
+	return accepted
+}
+
+// Probe connects to the server multiple times to find the TLS versions and the
+// weak cipher suites it accepts, and checks the certificate it presents.
+// ErrTLSNotSupported is not returned as an error; the report has TLSSupported
+// set to false instead.
+func Probe(ctx context.Context, host string, port int32, protocol Protocol, opts ...Option) (*Report, error) {
+	o, err := makeOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &prober{
+		address:  net.JoinHostPort(host, strconv.Itoa(int(port))),
+		protocol: protocol,
+		options:  o,
+	}
+	report := &Report{
+		Address:    p.address,
+		ServerName: o.serverName,
+	}
+	if report.ServerName == "" {
+		report.ServerName = host
+	}
+
+	for _, version := range versions {
+		state, err := p.handshake(ctx, version, allCipherSuites())
+		if errors.Is(err, ErrTLSNotSupported) {
+			return report, nil
+		}
+		var netErr *net.OpError
+		if errors.As(err, &netErr) && netErr.Op == "dial" {
+			return nil, err
+		}
+		if err != nil {
+			continue
+		}
+
+		report.TLSSupported = true
+		report.Versions = append(report.Versions, version)
+		if report.Certificates == nil {
+			report.Certificates = state.PeerCertificates
+		}
+	}
+	slices.Sort(report.Versions)
+
+	for i := len(report.Versions) - 1; i >= 0; i-- {
+		if report.Versions[i] <= tls.VersionTLS12 {
+			report.WeakCipherSuites = p.weakCipherSuites(ctx, report.Versions[i])
+			break
+		}
+	}
+
+	if len(report.Certificates) > 0 {
+		report.checkCertificate(o)
+	}
+
+	return report, nil
+}
+
+func (report *Report) checkCertificate(o *options) {
+	leaf := report.Certificates[0]
+	now := o.now()
+
+	report.NotAfter = leaf.NotAfter
+	report.Expired = now.After(leaf.NotAfter)
+	report.ExpiresSoon = !report.Expired && now.Add(o.expiryThreshold).After(leaf.NotAfter)
+	report.HostnameErr = leaf.VerifyHostname(report.ServerName)
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range report.Certificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	// Expiry is reported on its own, so the chain is verified at a time the
+	// certificate is valid.
+	verifyTime := now
+	if report.Expired {
+		verifyTime = leaf.NotAfter.Add(-time.Second)
+	} else if now.Before(leaf.NotBefore) {
+		verifyTime = leaf.NotBefore.Add(time.Second)
+	}
+	_, report.ChainError = leaf.Verify(x509.VerifyOptions{
+		Roots:         o.rootCAs,
+		Intermediates: intermediates,
+		CurrentTime:   verifyTime,
+	})
+	if report.ChainError != nil {
+		report.SelfSigned = bytes.Equal(leaf.RawIssuer, leaf.RawSubject) && leaf.CheckSignatureFrom(leaf) == nil
+	}
+}
+
+func versionNames(versions []uint16) []string {
+	names := make([]string, len(versions))
+	for i, version := range versions {
+		names[i] = tls.VersionName(version)
+	}
+	return names
+}
+
+func cipherSuiteNames(suites []uint16) []string {
+	names := make([]string, len(suites))
+	for i, suite := range suites {
+		names[i] = tls.CipherSuiteName(suite)
+	}
+	return names
+}
+
+func (report *Report) String() string {
+	return fmt.Sprintf("TLS on %s", report.Address)
+}
@@ -0,0 +1,250 @@
+package tlsprobe
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"io"
+	"math/big"
+	"net"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testCertificate struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newCertificate(t *testing.T, name string, notAfter time.Time, parent *testCertificate) *testCertificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		DNSNames:              []string{name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  parent == nil,
+	}
+	signerCert, signerKey := template, key
+	if parent != nil {
+		signerCert, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signerCert, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCertificate{cert: cert, key: key}
+}
+
+func (c *testCertificate) tlsCertificate() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{c.cert.Raw}, PrivateKey: c.key, Leaf: c.cert}
+}
+
+// serve starts a listener that runs prelude on every connection and then
+// starts a TLS server if prelude returns true.
+func serve(t *testing.T, config *tls.Config, prelude func(net.Conn) bool) (string, int32) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				if prelude != nil && !prelude(conn) {
+					return
+				}
+				server := tls.Server(conn, config)
+				if err := server.Handshake(); err != nil {
+					return
+				}
+				server.Close()
+			}()
+		}
+	}()
+
+	address := listener.Addr().(*net.TCPAddr)
+	return address.IP.String(), int32(address.Port)
+}
+
+func postgresPrelude(supported bool) func(net.Conn) bool {
+	return func(conn net.Conn) bool {
+		request := make([]byte, 8)
+		if _, err := io.ReadFull(conn, request); err != nil {
+			return false
+		}
+		if binary.BigEndian.Uint32(request[4:]) != postgresSSLRequestCode {
+			return false
+		}
+		if !supported {
+			conn.Write([]byte{'N'})
+			return false
+		}
+		conn.Write([]byte{'S'})
+		return true
+	}
+}
+
+func mysqlPrelude(capabilities uint16) func(net.Conn) bool {
+	return func(conn net.Conn) bool {
+		payload := []byte{10}
+		payload = append(payload, "8.0.36\x00"...)
+		payload = append(payload, 1, 0, 0, 0)
+		payload = append(payload, "abcdefgh\x00"...)
+		payload = binary.LittleEndian.AppendUint16(payload, capabilities)
+		payload = append(payload, mysqlCharsetUTF8, 2, 0, 0, 0)
+		packet := []byte{byte(len(payload)), 0, 0, 0}
+		conn.Write(append(packet, payload...))
+
+		if capabilities&mysqlClientSSL == 0 {
+			return false
+		}
+		request := make([]byte, 36)
+		if _, err := io.ReadFull(conn, request); err != nil {
+			return false
+		}
+		return binary.LittleEndian.Uint32(request[4:])&mysqlClientSSL != 0
+	}
+}
+
+func messages(report *Report) []string {
+	var result []string
+	for _, r := range report.Results() {
+		message := r.Finding().Title
+		result = append(result, message[strings.Index(message, ": ")+2:])
+	}
+	return result
+}
+
+func TestProbe(t *testing.T) {
+	ca := newCertificate(t, "Test CA", time.Now().Add(365*24*time.Hour), nil)
+	valid := newCertificate(t, "db.example.com", time.Now().Add(365*24*time.Hour), ca)
+	expiring := newCertificate(t, "db.example.com", time.Now().Add(24*time.Hour), ca)
+	selfSigned := newCertificate(t, "other.example.com", time.Now().Add(-time.Minute), nil)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+
+	tests := []struct {
+		name         string
+		protocol     Protocol
+		prelude      func(net.Conn) bool
+		config       *tls.Config
+		want         []string
+		wantVersions []uint16
+	}{
+		{
+			name:         "direct with a trusted certificate",
+			protocol:     PROTOCOL_DIRECT,
+			config:       &tls.Config{Certificates: []tls.Certificate{valid.tlsCertificate()}},
+			want:         []string{"accepted versions TLS 1.2, TLS 1.3."},
+			wantVersions: []uint16{tls.VersionTLS12, tls.VersionTLS13},
+		},
+		{
+			name:     "direct with old versions and a bad certificate",
+			protocol: PROTOCOL_DIRECT,
+			config:   &tls.Config{Certificates: []tls.Certificate{selfSigned.tlsCertificate()}, MinVersion: tls.VersionTLS10, MaxVersion: tls.VersionTLS12},
+			want: []string{
+				"accepted versions TLS 1.0, TLS 1.1, TLS 1.2.",
+				"deprecated TLS versions are accepted.",
+				"the certificate has expired.",
+				"the certificate does not match the hostname.",
+				"the certificate is self-signed.",
+			},
+			wantVersions: []uint16{tls.VersionTLS10, tls.VersionTLS11, tls.VersionTLS12},
+		},
+		{
+			name:     "postgres with a certificate that expires soon",
+			protocol: PROTOCOL_POSTGRES,
+			prelude:  postgresPrelude(true),
+			config:   &tls.Config{Certificates: []tls.Certificate{expiring.tlsCertificate()}, MinVersion: tls.VersionTLS13},
+			want: []string{
+				"accepted versions TLS 1.3.",
+				"the certificate expires soon.",
+			},
+			wantVersions: []uint16{tls.VersionTLS13},
+		},
+		{
+			name:     "postgres without TLS",
+			protocol: PROTOCOL_POSTGRES,
+			prelude:  postgresPrelude(false),
+			want:     []string{"TLS is not supported."},
+		},
+		{
+			name:     "mysql with weak cipher suites",
+			protocol: PROTOCOL_MYSQL,
+			prelude:  mysqlPrelude(mysqlClientProtocol41 | mysqlClientSSL | mysqlClientSecureConnection),
+			config: &tls.Config{
+				Certificates: []tls.Certificate{valid.tlsCertificate()},
+				MaxVersion:   tls.VersionTLS12,
+				CipherSuites: []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256, tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256},
+			},
+			want: []string{
+				"accepted versions TLS 1.2.",
+				"weak cipher suites are accepted.",
+			},
+			wantVersions: []uint16{tls.VersionTLS12},
+		},
+		{
+			name:     "mysql without TLS",
+			protocol: PROTOCOL_MYSQL,
+			prelude:  mysqlPrelude(mysqlClientProtocol41 | mysqlClientSecureConnection),
+			want:     []string{"TLS is not supported."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, port := serve(t, tt.config, tt.prelude)
+			report, err := Probe(context.Background(), host, port, tt.protocol,
+				WithRootCAs(roots), WithServerName("db.example.com"), WithTimeout(2*time.Second))
+			if err != nil {
+				t.Fatalf("Probe() error = %v", err)
+			}
+			if !slices.Equal(report.Versions, tt.wantVersions) {
+				t.Errorf("Probe() versions = %v, want %v", report.Versions, tt.wantVersions)
+			}
+			if got := messages(report); !slices.Equal(got, tt.want) {
+				t.Errorf("Results() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProbeConnectionRefused(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := listener.Addr().(*net.TCPAddr)
+	listener.Close()
+
+	if _, err := Probe(context.Background(), address.IP.String(), int32(address.Port), PROTOCOL_DIRECT); err == nil {
+		t.Error("Probe() error = nil, want a connection error")
+	}
+}
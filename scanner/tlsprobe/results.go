@@ -0,0 +1,77 @@
+package tlsprobe
+
+import (
+	"crypto/tls"
+	"fmt"
+	"strings"
+
+	"github.com/tedyst/licenta/scanner"
+)
+
+// Results converts the report into scan results.
+func (report *Report) Results() []scanner.ScanResult {
+	results := []scanner.ScanResult{}
+	add := func(ruleID string, severity scanner.Severity, message string, detail string, remediation string) {
+		results = append(results, scanner.NewResult(severity, scanner.Finding{
+			RuleID:         ruleID,
+			Title:          fmt.Sprintf("%s: %s", report, message),
+			Description:    fmt.Sprintf("%s: %s", report, detail),
+			Remediation:    remediation,
+			AffectedObject: scanner.AffectedObject{Type: scanner.OBJECT_SERVER, Name: report.String()},
+		}))
+	}
+
+	if !report.TLSSupported {
+		add("tls-not-supported", scanner.SEVERITY_HIGH, "TLS is not supported.",
+			"the server does not support TLS, so all connections, including passwords, are sent in clear text.", "Enable TLS on the server.")
+		return results
+	}
+
+	add("tls-versions", scanner.SEVERITY_INFORMATIONAL, "accepted versions "+strings.Join(versionNames(report.Versions), ", ")+".",
+		"the server accepts "+strings.Join(versionNames(report.Versions), ", ")+".", "")
+
+	var deprecated []uint16
+	for _, version := range report.Versions {
+		if version < tls.VersionTLS12 {
+			deprecated = append(deprecated, version)
+		}
+	}
+	if len(deprecated) > 0 {
+		add("tls-deprecated-version", scanner.SEVERITY_MEDIUM, "deprecated TLS versions are accepted.",
+			"the server accepts "+strings.Join(versionNames(deprecated), ", ")+", which are deprecated.", "Require at least TLS 1.2.")
+	}
+
+	if len(report.WeakCipherSuites) > 0 {
+		add("tls-weak-cipher-suite", scanner.SEVERITY_MEDIUM, "weak cipher suites are accepted.",
+			"the server accepts the weak cipher suites "+strings.Join(cipherSuiteNames(report.WeakCipherSuites), ", ")+".", "Disable the weak cipher suites.")
+	}
+
+	if len(report.Certificates) == 0 {
+		return results
+	}
+
+	switch {
+	case report.Expired:
+		add("tls-certificate-expired", scanner.SEVERITY_HIGH, "the certificate has expired.",
+			fmt.Sprintf("the certificate expired on %s.", report.NotAfter.Format("2006-01-02")), "Renew the certificate.")
+	case report.ExpiresSoon:
+		add("tls-certificate-expires-soon", scanner.SEVERITY_WARNING, "the certificate expires soon.",
+			fmt.Sprintf("the certificate expires on %s.", report.NotAfter.Format("2006-01-02")), "Renew it before then.")
+	}
+
+	if report.HostnameErr != nil {
+		add("tls-hostname-mismatch", scanner.SEVERITY_MEDIUM, "the certificate does not match the hostname.",
+			fmt.Sprintf("the certificate is not valid for %s: %s.", report.ServerName, report.HostnameErr), "Use a certificate issued for the hostname of the server.")
+	}
+
+	switch {
+	case report.SelfSigned:
+		add("tls-self-signed", scanner.SEVERITY_MEDIUM, "the certificate is self-signed.",
+			"the certificate is self-signed, so clients can not verify the identity of the server.", "Use a certificate signed by a trusted certificate authority.")
+	case report.ChainError != nil:
+		add("tls-untrusted-chain", scanner.SEVERITY_MEDIUM, "the certificate chain is not trusted.",
+			fmt.Sprintf("the certificate chain could not be verified: %s.", report.ChainError), "Send the full certificate chain from the server.")
+	}
+
+	return results
+}
@@ -56,6 +56,30 @@ func (s *scheduler) Run(ctx context.Context) error {
 		return fmt.Errorf("could not update nvd vulnerabilities for product: %w", err)
 	}
 
+	slog.InfoContext(ctx, "Updating NVD vulnerabilities for ELASTICSEARCH")
+	err = s.tasksRunner.UpdateNVDVulnerabilitiesForProduct(ctx, nvd.ELASTICSEARCH)
+	if err != nil {
+		return fmt.Errorf("could not update nvd vulnerabilities for product: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Updating NVD vulnerabilities for OPENSEARCH")
+	err = s.tasksRunner.UpdateNVDVulnerabilitiesForProduct(ctx, nvd.OPENSEARCH)
+	if err != nil {
+		return fmt.Errorf("could not update nvd vulnerabilities for product: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Updating NVD vulnerabilities for MSSQL")
+	err = s.tasksRunner.UpdateNVDVulnerabilitiesForProduct(ctx, nvd.MSSQL)
+	if err != nil {
+		return fmt.Errorf("could not update nvd vulnerabilities for product: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Updating NVD vulnerabilities for ETCD")
+	err = s.tasksRunner.UpdateNVDVulnerabilitiesForProduct(ctx, nvd.ETCD)
+	if err != nil {
+		return fmt.Errorf("could not update nvd vulnerabilities for product: %w", err)
+	}
+
 	projects, err := s.queries.GetProjects(ctx)
 	if err != nil {
 		return fmt.Errorf("could not get projects: %w", err)
@@ -0,0 +1,63 @@
+package local
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/spf13/viper"
+	"github.com/tedyst/licenta/db"
+	"github.com/tedyst/licenta/db/queries"
+	"github.com/tedyst/licenta/extractors/file"
+)
+
+type DetectorSetQuerier interface {
+	GetDetectorSet(ctx context.Context, id int64) (*queries.DetectorSet, error)
+	GetDetectorSetForProject(ctx context.Context, projectID int64) (*queries.DetectorSet, error)
+}
+
+// loadDetectorSets returns the file scanner options that select the secret
+// detectors of a scan. The detector sets configured on disk are loaded first,
+// then the set stored for the image, if detectorSetID is valid, or else the
+// set selected for the project. The stored set decides whether the builtin
+// detectors are kept.
+func loadDetectorSets(ctx context.Context, q any, projectID int64, detectorSetID sql.NullInt64) ([]file.Option, error) {
+	useDefault := true
+	sets := []*file.DetectorSet{}
+	for _, path := range viper.GetStringSlice("detector-sets") {
+		set, err := file.LoadDetectorSet(path)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, set)
+	}
+
+	if dq, ok := q.(DetectorSetQuerier); ok {
+		var stored *queries.DetectorSet
+		var err error
+		if detectorSetID.Valid {
+			stored, err = dq.GetDetectorSet(ctx, detectorSetID.Int64)
+		} else {
+			stored, err = dq.GetDetectorSetForProject(ctx, projectID)
+		}
+		if err != nil && err != pgx.ErrNoRows {
+			return nil, fmt.Errorf("could not get detector set: %w", err)
+		}
+		if err == nil {
+			set, err := file.ParseDetectorSetFormat(stored.Format, []byte(stored.Content))
+			if err != nil {
+				return nil, fmt.Errorf("could not load detector set %s: %w", stored.Name, err)
+			}
+			sets = append(sets, set)
+			useDefault = stored.UseDefault
+		}
+	}
+
+	if len(sets) == 0 {
+		return nil, nil
+	}
+	return []file.Option{file.WithDetectorSets(useDefault, sets...)}, nil
+}
+
+var _ DetectorSetQuerier = (db.TransactionQuerier)(nil)
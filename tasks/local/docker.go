@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/tedyst/licenta/db/queries"
 	"github.com/tedyst/licenta/extractors/docker"
 	"github.com/tedyst/licenta/extractors/file"
@@ -79,6 +80,10 @@ func (r *DockerRunner) ScanDockerRepository(ctx context.Context, image *queries.
 				Name:          fileResult.Name,
 				Line:          fileResult.Line,
 				LineNumber:    int32(fileResult.LineNumber),
+				EndLineNumber: int32(fileResult.EndLineNumber),
+				Provider:      fileResult.Provider,
+				Confidence:    string(fileResult.Confidence),
+				ExpiresAt:     pgtype.Timestamptz{Time: fileResult.ExpiresAt, Valid: !fileResult.ExpiresAt.IsZero()},
 				Match:         fileResult.Match,
 				Probability:   fileResult.Probability,
 				Username:      sql.NullString{String: fileResult.Username, Valid: fileResult.Username != ""},
@@ -126,7 +131,10 @@ func (r *DockerRunner) ScanDockerRepository(ctx context.Context, image *queries.
 		options = append(options, docker.WithProbability(image.MinProbability.Float64))
 	}
 
-	fileOptions := []file.Option{}
+	fileOptions, err := loadDetectorSets(ctx, r.queries, image.ProjectID, image.DetectorSetID)
+	if err != nil {
+		return fmt.Errorf("ScanDockerRepository: cannot load detector sets: %w", err)
+	}
 
 	if image.ProbabilityIncreaseMultiplier.Valid {
 		fileOptions = append(fileOptions, file.WithProbabilityIncreaseMultiplier(image.ProbabilityIncreaseMultiplier.Float64))
@@ -125,15 +125,19 @@ func (r *GitRunner) ScanGitRepository(ctx context.Context, repo *queries.GitRepo
 		results := []queries.CreateGitResultForCommitParams{}
 		for _, item := range result.Results {
 			results = append(results, queries.CreateGitResultForCommitParams{
-				Commit:      commit.ID,
-				Name:        item.Name,
-				Line:        item.Line,
-				LineNumber:  int32(item.LineNumber),
-				Match:       item.Match,
-				Probability: item.Probability,
-				Username:    sql.NullString{String: item.Username, Valid: true},
-				Password:    sql.NullString{String: item.Password, Valid: true},
-				Filename:    item.FileName,
+				Commit:        commit.ID,
+				Name:          item.Name,
+				Line:          item.Line,
+				LineNumber:    int32(item.LineNumber),
+				EndLineNumber: int32(item.EndLineNumber),
+				Provider:      item.Provider,
+				Confidence:    string(item.Confidence),
+				ExpiresAt:     pgtype.Timestamptz{Time: item.ExpiresAt, Valid: !item.ExpiresAt.IsZero()},
+				Match:         item.Match,
+				Probability:   item.Probability,
+				Username:      sql.NullString{String: item.Username, Valid: true},
+				Password:      sql.NullString{String: item.Password, Valid: true},
+				Filename:      item.FileName,
 			})
 		}
 		_, err := r.queries.CreateGitResultForCommit(ctx, results)
@@ -155,7 +159,12 @@ func (r *GitRunner) ScanGitRepository(ctx context.Context, repo *queries.GitRepo
 		return nil
 	}))
 
-	fileScanner, err := r.FileScannerProvider()
+	fileOptions, err := loadDetectorSets(ctx, r.queries, repo.ProjectID, sql.NullInt64{})
+	if err != nil {
+		return err
+	}
+
+	fileScanner, err := r.FileScannerProvider(fileOptions...)
 	if err != nil {
 		return err
 	}
@@ -229,11 +229,12 @@ func (source *localRunner) ScheduleSourceRun(ctx context.Context, project *queri
 
 			slog.DebugContext(ctx, "Scheduling docker scan", "image", image.ID, "name", image.DockerImage)
 			if err := source.ScanDockerRepository(ctx, &queries.DockerImage{
-				ID:          image.ID,
-				ProjectID:   image.ProjectID,
-				DockerImage: image.DockerImage,
-				Username:    image.Username,
-				Password:    image.Password,
+				ID:            image.ID,
+				ProjectID:     image.ProjectID,
+				DockerImage:   image.DockerImage,
+				Username:      image.Username,
+				Password:      image.Password,
+				DetectorSetID: image.DetectorSetID,
 			}, &scan.Scan); err != nil {
 				return fmt.Errorf("failed to scan docker repository: %w", err)
 			}
@@ -24,6 +24,13 @@ type NvdQuerier interface {
 	CreateNvdCve(ctx context.Context, params queries.CreateNvdCveParams) (*queries.NvdCfe, error)
 	GetCveCpeByCveAndCpe(ctx context.Context, params queries.GetCveCpeByCveAndCpeParams) (*queries.NvdCveCpe, error)
 	CreateNvdCveCPE(ctx context.Context, params queries.CreateNvdCveCPEParams) (*queries.NvdCveCpe, error)
+
+	NvdRangeQuerier
+}
+
+type NvdRangeQuerier interface {
+	CreateNvdCveRange(ctx context.Context, params queries.CreateNvdCveRangeParams) (*queries.NvdCveRange, error)
+	DeleteNvdCveRangesForCve(ctx context.Context, params queries.DeleteNvdCveRangesForCveParams) error
 }
 
 type NvdRunner struct {
@@ -43,7 +50,7 @@ func (r *NvdRunner) importCpesInDB(ctx context.Context, product nvd.Product, dat
 	for _, result := range result.Products {
 		var cpe *queries.NvdCpe
 
-		version, err := nvd.ExtractCpeVersionProduct(product, result.Cpe.Titles)
+		version, err := nvd.ExtractCpeVersionProduct(product, result.Cpe)
 		if err != nil {
 			continue
 		}
@@ -133,6 +140,7 @@ func (r *NvdRunner) importCVEsInDB(ctx context.Context, product nvd.Product, dat
 				Published:    pgtype.Timestamptz{Time: publishedDate, Valid: true},
 				LastModified: pgtype.Timestamptz{Time: lastModified, Valid: true},
 				Score:        score,
+				Vector:       result.Cve.Vector(),
 			})
 			if err != nil {
 				return fmt.Errorf("failed to create cve: %w", err)
@@ -141,6 +149,10 @@ func (r *NvdRunner) importCVEsInDB(ctx context.Context, product nvd.Product, dat
 			slog.DebugContext(ctx, "Created CVE", slog.Int("product", int(product)), slog.String("cpe", cpe.Cpe), slog.String("cve", result.Cve.ID))
 		}
 
+		if err := ImportVersionRanges(ctx, database, product, cve.ID, result.Cve); err != nil {
+			return err
+		}
+
 		_, err = database.GetCveCpeByCveAndCpe(ctx, queries.GetCveCpeByCveAndCpeParams{
 			CveID: cve.ID,
 			CpeID: cpe.ID,
@@ -162,6 +174,39 @@ func (r *NvdRunner) importCVEsInDB(ctx context.Context, product nvd.Product, dat
 	return nil
 }
 
+// ImportVersionRanges replaces the stored version ranges of the CVE for the
+// product with the ones from its NVD configurations.
+func ImportVersionRanges(ctx context.Context, database NvdRangeQuerier, product nvd.Product, cveID int64, cve nvd.NvdCveCve) error {
+	ranges, err := nvd.ExtractVersionRanges(product, cve)
+	if err != nil {
+		return fmt.Errorf("failed to extract version ranges: %w", err)
+	}
+
+	if err := database.DeleteNvdCveRangesForCve(ctx, queries.DeleteNvdCveRangesForCveParams{
+		CveID:        cveID,
+		DatabaseType: int32(product),
+	}); err != nil {
+		return fmt.Errorf("failed to delete version ranges: %w", err)
+	}
+
+	for _, r := range ranges {
+		if _, err := database.CreateNvdCveRange(ctx, queries.CreateNvdCveRangeParams{
+			CveID:                 cveID,
+			DatabaseType:          int32(product),
+			Criteria:              r.Criteria,
+			VersionExact:          sql.NullString{String: r.Exact, Valid: r.Exact != ""},
+			VersionStartIncluding: sql.NullString{String: r.StartIncluding, Valid: r.StartIncluding != ""},
+			VersionStartExcluding: sql.NullString{String: r.StartExcluding, Valid: r.StartExcluding != ""},
+			VersionEndIncluding:   sql.NullString{String: r.EndIncluding, Valid: r.EndIncluding != ""},
+			VersionEndExcluding:   sql.NullString{String: r.EndExcluding, Valid: r.EndExcluding != ""},
+		}); err != nil {
+			return fmt.Errorf("failed to create version range: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (r *NvdRunner) updateCVEsForSpecificCPE(ctx context.Context, database NvdQuerier, product nvd.Product, cpe *queries.NvdCpe) (err error) {
 	ctx, span := tracer.Start(ctx, "updateCVEsForSpecificCPE")
 	defer span.End()
@@ -77,11 +77,12 @@ func (gs *dockerScannerTaskRunner) Run(ctx context.Context, wg *sync.WaitGroup)
 			}
 
 			err = gs.localRunner.ScanDockerRepository(ctx, &queries.DockerImage{
-				ID:          repo.ID,
-				ProjectID:   repo.ProjectID,
-				DockerImage: repo.DockerImage,
-				Username:    repo.Username,
-				Password:    repo.Password,
+				ID:            repo.ID,
+				ProjectID:     repo.ProjectID,
+				DockerImage:   repo.DockerImage,
+				Username:      repo.Username,
+				Password:      repo.Password,
+				DetectorSetID: repo.DetectorSetID,
 			}, &scan.Scan)
 			if err != nil {
 				return nil
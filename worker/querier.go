@@ -13,6 +13,8 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/tedyst/licenta/api/v1/generated"
+	"github.com/tedyst/licenta/breach"
+	"github.com/tedyst/licenta/bruteforce"
 	"github.com/tedyst/licenta/db/queries"
 	"github.com/tedyst/licenta/nvd"
 	"github.com/tedyst/licenta/saver"
@@ -26,8 +28,9 @@ type remoteQuerier struct {
 
 func (q *remoteQuerier) UpdateBruteforcedPassword(ctx context.Context, arg queries.UpdateBruteforcedPasswordParams) (*queries.BruteforcedPassword, error) {
 	response, err := q.client.PatchBruteforcedPasswordsIdWithResponse(ctx, arg.ID, generated.PatchBruteforcedPasswordsIdJSONRequestBody{
-		LastBruteforceId: int(arg.LastBruteforceID.Int64),
-		Password:         arg.Password.String,
+		LastBruteforceId:          int(arg.LastBruteforceID.Int64),
+		LastBruteforceFingerprint: arg.LastBruteforceFingerprint,
+		Password:                  arg.Password.String,
 	})
 	if err != nil {
 		return nil, err
@@ -48,6 +51,7 @@ func (q *remoteQuerier) UpdateBruteforcedPassword(ctx context.Context, arg queri
 				Int64: int64(response.JSON200.BruteforcedPassword.LastBruteforceId),
 				Valid: response.JSON200.BruteforcedPassword.LastBruteforceId != 0,
 			},
+			LastBruteforceFingerprint: response.JSON200.BruteforcedPassword.LastBruteforceFingerprint,
 			ProjectID: sql.NullInt64{
 				Int64: int64(response.JSON200.BruteforcedPassword.ProjectId),
 				Valid: response.JSON200.BruteforcedPassword.ProjectId != 0,
@@ -123,6 +127,84 @@ func (q *remoteQuerier) CreateScanResult(ctx context.Context, params queries.Cre
 	}
 }
 
+func (q *remoteQuerier) CreateScanCveResult(ctx context.Context, params queries.CreateScanCveResultParams) (*queries.ScanCveResult, error) {
+	slog.InfoContext(ctx, "Creating cve result", "params", params, "endpoint", "CreateScanCveResult")
+
+	response, err := q.client.PostScanIdCveResultWithResponse(ctx, params.ScanID, generated.CreateScanCveResult{
+		Severity:    int(params.Severity),
+		Message:     params.Message,
+		ScanSource:  int(params.ScanSource),
+		CveId:       params.CveID,
+		Score:       float32(params.Score),
+		Vector:      params.Vector,
+		Description: params.Description,
+		PublishedAt: params.Published.Time.Format(time.RFC3339Nano),
+		FixedIn:     params.FixedIn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "Got response from server", "response", string(response.Body), "endpoint", "CreateScanCveResult")
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return &queries.ScanCveResult{
+			ScanResultID: int64(response.JSON200.Scan.Id),
+			CveID:        params.CveID,
+			Score:        params.Score,
+			Vector:       params.Vector,
+			Description:  params.Description,
+			Published:    params.Published,
+			FixedIn:      params.FixedIn,
+		}, nil
+	default:
+		return nil, errors.New("error creating cve result")
+	}
+}
+
+func (q *remoteQuerier) CreateScanFindingResult(ctx context.Context, params queries.CreateScanFindingResultParams) (*queries.ScanResultFinding, error) {
+	slog.InfoContext(ctx, "Creating finding result", "params", params, "endpoint", "CreateScanFindingResult")
+
+	response, err := q.client.PostScanIdFindingResultWithResponse(ctx, params.ScanID, generated.CreateScanFindingResult{
+		Severity:   int(params.Severity),
+		Message:    params.Message,
+		ScanSource: int(params.ScanSource),
+		Finding: generated.Finding{
+			RuleId:      params.RuleID,
+			Title:       params.Title,
+			Description: params.Description,
+			Remediation: params.Remediation,
+			References:  params.ReferenceUrls,
+			ObjectType:  params.ObjectType,
+			ObjectName:  params.ObjectName,
+			Evidence:    params.Evidence,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "Got response from server", "response", string(response.Body), "endpoint", "CreateScanFindingResult")
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return &queries.ScanResultFinding{
+			ScanResultID:  int64(response.JSON200.Scan.Id),
+			RuleID:        params.RuleID,
+			Title:         params.Title,
+			Description:   params.Description,
+			Remediation:   params.Remediation,
+			ReferenceUrls: params.ReferenceUrls,
+			ObjectType:    params.ObjectType,
+			ObjectName:    params.ObjectName,
+			Evidence:      params.Evidence,
+		}, nil
+	default:
+		return nil, errors.New("error creating finding result")
+	}
+}
+
 func (q *remoteQuerier) CreateScanBruteforceResult(ctx context.Context, arg queries.CreateScanBruteforceResultParams) (*queries.ScanBruteforceResult, error) {
 	slog.InfoContext(ctx, "Creating bruteforce result", "params", arg, "endpoint", "CreateScanBruteforceResult")
 
@@ -202,7 +284,10 @@ func (q *remoteQuerier) GetCvesByProductAndVersion(ctx context.Context, arg quer
 						Time:  time.Now(),
 						Valid: true,
 					},
+					Score:  cve.Score,
+					Vector: cve.Vector,
 				},
+				FixedIn: cve.FixedIn,
 			})
 		}
 		return result, nil
@@ -238,10 +323,11 @@ func (q *remoteQuerier) GetWorkersForProject(ctx context.Context, projectID int6
 
 func (q *remoteQuerier) CreateBruteforcedPassword(ctx context.Context, arg queries.CreateBruteforcedPasswordParams) (*queries.BruteforcedPassword, error) {
 	response, err := q.client.PostProjectsIdBruteforcedPasswordWithResponse(ctx, q.scanGroup.ProjectID, generated.CreateBruteforcedPassword{
-		Password:         arg.Password.String,
-		Hash:             arg.Hash,
-		LastBruteforceId: int(arg.LastBruteforceID.Int64),
-		Username:         arg.Username,
+		Password:                  arg.Password.String,
+		Hash:                      arg.Hash,
+		LastBruteforceId:          int(arg.LastBruteforceID.Int64),
+		LastBruteforceFingerprint: arg.LastBruteforceFingerprint,
+		Username:                  arg.Username,
 	})
 	if err != nil {
 		return nil, err
@@ -262,6 +348,7 @@ func (q *remoteQuerier) CreateBruteforcedPassword(ctx context.Context, arg queri
 				Int64: int64(response.JSON200.BruteforcedPassword.LastBruteforceId),
 				Valid: response.JSON200.BruteforcedPassword.LastBruteforceId != 0,
 			},
+			LastBruteforceFingerprint: response.JSON200.BruteforcedPassword.LastBruteforceFingerprint,
 			ProjectID: sql.NullInt64{
 				Int64: int64(response.JSON200.BruteforcedPassword.ProjectId),
 				Valid: response.JSON200.BruteforcedPassword.ProjectId != 0,
@@ -337,6 +424,7 @@ func (q *remoteQuerier) GetBruteforcedPasswords(ctx context.Context, arg queries
 				Int64: int64(response.JSON200.BruteforcedPassword.LastBruteforceId),
 				Valid: response.JSON200.BruteforcedPassword.LastBruteforceId != 0,
 			},
+			LastBruteforceFingerprint: response.JSON200.BruteforcedPassword.LastBruteforceFingerprint,
 			ProjectID: sql.NullInt64{
 				Int64: int64(response.JSON200.BruteforcedPassword.ProjectId),
 				Valid: response.JSON200.BruteforcedPassword.ProjectId != 0,
@@ -369,3 +457,267 @@ func (q *remoteQuerier) GetSpecificBruteforcePasswordID(ctx context.Context, arg
 		return 0, errors.New("error getting bruteforced password")
 	}
 }
+
+func (q *remoteQuerier) GetRulePacksForProject(ctx context.Context, id int64) ([]*queries.RulePack, error) {
+	response, err := q.client.GetProjectsIdRulePacksWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "Got response from server", "response", string(response.Body), "endpoint", "GetRulePacksForProject")
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		result := make([]*queries.RulePack, len(response.JSON200.RulePacks))
+		for i, pack := range response.JSON200.RulePacks {
+			result[i] = &queries.RulePack{
+				ID:             pack.Id,
+				OrganizationID: pack.OrganizationId,
+				Name:           pack.Name,
+				Content:        pack.Content,
+				Enabled:        pack.Enabled,
+			}
+		}
+		return result, nil
+	default:
+		return nil, errors.New("error getting rule packs")
+	}
+}
+
+var _ saver.RulePackQuerier = (*remoteQuerier)(nil)
+
+func (q *remoteQuerier) GetProjectIgnoredCves(ctx context.Context, projectID int64) ([]*queries.ProjectIgnoredCfe, error) {
+	response, err := q.client.GetProjectsIdIgnoredCvesWithResponse(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "Got response from server", "response", string(response.Body), "endpoint", "GetProjectIgnoredCves")
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		result := make([]*queries.ProjectIgnoredCfe, len(response.JSON200.IgnoredCves))
+		for i, cve := range response.JSON200.IgnoredCves {
+			result[i] = &queries.ProjectIgnoredCfe{
+				ID:        cve.Id,
+				ProjectID: cve.ProjectId,
+				CveID:     cve.CveId,
+				Reason:    cve.Reason,
+			}
+		}
+		return result, nil
+	default:
+		return nil, errors.New("error getting ignored cves")
+	}
+}
+
+func (q *remoteQuerier) GetProjectPasswordRules(ctx context.Context, projectID int64) (*queries.ProjectPasswordRule, error) {
+	response, err := q.client.GetProjectsIdPasswordRulesWithResponse(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "Got response from server", "response", string(response.Body), "endpoint", "GetProjectPasswordRules")
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return &queries.ProjectPasswordRule{
+			ProjectID:  response.JSON200.PasswordRules.ProjectId,
+			UseDefault: response.JSON200.PasswordRules.UseDefault,
+			Rules:      response.JSON200.PasswordRules.Rules,
+		}, nil
+	case http.StatusNotFound:
+		return nil, pgx.ErrNoRows
+	default:
+		return nil, errors.New("error getting password rules")
+	}
+}
+
+var _ bruteforce.PasswordRulesQuerier = (*remoteQuerier)(nil)
+
+func (q *remoteQuerier) GetProjectPasswordCandidates(ctx context.Context, projectID int64) ([]*queries.GetProjectPasswordCandidatesRow, error) {
+	response, err := q.client.GetProjectsIdPasswordCandidatesWithResponse(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "Got response from server", "endpoint", "GetProjectPasswordCandidates", "status", response.StatusCode())
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		result := make([]*queries.GetProjectPasswordCandidatesRow, len(response.JSON200.Candidates))
+		for i, candidate := range response.JSON200.Candidates {
+			result[i] = &queries.GetProjectPasswordCandidatesRow{
+				Candidate: candidate.Candidate,
+				Source:    string(candidate.Source),
+				Reference: candidate.Reference,
+				Location:  candidate.Location,
+				Priority:  candidate.Priority,
+			}
+		}
+		return result, nil
+	default:
+		return nil, errors.New("error getting password candidates")
+	}
+}
+
+var _ bruteforce.PasswordCandidatesQuerier = (*remoteQuerier)(nil)
+
+func (q *remoteQuerier) GetBreachedPasswordRange(ctx context.Context, prefix string) ([]breach.RangeEntry, error) {
+	response, err := q.client.GetBreachedPasswordsPrefixWithResponse(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "Got response from server", "endpoint", "GetBreachedPasswordRange", "status", response.StatusCode())
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		result := make([]breach.RangeEntry, len(response.JSON200.Entries))
+		for i, entry := range response.JSON200.Entries {
+			result[i] = breach.RangeEntry{
+				Suffix: entry.Suffix,
+				Count:  entry.Count,
+			}
+		}
+		return result, nil
+	case http.StatusNotFound:
+		return nil, pgx.ErrNoRows
+	default:
+		return nil, errors.New("error getting breached passwords")
+	}
+}
+
+var _ breach.RangeQuerier = (*remoteQuerier)(nil)
+
+func bruteforceShardFromAPI(shard generated.BruteforceShard) *queries.ScanBruteforceShard {
+	result := &queries.ScanBruteforceShard{
+		ID:       shard.Id,
+		ScanID:   shard.ScanId,
+		ScanType: int32(shard.ScanType),
+		Username: shard.Username,
+		Hash:     shard.Hash,
+		StartID:  shard.StartId,
+		NextID:   shard.NextId,
+		Tried:    shard.Tried,
+		Status:   int32(shard.Status),
+	}
+	if shard.EndId != nil {
+		result.EndID = sql.NullInt64{Int64: *shard.EndId, Valid: true}
+	}
+	if shard.Password != nil {
+		result.Password = sql.NullString{String: *shard.Password, Valid: true}
+	}
+	return result
+}
+
+func bruteforceShardsFromAPI(shards []generated.BruteforceShard) []*queries.ScanBruteforceShard {
+	result := make([]*queries.ScanBruteforceShard, len(shards))
+	for i, shard := range shards {
+		result[i] = bruteforceShardFromAPI(shard)
+	}
+	return result
+}
+
+func (q *remoteQuerier) CreateScanBruteforceShards(ctx context.Context, arg queries.CreateScanBruteforceShardsParams) ([]*queries.ScanBruteforceShard, error) {
+	response, err := q.client.PostScanIdBruteforceShardsWithResponse(ctx, arg.ScanID, generated.CreateBruteforceShards{
+		ScanType:  int(arg.ScanType),
+		Username:  arg.Username,
+		Hash:      arg.Hash,
+		StartId:   arg.StartID,
+		Count:     arg.Count,
+		ShardSize: arg.ShardSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create bruteforce shards: %w", err)
+	}
+
+	slog.DebugContext(ctx, "Got response from server", "response", string(response.Body), "endpoint", "CreateScanBruteforceShards")
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return bruteforceShardsFromAPI(response.JSON200.Shards), nil
+	default:
+		return nil, errors.New("error creating bruteforce shards")
+	}
+}
+
+func (q *remoteQuerier) GetScanBruteforceShards(ctx context.Context, arg queries.GetScanBruteforceShardsParams) ([]*queries.ScanBruteforceShard, error) {
+	response, err := q.client.GetScanIdBruteforceShardsWithResponse(ctx, arg.ScanID, &generated.GetScanIdBruteforceShardsParams{
+		ScanType: int(arg.ScanType),
+		Username: arg.Username,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get bruteforce shards: %w", err)
+	}
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return bruteforceShardsFromAPI(response.JSON200.Shards), nil
+	default:
+		return nil, errors.New("error getting bruteforce shards")
+	}
+}
+
+func (q *remoteQuerier) CancelScanBruteforceShards(ctx context.Context, arg queries.CancelScanBruteforceShardsParams) error {
+	response, err := q.client.DeleteScanIdBruteforceShardsWithResponse(ctx, arg.ScanID, &generated.DeleteScanIdBruteforceShardsParams{
+		ScanType: int(arg.ScanType),
+		Username: arg.Username,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot cancel bruteforce shards: %w", err)
+	}
+
+	switch response.StatusCode() {
+	case http.StatusNoContent:
+		return nil
+	default:
+		return errors.New("error cancelling bruteforce shards")
+	}
+}
+
+var _ bruteforce.ShardQuerier = (*remoteQuerier)(nil)
+
+// updateBruteforceShard reports the progress of a shard claimed by this
+// worker. It returns pgx.ErrNoRows if the shard was cancelled or given to
+// another worker, in which case it should be stopped.
+func (q *remoteQuerier) updateBruteforceShard(ctx context.Context, id int64, status bruteforce.BruteforceUserStatus, finished bool) error {
+	body := generated.PatchBruteforceShard{
+		Tried:    status.Tried,
+		NextId:   status.MaximumInternalID + 1,
+		Finished: finished,
+	}
+	if status.FoundPassword != "" {
+		body.Password = &status.FoundPassword
+		body.NextId = status.MaximumInternalID
+	}
+	return q.patchBruteforceShard(ctx, id, body)
+}
+
+// failBruteforceShard marks a shard claimed by this worker as failed, with
+// the last progress it reported, so that the worker that created it runs the
+// rest of it.
+func (q *remoteQuerier) failBruteforceShard(ctx context.Context, id int64, status bruteforce.BruteforceUserStatus) error {
+	failed := true
+	return q.patchBruteforceShard(ctx, id, generated.PatchBruteforceShard{
+		Tried:  status.Tried,
+		NextId: status.MaximumInternalID + 1,
+		Failed: &failed,
+	})
+}
+
+func (q *remoteQuerier) patchBruteforceShard(ctx context.Context, id int64, body generated.PatchBruteforceShard) error {
+	response, err := q.client.PatchBruteforceShardsIdWithResponse(ctx, id, body)
+	if err != nil {
+		return fmt.Errorf("cannot update bruteforce shard: %w", err)
+	}
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return pgx.ErrNoRows
+	default:
+		return errors.New("error updating bruteforce shard")
+	}
+}
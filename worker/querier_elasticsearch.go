@@ -0,0 +1,85 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/tedyst/licenta/api/v1/generated"
+	"github.com/tedyst/licenta/db/queries"
+	"github.com/tedyst/licenta/saver"
+)
+
+func (q *remoteQuerier) GetElasticsearchDatabase(ctx context.Context, r queries.GetElasticsearchDatabaseParams) (*queries.GetElasticsearchDatabaseRow, error) {
+	response, err := q.client.GetElasticsearchIdWithResponse(ctx, r.ID)
+	if err != nil {
+		return nil, errors.New("cannot get Elasticsearch database from server")
+	}
+
+	slog.DebugContext(ctx, "Got response from server", "response", string(response.Body))
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return &queries.GetElasticsearchDatabaseRow{
+			ID:        int64(response.JSON200.ElasticsearchDatabase.Id),
+			ProjectID: int64(response.JSON200.ElasticsearchDatabase.ProjectId),
+			Host:      response.JSON200.ElasticsearchDatabase.Host,
+			Port:      int32(response.JSON200.ElasticsearchDatabase.Port),
+			Username:  response.JSON200.ElasticsearchDatabase.Username,
+			Password:  response.JSON200.ElasticsearchDatabase.Password,
+			Version: sql.NullString{
+				String: response.JSON200.ElasticsearchDatabase.Version,
+				Valid:  response.JSON200.ElasticsearchDatabase.Version != "",
+			},
+		}, nil
+	default:
+		return nil, errors.New("error getting Elasticsearch database")
+	}
+}
+
+func (q *remoteQuerier) UpdateElasticsearchVersion(ctx context.Context, params queries.UpdateElasticsearchVersionParams) error {
+	response, err := q.client.PatchElasticsearchIdWithResponse(ctx, params.ID, generated.PatchElasticsearchDatabase{
+		Version: &params.Version.String,
+	})
+	if err != nil {
+		return err
+	}
+
+	slog.DebugContext(ctx, "Got response from server", "response", string(response.Body))
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return nil
+	default:
+		return errors.New("error updating Elasticsearch version")
+	}
+}
+
+func (q *remoteQuerier) GetElasticsearchScanByScanID(ctx context.Context, scanID int64) (*queries.ElasticsearchScan, error) {
+	response, err := q.client.GetElasticsearchScansWithResponse(ctx, &generated.GetElasticsearchScansParams{
+		Scan: scanID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "Got response from server", "response", string(response.Body))
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return &queries.ElasticsearchScan{
+			ID:         int64(response.JSON200.Scans[0].Id),
+			ScanID:     scanID,
+			DatabaseID: int64(response.JSON200.Scans[0].DatabaseId),
+		}, nil
+	case http.StatusNotFound:
+		return nil, pgx.ErrNoRows
+	default:
+		return nil, errors.New("error getting Elasticsearch scan")
+	}
+}
+
+var _ saver.ElasticsearchQuerier = &remoteQuerier{}
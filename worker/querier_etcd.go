@@ -0,0 +1,85 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/tedyst/licenta/api/v1/generated"
+	"github.com/tedyst/licenta/db/queries"
+	"github.com/tedyst/licenta/saver"
+)
+
+func (q *remoteQuerier) GetEtcdDatabase(ctx context.Context, r queries.GetEtcdDatabaseParams) (*queries.GetEtcdDatabaseRow, error) {
+	response, err := q.client.GetEtcdIdWithResponse(ctx, r.ID)
+	if err != nil {
+		return nil, errors.New("cannot get Etcd database from server")
+	}
+
+	slog.DebugContext(ctx, "Got response from server", "response", string(response.Body))
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return &queries.GetEtcdDatabaseRow{
+			ID:        int64(response.JSON200.EtcdDatabase.Id),
+			ProjectID: int64(response.JSON200.EtcdDatabase.ProjectId),
+			Host:      response.JSON200.EtcdDatabase.Host,
+			Port:      int32(response.JSON200.EtcdDatabase.Port),
+			Username:  response.JSON200.EtcdDatabase.Username,
+			Password:  response.JSON200.EtcdDatabase.Password,
+			Version: sql.NullString{
+				String: response.JSON200.EtcdDatabase.Version,
+				Valid:  response.JSON200.EtcdDatabase.Version != "",
+			},
+		}, nil
+	default:
+		return nil, errors.New("error getting Etcd database")
+	}
+}
+
+func (q *remoteQuerier) UpdateEtcdVersion(ctx context.Context, params queries.UpdateEtcdVersionParams) error {
+	response, err := q.client.PatchEtcdIdWithResponse(ctx, params.ID, generated.PatchEtcdDatabase{
+		Version: &params.Version.String,
+	})
+	if err != nil {
+		return err
+	}
+
+	slog.DebugContext(ctx, "Got response from server", "response", string(response.Body))
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return nil
+	default:
+		return errors.New("error updating Etcd version")
+	}
+}
+
+func (q *remoteQuerier) GetEtcdScanByScanID(ctx context.Context, scanID int64) (*queries.EtcdScan, error) {
+	response, err := q.client.GetEtcdScansWithResponse(ctx, &generated.GetEtcdScansParams{
+		Scan: scanID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "Got response from server", "response", string(response.Body))
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return &queries.EtcdScan{
+			ID:         int64(response.JSON200.Scans[0].Id),
+			ScanID:     scanID,
+			DatabaseID: int64(response.JSON200.Scans[0].DatabaseId),
+		}, nil
+	case http.StatusNotFound:
+		return nil, pgx.ErrNoRows
+	default:
+		return nil, errors.New("error getting Etcd scan")
+	}
+}
+
+var _ saver.EtcdQuerier = &remoteQuerier{}
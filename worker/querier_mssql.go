@@ -0,0 +1,86 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/tedyst/licenta/api/v1/generated"
+	"github.com/tedyst/licenta/db/queries"
+	"github.com/tedyst/licenta/saver"
+)
+
+func (q *remoteQuerier) GetMssqlDatabase(ctx context.Context, r queries.GetMssqlDatabaseParams) (*queries.GetMssqlDatabaseRow, error) {
+	response, err := q.client.GetMssqlIdWithResponse(ctx, r.ID)
+	if err != nil {
+		return nil, errors.New("cannot get Mssql database from server")
+	}
+
+	slog.DebugContext(ctx, "Got response from server", "response", string(response.Body))
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return &queries.GetMssqlDatabaseRow{
+			ID:           int64(response.JSON200.MssqlDatabase.Id),
+			ProjectID:    int64(response.JSON200.MssqlDatabase.ProjectId),
+			Host:         response.JSON200.MssqlDatabase.Host,
+			Port:         int32(response.JSON200.MssqlDatabase.Port),
+			DatabaseName: response.JSON200.MssqlDatabase.DatabaseName,
+			Username:     response.JSON200.MssqlDatabase.Username,
+			Password:     response.JSON200.MssqlDatabase.Password,
+			Version: sql.NullString{
+				String: response.JSON200.MssqlDatabase.Version,
+				Valid:  response.JSON200.MssqlDatabase.Version != "",
+			},
+		}, nil
+	default:
+		return nil, errors.New("error getting Mssql database")
+	}
+}
+
+func (q *remoteQuerier) UpdateMssqlVersion(ctx context.Context, params queries.UpdateMssqlVersionParams) error {
+	response, err := q.client.PatchMssqlIdWithResponse(ctx, params.ID, generated.PatchMssqlDatabase{
+		Version: &params.Version.String,
+	})
+	if err != nil {
+		return err
+	}
+
+	slog.DebugContext(ctx, "Got response from server", "response", string(response.Body))
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return nil
+	default:
+		return errors.New("error updating Mssql version")
+	}
+}
+
+func (q *remoteQuerier) GetMssqlScanByScanID(ctx context.Context, scanID int64) (*queries.MssqlScan, error) {
+	response, err := q.client.GetMssqlScansWithResponse(ctx, &generated.GetMssqlScansParams{
+		Scan: scanID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "Got response from server", "response", string(response.Body))
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return &queries.MssqlScan{
+			ID:         int64(response.JSON200.Scans[0].Id),
+			ScanID:     scanID,
+			DatabaseID: int64(response.JSON200.Scans[0].DatabaseId),
+		}, nil
+	case http.StatusNotFound:
+		return nil, pgx.ErrNoRows
+	default:
+		return nil, errors.New("error getting Mssql scan")
+	}
+}
+
+var _ saver.MssqlQuerier = &remoteQuerier{}
@@ -55,11 +55,22 @@ func ReceiveTasks(ctx context.Context, client generated.ClientWithResponsesInter
 				scan:      &scan,
 				scanGroup: &scanGroup,
 			}
-			passProvider := bruteforce.NewDatabaseBruteforceProvider(database)
+			passProvider, err := bruteforce.NewConfiguredBruteforceProvider(database)
+			if err != nil {
+				return err
+			}
+
+			if task.JSON200.BruteforceShard != nil {
+				shard := bruteforceShardFromAPI(*task.JSON200.BruteforceShard)
+				if err := runShard(ctx, database, passProvider, shard); err != nil {
+					slog.ErrorContext(ctx, "Error running bruteforce shard", "error", err, "shard", shard.ID)
+				}
+				continue
+			}
 
 			runner := local.NewSaverRunner(database, localExchange, passProvider, viper.GetString("db-encryption-salt"))
 
-			err := runner.RunSaverRemote(ctx, &scan, "all")
+			err = runner.RunSaverRemote(ctx, &scan, "all")
 			if err != nil {
 				slog.ErrorContext(ctx, "Error running saver", "error", err)
 			}
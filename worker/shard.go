@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/tedyst/licenta/bruteforce"
+	"github.com/tedyst/licenta/db/queries"
+)
+
+// runShard runs a bruteforce shard claimed by this worker and reports its
+// progress to the server, until it finishes or the server cancels it. If the
+// shard cannot be run, it is marked as failed, so that the worker that
+// created it runs it instead.
+func runShard(ctx context.Context, q *remoteQuerier, provider bruteforce.BruteforceProvider, shard *queries.ScanBruteforceShard) error {
+	status := bruteforce.BruteforceUserStatus{
+		Tried:             shard.Tried,
+		MaximumInternalID: shard.NextID - 1,
+	}
+	err := runClaimedShard(ctx, q, provider, shard, &status)
+	if err == nil {
+		return nil
+	}
+	if failErr := q.failBruteforceShard(context.WithoutCancel(ctx), shard.ID, status); failErr != nil && !errors.Is(failErr, pgx.ErrNoRows) {
+		return errors.Join(err, fmt.Errorf("could not mark bruteforce shard as failed: %w", failErr))
+	}
+	return err
+}
+
+// runClaimedShard runs shard and stores its last reported progress in
+// status.
+func runClaimedShard(ctx context.Context, q *remoteQuerier, provider bruteforce.BruteforceProvider, shard *queries.ScanBruteforceShard, status *bruteforce.BruteforceUserStatus) error {
+	runner, ok := provider.(bruteforce.ShardRunner)
+	if !ok {
+		return errors.New("bruteforce provider cannot run shards")
+	}
+	user, err := bruteforce.ShardUser(shard)
+	if err != nil {
+		return fmt.Errorf("could not create user of shard: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Running bruteforce shard", "shard", shard.ID, "user", shard.Username, "start", shard.NextID, "end", shard.EndID)
+
+	result, err := runner.RunShard(ctx, user, q.scanGroup.ProjectID, shard, func(progress bruteforce.BruteforceUserStatus) error {
+		if err := q.updateBruteforceShard(ctx, shard.ID, progress, false); err != nil {
+			return err
+		}
+		*status = progress
+		return nil
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		slog.InfoContext(ctx, "Bruteforce shard was cancelled", "shard", shard.ID)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not run bruteforce shard: %w", err)
+	}
+
+	err = q.updateBruteforceShard(ctx, shard.ID, result, true)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	return err
+}